@@ -40,6 +40,8 @@ var (
 type InitFunc func(kv kvdb.Kvdb, clusterID string) (Alert, error)
 
 // AlertWatcherFunc is a function type used as a callback for KV WatchTree.
+// On delete only the resource type and id of the alert are provided.
+// Returning an error from the callback stops the watch.
 type AlertWatcherFunc func(*api.Alert, api.AlertActionType, string, string) error
 
 // Alert interface for Alert API.
@@ -93,6 +95,11 @@ type Alert interface {
 	// options provided while creating the alertClient object to access this
 	// cluster
 	Watch(clusterID string, alertWatcher AlertWatcherFunc) error
+
+	// WatchUntil is the same as Watch except that the watcher is no longer
+	// called once stop is closed. The underlying watch may only end on the
+	// next alert event.
+	WatchUntil(clusterID string, alertWatcher AlertWatcherFunc, stop <-chan struct{}) error
 }
 
 // Shutdown the alert instance.
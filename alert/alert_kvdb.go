@@ -36,11 +36,11 @@ const (
 const (
 	watchReady = iota
 	watchError
+	watchStopped
 )
 
 var (
 	kvdbMap     = make(map[string]kvdb.Kvdb)
-	watcherMap  = make(map[string]*watcher)
 	watchErrors int
 	kvdbLock    sync.RWMutex
 )
@@ -210,21 +210,51 @@ func (kva *KvAlert) EnumerateWithinTimeRange(
 // This way we ensure that the caller of the api is able to watch alerts on clusters that
 // it is authorized for.
 func (kva *KvAlert) Watch(clusterID string, alertWatcherFunc AlertWatcherFunc) error {
+	_, err := kva.watch(clusterID, alertWatcherFunc)
+	return err
+}
 
-	kv, err := kva.getKvdbForCluster(clusterID)
+// WatchUntil watches the alerts of the cluster until stop is closed.
+// The watcher is not called once stop is closed. kvdb only ends a watch
+// when its callback returns an error though, so the kvdb watch itself
+// only ends on the next alert event of the cluster.
+func (kva *KvAlert) WatchUntil(
+	clusterID string,
+	alertWatcherFunc AlertWatcherFunc,
+	stop <-chan struct{},
+) error {
+	w, err := kva.watch(clusterID, alertWatcherFunc)
 	if err != nil {
 		return err
 	}
+	go func() {
+		<-stop
+		lock.Lock()
+		w.status = watchStopped
+		lock.Unlock()
+	}()
+	return nil
+}
 
-	alertWatcher := &watcher{status: watchReady, cb: alertWatcherFunc, kvcb: kvdbWatch, kvdb: kv}
-	watcherKey := clusterID
-	watcherMap[watcherKey] = alertWatcher
+func (kva *KvAlert) watch(clusterID string, alertWatcherFunc AlertWatcherFunc) (*watcher, error) {
+	kv, err := kva.getKvdbForCluster(clusterID)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := subscribeWatch(watcherKey); err != nil {
-		return err
+	alertWatcher := &watcher{
+		status:    watchReady,
+		cb:        alertWatcherFunc,
+		kvcb:      kvdbWatch,
+		clusterID: clusterID,
+		kvdb:      kv,
 	}
 
-	return nil
+	if err := subscribeWatch(alertWatcher); err != nil {
+		return nil, err
+	}
+
+	return alertWatcher, nil
 }
 
 // Shutdown shutdown
@@ -469,19 +499,23 @@ func (kva *KvAlert) getKvdbForCluster(clusterID string) (kvdb.Kvdb, error) {
 	return kv, nil
 }
 
-func processWatchError(err error, watcherKey string, prefix string) error {
-	logrus.Errorf("Alert Watch error for key %v: %v", watcherKey, err)
-	w := watcherMap[watcherKey]
+func processWatchError(err error, w *watcher, prefix string) error {
+	if w.status == watchStopped {
+		// The watcher asked for the watch to be stopped
+		logrus.Infof("Alert Watch stopped for cluster %v", w.clusterID)
+		return err
+	}
+	logrus.Errorf("Alert Watch error for cluster %v: %v", w.clusterID, err)
 	w.status = watchError
 	if w.watchErrors == 5 {
-		logrus.Warnf("Too many watch errors for key (%v). Error: %s. Stopping the watch!!", watcherKey, err.Error())
+		logrus.Warnf("Too many watch errors for cluster (%v). Error: %s. Stopping the watch!!", w.clusterID, err.Error())
 		w.cb(nil, api.AlertActionType_ALERT_ACTION_TYPE_NONE, prefix, "")
 		// Too many watch errors. Stop the watch
 		return err
 	}
 	w.watchErrors++
-	if err := subscribeWatch(watcherKey); err != nil {
-		logrus.Warnf("Failed to resubscribe alert watch for key: %s: %s", watcherKey, err.Error())
+	if err := subscribeWatch(w); err != nil {
+		logrus.Warnf("Failed to resubscribe alert watch for cluster: %s: %s", w.clusterID, err.Error())
 	}
 	return err
 }
@@ -490,10 +524,16 @@ func kvdbWatch(prefix string, opaque interface{}, kvp *kvdb.KVPair, err error) e
 	lock.Lock()
 	defer lock.Unlock()
 
-	watcherKey := strings.Split(prefix, "/")[1]
+	w, ok := opaque.(*watcher)
+	if !ok {
+		return fmt.Errorf("Unknown alert watcher for prefix %v", prefix)
+	}
 
 	if err != nil {
-		return processWatchError(err, watcherKey, prefix)
+		return processWatchError(err, w, prefix)
+	}
+	if w.status == watchStopped {
+		return kvdb.ErrWatchStopped
 	}
 
 	if strings.HasSuffix(kvp.Key, nextAlertIDKey) || strings.Contains(kvp.Key, subscriptionsKey) {
@@ -501,12 +541,10 @@ func kvdbWatch(prefix string, opaque interface{}, kvp *kvdb.KVPair, err error) e
 		// Todo : Add a map of ignore keys
 		return nil
 	}
-	w := watcherMap[watcherKey]
 	w.watchErrors = 0
 
 	if kvp.Action == kvdb.KVDelete {
-		err = w.cb(nil, api.AlertActionType_ALERT_ACTION_TYPE_DELETE, prefix, kvp.Key)
-		return err
+		return w.notify(alertFromKey(kvp.Key), api.AlertActionType_ALERT_ACTION_TYPE_DELETE, prefix, kvp.Key)
 	}
 
 	var alert api.Alert
@@ -516,25 +554,71 @@ func kvdbWatch(prefix string, opaque interface{}, kvp *kvdb.KVPair, err error) e
 
 	switch kvp.Action {
 	case kvdb.KVCreate:
-		err = w.cb(&alert, api.AlertActionType_ALERT_ACTION_TYPE_CREATE, prefix, kvp.Key)
+		err = w.notify(&alert, api.AlertActionType_ALERT_ACTION_TYPE_CREATE, prefix, kvp.Key)
 	case kvdb.KVSet:
-		err = w.cb(&alert, api.AlertActionType_ALERT_ACTION_TYPE_UPDATE, prefix, kvp.Key)
+		err = w.notify(&alert, api.AlertActionType_ALERT_ACTION_TYPE_UPDATE, prefix, kvp.Key)
 	default:
 		err = fmt.Errorf("Unhandled KV Action")
 	}
 	return err
 }
 
-func subscribeWatch(key string) error {
-	// Always set the watchIndex to 0
-	watchIndex := 0
-	w, ok := watcherMap[key]
-	if !ok {
-		return fmt.Errorf("Failed to find a watch on cluster : %v", key)
+// notify calls the watcher callback. If the callback returns an error
+// the watch is stopped.
+func (w *watcher) notify(
+	alert *api.Alert,
+	action api.AlertActionType,
+	prefix string,
+	key string,
+) error {
+	if err := w.cb(alert, action, prefix, key); err != nil {
+		w.status = watchStopped
+		return err
+	}
+	return nil
+}
+
+// alertFromKey returns an alert with only the resource type and id set
+// from the kvdb key of the alert. It returns nil if the key is not
+// the key of an alert.
+func alertFromKey(key string) *api.Alert {
+	i := strings.LastIndex(key, alertKey)
+	if i < 0 {
+		return nil
+	}
+	parts := strings.Split(key[i+len(alertKey):], "/")
+	if len(parts) != 2 {
+		return nil
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	var resource api.ResourceType
+	switch parts[0] + "/" {
+	case volumeKey:
+		resource = api.ResourceType_RESOURCE_TYPE_VOLUME
+	case nodeKey:
+		resource = api.ResourceType_RESOURCE_TYPE_NODE
+	case clusterKey:
+		resource = api.ResourceType_RESOURCE_TYPE_CLUSTER
+	case driveKey:
+		resource = api.ResourceType_RESOURCE_TYPE_DRIVE
+	default:
+		return nil
 	}
 
-	kv := w.kvdb
-	if err := kv.WatchTree(alertKey, uint64(watchIndex), nil, w.kvcb); err != nil {
+	return &api.Alert{
+		Id:       id,
+		Resource: resource,
+	}
+}
+
+func subscribeWatch(w *watcher) error {
+	// Always set the watchIndex to 0
+	watchIndex := 0
+	if err := w.kvdb.WatchTree(alertKey, uint64(watchIndex), w, w.kvcb); err != nil {
 		return err
 	}
 	return nil
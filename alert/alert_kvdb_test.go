@@ -1,6 +1,7 @@
 package alert
 
 import (
+	"fmt"
 	"strconv"
 	"sync"
 	"testing"
@@ -40,6 +41,8 @@ func TestAll(t *testing.T) {
 	clearWithTTL(t)
 	enumerate(t)
 	watch(t)
+	watchStop(t)
+	watchUntil(t)
 }
 
 func setup(t *testing.T) {
@@ -448,3 +451,100 @@ func watch(t *testing.T) {
 
 	err = kva.Erase(api.ResourceType_RESOURCE_TYPE_NODE, raiseAlertNew.Id)
 }
+
+func watchStop(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		stopCalls int
+		actions   = make(map[string][]api.AlertActionType)
+		deleted   = make(map[string]*api.Alert)
+	)
+
+	// This watcher stops after the first event
+	err := kva.Watch(clusterName, func(alert *api.Alert, action api.AlertActionType, prefix string, key string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		stopCalls++
+		return fmt.Errorf("stop")
+	})
+	require.NoError(t, err, "Failed to subscribe a watch function")
+
+	// This watcher keeps running
+	err = kva.Watch(clusterName, func(alert *api.Alert, action api.AlertActionType, prefix string, key string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		actions[key] = append(actions[key], action)
+		if action == api.AlertActionType_ALERT_ACTION_TYPE_DELETE {
+			deleted[key] = alert
+		}
+		return nil
+	})
+	require.NoError(t, err, "Failed to subscribe a watch function")
+
+	raiseAlert := api.Alert{
+		Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		Severity: api.SeverityType_SEVERITY_TYPE_WARNING,
+	}
+	err = kva.Raise(&raiseAlert)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond * 100)
+
+	err = kva.Erase(api.ResourceType_RESOURCE_TYPE_VOLUME, raiseAlert.Id)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond * 100)
+
+	mu.Lock()
+	defer mu.Unlock()
+	key := "alert/volume/" + strconv.FormatInt(raiseAlert.Id, 10)
+	require.Equal(t, 1, stopCalls, "Stopped watcher must only be called once")
+	require.Equal(t,
+		[]api.AlertActionType{
+			api.AlertActionType_ALERT_ACTION_TYPE_CREATE,
+			api.AlertActionType_ALERT_ACTION_TYPE_DELETE,
+		},
+		actions[key],
+		"Watcher must be called on create and delete")
+	require.NotNil(t, deleted[key], "Deleted alert must be provided")
+	require.Equal(t, raiseAlert.Id, deleted[key].Id, "alert id mismatch")
+	require.Equal(t, api.ResourceType_RESOURCE_TYPE_VOLUME, deleted[key].Resource, "resource mismatch")
+}
+
+func watchUntil(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls = make(map[string]int)
+	)
+	stop := make(chan struct{})
+	err := kva.WatchUntil(clusterName, func(alert *api.Alert, action api.AlertActionType, prefix string, key string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[key]++
+		return nil
+	}, stop)
+	require.NoError(t, err, "Failed to subscribe a watch function")
+
+	raiseAlert := api.Alert{
+		Resource: api.ResourceType_RESOURCE_TYPE_NODE,
+		Severity: api.SeverityType_SEVERITY_TYPE_NOTIFY,
+	}
+	err = kva.Raise(&raiseAlert)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond * 100)
+	key := "alert/node/" + strconv.FormatInt(raiseAlert.Id, 10)
+	mu.Lock()
+	require.Equal(t, 1, calls[key], "Watcher must be called on create")
+	mu.Unlock()
+
+	// The watcher is not called once stopped, and stopping it does not
+	// write any key seen by the other watchers
+	close(stop)
+	time.Sleep(time.Millisecond * 100)
+	_, err = kva.GetKvdbInstance().Get(alertKey + "watchStop")
+	require.Equal(t, kvdb.ErrNotFound, err, "Stopping a watch must not write to kvdb")
+	err = kva.Erase(api.ResourceType_RESOURCE_TYPE_NODE, raiseAlert.Id)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond * 100)
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, 1, calls[key], "Stopped watcher must not be called")
+}
@@ -101,6 +101,25 @@ const (
 	OptBkupOpState = "OpState"
 	// OptBackupSchedUUID is the UUID of the backup-schedule
 	OptBackupSchedUUID = "BkupSchedUUID"
+	// OptLabelSelector query parameter used to lookup volumes by label
+	// requirements in the syntax of ParseLabelSelector
+	OptLabelSelector = "LabelSelector"
+	// OptState query parameter used to lookup volumes by state
+	OptState = "State"
+	// OptStatus query parameter used to lookup volumes by status
+	OptStatus = "Status"
+	// OptOwner query parameter used to lookup volumes by owner
+	OptOwner = "Owner"
+	// OptCreatedAfter query parameter used to lookup volumes created
+	// after a time in RFC3339 format
+	OptCreatedAfter = "CreatedAfter"
+	// OptCreatedBefore query parameter used to lookup volumes created
+	// before a time in RFC3339 format
+	OptCreatedBefore = "CreatedBefore"
+	// OptPageToken query parameter used to request the next page of volumes
+	OptPageToken = "PageToken"
+	// OptMaxResults query parameter used to limit the number of volumes returned
+	OptMaxResults = "MaxResults"
 )
 
 // Api clientserver Constants
@@ -110,6 +129,9 @@ const (
 	OsdCredsPath    = "osd-creds"
 	OsdBackupPath   = "osd-backup"
 	TimeLayout      = "Jan 2 15:04:05 UTC 2006"
+	// NextPageTokenHeader is the response header holding the token of
+	// the next page of a paginated enumeration
+	NextPageTokenHeader = "X-Next-Page-Token"
 )
 
 const (
@@ -631,6 +653,7 @@ func (s *VolumeSpec) Copy() *VolumeSpec {
 		spec.ReplicaSet = &ReplicaSet{Nodes: make([]string, len(s.ReplicaSet.Nodes))}
 		copy(spec.ReplicaSet.Nodes, s.ReplicaSet.Nodes)
 	}
+	spec.Ownership = s.Ownership.Copy()
 	return &spec
 }
 
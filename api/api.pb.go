@@ -85,7 +85,7 @@ func (x Status) String() string {
 	return proto.EnumName(Status_name, int32(x))
 }
 func (Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{0}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{0}
 }
 
 type DriverType int32
@@ -120,7 +120,7 @@ func (x DriverType) String() string {
 	return proto.EnumName(DriverType_name, int32(x))
 }
 func (DriverType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{1}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{1}
 }
 
 type FSType int32
@@ -161,7 +161,7 @@ func (x FSType) String() string {
 	return proto.EnumName(FSType_name, int32(x))
 }
 func (FSType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{2}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{2}
 }
 
 type GraphDriverChangeType int32
@@ -190,7 +190,7 @@ func (x GraphDriverChangeType) String() string {
 	return proto.EnumName(GraphDriverChangeType_name, int32(x))
 }
 func (GraphDriverChangeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{3}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{3}
 }
 
 type SeverityType int32
@@ -219,7 +219,7 @@ func (x SeverityType) String() string {
 	return proto.EnumName(SeverityType_name, int32(x))
 }
 func (SeverityType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{4}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{4}
 }
 
 type ResourceType int32
@@ -251,7 +251,7 @@ func (x ResourceType) String() string {
 	return proto.EnumName(ResourceType_name, int32(x))
 }
 func (ResourceType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{5}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{5}
 }
 
 type AlertActionType int32
@@ -280,7 +280,7 @@ func (x AlertActionType) String() string {
 	return proto.EnumName(AlertActionType_name, int32(x))
 }
 func (AlertActionType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{6}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{6}
 }
 
 type VolumeActionParam int32
@@ -308,7 +308,7 @@ func (x VolumeActionParam) String() string {
 	return proto.EnumName(VolumeActionParam_name, int32(x))
 }
 func (VolumeActionParam) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{7}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{7}
 }
 
 type CosType int32
@@ -337,7 +337,7 @@ func (x CosType) String() string {
 	return proto.EnumName(CosType_name, int32(x))
 }
 func (CosType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{8}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{8}
 }
 
 type IoProfile int32
@@ -369,7 +369,7 @@ func (x IoProfile) String() string {
 	return proto.EnumName(IoProfile_name, int32(x))
 }
 func (IoProfile) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{9}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{9}
 }
 
 // VolumeState represents the state of a volume.
@@ -427,7 +427,7 @@ func (x VolumeState) String() string {
 	return proto.EnumName(VolumeState_name, int32(x))
 }
 func (VolumeState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{10}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{10}
 }
 
 // VolumeStatus represents a health status for a volume.
@@ -465,7 +465,7 @@ func (x VolumeStatus) String() string {
 	return proto.EnumName(VolumeStatus_name, int32(x))
 }
 func (VolumeStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{11}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{11}
 }
 
 type StorageMedium int32
@@ -494,7 +494,7 @@ func (x StorageMedium) String() string {
 	return proto.EnumName(StorageMedium_name, int32(x))
 }
 func (StorageMedium) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{12}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{12}
 }
 
 type ClusterNotify int32
@@ -515,7 +515,7 @@ func (x ClusterNotify) String() string {
 	return proto.EnumName(ClusterNotify_name, int32(x))
 }
 func (ClusterNotify) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{13}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{13}
 }
 
 type AttachState int32
@@ -544,7 +544,7 @@ func (x AttachState) String() string {
 	return proto.EnumName(AttachState_name, int32(x))
 }
 func (AttachState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{14}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{14}
 }
 
 type OperationFlags int32
@@ -571,7 +571,72 @@ func (x OperationFlags) String() string {
 	return proto.EnumName(OperationFlags_name, int32(x))
 }
 func (OperationFlags) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{15}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{15}
+}
+
+// OwnershipAccessType is the access to a resource granted by its owner
+type OwnershipAccessType int32
+
+const (
+	// Read access only
+	OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ OwnershipAccessType = 0
+	// Read and write access, for example to mount or snapshot a volume
+	OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE OwnershipAccessType = 1
+	// Write access plus permission to delete the resource and change its ownership
+	OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_ADMIN OwnershipAccessType = 2
+)
+
+var OwnershipAccessType_name = map[int32]string{
+	0: "OWNERSHIP_ACCESS_TYPE_READ",
+	1: "OWNERSHIP_ACCESS_TYPE_WRITE",
+	2: "OWNERSHIP_ACCESS_TYPE_ADMIN",
+}
+var OwnershipAccessType_value = map[string]int32{
+	"OWNERSHIP_ACCESS_TYPE_READ":  0,
+	"OWNERSHIP_ACCESS_TYPE_WRITE": 1,
+	"OWNERSHIP_ACCESS_TYPE_ADMIN": 2,
+}
+
+func (x OwnershipAccessType) String() string {
+	return proto.EnumName(OwnershipAccessType_name, int32(x))
+}
+func (OwnershipAccessType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{16}
+}
+
+// LabelSelectorOperator is the relation between a label and the
+// values of a LabelSelectorRequirement
+type LabelSelectorOperator int32
+
+const (
+	// The label is set to one of the values
+	LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN LabelSelectorOperator = 0
+	// The label is not set or is set to none of the values
+	LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_NOT_IN LabelSelectorOperator = 1
+	// The label is set, the values are ignored
+	LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_EXISTS LabelSelectorOperator = 2
+	// The label is not set, the values are ignored
+	LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST LabelSelectorOperator = 3
+)
+
+var LabelSelectorOperator_name = map[int32]string{
+	0: "LABEL_SELECTOR_OPERATOR_IN",
+	1: "LABEL_SELECTOR_OPERATOR_NOT_IN",
+	2: "LABEL_SELECTOR_OPERATOR_EXISTS",
+	3: "LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST",
+}
+var LabelSelectorOperator_value = map[string]int32{
+	"LABEL_SELECTOR_OPERATOR_IN":             0,
+	"LABEL_SELECTOR_OPERATOR_NOT_IN":         1,
+	"LABEL_SELECTOR_OPERATOR_EXISTS":         2,
+	"LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST": 3,
+}
+
+func (x LabelSelectorOperator) String() string {
+	return proto.EnumName(LabelSelectorOperator_name, int32(x))
+}
+func (LabelSelectorOperator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{17}
 }
 
 type SdkCloudBackupOpType int32
@@ -597,7 +662,7 @@ func (x SdkCloudBackupOpType) String() string {
 	return proto.EnumName(SdkCloudBackupOpType_name, int32(x))
 }
 func (SdkCloudBackupOpType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{16}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{18}
 }
 
 type SdkCloudBackupStatusType int32
@@ -638,7 +703,7 @@ func (x SdkCloudBackupStatusType) String() string {
 	return proto.EnumName(SdkCloudBackupStatusType_name, int32(x))
 }
 func (SdkCloudBackupStatusType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{17}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{19}
 }
 
 type SdkCloudBackupRequestedState int32
@@ -667,7 +732,7 @@ func (x SdkCloudBackupRequestedState) String() string {
 	return proto.EnumName(SdkCloudBackupRequestedState_name, int32(x))
 }
 func (SdkCloudBackupRequestedState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{18}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{20}
 }
 
 // StorageResource groups properties of a storage device.
@@ -708,7 +773,7 @@ func (m *StorageResource) Reset()         { *m = StorageResource{} }
 func (m *StorageResource) String() string { return proto.CompactTextString(m) }
 func (*StorageResource) ProtoMessage()    {}
 func (*StorageResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{0}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{0}
 }
 func (m *StorageResource) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageResource.Unmarshal(m, b)
@@ -844,7 +909,7 @@ func (m *StoragePool) Reset()         { *m = StoragePool{} }
 func (m *StoragePool) String() string { return proto.CompactTextString(m) }
 func (*StoragePool) ProtoMessage()    {}
 func (*StoragePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{1}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{1}
 }
 func (m *StoragePool) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StoragePool.Unmarshal(m, b)
@@ -930,7 +995,7 @@ func (m *VolumeLocator) Reset()         { *m = VolumeLocator{} }
 func (m *VolumeLocator) String() string { return proto.CompactTextString(m) }
 func (*VolumeLocator) ProtoMessage()    {}
 func (*VolumeLocator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{2}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{2}
 }
 func (m *VolumeLocator) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeLocator.Unmarshal(m, b)
@@ -982,7 +1047,7 @@ func (m *Source) Reset()         { *m = Source{} }
 func (m *Source) String() string { return proto.CompactTextString(m) }
 func (*Source) ProtoMessage()    {}
 func (*Source) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{3}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{3}
 }
 func (m *Source) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Source.Unmarshal(m, b)
@@ -1031,7 +1096,7 @@ func (m *Group) Reset()         { *m = Group{} }
 func (m *Group) String() string { return proto.CompactTextString(m) }
 func (*Group) ProtoMessage()    {}
 func (*Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{4}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{4}
 }
 func (m *Group) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Group.Unmarshal(m, b)
@@ -1058,6 +1123,265 @@ func (m *Group) GetId() string {
 	return ""
 }
 
+// OwnershipAcls are the access types granted by the owner of a resource
+// to groups and to other users, the collaborators.
+// swagger:model
+type OwnershipAcls struct {
+	// Groups maps group names to the access granted to their members
+	Groups map[string]OwnershipAccessType `protobuf:"bytes,1,rep,name=groups" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=openstorage.api.OwnershipAccessType"`
+	// Collaborators maps user names to the access granted to them
+	Collaborators        map[string]OwnershipAccessType `protobuf:"bytes,2,rep,name=collaborators" json:"collaborators,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=openstorage.api.OwnershipAccessType"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *OwnershipAcls) Reset()         { *m = OwnershipAcls{} }
+func (m *OwnershipAcls) String() string { return proto.CompactTextString(m) }
+func (*OwnershipAcls) ProtoMessage()    {}
+func (*OwnershipAcls) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{5}
+}
+func (m *OwnershipAcls) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OwnershipAcls.Unmarshal(m, b)
+}
+func (m *OwnershipAcls) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OwnershipAcls.Marshal(b, m, deterministic)
+}
+func (dst *OwnershipAcls) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipAcls.Merge(dst, src)
+}
+func (m *OwnershipAcls) XXX_Size() int {
+	return xxx_messageInfo_OwnershipAcls.Size(m)
+}
+func (m *OwnershipAcls) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipAcls.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnershipAcls proto.InternalMessageInfo
+
+func (m *OwnershipAcls) GetGroups() map[string]OwnershipAccessType {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *OwnershipAcls) GetCollaborators() map[string]OwnershipAccessType {
+	if m != nil {
+		return m.Collaborators
+	}
+	return nil
+}
+
+// Ownership of a resource. Resources without an owner are accessible to all.
+// swagger:model
+type Ownership struct {
+	// Owner is the user who owns the resource and has full access to it
+	Owner string `protobuf:"bytes,1,opt,name=owner" json:"owner,omitempty"`
+	// Acls grant access to the resource to other users and groups
+	Acls                 *OwnershipAcls `protobuf:"bytes,2,opt,name=acls" json:"acls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Ownership) Reset()         { *m = Ownership{} }
+func (m *Ownership) String() string { return proto.CompactTextString(m) }
+func (*Ownership) ProtoMessage()    {}
+func (*Ownership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{6}
+}
+func (m *Ownership) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Ownership.Unmarshal(m, b)
+}
+func (m *Ownership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Ownership.Marshal(b, m, deterministic)
+}
+func (dst *Ownership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ownership.Merge(dst, src)
+}
+func (m *Ownership) XXX_Size() int {
+	return xxx_messageInfo_Ownership.Size(m)
+}
+func (m *Ownership) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ownership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ownership proto.InternalMessageInfo
+
+func (m *Ownership) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Ownership) GetAcls() *OwnershipAcls {
+	if m != nil {
+		return m.Acls
+	}
+	return nil
+}
+
+// LabelSelectorRequirement selects the resources whose labels
+// satisfy the operator and the values.
+// swagger:model
+type LabelSelectorRequirement struct {
+	// Key of the label
+	Key string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	// Operator relating the label to the values
+	Operator LabelSelectorOperator `protobuf:"varint,2,opt,name=operator,enum=openstorage.api.LabelSelectorOperator" json:"operator,omitempty"`
+	// Values of the label for the in and not in operators
+	Values               []string `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabelSelectorRequirement) Reset()         { *m = LabelSelectorRequirement{} }
+func (m *LabelSelectorRequirement) String() string { return proto.CompactTextString(m) }
+func (*LabelSelectorRequirement) ProtoMessage()    {}
+func (*LabelSelectorRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{7}
+}
+func (m *LabelSelectorRequirement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelSelectorRequirement.Unmarshal(m, b)
+}
+func (m *LabelSelectorRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelSelectorRequirement.Marshal(b, m, deterministic)
+}
+func (dst *LabelSelectorRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelSelectorRequirement.Merge(dst, src)
+}
+func (m *LabelSelectorRequirement) XXX_Size() int {
+	return xxx_messageInfo_LabelSelectorRequirement.Size(m)
+}
+func (m *LabelSelectorRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelSelectorRequirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelSelectorRequirement proto.InternalMessageInfo
+
+func (m *LabelSelectorRequirement) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *LabelSelectorRequirement) GetOperator() LabelSelectorOperator {
+	if m != nil {
+		return m.Operator
+	}
+	return LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN
+}
+
+func (m *LabelSelectorRequirement) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// VolumeFilter selects volumes. Volumes are selected when they
+// match all the fields provided.
+// swagger:model
+type VolumeFilter struct {
+	// Locator selects the volumes with this name, if provided, and
+	// with all these labels
+	Locator *VolumeLocator `protobuf:"bytes,1,opt,name=locator" json:"locator,omitempty"`
+	// LabelSelector selects the volumes whose locator labels satisfy
+	// all the requirements
+	LabelSelector []*LabelSelectorRequirement `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector" json:"label_selector,omitempty"`
+	// State selects the volumes in this state
+	State VolumeState `protobuf:"varint,3,opt,name=state,enum=openstorage.api.VolumeState" json:"state,omitempty"`
+	// Status selects the volumes with this status
+	Status VolumeStatus `protobuf:"varint,4,opt,name=status,enum=openstorage.api.VolumeStatus" json:"status,omitempty"`
+	// Owner selects the volumes owned by this user
+	Owner string `protobuf:"bytes,5,opt,name=owner" json:"owner,omitempty"`
+	// CreatedAfter selects the volumes created after this time
+	CreatedAfter *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_after,json=createdAfter" json:"created_after,omitempty"`
+	// CreatedBefore selects the volumes created before this time
+	CreatedBefore        *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_before,json=createdBefore" json:"created_before,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *VolumeFilter) Reset()         { *m = VolumeFilter{} }
+func (m *VolumeFilter) String() string { return proto.CompactTextString(m) }
+func (*VolumeFilter) ProtoMessage()    {}
+func (*VolumeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{8}
+}
+func (m *VolumeFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VolumeFilter.Unmarshal(m, b)
+}
+func (m *VolumeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VolumeFilter.Marshal(b, m, deterministic)
+}
+func (dst *VolumeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeFilter.Merge(dst, src)
+}
+func (m *VolumeFilter) XXX_Size() int {
+	return xxx_messageInfo_VolumeFilter.Size(m)
+}
+func (m *VolumeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeFilter proto.InternalMessageInfo
+
+func (m *VolumeFilter) GetLocator() *VolumeLocator {
+	if m != nil {
+		return m.Locator
+	}
+	return nil
+}
+
+func (m *VolumeFilter) GetLabelSelector() []*LabelSelectorRequirement {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return nil
+}
+
+func (m *VolumeFilter) GetState() VolumeState {
+	if m != nil {
+		return m.State
+	}
+	return VolumeState_VOLUME_STATE_NONE
+}
+
+func (m *VolumeFilter) GetStatus() VolumeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return VolumeStatus_VOLUME_STATUS_NONE
+}
+
+func (m *VolumeFilter) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *VolumeFilter) GetCreatedAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return nil
+}
+
+func (m *VolumeFilter) GetCreatedBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return nil
+}
+
 // VolumeSpec has the properties needed to create a volume.
 // swagger:model
 type VolumeSpec struct {
@@ -1108,17 +1432,19 @@ type VolumeSpec struct {
 	// Journal is true if data for the volume goes into the journal.
 	Journal bool `protobuf:"varint,25,opt,name=journal" json:"journal,omitempty"`
 	// Sharedv4 is true if this volume can be accessed via sharedv4.
-	Sharedv4             bool     `protobuf:"varint,26,opt,name=sharedv4" json:"sharedv4,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Sharedv4 bool `protobuf:"varint,26,opt,name=sharedv4" json:"sharedv4,omitempty"`
+	// Ownership of the volume. Snapshots inherit the ownership of their parent.
+	Ownership            *Ownership `protobuf:"bytes,27,opt,name=ownership" json:"ownership,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *VolumeSpec) Reset()         { *m = VolumeSpec{} }
 func (m *VolumeSpec) String() string { return proto.CompactTextString(m) }
 func (*VolumeSpec) ProtoMessage()    {}
 func (*VolumeSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{5}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{9}
 }
 func (m *VolumeSpec) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeSpec.Unmarshal(m, b)
@@ -1306,6 +1632,13 @@ func (m *VolumeSpec) GetSharedv4() bool {
 	return false
 }
 
+func (m *VolumeSpec) GetOwnership() *Ownership {
+	if m != nil {
+		return m.Ownership
+	}
+	return nil
+}
+
 // ReplicaSet set of machine IDs (nodes) to which part of this volume is erasure
 // coded - for clustered storage arrays
 // swagger:model
@@ -1320,7 +1653,7 @@ func (m *ReplicaSet) Reset()         { *m = ReplicaSet{} }
 func (m *ReplicaSet) String() string { return proto.CompactTextString(m) }
 func (*ReplicaSet) ProtoMessage()    {}
 func (*ReplicaSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{6}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{10}
 }
 func (m *ReplicaSet) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplicaSet.Unmarshal(m, b)
@@ -1361,7 +1694,7 @@ func (m *RuntimeStateMap) Reset()         { *m = RuntimeStateMap{} }
 func (m *RuntimeStateMap) String() string { return proto.CompactTextString(m) }
 func (*RuntimeStateMap) ProtoMessage()    {}
 func (*RuntimeStateMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{7}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{11}
 }
 func (m *RuntimeStateMap) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuntimeStateMap.Unmarshal(m, b)
@@ -1446,7 +1779,7 @@ func (m *Volume) Reset()         { *m = Volume{} }
 func (m *Volume) String() string { return proto.CompactTextString(m) }
 func (*Volume) ProtoMessage()    {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{8}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{12}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Volume.Unmarshal(m, b)
@@ -1650,7 +1983,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{9}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{13}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Stats.Unmarshal(m, b)
@@ -1772,7 +2105,7 @@ func (m *Alert) Reset()         { *m = Alert{} }
 func (m *Alert) String() string { return proto.CompactTextString(m) }
 func (*Alert) ProtoMessage()    {}
 func (*Alert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{10}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{14}
 }
 func (m *Alert) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Alert.Unmarshal(m, b)
@@ -1875,7 +2208,7 @@ func (m *Alerts) Reset()         { *m = Alerts{} }
 func (m *Alerts) String() string { return proto.CompactTextString(m) }
 func (*Alerts) ProtoMessage()    {}
 func (*Alerts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{11}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{15}
 }
 func (m *Alerts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Alerts.Unmarshal(m, b)
@@ -1902,6 +2235,128 @@ func (m *Alerts) GetAlert() []*Alert {
 	return nil
 }
 
+// AuditEvent records a mutating operation made through the APIs
+// swagger:model
+type AuditEvent struct {
+	// Id uniquely identifies the event
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Timestamp of the operation
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty"`
+	// NodeId is the id of the node which serviced the operation
+	NodeId string `protobuf:"bytes,3,opt,name=node_id,json=nodeId" json:"node_id,omitempty"`
+	// Api through which the operation was requested: sdk or rest
+	Api string `protobuf:"bytes,4,opt,name=api" json:"api,omitempty"`
+	// Operation is the gRPC method or the REST verb and route
+	Operation string `protobuf:"bytes,5,opt,name=operation" json:"operation,omitempty"`
+	// Subject identifies the authenticated caller
+	Subject string `protobuf:"bytes,6,opt,name=subject" json:"subject,omitempty"`
+	// RemoteAddress is the network address of the caller
+	RemoteAddress string `protobuf:"bytes,7,opt,name=remote_address,json=remoteAddress" json:"remote_address,omitempty"`
+	// Request parameters in JSON with the secrets redacted
+	Request string `protobuf:"bytes,8,opt,name=request" json:"request,omitempty"`
+	// Success is true if the operation succeeded
+	Success bool `protobuf:"varint,9,opt,name=success" json:"success,omitempty"`
+	// Error returned by the operation when it failed
+	Error                string   `protobuf:"bytes,10,opt,name=error" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{16}
+}
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (dst *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(dst, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetRemoteAddress() string {
+	if m != nil {
+		return m.RemoteAddress
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *AuditEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // ObjectstoreInfo is a structure that has current objectstore info
 // swagger:model
 type ObjectstoreInfo struct {
@@ -1936,7 +2391,7 @@ func (m *ObjectstoreInfo) Reset()         { *m = ObjectstoreInfo{} }
 func (m *ObjectstoreInfo) String() string { return proto.CompactTextString(m) }
 func (*ObjectstoreInfo) ProtoMessage()    {}
 func (*ObjectstoreInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{12}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{17}
 }
 func (m *ObjectstoreInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectstoreInfo.Unmarshal(m, b)
@@ -2052,7 +2507,7 @@ func (m *VolumeCreateRequest) Reset()         { *m = VolumeCreateRequest{} }
 func (m *VolumeCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*VolumeCreateRequest) ProtoMessage()    {}
 func (*VolumeCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{13}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{18}
 }
 func (m *VolumeCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeCreateRequest.Unmarshal(m, b)
@@ -2110,7 +2565,7 @@ func (m *VolumeResponse) Reset()         { *m = VolumeResponse{} }
 func (m *VolumeResponse) String() string { return proto.CompactTextString(m) }
 func (*VolumeResponse) ProtoMessage()    {}
 func (*VolumeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{14}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{19}
 }
 func (m *VolumeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeResponse.Unmarshal(m, b)
@@ -2159,7 +2614,7 @@ func (m *VolumeCreateResponse) Reset()         { *m = VolumeCreateResponse{} }
 func (m *VolumeCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*VolumeCreateResponse) ProtoMessage()    {}
 func (*VolumeCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{15}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{20}
 }
 func (m *VolumeCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeCreateResponse.Unmarshal(m, b)
@@ -2213,7 +2668,7 @@ func (m *VolumeStateAction) Reset()         { *m = VolumeStateAction{} }
 func (m *VolumeStateAction) String() string { return proto.CompactTextString(m) }
 func (*VolumeStateAction) ProtoMessage()    {}
 func (*VolumeStateAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{16}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{21}
 }
 func (m *VolumeStateAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeStateAction.Unmarshal(m, b)
@@ -2282,7 +2737,7 @@ func (m *VolumeSetRequest) Reset()         { *m = VolumeSetRequest{} }
 func (m *VolumeSetRequest) String() string { return proto.CompactTextString(m) }
 func (*VolumeSetRequest) ProtoMessage()    {}
 func (*VolumeSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{17}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{22}
 }
 func (m *VolumeSetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeSetRequest.Unmarshal(m, b)
@@ -2352,7 +2807,7 @@ func (m *VolumeSetResponse) Reset()         { *m = VolumeSetResponse{} }
 func (m *VolumeSetResponse) String() string { return proto.CompactTextString(m) }
 func (*VolumeSetResponse) ProtoMessage()    {}
 func (*VolumeSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{18}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{23}
 }
 func (m *VolumeSetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeSetResponse.Unmarshal(m, b)
@@ -2402,7 +2857,7 @@ func (m *SnapCreateRequest) Reset()         { *m = SnapCreateRequest{} }
 func (m *SnapCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SnapCreateRequest) ProtoMessage()    {}
 func (*SnapCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{19}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{24}
 }
 func (m *SnapCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SnapCreateRequest.Unmarshal(m, b)
@@ -2460,7 +2915,7 @@ func (m *SnapCreateResponse) Reset()         { *m = SnapCreateResponse{} }
 func (m *SnapCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SnapCreateResponse) ProtoMessage()    {}
 func (*SnapCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{20}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{25}
 }
 func (m *SnapCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SnapCreateResponse.Unmarshal(m, b)
@@ -2502,7 +2957,7 @@ func (m *VolumeInfo) Reset()         { *m = VolumeInfo{} }
 func (m *VolumeInfo) String() string { return proto.CompactTextString(m) }
 func (*VolumeInfo) ProtoMessage()    {}
 func (*VolumeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{21}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{26}
 }
 func (m *VolumeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeInfo.Unmarshal(m, b)
@@ -2574,7 +3029,7 @@ func (m *VolumeConsumer) Reset()         { *m = VolumeConsumer{} }
 func (m *VolumeConsumer) String() string { return proto.CompactTextString(m) }
 func (*VolumeConsumer) ProtoMessage()    {}
 func (*VolumeConsumer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{22}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{27}
 }
 func (m *VolumeConsumer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeConsumer.Unmarshal(m, b)
@@ -2653,7 +3108,7 @@ func (m *GraphDriverChanges) Reset()         { *m = GraphDriverChanges{} }
 func (m *GraphDriverChanges) String() string { return proto.CompactTextString(m) }
 func (*GraphDriverChanges) ProtoMessage()    {}
 func (*GraphDriverChanges) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{23}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{28}
 }
 func (m *GraphDriverChanges) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GraphDriverChanges.Unmarshal(m, b)
@@ -2703,7 +3158,7 @@ func (m *ClusterResponse) Reset()         { *m = ClusterResponse{} }
 func (m *ClusterResponse) String() string { return proto.CompactTextString(m) }
 func (*ClusterResponse) ProtoMessage()    {}
 func (*ClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{24}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{29}
 }
 func (m *ClusterResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClusterResponse.Unmarshal(m, b)
@@ -2743,7 +3198,7 @@ func (m *ActiveRequest) Reset()         { *m = ActiveRequest{} }
 func (m *ActiveRequest) String() string { return proto.CompactTextString(m) }
 func (*ActiveRequest) ProtoMessage()    {}
 func (*ActiveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{25}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{30}
 }
 func (m *ActiveRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ActiveRequest.Unmarshal(m, b)
@@ -2784,7 +3239,7 @@ func (m *ActiveRequests) Reset()         { *m = ActiveRequests{} }
 func (m *ActiveRequests) String() string { return proto.CompactTextString(m) }
 func (*ActiveRequests) ProtoMessage()    {}
 func (*ActiveRequests) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{26}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{31}
 }
 func (m *ActiveRequests) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ActiveRequests.Unmarshal(m, b)
@@ -2832,7 +3287,7 @@ func (m *GroupSnapCreateRequest) Reset()         { *m = GroupSnapCreateRequest{}
 func (m *GroupSnapCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*GroupSnapCreateRequest) ProtoMessage()    {}
 func (*GroupSnapCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{27}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{32}
 }
 func (m *GroupSnapCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupSnapCreateRequest.Unmarshal(m, b)
@@ -2888,7 +3343,7 @@ func (m *GroupSnapCreateResponse) Reset()         { *m = GroupSnapCreateResponse
 func (m *GroupSnapCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*GroupSnapCreateResponse) ProtoMessage()    {}
 func (*GroupSnapCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{28}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{33}
 }
 func (m *GroupSnapCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupSnapCreateResponse.Unmarshal(m, b)
@@ -2959,7 +3414,7 @@ func (m *StorageNode) Reset()         { *m = StorageNode{} }
 func (m *StorageNode) String() string { return proto.CompactTextString(m) }
 func (*StorageNode) ProtoMessage()    {}
 func (*StorageNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{29}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{34}
 }
 func (m *StorageNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageNode.Unmarshal(m, b)
@@ -3089,7 +3544,7 @@ func (m *StorageCluster) Reset()         { *m = StorageCluster{} }
 func (m *StorageCluster) String() string { return proto.CompactTextString(m) }
 func (*StorageCluster) ProtoMessage()    {}
 func (*StorageCluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{30}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{35}
 }
 func (m *StorageCluster) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageCluster.Unmarshal(m, b)
@@ -3149,7 +3604,7 @@ func (m *SdkSchedulePolicyCreateRequest) Reset()         { *m = SdkSchedulePolic
 func (m *SdkSchedulePolicyCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyCreateRequest) ProtoMessage()    {}
 func (*SdkSchedulePolicyCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{31}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{36}
 }
 func (m *SdkSchedulePolicyCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyCreateRequest.Unmarshal(m, b)
@@ -3186,7 +3641,7 @@ func (m *SdkSchedulePolicyCreateResponse) Reset()         { *m = SdkSchedulePoli
 func (m *SdkSchedulePolicyCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyCreateResponse) ProtoMessage()    {}
 func (*SdkSchedulePolicyCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{32}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{37}
 }
 func (m *SdkSchedulePolicyCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyCreateResponse.Unmarshal(m, b)
@@ -3218,7 +3673,7 @@ func (m *SdkSchedulePolicyUpdateRequest) Reset()         { *m = SdkSchedulePolic
 func (m *SdkSchedulePolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyUpdateRequest) ProtoMessage()    {}
 func (*SdkSchedulePolicyUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{33}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{38}
 }
 func (m *SdkSchedulePolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyUpdateRequest.Unmarshal(m, b)
@@ -3255,7 +3710,7 @@ func (m *SdkSchedulePolicyUpdateResponse) Reset()         { *m = SdkSchedulePoli
 func (m *SdkSchedulePolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyUpdateResponse) ProtoMessage()    {}
 func (*SdkSchedulePolicyUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{34}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{39}
 }
 func (m *SdkSchedulePolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyUpdateResponse.Unmarshal(m, b)
@@ -3285,7 +3740,7 @@ func (m *SdkSchedulePolicyEnumerateRequest) Reset()         { *m = SdkSchedulePo
 func (m *SdkSchedulePolicyEnumerateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyEnumerateRequest) ProtoMessage()    {}
 func (*SdkSchedulePolicyEnumerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{35}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{40}
 }
 func (m *SdkSchedulePolicyEnumerateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyEnumerateRequest.Unmarshal(m, b)
@@ -3317,7 +3772,7 @@ func (m *SdkSchedulePolicyEnumerateResponse) Reset()         { *m = SdkScheduleP
 func (m *SdkSchedulePolicyEnumerateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyEnumerateResponse) ProtoMessage()    {}
 func (*SdkSchedulePolicyEnumerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{36}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{41}
 }
 func (m *SdkSchedulePolicyEnumerateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyEnumerateResponse.Unmarshal(m, b)
@@ -3356,7 +3811,7 @@ func (m *SdkSchedulePolicyInspectRequest) Reset()         { *m = SdkSchedulePoli
 func (m *SdkSchedulePolicyInspectRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyInspectRequest) ProtoMessage()    {}
 func (*SdkSchedulePolicyInspectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{37}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{42}
 }
 func (m *SdkSchedulePolicyInspectRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyInspectRequest.Unmarshal(m, b)
@@ -3395,7 +3850,7 @@ func (m *SdkSchedulePolicyInspectResponse) Reset()         { *m = SdkSchedulePol
 func (m *SdkSchedulePolicyInspectResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyInspectResponse) ProtoMessage()    {}
 func (*SdkSchedulePolicyInspectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{38}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{43}
 }
 func (m *SdkSchedulePolicyInspectResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyInspectResponse.Unmarshal(m, b)
@@ -3434,7 +3889,7 @@ func (m *SdkSchedulePolicyDeleteRequest) Reset()         { *m = SdkSchedulePolic
 func (m *SdkSchedulePolicyDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyDeleteRequest) ProtoMessage()    {}
 func (*SdkSchedulePolicyDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{39}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{44}
 }
 func (m *SdkSchedulePolicyDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyDeleteRequest.Unmarshal(m, b)
@@ -3471,7 +3926,7 @@ func (m *SdkSchedulePolicyDeleteResponse) Reset()         { *m = SdkSchedulePoli
 func (m *SdkSchedulePolicyDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicyDeleteResponse) ProtoMessage()    {}
 func (*SdkSchedulePolicyDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{40}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{45}
 }
 func (m *SdkSchedulePolicyDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicyDeleteResponse.Unmarshal(m, b)
@@ -3505,7 +3960,7 @@ func (m *SdkSchedulePolicy) Reset()         { *m = SdkSchedulePolicy{} }
 func (m *SdkSchedulePolicy) String() string { return proto.CompactTextString(m) }
 func (*SdkSchedulePolicy) ProtoMessage()    {}
 func (*SdkSchedulePolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{41}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{46}
 }
 func (m *SdkSchedulePolicy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkSchedulePolicy.Unmarshal(m, b)
@@ -3551,7 +4006,7 @@ func (m *SdkCredentialCreateAzureRequest) Reset()         { *m = SdkCredentialCr
 func (m *SdkCredentialCreateAzureRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialCreateAzureRequest) ProtoMessage()    {}
 func (*SdkCredentialCreateAzureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{42}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{47}
 }
 func (m *SdkCredentialCreateAzureRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialCreateAzureRequest.Unmarshal(m, b)
@@ -3590,7 +4045,7 @@ func (m *SdkCredentialCreateAzureResponse) Reset()         { *m = SdkCredentialC
 func (m *SdkCredentialCreateAzureResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialCreateAzureResponse) ProtoMessage()    {}
 func (*SdkCredentialCreateAzureResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{43}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{48}
 }
 func (m *SdkCredentialCreateAzureResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialCreateAzureResponse.Unmarshal(m, b)
@@ -3629,7 +4084,7 @@ func (m *SdkCredentialCreateGoogleRequest) Reset()         { *m = SdkCredentialC
 func (m *SdkCredentialCreateGoogleRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialCreateGoogleRequest) ProtoMessage()    {}
 func (*SdkCredentialCreateGoogleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{44}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{49}
 }
 func (m *SdkCredentialCreateGoogleRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialCreateGoogleRequest.Unmarshal(m, b)
@@ -3668,7 +4123,7 @@ func (m *SdkCredentialCreateGoogleResponse) Reset()         { *m = SdkCredential
 func (m *SdkCredentialCreateGoogleResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialCreateGoogleResponse) ProtoMessage()    {}
 func (*SdkCredentialCreateGoogleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{45}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{50}
 }
 func (m *SdkCredentialCreateGoogleResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialCreateGoogleResponse.Unmarshal(m, b)
@@ -3707,7 +4162,7 @@ func (m *SdkCredentialCreateAWSRequest) Reset()         { *m = SdkCredentialCrea
 func (m *SdkCredentialCreateAWSRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialCreateAWSRequest) ProtoMessage()    {}
 func (*SdkCredentialCreateAWSRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{46}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{51}
 }
 func (m *SdkCredentialCreateAWSRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialCreateAWSRequest.Unmarshal(m, b)
@@ -3746,7 +4201,7 @@ func (m *SdkCredentialCreateAWSResponse) Reset()         { *m = SdkCredentialCre
 func (m *SdkCredentialCreateAWSResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialCreateAWSResponse) ProtoMessage()    {}
 func (*SdkCredentialCreateAWSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{47}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{52}
 }
 func (m *SdkCredentialCreateAWSResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialCreateAWSResponse.Unmarshal(m, b)
@@ -3793,7 +4248,7 @@ func (m *S3Credential) Reset()         { *m = S3Credential{} }
 func (m *S3Credential) String() string { return proto.CompactTextString(m) }
 func (*S3Credential) ProtoMessage()    {}
 func (*S3Credential) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{48}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{53}
 }
 func (m *S3Credential) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_S3Credential.Unmarshal(m, b)
@@ -3864,7 +4319,7 @@ func (m *AzureCredential) Reset()         { *m = AzureCredential{} }
 func (m *AzureCredential) String() string { return proto.CompactTextString(m) }
 func (*AzureCredential) ProtoMessage()    {}
 func (*AzureCredential) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{49}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{54}
 }
 func (m *AzureCredential) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AzureCredential.Unmarshal(m, b)
@@ -3920,7 +4375,7 @@ func (m *GoogleCredential) Reset()         { *m = GoogleCredential{} }
 func (m *GoogleCredential) String() string { return proto.CompactTextString(m) }
 func (*GoogleCredential) ProtoMessage()    {}
 func (*GoogleCredential) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{50}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{55}
 }
 func (m *GoogleCredential) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GoogleCredential.Unmarshal(m, b)
@@ -3974,7 +4429,7 @@ func (m *SdkCredentialEnumerateAWSRequest) Reset()         { *m = SdkCredentialE
 func (m *SdkCredentialEnumerateAWSRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialEnumerateAWSRequest) ProtoMessage()    {}
 func (*SdkCredentialEnumerateAWSRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{51}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{56}
 }
 func (m *SdkCredentialEnumerateAWSRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialEnumerateAWSRequest.Unmarshal(m, b)
@@ -4013,7 +4468,7 @@ func (m *SdkCredentialEnumerateAWSResponse) Reset()         { *m = SdkCredential
 func (m *SdkCredentialEnumerateAWSResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialEnumerateAWSResponse) ProtoMessage()    {}
 func (*SdkCredentialEnumerateAWSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{52}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{57}
 }
 func (m *SdkCredentialEnumerateAWSResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialEnumerateAWSResponse.Unmarshal(m, b)
@@ -4051,7 +4506,7 @@ func (m *SdkCredentialEnumerateAzureRequest) Reset()         { *m = SdkCredentia
 func (m *SdkCredentialEnumerateAzureRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialEnumerateAzureRequest) ProtoMessage()    {}
 func (*SdkCredentialEnumerateAzureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{53}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{58}
 }
 func (m *SdkCredentialEnumerateAzureRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialEnumerateAzureRequest.Unmarshal(m, b)
@@ -4090,7 +4545,7 @@ func (m *SdkCredentialEnumerateAzureResponse) Reset()         { *m = SdkCredenti
 func (m *SdkCredentialEnumerateAzureResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialEnumerateAzureResponse) ProtoMessage()    {}
 func (*SdkCredentialEnumerateAzureResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{54}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{59}
 }
 func (m *SdkCredentialEnumerateAzureResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialEnumerateAzureResponse.Unmarshal(m, b)
@@ -4128,7 +4583,7 @@ func (m *SdkCredentialEnumerateGoogleRequest) Reset()         { *m = SdkCredenti
 func (m *SdkCredentialEnumerateGoogleRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialEnumerateGoogleRequest) ProtoMessage()    {}
 func (*SdkCredentialEnumerateGoogleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{55}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{60}
 }
 func (m *SdkCredentialEnumerateGoogleRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialEnumerateGoogleRequest.Unmarshal(m, b)
@@ -4167,7 +4622,7 @@ func (m *SdkCredentialEnumerateGoogleResponse) Reset()         { *m = SdkCredent
 func (m *SdkCredentialEnumerateGoogleResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialEnumerateGoogleResponse) ProtoMessage()    {}
 func (*SdkCredentialEnumerateGoogleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{56}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{61}
 }
 func (m *SdkCredentialEnumerateGoogleResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialEnumerateGoogleResponse.Unmarshal(m, b)
@@ -4206,7 +4661,7 @@ func (m *SdkCredentialDeleteRequest) Reset()         { *m = SdkCredentialDeleteR
 func (m *SdkCredentialDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialDeleteRequest) ProtoMessage()    {}
 func (*SdkCredentialDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{57}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{62}
 }
 func (m *SdkCredentialDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialDeleteRequest.Unmarshal(m, b)
@@ -4243,7 +4698,7 @@ func (m *SdkCredentialDeleteResponse) Reset()         { *m = SdkCredentialDelete
 func (m *SdkCredentialDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialDeleteResponse) ProtoMessage()    {}
 func (*SdkCredentialDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{58}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{63}
 }
 func (m *SdkCredentialDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialDeleteResponse.Unmarshal(m, b)
@@ -4275,7 +4730,7 @@ func (m *SdkCredentialValidateRequest) Reset()         { *m = SdkCredentialValid
 func (m *SdkCredentialValidateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialValidateRequest) ProtoMessage()    {}
 func (*SdkCredentialValidateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{59}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{64}
 }
 func (m *SdkCredentialValidateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialValidateRequest.Unmarshal(m, b)
@@ -4312,7 +4767,7 @@ func (m *SdkCredentialValidateResponse) Reset()         { *m = SdkCredentialVali
 func (m *SdkCredentialValidateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCredentialValidateResponse) ProtoMessage()    {}
 func (*SdkCredentialValidateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{60}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{65}
 }
 func (m *SdkCredentialValidateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCredentialValidateResponse.Unmarshal(m, b)
@@ -4348,7 +4803,7 @@ func (m *SdkVolumeMountRequest) Reset()         { *m = SdkVolumeMountRequest{} }
 func (m *SdkVolumeMountRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeMountRequest) ProtoMessage()    {}
 func (*SdkVolumeMountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{61}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{66}
 }
 func (m *SdkVolumeMountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeMountRequest.Unmarshal(m, b)
@@ -4399,7 +4854,7 @@ func (m *SdkVolumeMountResponse) Reset()         { *m = SdkVolumeMountResponse{}
 func (m *SdkVolumeMountResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeMountResponse) ProtoMessage()    {}
 func (*SdkVolumeMountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{62}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{67}
 }
 func (m *SdkVolumeMountResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeMountResponse.Unmarshal(m, b)
@@ -4435,7 +4890,7 @@ func (m *SdkVolumeUnmountRequest) Reset()         { *m = SdkVolumeUnmountRequest
 func (m *SdkVolumeUnmountRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeUnmountRequest) ProtoMessage()    {}
 func (*SdkVolumeUnmountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{63}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{68}
 }
 func (m *SdkVolumeUnmountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeUnmountRequest.Unmarshal(m, b)
@@ -4486,7 +4941,7 @@ func (m *SdkVolumeUnmountResponse) Reset()         { *m = SdkVolumeUnmountRespon
 func (m *SdkVolumeUnmountResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeUnmountResponse) ProtoMessage()    {}
 func (*SdkVolumeUnmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{64}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{69}
 }
 func (m *SdkVolumeUnmountResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeUnmountResponse.Unmarshal(m, b)
@@ -4520,7 +4975,7 @@ func (m *SdkVolumeAttachRequest) Reset()         { *m = SdkVolumeAttachRequest{}
 func (m *SdkVolumeAttachRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeAttachRequest) ProtoMessage()    {}
 func (*SdkVolumeAttachRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{65}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{70}
 }
 func (m *SdkVolumeAttachRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeAttachRequest.Unmarshal(m, b)
@@ -4566,7 +5021,7 @@ func (m *SdkVolumeAttachResponse) Reset()         { *m = SdkVolumeAttachResponse
 func (m *SdkVolumeAttachResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeAttachResponse) ProtoMessage()    {}
 func (*SdkVolumeAttachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{66}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{71}
 }
 func (m *SdkVolumeAttachResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeAttachResponse.Unmarshal(m, b)
@@ -4605,7 +5060,7 @@ func (m *SdkVolumeDetachRequest) Reset()         { *m = SdkVolumeDetachRequest{}
 func (m *SdkVolumeDetachRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeDetachRequest) ProtoMessage()    {}
 func (*SdkVolumeDetachRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{67}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{72}
 }
 func (m *SdkVolumeDetachRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeDetachRequest.Unmarshal(m, b)
@@ -4642,7 +5097,7 @@ func (m *SdkVolumeDetachResponse) Reset()         { *m = SdkVolumeDetachResponse
 func (m *SdkVolumeDetachResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeDetachResponse) ProtoMessage()    {}
 func (*SdkVolumeDetachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{68}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{73}
 }
 func (m *SdkVolumeDetachResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeDetachResponse.Unmarshal(m, b)
@@ -4676,7 +5131,7 @@ func (m *SdkVolumeCreateRequest) Reset()         { *m = SdkVolumeCreateRequest{}
 func (m *SdkVolumeCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeCreateRequest) ProtoMessage()    {}
 func (*SdkVolumeCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{69}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{74}
 }
 func (m *SdkVolumeCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeCreateRequest.Unmarshal(m, b)
@@ -4722,7 +5177,7 @@ func (m *SdkVolumeCreateResponse) Reset()         { *m = SdkVolumeCreateResponse
 func (m *SdkVolumeCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeCreateResponse) ProtoMessage()    {}
 func (*SdkVolumeCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{70}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{75}
 }
 func (m *SdkVolumeCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeCreateResponse.Unmarshal(m, b)
@@ -4765,7 +5220,7 @@ func (m *SdkVolumeCreateFromVolumeIdRequest) Reset()         { *m = SdkVolumeCre
 func (m *SdkVolumeCreateFromVolumeIdRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeCreateFromVolumeIdRequest) ProtoMessage()    {}
 func (*SdkVolumeCreateFromVolumeIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{71}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{76}
 }
 func (m *SdkVolumeCreateFromVolumeIdRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeCreateFromVolumeIdRequest.Unmarshal(m, b)
@@ -4818,7 +5273,7 @@ func (m *SdkVolumeCreateFromVolumeIdResponse) Reset()         { *m = SdkVolumeCr
 func (m *SdkVolumeCreateFromVolumeIdResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeCreateFromVolumeIdResponse) ProtoMessage()    {}
 func (*SdkVolumeCreateFromVolumeIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{72}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{77}
 }
 func (m *SdkVolumeCreateFromVolumeIdResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeCreateFromVolumeIdResponse.Unmarshal(m, b)
@@ -4857,7 +5312,7 @@ func (m *SdkVolumeDeleteRequest) Reset()         { *m = SdkVolumeDeleteRequest{}
 func (m *SdkVolumeDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeDeleteRequest) ProtoMessage()    {}
 func (*SdkVolumeDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{73}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{78}
 }
 func (m *SdkVolumeDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeDeleteRequest.Unmarshal(m, b)
@@ -4894,7 +5349,7 @@ func (m *SdkVolumeDeleteResponse) Reset()         { *m = SdkVolumeDeleteResponse
 func (m *SdkVolumeDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeDeleteResponse) ProtoMessage()    {}
 func (*SdkVolumeDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{74}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{79}
 }
 func (m *SdkVolumeDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeDeleteResponse.Unmarshal(m, b)
@@ -4926,7 +5381,7 @@ func (m *SdkVolumeInspectRequest) Reset()         { *m = SdkVolumeInspectRequest
 func (m *SdkVolumeInspectRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeInspectRequest) ProtoMessage()    {}
 func (*SdkVolumeInspectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{75}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{80}
 }
 func (m *SdkVolumeInspectRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeInspectRequest.Unmarshal(m, b)
@@ -4965,7 +5420,7 @@ func (m *SdkVolumeInspectResponse) Reset()         { *m = SdkVolumeInspectRespon
 func (m *SdkVolumeInspectResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeInspectResponse) ProtoMessage()    {}
 func (*SdkVolumeInspectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{76}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{81}
 }
 func (m *SdkVolumeInspectResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeInspectResponse.Unmarshal(m, b)
@@ -4995,17 +5450,26 @@ func (m *SdkVolumeInspectResponse) GetVolume() *Volume {
 type SdkVolumeEnumerateRequest struct {
 	// Volumes to match to this locator.
 	// If not provided, all volumes will be returned.
-	Locator              *VolumeLocator `protobuf:"bytes,1,opt,name=locator" json:"locator,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Locator *VolumeLocator `protobuf:"bytes,1,opt,name=locator" json:"locator,omitempty"`
+	// Filter selects the volumes returned. The locator of the filter
+	// takes precedence over the locator of the request.
+	Filter *VolumeFilter `protobuf:"bytes,2,opt,name=filter" json:"filter,omitempty"`
+	// MaxResults is the maximum number of volumes returned. If not
+	// provided, all the volumes selected are returned.
+	MaxResults uint32 `protobuf:"varint,3,opt,name=max_results,json=maxResults" json:"max_results,omitempty"`
+	// PageToken is the next_page_token of a previous response, used
+	// to return the next volumes
+	PageToken            string   `protobuf:"bytes,4,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SdkVolumeEnumerateRequest) Reset()         { *m = SdkVolumeEnumerateRequest{} }
 func (m *SdkVolumeEnumerateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeEnumerateRequest) ProtoMessage()    {}
 func (*SdkVolumeEnumerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{77}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{82}
 }
 func (m *SdkVolumeEnumerateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeEnumerateRequest.Unmarshal(m, b)
@@ -5032,19 +5496,43 @@ func (m *SdkVolumeEnumerateRequest) GetLocator() *VolumeLocator {
 	return nil
 }
 
+func (m *SdkVolumeEnumerateRequest) GetFilter() *VolumeFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *SdkVolumeEnumerateRequest) GetMaxResults() uint32 {
+	if m != nil {
+		return m.MaxResults
+	}
+	return 0
+}
+
+func (m *SdkVolumeEnumerateRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type SdkVolumeEnumerateResponse struct {
 	// List of volumes matching label
-	Volumes              []*Volume `protobuf:"bytes,1,rep,name=volumes" json:"volumes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Volumes []*Volume `protobuf:"bytes,1,rep,name=volumes" json:"volumes,omitempty"`
+	// NextPageToken is set when more volumes are selected. It must
+	// be provided as the page_token of the request of the next page.
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SdkVolumeEnumerateResponse) Reset()         { *m = SdkVolumeEnumerateResponse{} }
 func (m *SdkVolumeEnumerateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeEnumerateResponse) ProtoMessage()    {}
 func (*SdkVolumeEnumerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{78}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{83}
 }
 func (m *SdkVolumeEnumerateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeEnumerateResponse.Unmarshal(m, b)
@@ -5071,9 +5559,414 @@ func (m *SdkVolumeEnumerateResponse) GetVolumes() []*Volume {
 	return nil
 }
 
-type SdkVolumeSnapshotCreateRequest struct {
-	// Id of volume to take the snapshot from
-	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+func (m *SdkVolumeEnumerateResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type SdkVolumeUpdateRequest struct {
+	// Id of the volume to update
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	// Labels to add or change on the volume. A label with an
+	// empty value is removed from the volume.
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Desired specification of the volume. If provided, it must be the
+	// complete spec as returned by Inspect with only the following fields
+	// changed: size (increase only), ha_level, cos, io_profile, sticky,
+	// shared, snapshot_interval, snapshot_schedule and replica_set.
+	Spec                 *VolumeSpec `protobuf:"bytes,3,opt,name=spec" json:"spec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SdkVolumeUpdateRequest) Reset()         { *m = SdkVolumeUpdateRequest{} }
+func (m *SdkVolumeUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeUpdateRequest) ProtoMessage()    {}
+func (*SdkVolumeUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{84}
+}
+func (m *SdkVolumeUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeUpdateRequest.Unmarshal(m, b)
+}
+func (m *SdkVolumeUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeUpdateRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkVolumeUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeUpdateRequest.Merge(dst, src)
+}
+func (m *SdkVolumeUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeUpdateRequest.Size(m)
+}
+func (m *SdkVolumeUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkVolumeUpdateRequest proto.InternalMessageInfo
+
+func (m *SdkVolumeUpdateRequest) GetVolumeId() string {
+	if m != nil {
+		return m.VolumeId
+	}
+	return ""
+}
+
+func (m *SdkVolumeUpdateRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *SdkVolumeUpdateRequest) GetSpec() *VolumeSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+type SdkVolumeUpdateResponse struct {
+	// Information about the volume after the update
+	Volume               *Volume  `protobuf:"bytes,1,opt,name=volume" json:"volume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkVolumeUpdateResponse) Reset()         { *m = SdkVolumeUpdateResponse{} }
+func (m *SdkVolumeUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeUpdateResponse) ProtoMessage()    {}
+func (*SdkVolumeUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{85}
+}
+func (m *SdkVolumeUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeUpdateResponse.Unmarshal(m, b)
+}
+func (m *SdkVolumeUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeUpdateResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkVolumeUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeUpdateResponse.Merge(dst, src)
+}
+func (m *SdkVolumeUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeUpdateResponse.Size(m)
+}
+func (m *SdkVolumeUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkVolumeUpdateResponse proto.InternalMessageInfo
+
+func (m *SdkVolumeUpdateResponse) GetVolume() *Volume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type SdkVolumeStatsRequest struct {
+	// Id of the volume to get statistics for
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	// When set, the statistics returned are for the last interval only
+	// instead of the cumulative /proc/diskstats style values
+	NotCumulative        bool     `protobuf:"varint,2,opt,name=not_cumulative,json=notCumulative" json:"not_cumulative,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkVolumeStatsRequest) Reset()         { *m = SdkVolumeStatsRequest{} }
+func (m *SdkVolumeStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeStatsRequest) ProtoMessage()    {}
+func (*SdkVolumeStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{86}
+}
+func (m *SdkVolumeStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeStatsRequest.Unmarshal(m, b)
+}
+func (m *SdkVolumeStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeStatsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkVolumeStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeStatsRequest.Merge(dst, src)
+}
+func (m *SdkVolumeStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeStatsRequest.Size(m)
+}
+func (m *SdkVolumeStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkVolumeStatsRequest proto.InternalMessageInfo
+
+func (m *SdkVolumeStatsRequest) GetVolumeId() string {
+	if m != nil {
+		return m.VolumeId
+	}
+	return ""
+}
+
+func (m *SdkVolumeStatsRequest) GetNotCumulative() bool {
+	if m != nil {
+		return m.NotCumulative
+	}
+	return false
+}
+
+type SdkVolumeStatsResponse struct {
+	// Statistics as reported by the volume driver
+	Stats *Stats `protobuf:"bytes,1,opt,name=stats" json:"stats,omitempty"`
+	// Bytes written per second over the interval
+	WriteThroughput uint64 `protobuf:"varint,2,opt,name=write_throughput,json=writeThroughput" json:"write_throughput,omitempty"`
+	// Bytes read per second over the interval
+	ReadThroughput uint64 `protobuf:"varint,3,opt,name=read_throughput,json=readThroughput" json:"read_throughput,omitempty"`
+	// Average time in microseconds for an IO to complete
+	Latency uint64 `protobuf:"varint,4,opt,name=latency" json:"latency,omitempty"`
+	// Average time in microseconds for a read to complete
+	ReadLatency uint64 `protobuf:"varint,5,opt,name=read_latency,json=readLatency" json:"read_latency,omitempty"`
+	// Average time in microseconds for a write to complete
+	WriteLatency uint64 `protobuf:"varint,6,opt,name=write_latency,json=writeLatency" json:"write_latency,omitempty"`
+	// IO operations per second over the interval
+	Iops                 uint64   `protobuf:"varint,7,opt,name=iops" json:"iops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkVolumeStatsResponse) Reset()         { *m = SdkVolumeStatsResponse{} }
+func (m *SdkVolumeStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeStatsResponse) ProtoMessage()    {}
+func (*SdkVolumeStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{87}
+}
+func (m *SdkVolumeStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeStatsResponse.Unmarshal(m, b)
+}
+func (m *SdkVolumeStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkVolumeStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeStatsResponse.Merge(dst, src)
+}
+func (m *SdkVolumeStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeStatsResponse.Size(m)
+}
+func (m *SdkVolumeStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkVolumeStatsResponse proto.InternalMessageInfo
+
+func (m *SdkVolumeStatsResponse) GetStats() *Stats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *SdkVolumeStatsResponse) GetWriteThroughput() uint64 {
+	if m != nil {
+		return m.WriteThroughput
+	}
+	return 0
+}
+
+func (m *SdkVolumeStatsResponse) GetReadThroughput() uint64 {
+	if m != nil {
+		return m.ReadThroughput
+	}
+	return 0
+}
+
+func (m *SdkVolumeStatsResponse) GetLatency() uint64 {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+func (m *SdkVolumeStatsResponse) GetReadLatency() uint64 {
+	if m != nil {
+		return m.ReadLatency
+	}
+	return 0
+}
+
+func (m *SdkVolumeStatsResponse) GetWriteLatency() uint64 {
+	if m != nil {
+		return m.WriteLatency
+	}
+	return 0
+}
+
+func (m *SdkVolumeStatsResponse) GetIops() uint64 {
+	if m != nil {
+		return m.Iops
+	}
+	return 0
+}
+
+type SdkVolumeCapacityUsageRequest struct {
+	// Id of the volume
+	VolumeId             string   `protobuf:"bytes,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkVolumeCapacityUsageRequest) Reset()         { *m = SdkVolumeCapacityUsageRequest{} }
+func (m *SdkVolumeCapacityUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeCapacityUsageRequest) ProtoMessage()    {}
+func (*SdkVolumeCapacityUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{88}
+}
+func (m *SdkVolumeCapacityUsageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeCapacityUsageRequest.Unmarshal(m, b)
+}
+func (m *SdkVolumeCapacityUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeCapacityUsageRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkVolumeCapacityUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeCapacityUsageRequest.Merge(dst, src)
+}
+func (m *SdkVolumeCapacityUsageRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeCapacityUsageRequest.Size(m)
+}
+func (m *SdkVolumeCapacityUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeCapacityUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkVolumeCapacityUsageRequest proto.InternalMessageInfo
+
+func (m *SdkVolumeCapacityUsageRequest) GetVolumeId() string {
+	if m != nil {
+		return m.VolumeId
+	}
+	return ""
+}
+
+type SdkVolumeCapacityUsageResponse struct {
+	// Bytes currently used by the volume
+	UsedBytes uint64 `protobuf:"varint,1,opt,name=used_bytes,json=usedBytes" json:"used_bytes,omitempty"`
+	// Provisioned size of the volume in bytes
+	TotalBytes           uint64   `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes" json:"total_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkVolumeCapacityUsageResponse) Reset()         { *m = SdkVolumeCapacityUsageResponse{} }
+func (m *SdkVolumeCapacityUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeCapacityUsageResponse) ProtoMessage()    {}
+func (*SdkVolumeCapacityUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{89}
+}
+func (m *SdkVolumeCapacityUsageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeCapacityUsageResponse.Unmarshal(m, b)
+}
+func (m *SdkVolumeCapacityUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeCapacityUsageResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkVolumeCapacityUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeCapacityUsageResponse.Merge(dst, src)
+}
+func (m *SdkVolumeCapacityUsageResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeCapacityUsageResponse.Size(m)
+}
+func (m *SdkVolumeCapacityUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeCapacityUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkVolumeCapacityUsageResponse proto.InternalMessageInfo
+
+func (m *SdkVolumeCapacityUsageResponse) GetUsedBytes() uint64 {
+	if m != nil {
+		return m.UsedBytes
+	}
+	return 0
+}
+
+func (m *SdkVolumeCapacityUsageResponse) GetTotalBytes() uint64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+type SdkVolumeActiveRequestsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkVolumeActiveRequestsRequest) Reset()         { *m = SdkVolumeActiveRequestsRequest{} }
+func (m *SdkVolumeActiveRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeActiveRequestsRequest) ProtoMessage()    {}
+func (*SdkVolumeActiveRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{90}
+}
+func (m *SdkVolumeActiveRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeActiveRequestsRequest.Unmarshal(m, b)
+}
+func (m *SdkVolumeActiveRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeActiveRequestsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkVolumeActiveRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeActiveRequestsRequest.Merge(dst, src)
+}
+func (m *SdkVolumeActiveRequestsRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeActiveRequestsRequest.Size(m)
+}
+func (m *SdkVolumeActiveRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeActiveRequestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkVolumeActiveRequestsRequest proto.InternalMessageInfo
+
+type SdkVolumeActiveRequestsResponse struct {
+	// IO requests currently in progress
+	ActiveRequests       *ActiveRequests `protobuf:"bytes,1,opt,name=active_requests,json=activeRequests" json:"active_requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SdkVolumeActiveRequestsResponse) Reset()         { *m = SdkVolumeActiveRequestsResponse{} }
+func (m *SdkVolumeActiveRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeActiveRequestsResponse) ProtoMessage()    {}
+func (*SdkVolumeActiveRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{91}
+}
+func (m *SdkVolumeActiveRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeActiveRequestsResponse.Unmarshal(m, b)
+}
+func (m *SdkVolumeActiveRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeActiveRequestsResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkVolumeActiveRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeActiveRequestsResponse.Merge(dst, src)
+}
+func (m *SdkVolumeActiveRequestsResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeActiveRequestsResponse.Size(m)
+}
+func (m *SdkVolumeActiveRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeActiveRequestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkVolumeActiveRequestsResponse proto.InternalMessageInfo
+
+func (m *SdkVolumeActiveRequestsResponse) GetActiveRequests() *ActiveRequests {
+	if m != nil {
+		return m.ActiveRequests
+	}
+	return nil
+}
+
+type SdkVolumeSnapshotCreateRequest struct {
+	// Id of volume to take the snapshot from
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
 	// Labels to apply to snapshot
 	Labels               map[string]string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
@@ -5085,7 +5978,7 @@ func (m *SdkVolumeSnapshotCreateRequest) Reset()         { *m = SdkVolumeSnapsho
 func (m *SdkVolumeSnapshotCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeSnapshotCreateRequest) ProtoMessage()    {}
 func (*SdkVolumeSnapshotCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{79}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{92}
 }
 func (m *SdkVolumeSnapshotCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeSnapshotCreateRequest.Unmarshal(m, b)
@@ -5131,7 +6024,7 @@ func (m *SdkVolumeSnapshotCreateResponse) Reset()         { *m = SdkVolumeSnapsh
 func (m *SdkVolumeSnapshotCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeSnapshotCreateResponse) ProtoMessage()    {}
 func (*SdkVolumeSnapshotCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{80}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{93}
 }
 func (m *SdkVolumeSnapshotCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeSnapshotCreateResponse.Unmarshal(m, b)
@@ -5172,7 +6065,7 @@ func (m *SdkVolumeSnapshotRestoreRequest) Reset()         { *m = SdkVolumeSnapsh
 func (m *SdkVolumeSnapshotRestoreRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeSnapshotRestoreRequest) ProtoMessage()    {}
 func (*SdkVolumeSnapshotRestoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{81}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{94}
 }
 func (m *SdkVolumeSnapshotRestoreRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeSnapshotRestoreRequest.Unmarshal(m, b)
@@ -5216,7 +6109,7 @@ func (m *SdkVolumeSnapshotRestoreResponse) Reset()         { *m = SdkVolumeSnaps
 func (m *SdkVolumeSnapshotRestoreResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeSnapshotRestoreResponse) ProtoMessage()    {}
 func (*SdkVolumeSnapshotRestoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{82}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{95}
 }
 func (m *SdkVolumeSnapshotRestoreResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkVolumeSnapshotRestoreResponse.Unmarshal(m, b)
@@ -5250,78 +6143,342 @@ func (m *SdkVolumeSnapshotEnumerateRequest) Reset()         { *m = SdkVolumeSnap
 func (m *SdkVolumeSnapshotEnumerateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkVolumeSnapshotEnumerateRequest) ProtoMessage()    {}
 func (*SdkVolumeSnapshotEnumerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{83}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{96}
+}
+func (m *SdkVolumeSnapshotEnumerateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeSnapshotEnumerateRequest.Unmarshal(m, b)
+}
+func (m *SdkVolumeSnapshotEnumerateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeSnapshotEnumerateRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkVolumeSnapshotEnumerateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeSnapshotEnumerateRequest.Merge(dst, src)
+}
+func (m *SdkVolumeSnapshotEnumerateRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeSnapshotEnumerateRequest.Size(m)
+}
+func (m *SdkVolumeSnapshotEnumerateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeSnapshotEnumerateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkVolumeSnapshotEnumerateRequest proto.InternalMessageInfo
+
+func (m *SdkVolumeSnapshotEnumerateRequest) GetVolumeId() string {
+	if m != nil {
+		return m.VolumeId
+	}
+	return ""
+}
+
+func (m *SdkVolumeSnapshotEnumerateRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type SdkVolumeSnapshotEnumerateResponse struct {
+	// List of immutable snapshots
+	Snapshots            []*Volume `protobuf:"bytes,1,rep,name=snapshots" json:"snapshots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SdkVolumeSnapshotEnumerateResponse) Reset()         { *m = SdkVolumeSnapshotEnumerateResponse{} }
+func (m *SdkVolumeSnapshotEnumerateResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeSnapshotEnumerateResponse) ProtoMessage()    {}
+func (*SdkVolumeSnapshotEnumerateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{97}
+}
+func (m *SdkVolumeSnapshotEnumerateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeSnapshotEnumerateResponse.Unmarshal(m, b)
+}
+func (m *SdkVolumeSnapshotEnumerateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeSnapshotEnumerateResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkVolumeSnapshotEnumerateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeSnapshotEnumerateResponse.Merge(dst, src)
+}
+func (m *SdkVolumeSnapshotEnumerateResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeSnapshotEnumerateResponse.Size(m)
+}
+func (m *SdkVolumeSnapshotEnumerateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeSnapshotEnumerateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkVolumeSnapshotEnumerateResponse proto.InternalMessageInfo
+
+func (m *SdkVolumeSnapshotEnumerateResponse) GetSnapshots() []*Volume {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+type SdkVolumeSnapshotGroupCreateRequest struct {
+	// Id of the volume group
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId" json:"group_id,omitempty"`
+	// Labels to apply to the snapshots
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Quiesce all the volumes in the group before taking the snapshots.
+	// The volumes are always unquiesced afterwards, even on failure.
+	Quiesce bool `protobuf:"varint,3,opt,name=quiesce" json:"quiesce,omitempty"`
+	// Unquiesce the volumes after this number of seconds if the snapshot
+	// has not completed. Zero means no timeout.
+	QuiesceTimeoutSeconds uint64   `protobuf:"varint,4,opt,name=quiesce_timeout_seconds,json=quiesceTimeoutSeconds" json:"quiesce_timeout_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *SdkVolumeSnapshotGroupCreateRequest) Reset()         { *m = SdkVolumeSnapshotGroupCreateRequest{} }
+func (m *SdkVolumeSnapshotGroupCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeSnapshotGroupCreateRequest) ProtoMessage()    {}
+func (*SdkVolumeSnapshotGroupCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{98}
+}
+func (m *SdkVolumeSnapshotGroupCreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeSnapshotGroupCreateRequest.Unmarshal(m, b)
+}
+func (m *SdkVolumeSnapshotGroupCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeSnapshotGroupCreateRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkVolumeSnapshotGroupCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeSnapshotGroupCreateRequest.Merge(dst, src)
+}
+func (m *SdkVolumeSnapshotGroupCreateRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeSnapshotGroupCreateRequest.Size(m)
+}
+func (m *SdkVolumeSnapshotGroupCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeSnapshotGroupCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkVolumeSnapshotGroupCreateRequest proto.InternalMessageInfo
+
+func (m *SdkVolumeSnapshotGroupCreateRequest) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *SdkVolumeSnapshotGroupCreateRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *SdkVolumeSnapshotGroupCreateRequest) GetQuiesce() bool {
+	if m != nil {
+		return m.Quiesce
+	}
+	return false
+}
+
+func (m *SdkVolumeSnapshotGroupCreateRequest) GetQuiesceTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.QuiesceTimeoutSeconds
+	}
+	return 0
+}
+
+type SdkVolumeSnapshotGroupCreateResponse struct {
+	// Ids of the created snapshots keyed by the id of their volume
+	Snapshots            map[string]string `protobuf:"bytes,1,rep,name=snapshots" json:"snapshots,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SdkVolumeSnapshotGroupCreateResponse) Reset()         { *m = SdkVolumeSnapshotGroupCreateResponse{} }
+func (m *SdkVolumeSnapshotGroupCreateResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeSnapshotGroupCreateResponse) ProtoMessage()    {}
+func (*SdkVolumeSnapshotGroupCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{99}
+}
+func (m *SdkVolumeSnapshotGroupCreateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeSnapshotGroupCreateResponse.Unmarshal(m, b)
+}
+func (m *SdkVolumeSnapshotGroupCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeSnapshotGroupCreateResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkVolumeSnapshotGroupCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeSnapshotGroupCreateResponse.Merge(dst, src)
+}
+func (m *SdkVolumeSnapshotGroupCreateResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeSnapshotGroupCreateResponse.Size(m)
+}
+func (m *SdkVolumeSnapshotGroupCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeSnapshotGroupCreateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkVolumeSnapshotGroupCreateResponse proto.InternalMessageInfo
+
+func (m *SdkVolumeSnapshotGroupCreateResponse) GetSnapshots() map[string]string {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+type SdkVolumeQuiesceRequest struct {
+	// Id of the volume to quiesce
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	// Unquiesce the volume after this number of seconds.
+	// Zero means no timeout.
+	TimeoutSeconds uint64 `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds" json:"timeout_seconds,omitempty"`
+	// Optional driver specific identifier of the quiesce operation
+	QuiesceId            string   `protobuf:"bytes,3,opt,name=quiesce_id,json=quiesceId" json:"quiesce_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkVolumeQuiesceRequest) Reset()         { *m = SdkVolumeQuiesceRequest{} }
+func (m *SdkVolumeQuiesceRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeQuiesceRequest) ProtoMessage()    {}
+func (*SdkVolumeQuiesceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{100}
+}
+func (m *SdkVolumeQuiesceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeQuiesceRequest.Unmarshal(m, b)
+}
+func (m *SdkVolumeQuiesceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeQuiesceRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkVolumeQuiesceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeQuiesceRequest.Merge(dst, src)
+}
+func (m *SdkVolumeQuiesceRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeQuiesceRequest.Size(m)
+}
+func (m *SdkVolumeQuiesceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeQuiesceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkVolumeQuiesceRequest proto.InternalMessageInfo
+
+func (m *SdkVolumeQuiesceRequest) GetVolumeId() string {
+	if m != nil {
+		return m.VolumeId
+	}
+	return ""
+}
+
+func (m *SdkVolumeQuiesceRequest) GetTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+func (m *SdkVolumeQuiesceRequest) GetQuiesceId() string {
+	if m != nil {
+		return m.QuiesceId
+	}
+	return ""
+}
+
+type SdkVolumeQuiesceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkVolumeQuiesceResponse) Reset()         { *m = SdkVolumeQuiesceResponse{} }
+func (m *SdkVolumeQuiesceResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeQuiesceResponse) ProtoMessage()    {}
+func (*SdkVolumeQuiesceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{101}
+}
+func (m *SdkVolumeQuiesceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeQuiesceResponse.Unmarshal(m, b)
+}
+func (m *SdkVolumeQuiesceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeQuiesceResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkVolumeQuiesceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeQuiesceResponse.Merge(dst, src)
+}
+func (m *SdkVolumeQuiesceResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeQuiesceResponse.Size(m)
+}
+func (m *SdkVolumeQuiesceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeQuiesceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkVolumeQuiesceResponse proto.InternalMessageInfo
+
+type SdkVolumeUnquiesceRequest struct {
+	// Id of the volume to unquiesce
+	VolumeId             string   `protobuf:"bytes,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkVolumeUnquiesceRequest) Reset()         { *m = SdkVolumeUnquiesceRequest{} }
+func (m *SdkVolumeUnquiesceRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeUnquiesceRequest) ProtoMessage()    {}
+func (*SdkVolumeUnquiesceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{102}
 }
-func (m *SdkVolumeSnapshotEnumerateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SdkVolumeSnapshotEnumerateRequest.Unmarshal(m, b)
+func (m *SdkVolumeUnquiesceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeUnquiesceRequest.Unmarshal(m, b)
 }
-func (m *SdkVolumeSnapshotEnumerateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SdkVolumeSnapshotEnumerateRequest.Marshal(b, m, deterministic)
+func (m *SdkVolumeUnquiesceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeUnquiesceRequest.Marshal(b, m, deterministic)
 }
-func (dst *SdkVolumeSnapshotEnumerateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SdkVolumeSnapshotEnumerateRequest.Merge(dst, src)
+func (dst *SdkVolumeUnquiesceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeUnquiesceRequest.Merge(dst, src)
 }
-func (m *SdkVolumeSnapshotEnumerateRequest) XXX_Size() int {
-	return xxx_messageInfo_SdkVolumeSnapshotEnumerateRequest.Size(m)
+func (m *SdkVolumeUnquiesceRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeUnquiesceRequest.Size(m)
 }
-func (m *SdkVolumeSnapshotEnumerateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SdkVolumeSnapshotEnumerateRequest.DiscardUnknown(m)
+func (m *SdkVolumeUnquiesceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeUnquiesceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SdkVolumeSnapshotEnumerateRequest proto.InternalMessageInfo
+var xxx_messageInfo_SdkVolumeUnquiesceRequest proto.InternalMessageInfo
 
-func (m *SdkVolumeSnapshotEnumerateRequest) GetVolumeId() string {
+func (m *SdkVolumeUnquiesceRequest) GetVolumeId() string {
 	if m != nil {
 		return m.VolumeId
 	}
 	return ""
 }
 
-func (m *SdkVolumeSnapshotEnumerateRequest) GetLabels() map[string]string {
-	if m != nil {
-		return m.Labels
-	}
-	return nil
-}
-
-type SdkVolumeSnapshotEnumerateResponse struct {
-	// List of immutable snapshots
-	Snapshots            []*Volume `protobuf:"bytes,1,rep,name=snapshots" json:"snapshots,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type SdkVolumeUnquiesceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SdkVolumeSnapshotEnumerateResponse) Reset()         { *m = SdkVolumeSnapshotEnumerateResponse{} }
-func (m *SdkVolumeSnapshotEnumerateResponse) String() string { return proto.CompactTextString(m) }
-func (*SdkVolumeSnapshotEnumerateResponse) ProtoMessage()    {}
-func (*SdkVolumeSnapshotEnumerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{84}
+func (m *SdkVolumeUnquiesceResponse) Reset()         { *m = SdkVolumeUnquiesceResponse{} }
+func (m *SdkVolumeUnquiesceResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkVolumeUnquiesceResponse) ProtoMessage()    {}
+func (*SdkVolumeUnquiesceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{103}
 }
-func (m *SdkVolumeSnapshotEnumerateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SdkVolumeSnapshotEnumerateResponse.Unmarshal(m, b)
+func (m *SdkVolumeUnquiesceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkVolumeUnquiesceResponse.Unmarshal(m, b)
 }
-func (m *SdkVolumeSnapshotEnumerateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SdkVolumeSnapshotEnumerateResponse.Marshal(b, m, deterministic)
+func (m *SdkVolumeUnquiesceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkVolumeUnquiesceResponse.Marshal(b, m, deterministic)
 }
-func (dst *SdkVolumeSnapshotEnumerateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SdkVolumeSnapshotEnumerateResponse.Merge(dst, src)
+func (dst *SdkVolumeUnquiesceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkVolumeUnquiesceResponse.Merge(dst, src)
 }
-func (m *SdkVolumeSnapshotEnumerateResponse) XXX_Size() int {
-	return xxx_messageInfo_SdkVolumeSnapshotEnumerateResponse.Size(m)
+func (m *SdkVolumeUnquiesceResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkVolumeUnquiesceResponse.Size(m)
 }
-func (m *SdkVolumeSnapshotEnumerateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SdkVolumeSnapshotEnumerateResponse.DiscardUnknown(m)
+func (m *SdkVolumeUnquiesceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkVolumeUnquiesceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SdkVolumeSnapshotEnumerateResponse proto.InternalMessageInfo
-
-func (m *SdkVolumeSnapshotEnumerateResponse) GetSnapshots() []*Volume {
-	if m != nil {
-		return m.Snapshots
-	}
-	return nil
-}
+var xxx_messageInfo_SdkVolumeUnquiesceResponse proto.InternalMessageInfo
 
 type SdkClusterEnumerateRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -5333,7 +6490,7 @@ func (m *SdkClusterEnumerateRequest) Reset()         { *m = SdkClusterEnumerateR
 func (m *SdkClusterEnumerateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterEnumerateRequest) ProtoMessage()    {}
 func (*SdkClusterEnumerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{85}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{104}
 }
 func (m *SdkClusterEnumerateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterEnumerateRequest.Unmarshal(m, b)
@@ -5365,7 +6522,7 @@ func (m *SdkClusterEnumerateResponse) Reset()         { *m = SdkClusterEnumerate
 func (m *SdkClusterEnumerateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterEnumerateResponse) ProtoMessage()    {}
 func (*SdkClusterEnumerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{86}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{105}
 }
 func (m *SdkClusterEnumerateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterEnumerateResponse.Unmarshal(m, b)
@@ -5404,7 +6561,7 @@ func (m *SdkClusterInspectRequest) Reset()         { *m = SdkClusterInspectReque
 func (m *SdkClusterInspectRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterInspectRequest) ProtoMessage()    {}
 func (*SdkClusterInspectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{87}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{106}
 }
 func (m *SdkClusterInspectRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterInspectRequest.Unmarshal(m, b)
@@ -5443,7 +6600,7 @@ func (m *SdkClusterInspectResponse) Reset()         { *m = SdkClusterInspectResp
 func (m *SdkClusterInspectResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterInspectResponse) ProtoMessage()    {}
 func (*SdkClusterInspectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{88}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{107}
 }
 func (m *SdkClusterInspectResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterInspectResponse.Unmarshal(m, b)
@@ -5486,7 +6643,7 @@ func (m *SdkClusterAlertEnumerateRequest) Reset()         { *m = SdkClusterAlert
 func (m *SdkClusterAlertEnumerateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterAlertEnumerateRequest) ProtoMessage()    {}
 func (*SdkClusterAlertEnumerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{89}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{108}
 }
 func (m *SdkClusterAlertEnumerateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterAlertEnumerateRequest.Unmarshal(m, b)
@@ -5539,7 +6696,7 @@ func (m *SdkClusterAlertEnumerateResponse) Reset()         { *m = SdkClusterAler
 func (m *SdkClusterAlertEnumerateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterAlertEnumerateResponse) ProtoMessage()    {}
 func (*SdkClusterAlertEnumerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{90}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{109}
 }
 func (m *SdkClusterAlertEnumerateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterAlertEnumerateResponse.Unmarshal(m, b)
@@ -5580,7 +6737,7 @@ func (m *SdkClusterAlertClearRequest) Reset()         { *m = SdkClusterAlertClea
 func (m *SdkClusterAlertClearRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterAlertClearRequest) ProtoMessage()    {}
 func (*SdkClusterAlertClearRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{91}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{110}
 }
 func (m *SdkClusterAlertClearRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterAlertClearRequest.Unmarshal(m, b)
@@ -5624,7 +6781,7 @@ func (m *SdkClusterAlertClearResponse) Reset()         { *m = SdkClusterAlertCle
 func (m *SdkClusterAlertClearResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterAlertClearResponse) ProtoMessage()    {}
 func (*SdkClusterAlertClearResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{92}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{111}
 }
 func (m *SdkClusterAlertClearResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterAlertClearResponse.Unmarshal(m, b)
@@ -5658,7 +6815,7 @@ func (m *SdkClusterAlertEraseRequest) Reset()         { *m = SdkClusterAlertEras
 func (m *SdkClusterAlertEraseRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterAlertEraseRequest) ProtoMessage()    {}
 func (*SdkClusterAlertEraseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{93}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{112}
 }
 func (m *SdkClusterAlertEraseRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterAlertEraseRequest.Unmarshal(m, b)
@@ -5702,7 +6859,7 @@ func (m *SdkClusterAlertEraseResponse) Reset()         { *m = SdkClusterAlertEra
 func (m *SdkClusterAlertEraseResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkClusterAlertEraseResponse) ProtoMessage()    {}
 func (*SdkClusterAlertEraseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{94}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{113}
 }
 func (m *SdkClusterAlertEraseResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkClusterAlertEraseResponse.Unmarshal(m, b)
@@ -5713,14 +6870,427 @@ func (m *SdkClusterAlertEraseResponse) XXX_Marshal(b []byte, deterministic bool)
 func (dst *SdkClusterAlertEraseResponse) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_SdkClusterAlertEraseResponse.Merge(dst, src)
 }
-func (m *SdkClusterAlertEraseResponse) XXX_Size() int {
-	return xxx_messageInfo_SdkClusterAlertEraseResponse.Size(m)
+func (m *SdkClusterAlertEraseResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkClusterAlertEraseResponse.Size(m)
+}
+func (m *SdkClusterAlertEraseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkClusterAlertEraseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkClusterAlertEraseResponse proto.InternalMessageInfo
+
+type SdkClusterAlertWatchRequest struct {
+	// Only send alerts for this type of resource.
+	// If not provided, alerts for all resources are sent.
+	Resource ResourceType `protobuf:"varint,1,opt,name=resource,enum=openstorage.api.ResourceType" json:"resource,omitempty"`
+	// Only send alerts for this resource id.
+	// If not provided, alerts for all resource ids are sent.
+	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId" json:"resource_id,omitempty"`
+	// Only send alerts of this severity.
+	// If not provided, alerts of all severities are sent.
+	Severity             SeverityType `protobuf:"varint,3,opt,name=severity,enum=openstorage.api.SeverityType" json:"severity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SdkClusterAlertWatchRequest) Reset()         { *m = SdkClusterAlertWatchRequest{} }
+func (m *SdkClusterAlertWatchRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkClusterAlertWatchRequest) ProtoMessage()    {}
+func (*SdkClusterAlertWatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{114}
+}
+func (m *SdkClusterAlertWatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkClusterAlertWatchRequest.Unmarshal(m, b)
+}
+func (m *SdkClusterAlertWatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkClusterAlertWatchRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkClusterAlertWatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkClusterAlertWatchRequest.Merge(dst, src)
+}
+func (m *SdkClusterAlertWatchRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkClusterAlertWatchRequest.Size(m)
+}
+func (m *SdkClusterAlertWatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkClusterAlertWatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkClusterAlertWatchRequest proto.InternalMessageInfo
+
+func (m *SdkClusterAlertWatchRequest) GetResource() ResourceType {
+	if m != nil {
+		return m.Resource
+	}
+	return ResourceType_RESOURCE_TYPE_NONE
+}
+
+func (m *SdkClusterAlertWatchRequest) GetResourceId() string {
+	if m != nil {
+		return m.ResourceId
+	}
+	return ""
+}
+
+func (m *SdkClusterAlertWatchRequest) GetSeverity() SeverityType {
+	if m != nil {
+		return m.Severity
+	}
+	return SeverityType_SEVERITY_TYPE_NONE
+}
+
+type SdkClusterAlertWatchResponse struct {
+	// Action which occurred on the alert
+	Action AlertActionType `protobuf:"varint,1,opt,name=action,enum=openstorage.api.AlertActionType" json:"action,omitempty"`
+	// Information about the alert. When the alert is deleted only
+	// the resource and id of the alert are provided.
+	Alert                *Alert   `protobuf:"bytes,2,opt,name=alert" json:"alert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkClusterAlertWatchResponse) Reset()         { *m = SdkClusterAlertWatchResponse{} }
+func (m *SdkClusterAlertWatchResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkClusterAlertWatchResponse) ProtoMessage()    {}
+func (*SdkClusterAlertWatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{115}
+}
+func (m *SdkClusterAlertWatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkClusterAlertWatchResponse.Unmarshal(m, b)
+}
+func (m *SdkClusterAlertWatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkClusterAlertWatchResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkClusterAlertWatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkClusterAlertWatchResponse.Merge(dst, src)
+}
+func (m *SdkClusterAlertWatchResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkClusterAlertWatchResponse.Size(m)
+}
+func (m *SdkClusterAlertWatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkClusterAlertWatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkClusterAlertWatchResponse proto.InternalMessageInfo
+
+func (m *SdkClusterAlertWatchResponse) GetAction() AlertActionType {
+	if m != nil {
+		return m.Action
+	}
+	return AlertActionType_ALERT_ACTION_TYPE_NONE
+}
+
+func (m *SdkClusterAlertWatchResponse) GetAlert() *Alert {
+	if m != nil {
+		return m.Alert
+	}
+	return nil
+}
+
+type SdkNodeEnumerateRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkNodeEnumerateRequest) Reset()         { *m = SdkNodeEnumerateRequest{} }
+func (m *SdkNodeEnumerateRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkNodeEnumerateRequest) ProtoMessage()    {}
+func (*SdkNodeEnumerateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{116}
+}
+func (m *SdkNodeEnumerateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkNodeEnumerateRequest.Unmarshal(m, b)
+}
+func (m *SdkNodeEnumerateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkNodeEnumerateRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkNodeEnumerateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkNodeEnumerateRequest.Merge(dst, src)
+}
+func (m *SdkNodeEnumerateRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkNodeEnumerateRequest.Size(m)
+}
+func (m *SdkNodeEnumerateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkNodeEnumerateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkNodeEnumerateRequest proto.InternalMessageInfo
+
+type SdkNodeEnumerateResponse struct {
+	// Ids of all the nodes in the cluster
+	NodeIds              []string `protobuf:"bytes,1,rep,name=node_ids,json=nodeIds" json:"node_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkNodeEnumerateResponse) Reset()         { *m = SdkNodeEnumerateResponse{} }
+func (m *SdkNodeEnumerateResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkNodeEnumerateResponse) ProtoMessage()    {}
+func (*SdkNodeEnumerateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{117}
+}
+func (m *SdkNodeEnumerateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkNodeEnumerateResponse.Unmarshal(m, b)
+}
+func (m *SdkNodeEnumerateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkNodeEnumerateResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkNodeEnumerateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkNodeEnumerateResponse.Merge(dst, src)
+}
+func (m *SdkNodeEnumerateResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkNodeEnumerateResponse.Size(m)
+}
+func (m *SdkNodeEnumerateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkNodeEnumerateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkNodeEnumerateResponse proto.InternalMessageInfo
+
+func (m *SdkNodeEnumerateResponse) GetNodeIds() []string {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+type SdkNodeInspectRequest struct {
+	// Id of the node to inspect
+	NodeId               string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId" json:"node_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkNodeInspectRequest) Reset()         { *m = SdkNodeInspectRequest{} }
+func (m *SdkNodeInspectRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkNodeInspectRequest) ProtoMessage()    {}
+func (*SdkNodeInspectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{118}
+}
+func (m *SdkNodeInspectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkNodeInspectRequest.Unmarshal(m, b)
+}
+func (m *SdkNodeInspectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkNodeInspectRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkNodeInspectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkNodeInspectRequest.Merge(dst, src)
+}
+func (m *SdkNodeInspectRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkNodeInspectRequest.Size(m)
+}
+func (m *SdkNodeInspectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkNodeInspectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkNodeInspectRequest proto.InternalMessageInfo
+
+func (m *SdkNodeInspectRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+type SdkNodeInspectResponse struct {
+	// Information about the node
+	Node                 *StorageNode `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SdkNodeInspectResponse) Reset()         { *m = SdkNodeInspectResponse{} }
+func (m *SdkNodeInspectResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkNodeInspectResponse) ProtoMessage()    {}
+func (*SdkNodeInspectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{119}
+}
+func (m *SdkNodeInspectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkNodeInspectResponse.Unmarshal(m, b)
+}
+func (m *SdkNodeInspectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkNodeInspectResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkNodeInspectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkNodeInspectResponse.Merge(dst, src)
+}
+func (m *SdkNodeInspectResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkNodeInspectResponse.Size(m)
+}
+func (m *SdkNodeInspectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkNodeInspectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkNodeInspectResponse proto.InternalMessageInfo
+
+func (m *SdkNodeInspectResponse) GetNode() *StorageNode {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+type SdkNodeInspectCurrentRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkNodeInspectCurrentRequest) Reset()         { *m = SdkNodeInspectCurrentRequest{} }
+func (m *SdkNodeInspectCurrentRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkNodeInspectCurrentRequest) ProtoMessage()    {}
+func (*SdkNodeInspectCurrentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{120}
+}
+func (m *SdkNodeInspectCurrentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkNodeInspectCurrentRequest.Unmarshal(m, b)
+}
+func (m *SdkNodeInspectCurrentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkNodeInspectCurrentRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkNodeInspectCurrentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkNodeInspectCurrentRequest.Merge(dst, src)
+}
+func (m *SdkNodeInspectCurrentRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkNodeInspectCurrentRequest.Size(m)
+}
+func (m *SdkNodeInspectCurrentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkNodeInspectCurrentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkNodeInspectCurrentRequest proto.InternalMessageInfo
+
+type SdkNodeInspectCurrentResponse struct {
+	// Information about the node servicing the request
+	Node                 *StorageNode `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SdkNodeInspectCurrentResponse) Reset()         { *m = SdkNodeInspectCurrentResponse{} }
+func (m *SdkNodeInspectCurrentResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkNodeInspectCurrentResponse) ProtoMessage()    {}
+func (*SdkNodeInspectCurrentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{121}
+}
+func (m *SdkNodeInspectCurrentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkNodeInspectCurrentResponse.Unmarshal(m, b)
+}
+func (m *SdkNodeInspectCurrentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkNodeInspectCurrentResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkNodeInspectCurrentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkNodeInspectCurrentResponse.Merge(dst, src)
+}
+func (m *SdkNodeInspectCurrentResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkNodeInspectCurrentResponse.Size(m)
+}
+func (m *SdkNodeInspectCurrentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkNodeInspectCurrentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkNodeInspectCurrentResponse proto.InternalMessageInfo
+
+func (m *SdkNodeInspectCurrentResponse) GetNode() *StorageNode {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+type SdkNodeUpdateLabelsRequest struct {
+	// Id of the node. Labels can only be updated on the node servicing
+	// the request, so this must be empty or the id of that node.
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId" json:"node_id,omitempty"`
+	// Labels to add or change on the node
+	Labels               map[string]string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SdkNodeUpdateLabelsRequest) Reset()         { *m = SdkNodeUpdateLabelsRequest{} }
+func (m *SdkNodeUpdateLabelsRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkNodeUpdateLabelsRequest) ProtoMessage()    {}
+func (*SdkNodeUpdateLabelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{122}
+}
+func (m *SdkNodeUpdateLabelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkNodeUpdateLabelsRequest.Unmarshal(m, b)
+}
+func (m *SdkNodeUpdateLabelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkNodeUpdateLabelsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkNodeUpdateLabelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkNodeUpdateLabelsRequest.Merge(dst, src)
+}
+func (m *SdkNodeUpdateLabelsRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkNodeUpdateLabelsRequest.Size(m)
+}
+func (m *SdkNodeUpdateLabelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkNodeUpdateLabelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkNodeUpdateLabelsRequest proto.InternalMessageInfo
+
+func (m *SdkNodeUpdateLabelsRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *SdkNodeUpdateLabelsRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type SdkNodeUpdateLabelsResponse struct {
+	// Information about the node after the update
+	Node                 *StorageNode `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SdkNodeUpdateLabelsResponse) Reset()         { *m = SdkNodeUpdateLabelsResponse{} }
+func (m *SdkNodeUpdateLabelsResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkNodeUpdateLabelsResponse) ProtoMessage()    {}
+func (*SdkNodeUpdateLabelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{123}
+}
+func (m *SdkNodeUpdateLabelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkNodeUpdateLabelsResponse.Unmarshal(m, b)
+}
+func (m *SdkNodeUpdateLabelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkNodeUpdateLabelsResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkNodeUpdateLabelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkNodeUpdateLabelsResponse.Merge(dst, src)
+}
+func (m *SdkNodeUpdateLabelsResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkNodeUpdateLabelsResponse.Size(m)
 }
-func (m *SdkClusterAlertEraseResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SdkClusterAlertEraseResponse.DiscardUnknown(m)
+func (m *SdkNodeUpdateLabelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkNodeUpdateLabelsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SdkClusterAlertEraseResponse proto.InternalMessageInfo
+var xxx_messageInfo_SdkNodeUpdateLabelsResponse proto.InternalMessageInfo
+
+func (m *SdkNodeUpdateLabelsResponse) GetNode() *StorageNode {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
 
 type SdkObjectstoreInspectRequest struct {
 	// ObjecstoreID to query objestore status
@@ -5734,7 +7304,7 @@ func (m *SdkObjectstoreInspectRequest) Reset()         { *m = SdkObjectstoreInsp
 func (m *SdkObjectstoreInspectRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreInspectRequest) ProtoMessage()    {}
 func (*SdkObjectstoreInspectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{95}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{124}
 }
 func (m *SdkObjectstoreInspectRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreInspectRequest.Unmarshal(m, b)
@@ -5773,7 +7343,7 @@ func (m *SdkObjectstoreInspectResponse) Reset()         { *m = SdkObjectstoreIns
 func (m *SdkObjectstoreInspectResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreInspectResponse) ProtoMessage()    {}
 func (*SdkObjectstoreInspectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{96}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{125}
 }
 func (m *SdkObjectstoreInspectResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreInspectResponse.Unmarshal(m, b)
@@ -5812,7 +7382,7 @@ func (m *SdkObjectstoreCreateRequest) Reset()         { *m = SdkObjectstoreCreat
 func (m *SdkObjectstoreCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreCreateRequest) ProtoMessage()    {}
 func (*SdkObjectstoreCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{97}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{126}
 }
 func (m *SdkObjectstoreCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreCreateRequest.Unmarshal(m, b)
@@ -5851,7 +7421,7 @@ func (m *SdkObjectstoreCreateResponse) Reset()         { *m = SdkObjectstoreCrea
 func (m *SdkObjectstoreCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreCreateResponse) ProtoMessage()    {}
 func (*SdkObjectstoreCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{98}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{127}
 }
 func (m *SdkObjectstoreCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreCreateResponse.Unmarshal(m, b)
@@ -5890,7 +7460,7 @@ func (m *SdkObjectstoreDeleteRequest) Reset()         { *m = SdkObjectstoreDelet
 func (m *SdkObjectstoreDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreDeleteRequest) ProtoMessage()    {}
 func (*SdkObjectstoreDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{99}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{128}
 }
 func (m *SdkObjectstoreDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreDeleteRequest.Unmarshal(m, b)
@@ -5927,7 +7497,7 @@ func (m *SdkObjectstoreDeleteResponse) Reset()         { *m = SdkObjectstoreDele
 func (m *SdkObjectstoreDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreDeleteResponse) ProtoMessage()    {}
 func (*SdkObjectstoreDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{100}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{129}
 }
 func (m *SdkObjectstoreDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreDeleteResponse.Unmarshal(m, b)
@@ -5961,7 +7531,7 @@ func (m *SdkObjectstoreUpdateRequest) Reset()         { *m = SdkObjectstoreUpdat
 func (m *SdkObjectstoreUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreUpdateRequest) ProtoMessage()    {}
 func (*SdkObjectstoreUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{101}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{130}
 }
 func (m *SdkObjectstoreUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreUpdateRequest.Unmarshal(m, b)
@@ -6005,7 +7575,7 @@ func (m *SdkObjectstoreUpdateResponse) Reset()         { *m = SdkObjectstoreUpda
 func (m *SdkObjectstoreUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkObjectstoreUpdateResponse) ProtoMessage()    {}
 func (*SdkObjectstoreUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{102}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{131}
 }
 func (m *SdkObjectstoreUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkObjectstoreUpdateResponse.Unmarshal(m, b)
@@ -6041,7 +7611,7 @@ func (m *SdkCloudBackupCreateRequest) Reset()         { *m = SdkCloudBackupCreat
 func (m *SdkCloudBackupCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupCreateRequest) ProtoMessage()    {}
 func (*SdkCloudBackupCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{103}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{132}
 }
 func (m *SdkCloudBackupCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupCreateRequest.Unmarshal(m, b)
@@ -6094,7 +7664,7 @@ func (m *SdkCloudBackupCreateResponse) Reset()         { *m = SdkCloudBackupCrea
 func (m *SdkCloudBackupCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupCreateResponse) ProtoMessage()    {}
 func (*SdkCloudBackupCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{104}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{133}
 }
 func (m *SdkCloudBackupCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupCreateResponse.Unmarshal(m, b)
@@ -6141,7 +7711,7 @@ func (m *SdkCloudBackupRestoreRequest) Reset()         { *m = SdkCloudBackupRest
 func (m *SdkCloudBackupRestoreRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupRestoreRequest) ProtoMessage()    {}
 func (*SdkCloudBackupRestoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{105}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{134}
 }
 func (m *SdkCloudBackupRestoreRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupRestoreRequest.Unmarshal(m, b)
@@ -6201,7 +7771,7 @@ func (m *SdkCloudBackupRestoreResponse) Reset()         { *m = SdkCloudBackupRes
 func (m *SdkCloudBackupRestoreResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupRestoreResponse) ProtoMessage()    {}
 func (*SdkCloudBackupRestoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{106}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{135}
 }
 func (m *SdkCloudBackupRestoreResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupRestoreResponse.Unmarshal(m, b)
@@ -6244,7 +7814,7 @@ func (m *SdkCloudBackupDeleteRequest) Reset()         { *m = SdkCloudBackupDelet
 func (m *SdkCloudBackupDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupDeleteRequest) ProtoMessage()    {}
 func (*SdkCloudBackupDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{107}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{136}
 }
 func (m *SdkCloudBackupDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupDeleteRequest.Unmarshal(m, b)
@@ -6295,7 +7865,7 @@ func (m *SdkCloudBackupDeleteResponse) Reset()         { *m = SdkCloudBackupDele
 func (m *SdkCloudBackupDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupDeleteResponse) ProtoMessage()    {}
 func (*SdkCloudBackupDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{108}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{137}
 }
 func (m *SdkCloudBackupDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupDeleteResponse.Unmarshal(m, b)
@@ -6329,7 +7899,7 @@ func (m *SdkCloudBackupDeleteAllRequest) Reset()         { *m = SdkCloudBackupDe
 func (m *SdkCloudBackupDeleteAllRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupDeleteAllRequest) ProtoMessage()    {}
 func (*SdkCloudBackupDeleteAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{109}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{138}
 }
 func (m *SdkCloudBackupDeleteAllRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupDeleteAllRequest.Unmarshal(m, b)
@@ -6373,7 +7943,7 @@ func (m *SdkCloudBackupDeleteAllResponse) Reset()         { *m = SdkCloudBackupD
 func (m *SdkCloudBackupDeleteAllResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupDeleteAllResponse) ProtoMessage()    {}
 func (*SdkCloudBackupDeleteAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{110}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{139}
 }
 func (m *SdkCloudBackupDeleteAllResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupDeleteAllResponse.Unmarshal(m, b)
@@ -6411,7 +7981,7 @@ func (m *SdkCloudBackupEnumerateRequest) Reset()         { *m = SdkCloudBackupEn
 func (m *SdkCloudBackupEnumerateRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupEnumerateRequest) ProtoMessage()    {}
 func (*SdkCloudBackupEnumerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{111}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{140}
 }
 func (m *SdkCloudBackupEnumerateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupEnumerateRequest.Unmarshal(m, b)
@@ -6482,7 +8052,7 @@ func (m *SdkCloudBackupInfo) Reset()         { *m = SdkCloudBackupInfo{} }
 func (m *SdkCloudBackupInfo) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupInfo) ProtoMessage()    {}
 func (*SdkCloudBackupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{112}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{141}
 }
 func (m *SdkCloudBackupInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupInfo.Unmarshal(m, b)
@@ -6555,7 +8125,7 @@ func (m *SdkCloudBackupEnumerateResponse) Reset()         { *m = SdkCloudBackupE
 func (m *SdkCloudBackupEnumerateResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupEnumerateResponse) ProtoMessage()    {}
 func (*SdkCloudBackupEnumerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{113}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{142}
 }
 func (m *SdkCloudBackupEnumerateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupEnumerateResponse.Unmarshal(m, b)
@@ -6606,7 +8176,7 @@ func (m *SdkCloudBackupStatus) Reset()         { *m = SdkCloudBackupStatus{} }
 func (m *SdkCloudBackupStatus) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupStatus) ProtoMessage()    {}
 func (*SdkCloudBackupStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{114}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{143}
 }
 func (m *SdkCloudBackupStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupStatus.Unmarshal(m, b)
@@ -6690,7 +8260,7 @@ func (m *SdkCloudBackupStatusRequest) Reset()         { *m = SdkCloudBackupStatu
 func (m *SdkCloudBackupStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupStatusRequest) ProtoMessage()    {}
 func (*SdkCloudBackupStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{115}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{144}
 }
 func (m *SdkCloudBackupStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupStatusRequest.Unmarshal(m, b)
@@ -6736,7 +8306,7 @@ func (m *SdkCloudBackupStatusResponse) Reset()         { *m = SdkCloudBackupStat
 func (m *SdkCloudBackupStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupStatusResponse) ProtoMessage()    {}
 func (*SdkCloudBackupStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{116}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{145}
 }
 func (m *SdkCloudBackupStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupStatusResponse.Unmarshal(m, b)
@@ -6777,7 +8347,7 @@ func (m *SdkCloudBackupCatalogRequest) Reset()         { *m = SdkCloudBackupCata
 func (m *SdkCloudBackupCatalogRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupCatalogRequest) ProtoMessage()    {}
 func (*SdkCloudBackupCatalogRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{117}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{146}
 }
 func (m *SdkCloudBackupCatalogRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupCatalogRequest.Unmarshal(m, b)
@@ -6823,7 +8393,7 @@ func (m *SdkCloudBackupCatalogResponse) Reset()         { *m = SdkCloudBackupCat
 func (m *SdkCloudBackupCatalogResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupCatalogResponse) ProtoMessage()    {}
 func (*SdkCloudBackupCatalogResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{118}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{147}
 }
 func (m *SdkCloudBackupCatalogResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupCatalogResponse.Unmarshal(m, b)
@@ -6866,7 +8436,7 @@ func (m *SdkCloudBackupHistoryItem) Reset()         { *m = SdkCloudBackupHistory
 func (m *SdkCloudBackupHistoryItem) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupHistoryItem) ProtoMessage()    {}
 func (*SdkCloudBackupHistoryItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{119}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{148}
 }
 func (m *SdkCloudBackupHistoryItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupHistoryItem.Unmarshal(m, b)
@@ -6920,7 +8490,7 @@ func (m *SdkCloudBackupHistoryRequest) Reset()         { *m = SdkCloudBackupHist
 func (m *SdkCloudBackupHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupHistoryRequest) ProtoMessage()    {}
 func (*SdkCloudBackupHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{120}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{149}
 }
 func (m *SdkCloudBackupHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupHistoryRequest.Unmarshal(m, b)
@@ -6959,7 +8529,7 @@ func (m *SdkCloudBackupHistoryResponse) Reset()         { *m = SdkCloudBackupHis
 func (m *SdkCloudBackupHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupHistoryResponse) ProtoMessage()    {}
 func (*SdkCloudBackupHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{121}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{150}
 }
 func (m *SdkCloudBackupHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupHistoryResponse.Unmarshal(m, b)
@@ -7002,7 +8572,7 @@ func (m *SdkCloudBackupStateChangeRequest) Reset()         { *m = SdkCloudBackup
 func (m *SdkCloudBackupStateChangeRequest) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupStateChangeRequest) ProtoMessage()    {}
 func (*SdkCloudBackupStateChangeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{122}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{151}
 }
 func (m *SdkCloudBackupStateChangeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupStateChangeRequest.Unmarshal(m, b)
@@ -7046,7 +8616,7 @@ func (m *SdkCloudBackupStateChangeResponse) Reset()         { *m = SdkCloudBacku
 func (m *SdkCloudBackupStateChangeResponse) String() string { return proto.CompactTextString(m) }
 func (*SdkCloudBackupStateChangeResponse) ProtoMessage()    {}
 func (*SdkCloudBackupStateChangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_api_d5788eb4d49bdfcc, []int{123}
+	return fileDescriptor_api_0a6993e9904e02e0, []int{152}
 }
 func (m *SdkCloudBackupStateChangeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SdkCloudBackupStateChangeResponse.Unmarshal(m, b)
@@ -7066,6 +8636,111 @@ func (m *SdkCloudBackupStateChangeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SdkCloudBackupStateChangeResponse proto.InternalMessageInfo
 
+type SdkAuditEnumerateRequest struct {
+	// Only return the events which occurred after this time (optional)
+	TimeStart *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time_start,json=timeStart" json:"time_start,omitempty"`
+	// Only return the events which occurred before this time (optional)
+	TimeEnd *timestamp.Timestamp `protobuf:"bytes,2,opt,name=time_end,json=timeEnd" json:"time_end,omitempty"`
+	// Only return the events of this caller (optional)
+	Subject string `protobuf:"bytes,3,opt,name=subject" json:"subject,omitempty"`
+	// Only return the events of this operation (optional)
+	Operation            string   `protobuf:"bytes,4,opt,name=operation" json:"operation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SdkAuditEnumerateRequest) Reset()         { *m = SdkAuditEnumerateRequest{} }
+func (m *SdkAuditEnumerateRequest) String() string { return proto.CompactTextString(m) }
+func (*SdkAuditEnumerateRequest) ProtoMessage()    {}
+func (*SdkAuditEnumerateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{153}
+}
+func (m *SdkAuditEnumerateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkAuditEnumerateRequest.Unmarshal(m, b)
+}
+func (m *SdkAuditEnumerateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkAuditEnumerateRequest.Marshal(b, m, deterministic)
+}
+func (dst *SdkAuditEnumerateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkAuditEnumerateRequest.Merge(dst, src)
+}
+func (m *SdkAuditEnumerateRequest) XXX_Size() int {
+	return xxx_messageInfo_SdkAuditEnumerateRequest.Size(m)
+}
+func (m *SdkAuditEnumerateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkAuditEnumerateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkAuditEnumerateRequest proto.InternalMessageInfo
+
+func (m *SdkAuditEnumerateRequest) GetTimeStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.TimeStart
+	}
+	return nil
+}
+
+func (m *SdkAuditEnumerateRequest) GetTimeEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.TimeEnd
+	}
+	return nil
+}
+
+func (m *SdkAuditEnumerateRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *SdkAuditEnumerateRequest) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+type SdkAuditEnumerateResponse struct {
+	// Audit events ordered by time
+	Events               []*AuditEvent `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SdkAuditEnumerateResponse) Reset()         { *m = SdkAuditEnumerateResponse{} }
+func (m *SdkAuditEnumerateResponse) String() string { return proto.CompactTextString(m) }
+func (*SdkAuditEnumerateResponse) ProtoMessage()    {}
+func (*SdkAuditEnumerateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_api_0a6993e9904e02e0, []int{154}
+}
+func (m *SdkAuditEnumerateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SdkAuditEnumerateResponse.Unmarshal(m, b)
+}
+func (m *SdkAuditEnumerateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SdkAuditEnumerateResponse.Marshal(b, m, deterministic)
+}
+func (dst *SdkAuditEnumerateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SdkAuditEnumerateResponse.Merge(dst, src)
+}
+func (m *SdkAuditEnumerateResponse) XXX_Size() int {
+	return xxx_messageInfo_SdkAuditEnumerateResponse.Size(m)
+}
+func (m *SdkAuditEnumerateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SdkAuditEnumerateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SdkAuditEnumerateResponse proto.InternalMessageInfo
+
+func (m *SdkAuditEnumerateResponse) GetEvents() []*AuditEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StorageResource)(nil), "openstorage.api.StorageResource")
 	proto.RegisterType((*StoragePool)(nil), "openstorage.api.StoragePool")
@@ -7074,6 +8749,12 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "openstorage.api.VolumeLocator.VolumeLabelsEntry")
 	proto.RegisterType((*Source)(nil), "openstorage.api.Source")
 	proto.RegisterType((*Group)(nil), "openstorage.api.Group")
+	proto.RegisterType((*OwnershipAcls)(nil), "openstorage.api.OwnershipAcls")
+	proto.RegisterMapType((map[string]OwnershipAccessType)(nil), "openstorage.api.OwnershipAcls.CollaboratorsEntry")
+	proto.RegisterMapType((map[string]OwnershipAccessType)(nil), "openstorage.api.OwnershipAcls.GroupsEntry")
+	proto.RegisterType((*Ownership)(nil), "openstorage.api.Ownership")
+	proto.RegisterType((*LabelSelectorRequirement)(nil), "openstorage.api.LabelSelectorRequirement")
+	proto.RegisterType((*VolumeFilter)(nil), "openstorage.api.VolumeFilter")
 	proto.RegisterType((*VolumeSpec)(nil), "openstorage.api.VolumeSpec")
 	proto.RegisterMapType((map[string]string)(nil), "openstorage.api.VolumeSpec.VolumeLabelsEntry")
 	proto.RegisterType((*ReplicaSet)(nil), "openstorage.api.ReplicaSet")
@@ -7084,6 +8765,7 @@ func init() {
 	proto.RegisterType((*Stats)(nil), "openstorage.api.Stats")
 	proto.RegisterType((*Alert)(nil), "openstorage.api.Alert")
 	proto.RegisterType((*Alerts)(nil), "openstorage.api.Alerts")
+	proto.RegisterType((*AuditEvent)(nil), "openstorage.api.AuditEvent")
 	proto.RegisterType((*ObjectstoreInfo)(nil), "openstorage.api.ObjectstoreInfo")
 	proto.RegisterType((*VolumeCreateRequest)(nil), "openstorage.api.VolumeCreateRequest")
 	proto.RegisterType((*VolumeResponse)(nil), "openstorage.api.VolumeResponse")
@@ -7160,6 +8842,15 @@ func init() {
 	proto.RegisterType((*SdkVolumeInspectResponse)(nil), "openstorage.api.SdkVolumeInspectResponse")
 	proto.RegisterType((*SdkVolumeEnumerateRequest)(nil), "openstorage.api.SdkVolumeEnumerateRequest")
 	proto.RegisterType((*SdkVolumeEnumerateResponse)(nil), "openstorage.api.SdkVolumeEnumerateResponse")
+	proto.RegisterType((*SdkVolumeUpdateRequest)(nil), "openstorage.api.SdkVolumeUpdateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "openstorage.api.SdkVolumeUpdateRequest.LabelsEntry")
+	proto.RegisterType((*SdkVolumeUpdateResponse)(nil), "openstorage.api.SdkVolumeUpdateResponse")
+	proto.RegisterType((*SdkVolumeStatsRequest)(nil), "openstorage.api.SdkVolumeStatsRequest")
+	proto.RegisterType((*SdkVolumeStatsResponse)(nil), "openstorage.api.SdkVolumeStatsResponse")
+	proto.RegisterType((*SdkVolumeCapacityUsageRequest)(nil), "openstorage.api.SdkVolumeCapacityUsageRequest")
+	proto.RegisterType((*SdkVolumeCapacityUsageResponse)(nil), "openstorage.api.SdkVolumeCapacityUsageResponse")
+	proto.RegisterType((*SdkVolumeActiveRequestsRequest)(nil), "openstorage.api.SdkVolumeActiveRequestsRequest")
+	proto.RegisterType((*SdkVolumeActiveRequestsResponse)(nil), "openstorage.api.SdkVolumeActiveRequestsResponse")
 	proto.RegisterType((*SdkVolumeSnapshotCreateRequest)(nil), "openstorage.api.SdkVolumeSnapshotCreateRequest")
 	proto.RegisterMapType((map[string]string)(nil), "openstorage.api.SdkVolumeSnapshotCreateRequest.LabelsEntry")
 	proto.RegisterType((*SdkVolumeSnapshotCreateResponse)(nil), "openstorage.api.SdkVolumeSnapshotCreateResponse")
@@ -7168,6 +8859,14 @@ func init() {
 	proto.RegisterType((*SdkVolumeSnapshotEnumerateRequest)(nil), "openstorage.api.SdkVolumeSnapshotEnumerateRequest")
 	proto.RegisterMapType((map[string]string)(nil), "openstorage.api.SdkVolumeSnapshotEnumerateRequest.LabelsEntry")
 	proto.RegisterType((*SdkVolumeSnapshotEnumerateResponse)(nil), "openstorage.api.SdkVolumeSnapshotEnumerateResponse")
+	proto.RegisterType((*SdkVolumeSnapshotGroupCreateRequest)(nil), "openstorage.api.SdkVolumeSnapshotGroupCreateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "openstorage.api.SdkVolumeSnapshotGroupCreateRequest.LabelsEntry")
+	proto.RegisterType((*SdkVolumeSnapshotGroupCreateResponse)(nil), "openstorage.api.SdkVolumeSnapshotGroupCreateResponse")
+	proto.RegisterMapType((map[string]string)(nil), "openstorage.api.SdkVolumeSnapshotGroupCreateResponse.SnapshotsEntry")
+	proto.RegisterType((*SdkVolumeQuiesceRequest)(nil), "openstorage.api.SdkVolumeQuiesceRequest")
+	proto.RegisterType((*SdkVolumeQuiesceResponse)(nil), "openstorage.api.SdkVolumeQuiesceResponse")
+	proto.RegisterType((*SdkVolumeUnquiesceRequest)(nil), "openstorage.api.SdkVolumeUnquiesceRequest")
+	proto.RegisterType((*SdkVolumeUnquiesceResponse)(nil), "openstorage.api.SdkVolumeUnquiesceResponse")
 	proto.RegisterType((*SdkClusterEnumerateRequest)(nil), "openstorage.api.SdkClusterEnumerateRequest")
 	proto.RegisterType((*SdkClusterEnumerateResponse)(nil), "openstorage.api.SdkClusterEnumerateResponse")
 	proto.RegisterType((*SdkClusterInspectRequest)(nil), "openstorage.api.SdkClusterInspectRequest")
@@ -7178,6 +8877,17 @@ func init() {
 	proto.RegisterType((*SdkClusterAlertClearResponse)(nil), "openstorage.api.SdkClusterAlertClearResponse")
 	proto.RegisterType((*SdkClusterAlertEraseRequest)(nil), "openstorage.api.SdkClusterAlertEraseRequest")
 	proto.RegisterType((*SdkClusterAlertEraseResponse)(nil), "openstorage.api.SdkClusterAlertEraseResponse")
+	proto.RegisterType((*SdkClusterAlertWatchRequest)(nil), "openstorage.api.SdkClusterAlertWatchRequest")
+	proto.RegisterType((*SdkClusterAlertWatchResponse)(nil), "openstorage.api.SdkClusterAlertWatchResponse")
+	proto.RegisterType((*SdkNodeEnumerateRequest)(nil), "openstorage.api.SdkNodeEnumerateRequest")
+	proto.RegisterType((*SdkNodeEnumerateResponse)(nil), "openstorage.api.SdkNodeEnumerateResponse")
+	proto.RegisterType((*SdkNodeInspectRequest)(nil), "openstorage.api.SdkNodeInspectRequest")
+	proto.RegisterType((*SdkNodeInspectResponse)(nil), "openstorage.api.SdkNodeInspectResponse")
+	proto.RegisterType((*SdkNodeInspectCurrentRequest)(nil), "openstorage.api.SdkNodeInspectCurrentRequest")
+	proto.RegisterType((*SdkNodeInspectCurrentResponse)(nil), "openstorage.api.SdkNodeInspectCurrentResponse")
+	proto.RegisterType((*SdkNodeUpdateLabelsRequest)(nil), "openstorage.api.SdkNodeUpdateLabelsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "openstorage.api.SdkNodeUpdateLabelsRequest.LabelsEntry")
+	proto.RegisterType((*SdkNodeUpdateLabelsResponse)(nil), "openstorage.api.SdkNodeUpdateLabelsResponse")
 	proto.RegisterType((*SdkObjectstoreInspectRequest)(nil), "openstorage.api.SdkObjectstoreInspectRequest")
 	proto.RegisterType((*SdkObjectstoreInspectResponse)(nil), "openstorage.api.SdkObjectstoreInspectResponse")
 	proto.RegisterType((*SdkObjectstoreCreateRequest)(nil), "openstorage.api.SdkObjectstoreCreateRequest")
@@ -7209,6 +8919,8 @@ func init() {
 	proto.RegisterType((*SdkCloudBackupHistoryResponse)(nil), "openstorage.api.SdkCloudBackupHistoryResponse")
 	proto.RegisterType((*SdkCloudBackupStateChangeRequest)(nil), "openstorage.api.SdkCloudBackupStateChangeRequest")
 	proto.RegisterType((*SdkCloudBackupStateChangeResponse)(nil), "openstorage.api.SdkCloudBackupStateChangeResponse")
+	proto.RegisterType((*SdkAuditEnumerateRequest)(nil), "openstorage.api.SdkAuditEnumerateRequest")
+	proto.RegisterType((*SdkAuditEnumerateResponse)(nil), "openstorage.api.SdkAuditEnumerateResponse")
 	proto.RegisterEnum("openstorage.api.Status", Status_name, Status_value)
 	proto.RegisterEnum("openstorage.api.DriverType", DriverType_name, DriverType_value)
 	proto.RegisterEnum("openstorage.api.FSType", FSType_name, FSType_value)
@@ -7225,6 +8937,8 @@ func init() {
 	proto.RegisterEnum("openstorage.api.ClusterNotify", ClusterNotify_name, ClusterNotify_value)
 	proto.RegisterEnum("openstorage.api.AttachState", AttachState_name, AttachState_value)
 	proto.RegisterEnum("openstorage.api.OperationFlags", OperationFlags_name, OperationFlags_value)
+	proto.RegisterEnum("openstorage.api.OwnershipAccessType", OwnershipAccessType_name, OwnershipAccessType_value)
+	proto.RegisterEnum("openstorage.api.LabelSelectorOperator", LabelSelectorOperator_name, LabelSelectorOperator_value)
 	proto.RegisterEnum("openstorage.api.SdkCloudBackupOpType", SdkCloudBackupOpType_name, SdkCloudBackupOpType_value)
 	proto.RegisterEnum("openstorage.api.SdkCloudBackupStatusType", SdkCloudBackupStatusType_name, SdkCloudBackupStatusType_value)
 	proto.RegisterEnum("openstorage.api.SdkCloudBackupRequestedState", SdkCloudBackupRequestedState_name, SdkCloudBackupRequestedState_value)
@@ -7252,6 +8966,8 @@ type OpenStorageClusterClient interface {
 	AlertClear(ctx context.Context, in *SdkClusterAlertClearRequest, opts ...grpc.CallOption) (*SdkClusterAlertClearResponse, error)
 	// Erases an alert for a given resource
 	AlertErase(ctx context.Context, in *SdkClusterAlertEraseRequest, opts ...grpc.CallOption) (*SdkClusterAlertEraseResponse, error)
+	// Watch for alerts being created, updated or deleted in the storage cluster
+	AlertWatch(ctx context.Context, in *SdkClusterAlertWatchRequest, opts ...grpc.CallOption) (OpenStorageCluster_AlertWatchClient, error)
 }
 
 type openStorageClusterClient struct {
@@ -7307,137 +9023,369 @@ func (c *openStorageClusterClient) AlertErase(ctx context.Context, in *SdkCluste
 	return out, nil
 }
 
-// OpenStorageClusterServer is the server API for OpenStorageCluster service.
-type OpenStorageClusterServer interface {
-	// Enumerate lists all the nodes in the cluster.
-	Enumerate(context.Context, *SdkClusterEnumerateRequest) (*SdkClusterEnumerateResponse, error)
-	// Inspect the node given a UUID.
-	Inspect(context.Context, *SdkClusterInspectRequest) (*SdkClusterInspectResponse, error)
-	// Get a list of alerts from the storage cluster
-	AlertEnumerate(context.Context, *SdkClusterAlertEnumerateRequest) (*SdkClusterAlertEnumerateResponse, error)
-	// Clear the alert for a given resource
-	AlertClear(context.Context, *SdkClusterAlertClearRequest) (*SdkClusterAlertClearResponse, error)
-	// Erases an alert for a given resource
-	AlertErase(context.Context, *SdkClusterAlertEraseRequest) (*SdkClusterAlertEraseResponse, error)
+func (c *openStorageClusterClient) AlertWatch(ctx context.Context, in *SdkClusterAlertWatchRequest, opts ...grpc.CallOption) (OpenStorageCluster_AlertWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_OpenStorageCluster_serviceDesc.Streams[0], "/openstorage.api.OpenStorageCluster/AlertWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &openStorageClusterAlertWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OpenStorageCluster_AlertWatchClient interface {
+	Recv() (*SdkClusterAlertWatchResponse, error)
+	grpc.ClientStream
+}
+
+type openStorageClusterAlertWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *openStorageClusterAlertWatchClient) Recv() (*SdkClusterAlertWatchResponse, error) {
+	m := new(SdkClusterAlertWatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// OpenStorageClusterServer is the server API for OpenStorageCluster service.
+type OpenStorageClusterServer interface {
+	// Enumerate lists all the nodes in the cluster.
+	Enumerate(context.Context, *SdkClusterEnumerateRequest) (*SdkClusterEnumerateResponse, error)
+	// Inspect the node given a UUID.
+	Inspect(context.Context, *SdkClusterInspectRequest) (*SdkClusterInspectResponse, error)
+	// Get a list of alerts from the storage cluster
+	AlertEnumerate(context.Context, *SdkClusterAlertEnumerateRequest) (*SdkClusterAlertEnumerateResponse, error)
+	// Clear the alert for a given resource
+	AlertClear(context.Context, *SdkClusterAlertClearRequest) (*SdkClusterAlertClearResponse, error)
+	// Erases an alert for a given resource
+	AlertErase(context.Context, *SdkClusterAlertEraseRequest) (*SdkClusterAlertEraseResponse, error)
+	// Watch for alerts being created, updated or deleted in the storage cluster
+	AlertWatch(*SdkClusterAlertWatchRequest, OpenStorageCluster_AlertWatchServer) error
+}
+
+func RegisterOpenStorageClusterServer(s *grpc.Server, srv OpenStorageClusterServer) {
+	s.RegisterService(&_OpenStorageCluster_serviceDesc, srv)
+}
+
+func _OpenStorageCluster_Enumerate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkClusterEnumerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenStorageClusterServer).Enumerate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openstorage.api.OpenStorageCluster/Enumerate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenStorageClusterServer).Enumerate(ctx, req.(*SdkClusterEnumerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenStorageCluster_Inspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkClusterInspectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenStorageClusterServer).Inspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openstorage.api.OpenStorageCluster/Inspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenStorageClusterServer).Inspect(ctx, req.(*SdkClusterInspectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenStorageCluster_AlertEnumerate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkClusterAlertEnumerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenStorageClusterServer).AlertEnumerate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openstorage.api.OpenStorageCluster/AlertEnumerate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenStorageClusterServer).AlertEnumerate(ctx, req.(*SdkClusterAlertEnumerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenStorageCluster_AlertClear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkClusterAlertClearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenStorageClusterServer).AlertClear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openstorage.api.OpenStorageCluster/AlertClear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenStorageClusterServer).AlertClear(ctx, req.(*SdkClusterAlertClearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenStorageCluster_AlertErase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkClusterAlertEraseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenStorageClusterServer).AlertErase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openstorage.api.OpenStorageCluster/AlertErase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenStorageClusterServer).AlertErase(ctx, req.(*SdkClusterAlertEraseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenStorageCluster_AlertWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SdkClusterAlertWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OpenStorageClusterServer).AlertWatch(m, &openStorageClusterAlertWatchServer{stream})
+}
+
+type OpenStorageCluster_AlertWatchServer interface {
+	Send(*SdkClusterAlertWatchResponse) error
+	grpc.ServerStream
+}
+
+type openStorageClusterAlertWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *openStorageClusterAlertWatchServer) Send(m *SdkClusterAlertWatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _OpenStorageCluster_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "openstorage.api.OpenStorageCluster",
+	HandlerType: (*OpenStorageClusterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Enumerate",
+			Handler:    _OpenStorageCluster_Enumerate_Handler,
+		},
+		{
+			MethodName: "Inspect",
+			Handler:    _OpenStorageCluster_Inspect_Handler,
+		},
+		{
+			MethodName: "AlertEnumerate",
+			Handler:    _OpenStorageCluster_AlertEnumerate_Handler,
+		},
+		{
+			MethodName: "AlertClear",
+			Handler:    _OpenStorageCluster_AlertClear_Handler,
+		},
+		{
+			MethodName: "AlertErase",
+			Handler:    _OpenStorageCluster_AlertErase_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AlertWatch",
+			Handler:       _OpenStorageCluster_AlertWatch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/api.proto",
+}
+
+// OpenStorageNodeClient is the client API for OpenStorageNode service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OpenStorageNodeClient interface {
+	// Enumerate returns the ids of all the nodes in the cluster
+	Enumerate(ctx context.Context, in *SdkNodeEnumerateRequest, opts ...grpc.CallOption) (*SdkNodeEnumerateResponse, error)
+	// Inspect returns information about the specified node
+	Inspect(ctx context.Context, in *SdkNodeInspectRequest, opts ...grpc.CallOption) (*SdkNodeInspectResponse, error)
+	// InspectCurrent returns information about the node servicing the request
+	InspectCurrent(ctx context.Context, in *SdkNodeInspectCurrentRequest, opts ...grpc.CallOption) (*SdkNodeInspectCurrentResponse, error)
+	// UpdateLabels adds or changes the labels of the node servicing the request
+	UpdateLabels(ctx context.Context, in *SdkNodeUpdateLabelsRequest, opts ...grpc.CallOption) (*SdkNodeUpdateLabelsResponse, error)
+}
+
+type openStorageNodeClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewOpenStorageNodeClient(cc *grpc.ClientConn) OpenStorageNodeClient {
+	return &openStorageNodeClient{cc}
+}
+
+func (c *openStorageNodeClient) Enumerate(ctx context.Context, in *SdkNodeEnumerateRequest, opts ...grpc.CallOption) (*SdkNodeEnumerateResponse, error) {
+	out := new(SdkNodeEnumerateResponse)
+	err := c.cc.Invoke(ctx, "/openstorage.api.OpenStorageNode/Enumerate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openStorageNodeClient) Inspect(ctx context.Context, in *SdkNodeInspectRequest, opts ...grpc.CallOption) (*SdkNodeInspectResponse, error) {
+	out := new(SdkNodeInspectResponse)
+	err := c.cc.Invoke(ctx, "/openstorage.api.OpenStorageNode/Inspect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openStorageNodeClient) InspectCurrent(ctx context.Context, in *SdkNodeInspectCurrentRequest, opts ...grpc.CallOption) (*SdkNodeInspectCurrentResponse, error) {
+	out := new(SdkNodeInspectCurrentResponse)
+	err := c.cc.Invoke(ctx, "/openstorage.api.OpenStorageNode/InspectCurrent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openStorageNodeClient) UpdateLabels(ctx context.Context, in *SdkNodeUpdateLabelsRequest, opts ...grpc.CallOption) (*SdkNodeUpdateLabelsResponse, error) {
+	out := new(SdkNodeUpdateLabelsResponse)
+	err := c.cc.Invoke(ctx, "/openstorage.api.OpenStorageNode/UpdateLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterOpenStorageClusterServer(s *grpc.Server, srv OpenStorageClusterServer) {
-	s.RegisterService(&_OpenStorageCluster_serviceDesc, srv)
+// OpenStorageNodeServer is the server API for OpenStorageNode service.
+type OpenStorageNodeServer interface {
+	// Enumerate returns the ids of all the nodes in the cluster
+	Enumerate(context.Context, *SdkNodeEnumerateRequest) (*SdkNodeEnumerateResponse, error)
+	// Inspect returns information about the specified node
+	Inspect(context.Context, *SdkNodeInspectRequest) (*SdkNodeInspectResponse, error)
+	// InspectCurrent returns information about the node servicing the request
+	InspectCurrent(context.Context, *SdkNodeInspectCurrentRequest) (*SdkNodeInspectCurrentResponse, error)
+	// UpdateLabels adds or changes the labels of the node servicing the request
+	UpdateLabels(context.Context, *SdkNodeUpdateLabelsRequest) (*SdkNodeUpdateLabelsResponse, error)
 }
 
-func _OpenStorageCluster_Enumerate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SdkClusterEnumerateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(OpenStorageClusterServer).Enumerate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/openstorage.api.OpenStorageCluster/Enumerate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpenStorageClusterServer).Enumerate(ctx, req.(*SdkClusterEnumerateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func RegisterOpenStorageNodeServer(s *grpc.Server, srv OpenStorageNodeServer) {
+	s.RegisterService(&_OpenStorageNode_serviceDesc, srv)
 }
 
-func _OpenStorageCluster_Inspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SdkClusterInspectRequest)
+func _OpenStorageNode_Enumerate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkNodeEnumerateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpenStorageClusterServer).Inspect(ctx, in)
+		return srv.(OpenStorageNodeServer).Enumerate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openstorage.api.OpenStorageCluster/Inspect",
+		FullMethod: "/openstorage.api.OpenStorageNode/Enumerate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpenStorageClusterServer).Inspect(ctx, req.(*SdkClusterInspectRequest))
+		return srv.(OpenStorageNodeServer).Enumerate(ctx, req.(*SdkNodeEnumerateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpenStorageCluster_AlertEnumerate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SdkClusterAlertEnumerateRequest)
+func _OpenStorageNode_Inspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkNodeInspectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpenStorageClusterServer).AlertEnumerate(ctx, in)
+		return srv.(OpenStorageNodeServer).Inspect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openstorage.api.OpenStorageCluster/AlertEnumerate",
+		FullMethod: "/openstorage.api.OpenStorageNode/Inspect",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpenStorageClusterServer).AlertEnumerate(ctx, req.(*SdkClusterAlertEnumerateRequest))
+		return srv.(OpenStorageNodeServer).Inspect(ctx, req.(*SdkNodeInspectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpenStorageCluster_AlertClear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SdkClusterAlertClearRequest)
+func _OpenStorageNode_InspectCurrent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkNodeInspectCurrentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpenStorageClusterServer).AlertClear(ctx, in)
+		return srv.(OpenStorageNodeServer).InspectCurrent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openstorage.api.OpenStorageCluster/AlertClear",
+		FullMethod: "/openstorage.api.OpenStorageNode/InspectCurrent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpenStorageClusterServer).AlertClear(ctx, req.(*SdkClusterAlertClearRequest))
+		return srv.(OpenStorageNodeServer).InspectCurrent(ctx, req.(*SdkNodeInspectCurrentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OpenStorageCluster_AlertErase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SdkClusterAlertEraseRequest)
+func _OpenStorageNode_UpdateLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkNodeUpdateLabelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OpenStorageClusterServer).AlertErase(ctx, in)
+		return srv.(OpenStorageNodeServer).UpdateLabels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openstorage.api.OpenStorageCluster/AlertErase",
+		FullMethod: "/openstorage.api.OpenStorageNode/UpdateLabels",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OpenStorageClusterServer).AlertErase(ctx, req.(*SdkClusterAlertEraseRequest))
+		return srv.(OpenStorageNodeServer).UpdateLabels(ctx, req.(*SdkNodeUpdateLabelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _OpenStorageCluster_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "openstorage.api.OpenStorageCluster",
-	HandlerType: (*OpenStorageClusterServer)(nil),
+var _OpenStorageNode_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "openstorage.api.OpenStorageNode",
+	HandlerType: (*OpenStorageNodeServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Enumerate",
-			Handler:    _OpenStorageCluster_Enumerate_Handler,
+			Handler:    _OpenStorageNode_Enumerate_Handler,
 		},
 		{
 			MethodName: "Inspect",
-			Handler:    _OpenStorageCluster_Inspect_Handler,
-		},
-		{
-			MethodName: "AlertEnumerate",
-			Handler:    _OpenStorageCluster_AlertEnumerate_Handler,
+			Handler:    _OpenStorageNode_Inspect_Handler,
 		},
 		{
-			MethodName: "AlertClear",
-			Handler:    _OpenStorageCluster_AlertClear_Handler,
+			MethodName: "InspectCurrent",
+			Handler:    _OpenStorageNode_InspectCurrent_Handler,
 		},
 		{
-			MethodName: "AlertErase",
-			Handler:    _OpenStorageCluster_AlertErase_Handler,
+			MethodName: "UpdateLabels",
+			Handler:    _OpenStorageNode_UpdateLabels_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -7458,6 +9406,14 @@ type OpenStorageVolumeClient interface {
 	Inspect(ctx context.Context, in *SdkVolumeInspectRequest, opts ...grpc.CallOption) (*SdkVolumeInspectResponse, error)
 	// Get a list of volumes
 	Enumerate(ctx context.Context, in *SdkVolumeEnumerateRequest, opts ...grpc.CallOption) (*SdkVolumeEnumerateResponse, error)
+	// Update the labels and specification of an existing volume
+	Update(ctx context.Context, in *SdkVolumeUpdateRequest, opts ...grpc.CallOption) (*SdkVolumeUpdateResponse, error)
+	// Get the IO statistics of a volume
+	Stats(ctx context.Context, in *SdkVolumeStatsRequest, opts ...grpc.CallOption) (*SdkVolumeStatsResponse, error)
+	// Get the currently used capacity of a volume
+	CapacityUsage(ctx context.Context, in *SdkVolumeCapacityUsageRequest, opts ...grpc.CallOption) (*SdkVolumeCapacityUsageResponse, error)
+	// Get the IO requests currently in progress in the volume driver
+	ActiveRequests(ctx context.Context, in *SdkVolumeActiveRequestsRequest, opts ...grpc.CallOption) (*SdkVolumeActiveRequestsResponse, error)
 	// Create a snapshot of a volume. This creates an immutable (read-only),
 	// point-in-time snapshot of a volume.
 	SnapshotCreate(ctx context.Context, in *SdkVolumeSnapshotCreateRequest, opts ...grpc.CallOption) (*SdkVolumeSnapshotCreateResponse, error)
@@ -7465,6 +9421,12 @@ type OpenStorageVolumeClient interface {
 	SnapshotRestore(ctx context.Context, in *SdkVolumeSnapshotRestoreRequest, opts ...grpc.CallOption) (*SdkVolumeSnapshotRestoreResponse, error)
 	// List the number of snapshots for a specific volume
 	SnapshotEnumerate(ctx context.Context, in *SdkVolumeSnapshotEnumerateRequest, opts ...grpc.CallOption) (*SdkVolumeSnapshotEnumerateResponse, error)
+	// Create a consistent snapshot of all the volumes in a group
+	SnapshotGroupCreate(ctx context.Context, in *SdkVolumeSnapshotGroupCreateRequest, opts ...grpc.CallOption) (*SdkVolumeSnapshotGroupCreateResponse, error)
+	// Freeze the filesystem of a mounted volume
+	Quiesce(ctx context.Context, in *SdkVolumeQuiesceRequest, opts ...grpc.CallOption) (*SdkVolumeQuiesceResponse, error)
+	// Unfreeze the filesystem of a quiesced volume
+	Unquiesce(ctx context.Context, in *SdkVolumeUnquiesceRequest, opts ...grpc.CallOption) (*SdkVolumeUnquiesceResponse, error)
 	// Attach device to host
 	Attach(ctx context.Context, in *SdkVolumeAttachRequest, opts ...grpc.CallOption) (*SdkVolumeAttachResponse, error)
 	// Detaches the volume from the node.
@@ -7528,6 +9490,42 @@ func (c *openStorageVolumeClient) Enumerate(ctx context.Context, in *SdkVolumeEn
 	return out, nil
 }
 
+func (c *openStorageVolumeClient) Update(ctx context.Context, in *SdkVolumeUpdateRequest, opts ...grpc.CallOption) (*SdkVolumeUpdateResponse, error) {
+	out := new(SdkVolumeUpdateResponse)
+	err := c.cc.Invoke(ctx, "/openstorage.api.OpenStorageVolume/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openStorageVolumeClient) Stats(ctx context.Context, in *SdkVolumeStatsRequest, opts ...grpc.CallOption) (*SdkVolumeStatsResponse, error) {
+	out := new(SdkVolumeStatsResponse)
+	err := c.cc.Invoke(ctx, "/openstorage.api.OpenStorageVolume/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openStorageVolumeClient) CapacityUsage(ctx context.Context, in *SdkVolumeCapacityUsageRequest, opts ...grpc.CallOption) (*SdkVolumeCapacityUsageResponse, error) {
+	out := new(SdkVolumeCapacityUsageResponse)
+	err := c.cc.Invoke(ctx, "/openstorage.api.OpenStorageVolume/CapacityUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openStorageVolumeClient) ActiveRequests(ctx context.Context, in *SdkVolumeActiveRequestsRequest, opts ...grpc.CallOption) (*SdkVolumeActiveRequestsResponse, error) {
+	out := new(SdkVolumeActiveRequestsResponse)
+	err := c.cc.Invoke(ctx, "/openstorage.api.OpenStorageVolume/ActiveRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *openStorageVolumeClient) SnapshotCreate(ctx context.Context, in *SdkVolumeSnapshotCreateRequest, opts ...grpc.CallOption) (*SdkVolumeSnapshotCreateResponse, error) {
 	out := new(SdkVolumeSnapshotCreateResponse)
 	err := c.cc.Invoke(ctx, "/openstorage.api.OpenStorageVolume/SnapshotCreate", in, out, opts...)
@@ -7555,6 +9553,33 @@ func (c *openStorageVolumeClient) SnapshotEnumerate(ctx context.Context, in *Sdk
 	return out, nil
 }
 
+func (c *openStorageVolumeClient) SnapshotGroupCreate(ctx context.Context, in *SdkVolumeSnapshotGroupCreateRequest, opts ...grpc.CallOption) (*SdkVolumeSnapshotGroupCreateResponse, error) {
+	out := new(SdkVolumeSnapshotGroupCreateResponse)
+	err := c.cc.Invoke(ctx, "/openstorage.api.OpenStorageVolume/SnapshotGroupCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openStorageVolumeClient) Quiesce(ctx context.Context, in *SdkVolumeQuiesceRequest, opts ...grpc.CallOption) (*SdkVolumeQuiesceResponse, error) {
+	out := new(SdkVolumeQuiesceResponse)
+	err := c.cc.Invoke(ctx, "/openstorage.api.OpenStorageVolume/Quiesce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openStorageVolumeClient) Unquiesce(ctx context.Context, in *SdkVolumeUnquiesceRequest, opts ...grpc.CallOption) (*SdkVolumeUnquiesceResponse, error) {
+	out := new(SdkVolumeUnquiesceResponse)
+	err := c.cc.Invoke(ctx, "/openstorage.api.OpenStorageVolume/Unquiesce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *openStorageVolumeClient) Attach(ctx context.Context, in *SdkVolumeAttachRequest, opts ...grpc.CallOption) (*SdkVolumeAttachResponse, error) {
 	out := new(SdkVolumeAttachResponse)
 	err := c.cc.Invoke(ctx, "/openstorage.api.OpenStorageVolume/Attach", in, out, opts...)
@@ -7603,6 +9628,14 @@ type OpenStorageVolumeServer interface {
 	Inspect(context.Context, *SdkVolumeInspectRequest) (*SdkVolumeInspectResponse, error)
 	// Get a list of volumes
 	Enumerate(context.Context, *SdkVolumeEnumerateRequest) (*SdkVolumeEnumerateResponse, error)
+	// Update the labels and specification of an existing volume
+	Update(context.Context, *SdkVolumeUpdateRequest) (*SdkVolumeUpdateResponse, error)
+	// Get the IO statistics of a volume
+	Stats(context.Context, *SdkVolumeStatsRequest) (*SdkVolumeStatsResponse, error)
+	// Get the currently used capacity of a volume
+	CapacityUsage(context.Context, *SdkVolumeCapacityUsageRequest) (*SdkVolumeCapacityUsageResponse, error)
+	// Get the IO requests currently in progress in the volume driver
+	ActiveRequests(context.Context, *SdkVolumeActiveRequestsRequest) (*SdkVolumeActiveRequestsResponse, error)
 	// Create a snapshot of a volume. This creates an immutable (read-only),
 	// point-in-time snapshot of a volume.
 	SnapshotCreate(context.Context, *SdkVolumeSnapshotCreateRequest) (*SdkVolumeSnapshotCreateResponse, error)
@@ -7610,6 +9643,12 @@ type OpenStorageVolumeServer interface {
 	SnapshotRestore(context.Context, *SdkVolumeSnapshotRestoreRequest) (*SdkVolumeSnapshotRestoreResponse, error)
 	// List the number of snapshots for a specific volume
 	SnapshotEnumerate(context.Context, *SdkVolumeSnapshotEnumerateRequest) (*SdkVolumeSnapshotEnumerateResponse, error)
+	// Create a consistent snapshot of all the volumes in a group
+	SnapshotGroupCreate(context.Context, *SdkVolumeSnapshotGroupCreateRequest) (*SdkVolumeSnapshotGroupCreateResponse, error)
+	// Freeze the filesystem of a mounted volume
+	Quiesce(context.Context, *SdkVolumeQuiesceRequest) (*SdkVolumeQuiesceResponse, error)
+	// Unfreeze the filesystem of a quiesced volume
+	Unquiesce(context.Context, *SdkVolumeUnquiesceRequest) (*SdkVolumeUnquiesceResponse, error)
 	// Attach device to host
 	Attach(context.Context, *SdkVolumeAttachRequest) (*SdkVolumeAttachResponse, error)
 	// Detaches the volume from the node.
@@ -7714,6 +9753,78 @@ func _OpenStorageVolume_Enumerate_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OpenStorageVolume_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkVolumeUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenStorageVolumeServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openstorage.api.OpenStorageVolume/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenStorageVolumeServer).Update(ctx, req.(*SdkVolumeUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenStorageVolume_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkVolumeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenStorageVolumeServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openstorage.api.OpenStorageVolume/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenStorageVolumeServer).Stats(ctx, req.(*SdkVolumeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenStorageVolume_CapacityUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkVolumeCapacityUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenStorageVolumeServer).CapacityUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openstorage.api.OpenStorageVolume/CapacityUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenStorageVolumeServer).CapacityUsage(ctx, req.(*SdkVolumeCapacityUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenStorageVolume_ActiveRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkVolumeActiveRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenStorageVolumeServer).ActiveRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openstorage.api.OpenStorageVolume/ActiveRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenStorageVolumeServer).ActiveRequests(ctx, req.(*SdkVolumeActiveRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OpenStorageVolume_SnapshotCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SdkVolumeSnapshotCreateRequest)
 	if err := dec(in); err != nil {
@@ -7768,6 +9879,60 @@ func _OpenStorageVolume_SnapshotEnumerate_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OpenStorageVolume_SnapshotGroupCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkVolumeSnapshotGroupCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenStorageVolumeServer).SnapshotGroupCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openstorage.api.OpenStorageVolume/SnapshotGroupCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenStorageVolumeServer).SnapshotGroupCreate(ctx, req.(*SdkVolumeSnapshotGroupCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenStorageVolume_Quiesce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkVolumeQuiesceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenStorageVolumeServer).Quiesce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openstorage.api.OpenStorageVolume/Quiesce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenStorageVolumeServer).Quiesce(ctx, req.(*SdkVolumeQuiesceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenStorageVolume_Unquiesce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkVolumeUnquiesceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenStorageVolumeServer).Unquiesce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openstorage.api.OpenStorageVolume/Unquiesce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenStorageVolumeServer).Unquiesce(ctx, req.(*SdkVolumeUnquiesceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OpenStorageVolume_Attach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SdkVolumeAttachRequest)
 	if err := dec(in); err != nil {
@@ -7864,6 +10029,22 @@ var _OpenStorageVolume_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Enumerate",
 			Handler:    _OpenStorageVolume_Enumerate_Handler,
 		},
+		{
+			MethodName: "Update",
+			Handler:    _OpenStorageVolume_Update_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _OpenStorageVolume_Stats_Handler,
+		},
+		{
+			MethodName: "CapacityUsage",
+			Handler:    _OpenStorageVolume_CapacityUsage_Handler,
+		},
+		{
+			MethodName: "ActiveRequests",
+			Handler:    _OpenStorageVolume_ActiveRequests_Handler,
+		},
 		{
 			MethodName: "SnapshotCreate",
 			Handler:    _OpenStorageVolume_SnapshotCreate_Handler,
@@ -7876,6 +10057,18 @@ var _OpenStorageVolume_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SnapshotEnumerate",
 			Handler:    _OpenStorageVolume_SnapshotEnumerate_Handler,
 		},
+		{
+			MethodName: "SnapshotGroupCreate",
+			Handler:    _OpenStorageVolume_SnapshotGroupCreate_Handler,
+		},
+		{
+			MethodName: "Quiesce",
+			Handler:    _OpenStorageVolume_Quiesce_Handler,
+		},
+		{
+			MethodName: "Unquiesce",
+			Handler:    _OpenStorageVolume_Unquiesce_Handler,
+		},
 		{
 			MethodName: "Attach",
 			Handler:    _OpenStorageVolume_Attach_Handler,
@@ -8935,429 +11128,585 @@ var _OpenStorageCloudBackup_serviceDesc = grpc.ServiceDesc{
 	Metadata: "api/api.proto",
 }
 
-func init() { proto.RegisterFile("api/api.proto", fileDescriptor_api_d5788eb4d49bdfcc) }
-
-var fileDescriptor_api_d5788eb4d49bdfcc = []byte{
-	// 6727 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x5d, 0x8c, 0x1b, 0xd7,
-	0x75, 0xbf, 0x87, 0xdc, 0xe5, 0xc7, 0xd9, 0xaf, 0xd9, 0xb1, 0xbc, 0xa2, 0xa8, 0xaf, 0xd5, 0xd8,
-	0xb2, 0xe4, 0xb5, 0xbc, 0x2b, 0xaf, 0x2d, 0xc7, 0x96, 0xff, 0xf6, 0x3f, 0xd4, 0x2e, 0x57, 0xa2,
-	0xb5, 0x4b, 0x6e, 0x86, 0x5c, 0xc9, 0x4e, 0x9a, 0xb0, 0x23, 0xf2, 0x6a, 0x45, 0x8b, 0xe4, 0xd0,
-	0x33, 0xc3, 0x35, 0xd6, 0x68, 0x8b, 0xa2, 0x40, 0xd3, 0x14, 0xc8, 0x07, 0x82, 0x26, 0x01, 0x52,
-	0x34, 0x29, 0xd0, 0xa2, 0x7d, 0x68, 0x90, 0xa2, 0x45, 0x1f, 0x1b, 0x20, 0xe8, 0x63, 0x8b, 0x26,
-	0x2f, 0x79, 0x2c, 0xd0, 0x87, 0xb4, 0x2f, 0x45, 0x8b, 0xbe, 0xe7, 0xad, 0xb8, 0xe7, 0xde, 0x99,
-	0xb9, 0x77, 0x3e, 0xc8, 0x61, 0xe2, 0xe4, 0x45, 0xe2, 0x3d, 0xf7, 0xdc, 0x7b, 0x7e, 0xf7, 0xdc,
-	0x73, 0xcf, 0x39, 0xf7, 0x63, 0x16, 0x96, 0xcc, 0x51, 0x6f, 0xcb, 0x1c, 0xf5, 0x36, 0x47, 0xb6,
-	0xe5, 0x5a, 0xda, 0x8a, 0x35, 0x22, 0x43, 0xc7, 0xb5, 0x6c, 0xf3, 0x98, 0x6c, 0x9a, 0xa3, 0x5e,
-	0xf9, 0xf2, 0xb1, 0x65, 0x1d, 0xf7, 0xc9, 0x16, 0x56, 0x3f, 0x1a, 0x3f, 0xde, 0x72, 0x7b, 0x03,
-	0xe2, 0xb8, 0xe6, 0x60, 0xc4, 0x5a, 0x94, 0x2f, 0x70, 0x06, 0xec, 0x67, 0x38, 0xb4, 0x5c, 0xd3,
-	0xed, 0x59, 0x43, 0x87, 0xd5, 0xea, 0x5f, 0xcb, 0xc2, 0x4a, 0x93, 0x75, 0x67, 0x10, 0xc7, 0x1a,
-	0xdb, 0x1d, 0xa2, 0x2d, 0x43, 0xa6, 0xd7, 0x2d, 0x29, 0xeb, 0xca, 0xf5, 0xa2, 0x91, 0xe9, 0x75,
-	0x35, 0x0d, 0xe6, 0x46, 0xa6, 0xfb, 0xa4, 0x94, 0x41, 0x0a, 0xfe, 0xd6, 0xde, 0x80, 0xdc, 0x80,
-	0x74, 0x7b, 0xe3, 0x41, 0x29, 0xbb, 0xae, 0x5c, 0x5f, 0xde, 0xbe, 0xb4, 0x19, 0x02, 0xb6, 0xc9,
-	0x7b, 0x3d, 0x40, 0x2e, 0x83, 0x73, 0x6b, 0x6b, 0x90, 0xb3, 0x86, 0xfd, 0xde, 0x90, 0x94, 0xe6,
-	0xd6, 0x95, 0xeb, 0x05, 0x83, 0x97, 0xa8, 0x8c, 0x9e, 0x35, 0x72, 0x4a, 0xf3, 0xeb, 0xca, 0xf5,
-	0x39, 0x03, 0x7f, 0x6b, 0xe7, 0xa1, 0xe8, 0x90, 0x8f, 0xda, 0x1f, 0xdb, 0x3d, 0x97, 0x94, 0x72,
-	0xeb, 0xca, 0x75, 0xc5, 0x28, 0x38, 0xe4, 0xa3, 0x87, 0xb4, 0xac, 0x9d, 0x03, 0xfa, 0xbb, 0x6d,
-	0x13, 0xb3, 0x5b, 0xca, 0x63, 0x5d, 0xde, 0x21, 0x1f, 0x19, 0xc4, 0xec, 0x52, 0x19, 0xb6, 0x39,
-	0xec, 0x1a, 0x0f, 0x4b, 0x05, 0xac, 0xe0, 0x25, 0x2a, 0xc3, 0xe9, 0x7d, 0x42, 0x4a, 0x45, 0x26,
-	0x83, 0xfe, 0xa6, 0xb4, 0xb1, 0x43, 0xba, 0x25, 0x60, 0x34, 0xfa, 0x5b, 0xbb, 0x0a, 0xcb, 0x36,
-	0x57, 0x53, 0xdb, 0x19, 0x11, 0xd2, 0x2d, 0x2d, 0xe0, 0xc8, 0x97, 0x3c, 0x6a, 0x93, 0x12, 0xb5,
-	0xcf, 0x40, 0xb1, 0x6f, 0x3a, 0x6e, 0xdb, 0xe9, 0x98, 0xc3, 0xd2, 0xe2, 0xba, 0x72, 0x7d, 0x61,
-	0xbb, 0xbc, 0xc9, 0x94, 0xbd, 0xe9, 0xcd, 0xc6, 0x66, 0xcb, 0x9b, 0x0d, 0xa3, 0x40, 0x99, 0x9b,
-	0x1d, 0x73, 0xa8, 0x95, 0xa1, 0x30, 0x20, 0xae, 0xd9, 0x35, 0x5d, 0xb3, 0xb4, 0x84, 0x5a, 0xf0,
-	0xcb, 0xfa, 0x4f, 0x33, 0xb0, 0xc0, 0x35, 0x77, 0x68, 0x59, 0x7d, 0x3a, 0x17, 0xb5, 0x5d, 0x9c,
-	0x8b, 0x79, 0x23, 0x53, 0xdb, 0xd5, 0x36, 0x20, 0xbb, 0x63, 0x39, 0x38, 0x15, 0xcb, 0xdb, 0xa5,
-	0x88, 0xd2, 0x77, 0x2c, 0xa7, 0x75, 0x3a, 0x22, 0x06, 0x65, 0xa2, 0x73, 0x74, 0x30, 0xd3, 0x1c,
-	0xb1, 0xff, 0xb5, 0x0b, 0x50, 0x34, 0xcc, 0x5e, 0x77, 0x9f, 0x9c, 0x90, 0x3e, 0x4e, 0x53, 0xd1,
-	0x08, 0x08, 0xb4, 0xb6, 0x65, 0xb9, 0x66, 0xbf, 0x49, 0x55, 0x99, 0x47, 0xb5, 0x05, 0x04, 0xaa,
-	0xcf, 0x23, 0xaa, 0xcf, 0x02, 0xd3, 0x27, 0xfd, 0xad, 0x7d, 0x16, 0x72, 0x7d, 0xf3, 0x11, 0xe9,
-	0x3b, 0xa5, 0xe2, 0x7a, 0xf6, 0xfa, 0xc2, 0xf6, 0xf5, 0x24, 0x1c, 0x74, 0xc4, 0x9b, 0xfb, 0xc8,
-	0x5a, 0x1d, 0xba, 0xf6, 0xa9, 0xc1, 0xdb, 0x95, 0xdf, 0x82, 0x05, 0x81, 0xac, 0xa9, 0x90, 0x7d,
-	0x4a, 0x4e, 0xb9, 0x85, 0xd2, 0x9f, 0xda, 0x19, 0x98, 0x3f, 0x31, 0xfb, 0x63, 0xc2, 0x6d, 0x94,
-	0x15, 0x6e, 0x67, 0xde, 0x54, 0xf4, 0x7f, 0x54, 0x60, 0xe9, 0x81, 0xd5, 0x1f, 0x0f, 0xc8, 0xbe,
-	0xd5, 0x31, 0x5d, 0xcb, 0xa6, 0x10, 0x87, 0xe6, 0x80, 0xf0, 0xe6, 0xf8, 0x5b, 0x3b, 0x82, 0xa5,
-	0x13, 0x64, 0x6a, 0x73, 0xa4, 0x19, 0x44, 0x7a, 0x33, 0x82, 0x54, 0xea, 0xca, 0x2b, 0x09, 0x88,
-	0x17, 0x4f, 0x04, 0x52, 0xf9, 0xff, 0xc3, 0x6a, 0x84, 0x65, 0x26, 0xf4, 0xaf, 0x43, 0xae, 0xc9,
-	0x16, 0xe5, 0x1a, 0xe4, 0x46, 0xa6, 0x4d, 0x86, 0x2e, 0x6f, 0xc8, 0x4b, 0x68, 0xd4, 0xd4, 0x44,
-	0xf9, 0xe2, 0xa4, 0xbf, 0xf5, 0xb3, 0x30, 0x7f, 0xd7, 0xb6, 0xc6, 0xa3, 0xf0, 0x4a, 0xd6, 0x7f,
-	0x92, 0x07, 0x60, 0x80, 0x9a, 0x23, 0xd2, 0xa1, 0x53, 0x49, 0x46, 0x4f, 0xc8, 0x80, 0xd8, 0x66,
-	0x1f, 0xb9, 0x0a, 0x46, 0x40, 0xf0, 0x97, 0x4b, 0x46, 0x58, 0x2e, 0x5b, 0x90, 0x7b, 0x6c, 0xd9,
-	0x03, 0xd3, 0xe5, 0x26, 0x75, 0x36, 0xa2, 0xa0, 0xbd, 0x26, 0x1a, 0x20, 0x67, 0xd3, 0x2e, 0x02,
-	0x3c, 0xea, 0x5b, 0x9d, 0xa7, 0x6d, 0xec, 0x8a, 0x1a, 0x53, 0xd6, 0x28, 0x22, 0x05, 0xcd, 0xe5,
-	0x1c, 0x14, 0x9e, 0x98, 0xed, 0x3e, 0x5a, 0xda, 0x3c, 0x56, 0xe6, 0x9f, 0x98, 0xcc, 0xce, 0x36,
-	0x20, 0xdb, 0xb1, 0x1c, 0x5c, 0xf7, 0x13, 0x2d, 0xbd, 0x63, 0x39, 0xda, 0x5b, 0x00, 0x3d, 0xab,
-	0x3d, 0xb2, 0xad, 0xc7, 0xbd, 0x3e, 0x33, 0xca, 0xe5, 0xed, 0x72, 0xa4, 0x49, 0xcd, 0x3a, 0x64,
-	0x1c, 0x46, 0xb1, 0xe7, 0xfd, 0xa4, 0x7a, 0xed, 0x92, 0xee, 0x78, 0x44, 0xd0, 0x64, 0x0b, 0x06,
-	0x2f, 0x69, 0x2f, 0xc3, 0xaa, 0x33, 0x34, 0x47, 0xce, 0x13, 0xcb, 0x6d, 0xf7, 0x86, 0x2e, 0xb1,
-	0x4f, 0xcc, 0x3e, 0x7a, 0x8e, 0x25, 0x43, 0xf5, 0x2a, 0x6a, 0x9c, 0xae, 0x19, 0x61, 0xf3, 0x01,
-	0x34, 0x9f, 0x57, 0x12, 0xcc, 0x87, 0x2a, 0x7f, 0x9a, 0xed, 0x50, 0x60, 0xce, 0x13, 0xd3, 0xe6,
-	0xde, 0xa7, 0x60, 0xf0, 0x92, 0xf6, 0xff, 0x60, 0xc1, 0x26, 0xa3, 0x7e, 0xaf, 0x63, 0xb6, 0x1d,
-	0xe2, 0x72, 0xc7, 0x73, 0x3e, 0x22, 0xc9, 0x60, 0x3c, 0x4d, 0xe2, 0x1a, 0x60, 0xfb, 0xbf, 0xe9,
-	0xb0, 0xcc, 0xe3, 0x63, 0x9b, 0x1c, 0x33, 0xf7, 0xc6, 0x34, 0xbf, 0xc4, 0x86, 0x25, 0x54, 0xf8,
-	0x4b, 0x9d, 0x0c, 0x3b, 0xf6, 0xe9, 0xc8, 0x25, 0xdd, 0xd2, 0x32, 0xb7, 0x0f, 0x8f, 0xa0, 0x5d,
-	0x02, 0x18, 0x99, 0x8e, 0x33, 0x7a, 0x62, 0x9b, 0x0e, 0x29, 0xad, 0xa0, 0x91, 0x09, 0x14, 0x49,
-	0x83, 0x4e, 0xe7, 0x09, 0xe9, 0x8e, 0xfb, 0xa4, 0xa4, 0x22, 0x9b, 0xaf, 0xc1, 0x26, 0xa7, 0xd3,
-	0x25, 0xe0, 0x74, 0xcc, 0x3e, 0x29, 0xad, 0x22, 0x16, 0x56, 0x40, 0x1d, 0xb8, 0xbd, 0xce, 0xd3,
-	0xd3, 0x92, 0xc6, 0x75, 0x80, 0x25, 0xed, 0x06, 0xcc, 0x1f, 0x53, 0x03, 0x2f, 0x3d, 0x87, 0xa3,
-	0x5f, 0x8b, 0x8c, 0x1e, 0xcd, 0xdf, 0x60, 0x4c, 0xd4, 0x9f, 0xe3, 0x8f, 0x36, 0x19, 0x3e, 0xb6,
-	0xec, 0x0e, 0xe9, 0x96, 0xd6, 0xb0, 0xb7, 0x25, 0xa4, 0x56, 0x39, 0x91, 0x8e, 0xa7, 0x63, 0x0d,
-	0x46, 0x36, 0x71, 0xa8, 0x03, 0x3b, 0x8b, 0x2c, 0x02, 0x85, 0xba, 0xed, 0x8e, 0xe9, 0x74, 0xcc,
-	0x2e, 0xe9, 0x96, 0x4a, 0xcc, 0x6d, 0x7b, 0x65, 0xad, 0x04, 0xf9, 0x0f, 0xad, 0xb1, 0x3d, 0x34,
-	0xfb, 0xa5, 0x73, 0x58, 0xe5, 0x15, 0x69, 0x2b, 0x36, 0x71, 0x27, 0xaf, 0x97, 0xca, 0xac, 0x95,
-	0x57, 0xfe, 0xd5, 0xdd, 0x83, 0x0e, 0x10, 0xcc, 0x33, 0xe5, 0x1b, 0x5a, 0x5d, 0xe2, 0x94, 0x94,
-	0xf5, 0x2c, 0xe5, 0xc3, 0x82, 0xfe, 0x03, 0x05, 0x56, 0x8c, 0xf1, 0x90, 0xa6, 0x05, 0x4d, 0xd7,
-	0x74, 0xc9, 0x81, 0x39, 0xd2, 0x1e, 0xc2, 0x92, 0xcd, 0x48, 0x6d, 0x87, 0xd2, 0xb0, 0xc5, 0xc2,
-	0xf6, 0x76, 0xd4, 0x8a, 0xe4, 0x86, 0x52, 0x99, 0x1b, 0xad, 0x2d, 0x90, 0xe8, 0x88, 0x22, 0x2c,
-	0x33, 0x8d, 0xe8, 0x3f, 0x0a, 0x90, 0x63, 0x3a, 0x89, 0xa4, 0x21, 0x5b, 0x90, 0x63, 0x09, 0x0a,
-	0xb6, 0x5a, 0x88, 0xf1, 0x3d, 0xcc, 0x55, 0x1a, 0x9c, 0x2d, 0xb0, 0x92, 0x6c, 0x1a, 0x2b, 0x29,
-	0x43, 0x81, 0x26, 0x13, 0xd6, 0xb0, 0x7f, 0xca, 0x73, 0x13, 0xbf, 0xac, 0xbd, 0x09, 0xf9, 0x3e,
-	0x73, 0xf9, 0xe8, 0xa5, 0x16, 0x62, 0x42, 0xa9, 0x14, 0x18, 0x0c, 0x8f, 0x5d, 0xbb, 0x09, 0xf3,
-	0x1d, 0xaa, 0x0e, 0xf4, 0x63, 0x93, 0x13, 0x04, 0xc6, 0xa8, 0x6d, 0xc1, 0x9c, 0x33, 0x22, 0x1d,
-	0xf4, 0x62, 0x71, 0x0b, 0x3b, 0x70, 0x21, 0x06, 0x32, 0x52, 0x65, 0x8e, 0x1d, 0xf3, 0x98, 0xf0,
-	0x98, 0xcb, 0x0a, 0x72, 0x76, 0x52, 0x9c, 0x21, 0x3b, 0x09, 0x5c, 0x3c, 0xa4, 0x73, 0xf1, 0xb7,
-	0xe8, 0x22, 0x35, 0xdd, 0xb1, 0x83, 0x8e, 0x6a, 0x79, 0xfb, 0x62, 0x12, 0x64, 0x64, 0x32, 0x38,
-	0xb3, 0xb6, 0x0d, 0xf3, 0xcc, 0xf6, 0x16, 0xb1, 0xd5, 0x85, 0x09, 0xad, 0x88, 0xc1, 0x58, 0xb5,
-	0xcb, 0xb0, 0x60, 0xba, 0xae, 0x49, 0x9d, 0x46, 0xdb, 0x1a, 0xa2, 0xdf, 0x2a, 0x1a, 0xe0, 0x91,
-	0x1a, 0x43, 0x6d, 0x07, 0x96, 0x7d, 0x06, 0xd6, 0xfb, 0x72, 0x42, 0xef, 0x15, 0x64, 0x63, 0xbd,
-	0x2f, 0x79, 0x6d, 0x9a, 0x9e, 0x94, 0x2e, 0x39, 0xe9, 0x75, 0x48, 0x1b, 0xd3, 0x5e, 0xee, 0xd9,
-	0x18, 0xe9, 0x90, 0x26, 0xbf, 0x37, 0x40, 0x73, 0x48, 0x67, 0x6c, 0x93, 0xb6, 0xc8, 0xe7, 0xb9,
-	0x36, 0xac, 0xd9, 0x0d, 0xb8, 0x7d, 0xd0, 0x8c, 0x6d, 0x15, 0x17, 0x27, 0x07, 0x8d, 0x0c, 0xf7,
-	0x7c, 0x86, 0xde, 0xf0, 0xb1, 0x55, 0xd2, 0x70, 0x2d, 0x5e, 0x4b, 0xd0, 0x07, 0x07, 0x5e, 0x1b,
-	0x3e, 0xb6, 0xd8, 0x02, 0xe4, 0x3d, 0x51, 0x82, 0xf6, 0x2e, 0x2c, 0x0a, 0xb1, 0xc1, 0x29, 0x3d,
-	0x8b, 0x5d, 0x4d, 0x0c, 0x0e, 0x0b, 0x41, 0x70, 0x70, 0xb4, 0x6a, 0xd8, 0x2f, 0x9c, 0xc1, 0x0e,
-	0xd6, 0xa7, 0xf9, 0x05, 0xd9, 0x0b, 0x50, 0x8b, 0x24, 0xb6, 0x6d, 0xd9, 0xe8, 0x9e, 0x8b, 0x06,
-	0x2b, 0x68, 0xef, 0x81, 0xca, 0x83, 0x64, 0xc7, 0x1a, 0x3a, 0xe3, 0x01, 0xb1, 0x9d, 0xd2, 0x1a,
-	0xf6, 0x7f, 0x39, 0x61, 0xac, 0x3b, 0x9c, 0xcf, 0x58, 0x39, 0x91, 0xca, 0x4e, 0xf9, 0x1d, 0x58,
-	0x09, 0xe9, 0x61, 0x26, 0x2f, 0xf3, 0xe7, 0x19, 0x98, 0xa7, 0x50, 0x1d, 0xca, 0x43, 0x57, 0xb9,
-	0x83, 0xed, 0xe6, 0x0c, 0x56, 0xd0, 0xce, 0x42, 0x9e, 0xfe, 0x68, 0x0f, 0x1c, 0x9e, 0xfd, 0xe4,
-	0x68, 0xf1, 0xc0, 0xa1, 0xe9, 0x0c, 0x56, 0x3c, 0x3a, 0x75, 0x89, 0x83, 0x7e, 0x65, 0xce, 0x28,
-	0x52, 0xca, 0x1d, 0x4a, 0xa0, 0xf1, 0x0a, 0x77, 0x2b, 0x0e, 0x7a, 0x90, 0x39, 0x83, 0x97, 0x68,
-	0x9a, 0x83, 0xbf, 0x68, 0x87, 0x6c, 0x87, 0x93, 0xc7, 0xf2, 0x81, 0x43, 0xad, 0x83, 0x55, 0xb1,
-	0x2e, 0x73, 0x58, 0x0b, 0x48, 0x62, 0x7d, 0x5e, 0x86, 0x05, 0x96, 0xdb, 0x1c, 0xd3, 0x38, 0xc4,
-	0x33, 0x6e, 0xc0, 0x04, 0x06, 0x29, 0xda, 0xb3, 0x30, 0xdf, 0xb3, 0x68, 0xcf, 0x05, 0x6f, 0xef,
-	0xc4, 0x80, 0x62, 0x87, 0x6d, 0xdc, 0xdd, 0xb0, 0x1d, 0x4f, 0x11, 0x29, 0x98, 0x92, 0xd3, 0x4e,
-	0x79, 0xf2, 0x42, 0x5b, 0x02, 0xef, 0x94, 0x93, 0x0e, 0x1c, 0xfd, 0x7f, 0x32, 0x30, 0x5f, 0xe9,
-	0x13, 0xdb, 0x15, 0xdc, 0x70, 0x16, 0xdd, 0xf0, 0x5b, 0x74, 0xe3, 0x75, 0x42, 0xec, 0x9e, 0x7b,
-	0xca, 0xb7, 0x21, 0xd1, 0x05, 0xdf, 0xe4, 0x0c, 0xe8, 0x27, 0x7c, 0x76, 0x0a, 0xca, 0xa4, 0x7d,
-	0xb6, 0xdd, 0xd3, 0x11, 0x41, 0xed, 0x65, 0x8d, 0x22, 0x52, 0x28, 0x23, 0x0d, 0xa2, 0x03, 0xe2,
-	0xa0, 0x2b, 0x63, 0xbb, 0x0e, 0xaf, 0xa8, 0xbd, 0x09, 0x45, 0x7f, 0x5b, 0xcb, 0x3d, 0xf0, 0x24,
-	0x67, 0x16, 0x30, 0xd3, 0x81, 0xda, 0x7c, 0x5f, 0xdb, 0xee, 0x75, 0x51, 0xbd, 0x45, 0x9a, 0x10,
-	0x31, 0x52, 0x0d, 0x87, 0xe3, 0x95, 0x78, 0xe2, 0x78, 0x31, 0x66, 0xb9, 0x30, 0x06, 0x36, 0x1c,
-	0x8f, 0x9d, 0xe2, 0xed, 0xf4, 0x09, 0xa6, 0x68, 0x2c, 0x77, 0xf4, 0x8a, 0xd4, 0x16, 0x5d, 0xb7,
-	0xcf, 0xd5, 0x4e, 0x7f, 0xd2, 0xa1, 0x8f, 0x87, 0xbd, 0x8f, 0xc6, 0xa4, 0xed, 0x9a, 0xc7, 0xa8,
-	0xef, 0xa2, 0x51, 0x64, 0x94, 0x96, 0x79, 0xac, 0xbf, 0x01, 0x39, 0xd4, 0xb6, 0x43, 0x83, 0x16,
-	0x6a, 0x84, 0x87, 0xe4, 0x68, 0xd0, 0x42, 0x3e, 0x83, 0x31, 0xe9, 0xff, 0x9a, 0x81, 0x95, 0xc6,
-	0xa3, 0x0f, 0x49, 0xc7, 0xa5, 0x2c, 0x04, 0x9d, 0x00, 0xdd, 0xd2, 0x8e, 0xfd, 0xc8, 0x89, 0xbf,
-	0xe9, 0x56, 0x9a, 0xaf, 0xbd, 0x9e, 0xb7, 0x55, 0x28, 0x30, 0x42, 0x0d, 0x93, 0x17, 0x32, 0x34,
-	0x1f, 0xf5, 0x49, 0x17, 0xe7, 0xa4, 0x60, 0x78, 0x45, 0x96, 0x7f, 0xa1, 0x6b, 0x67, 0x13, 0xe2,
-	0xf9, 0xee, 0x35, 0xc8, 0x99, 0x1d, 0x9a, 0x27, 0xf2, 0xa4, 0x9d, 0x97, 0x70, 0x82, 0x3b, 0x1d,
-	0xe2, 0x38, 0x6d, 0xba, 0x14, 0x99, 0xb2, 0x8b, 0x8c, 0x72, 0x9f, 0xe0, 0xfc, 0x3b, 0xa4, 0x63,
-	0x13, 0x17, 0xab, 0xf3, 0xac, 0x9a, 0x51, 0x68, 0x35, 0xa6, 0x9b, 0xdd, 0x91, 0xd5, 0x1b, 0xba,
-	0xd4, 0x98, 0xa9, 0x9b, 0x0c, 0x08, 0xda, 0x4b, 0xa0, 0x76, 0xc6, 0x36, 0xdd, 0xf3, 0xb4, 0xc9,
-	0xb0, 0x7b, 0x48, 0x89, 0xa8, 0xe0, 0xa2, 0xb1, 0xc2, 0xe9, 0x55, 0x4e, 0x46, 0x8f, 0xcb, 0x60,
-	0x8c, 0x2c, 0x9b, 0xc5, 0xb1, 0xac, 0xc1, 0x91, 0x1d, 0x5a, 0xb6, 0x8b, 0x27, 0x04, 0xe4, 0x98,
-	0xe2, 0x67, 0x3b, 0x7b, 0x5e, 0xd2, 0xff, 0x5e, 0x81, 0x67, 0xb9, 0xeb, 0xb1, 0x09, 0x8d, 0x0c,
-	0xe4, 0xa3, 0x31, 0x71, 0x5c, 0x31, 0xfe, 0x2b, 0xb3, 0xc5, 0xff, 0x99, 0x93, 0x16, 0x2f, 0xfc,
-	0x67, 0x53, 0x86, 0x7f, 0xfd, 0x45, 0x58, 0x66, 0x34, 0x83, 0x38, 0x23, 0x6b, 0xe8, 0x08, 0xee,
-	0x57, 0x11, 0xdc, 0xaf, 0x3e, 0x82, 0x33, 0xf2, 0xd0, 0x38, 0x77, 0x38, 0xcd, 0xba, 0x07, 0xdc,
-	0xdb, 0xb6, 0x6d, 0xce, 0xc2, 0xa1, 0x27, 0x79, 0x69, 0xaf, 0x27, 0x63, 0xf9, 0x44, 0x2a, 0xeb,
-	0xff, 0xac, 0x78, 0xf9, 0x2d, 0x86, 0x85, 0x0a, 0xb3, 0x91, 0xdb, 0x90, 0x63, 0x11, 0x0b, 0x65,
-	0x2e, 0x6f, 0xeb, 0x09, 0xdd, 0x32, 0xf6, 0x43, 0xd3, 0x36, 0x07, 0x06, 0x6f, 0xa1, 0xbd, 0x09,
-	0xf3, 0x03, 0x6b, 0x3c, 0x74, 0xb9, 0xe3, 0x49, 0xd3, 0x94, 0x35, 0xa0, 0xa6, 0x87, 0x3f, 0x58,
-	0x0c, 0xce, 0x32, 0xd3, 0x43, 0x8a, 0x17, 0xa3, 0xc5, 0x50, 0x3e, 0x17, 0x0e, 0xf9, 0xfa, 0x8f,
-	0x33, 0xa0, 0xf2, 0xb1, 0x10, 0xf7, 0xd3, 0x30, 0x0b, 0x36, 0xcb, 0x99, 0xb4, 0x49, 0xde, 0x6d,
-	0x7f, 0xc5, 0x31, 0xc3, 0xd0, 0x27, 0xa5, 0x4b, 0x6c, 0xfc, 0xfe, 0xaa, 0xbc, 0x07, 0x79, 0x6b,
-	0x84, 0x87, 0x7e, 0xa5, 0x39, 0x74, 0x2a, 0x9b, 0x49, 0x8d, 0xfd, 0xa1, 0x6d, 0x36, 0x58, 0x03,
-	0x96, 0x62, 0x78, 0xcd, 0xcb, 0xb7, 0x61, 0x51, 0xac, 0x98, 0x29, 0xe6, 0x7e, 0x3d, 0xb0, 0x06,
-	0x2a, 0x86, 0x5b, 0xdf, 0x16, 0xe4, 0x98, 0xd5, 0x70, 0x0d, 0x9e, 0x4d, 0x32, 0x32, 0xce, 0xf6,
-	0x29, 0x9a, 0xe7, 0x29, 0xac, 0x36, 0x87, 0xe6, 0x48, 0x5e, 0xe9, 0xe1, 0xd5, 0x20, 0x4c, 0x71,
-	0x66, 0xb6, 0x29, 0x16, 0xf7, 0x13, 0x59, 0x79, 0x3f, 0xa1, 0x7f, 0x04, 0x9a, 0x28, 0x9a, 0xeb,
-	0xe2, 0x0b, 0xb0, 0xe6, 0x25, 0x48, 0x58, 0x11, 0x8c, 0x90, 0xe9, 0xe6, 0x6a, 0x52, 0x9a, 0x24,
-	0x75, 0x63, 0x9c, 0x39, 0x89, 0xa1, 0xea, 0xae, 0x77, 0xf2, 0x83, 0x31, 0x42, 0x8a, 0x07, 0x4a,
-	0x28, 0x1e, 0xc4, 0x9d, 0xf7, 0xde, 0x82, 0x3c, 0x17, 0x9c, 0xc6, 0x33, 0x79, 0xbc, 0xfa, 0x0f,
-	0x15, 0xcf, 0x3b, 0x79, 0xb9, 0x5b, 0xec, 0xf1, 0xdb, 0x05, 0x28, 0xd2, 0xff, 0x9d, 0x91, 0xd9,
-	0xf1, 0x2c, 0x27, 0x20, 0xd0, 0x16, 0x7e, 0xc2, 0x50, 0x34, 0xf0, 0x37, 0xcd, 0xd0, 0xe8, 0xf6,
-	0x96, 0xc2, 0xe7, 0xa1, 0x89, 0x16, 0x6b, 0x5d, 0xba, 0xd0, 0xad, 0x8f, 0x87, 0xc4, 0x6e, 0xa3,
-	0x90, 0x79, 0xd6, 0x17, 0x52, 0xea, 0x54, 0x92, 0x5f, 0x8d, 0x3d, 0xe6, 0x84, 0x6a, 0x1a, 0xdc,
-	0xf5, 0x2e, 0x68, 0x77, 0x6d, 0x73, 0xf4, 0x64, 0xd7, 0xee, 0x9d, 0x10, 0x7b, 0xe7, 0x89, 0x39,
-	0x3c, 0x26, 0x8e, 0xaf, 0x10, 0x45, 0x50, 0xc8, 0x6d, 0x98, 0x7b, 0xda, 0x1b, 0x76, 0xb9, 0x27,
-	0x7a, 0x31, 0x66, 0x6f, 0x19, 0xea, 0x06, 0x93, 0x07, 0x6c, 0xa3, 0x5f, 0x83, 0x95, 0x9d, 0xfe,
-	0xd8, 0x71, 0x89, 0x3d, 0xc5, 0x67, 0x7f, 0x47, 0x81, 0x25, 0xba, 0x98, 0x4f, 0x7c, 0xfb, 0xbc,
-	0x07, 0x05, 0x83, 0x7c, 0x44, 0x1c, 0xf7, 0xfe, 0x03, 0x9e, 0x21, 0xdc, 0x88, 0x66, 0x08, 0x62,
-	0x8b, 0x4d, 0x8f, 0x9d, 0x2d, 0x65, 0xbf, 0x75, 0xf9, 0x6d, 0x58, 0x92, 0xaa, 0xc4, 0xc5, 0x9c,
-	0x9d, 0xb6, 0x98, 0x3f, 0x81, 0x65, 0x49, 0x8a, 0xa3, 0xe9, 0xb0, 0xc8, 0x7f, 0xef, 0xa0, 0x87,
-	0x66, 0xdd, 0x48, 0x34, 0x6d, 0x37, 0x34, 0x1a, 0x7e, 0xca, 0x7a, 0x69, 0xf2, 0x08, 0x0c, 0xb9,
-	0x91, 0xfe, 0x77, 0x0a, 0xac, 0xe1, 0xce, 0x7d, 0xfa, 0xea, 0xbd, 0x0f, 0xb9, 0x7d, 0xf1, 0x3c,
-	0xf7, 0xb5, 0xf8, 0x23, 0x80, 0x48, 0x47, 0xf2, 0x21, 0xf4, 0xfe, 0xaf, 0x7c, 0x08, 0xfd, 0x5f,
-	0x0a, 0x9c, 0x8d, 0x48, 0xe2, 0x33, 0x7f, 0x04, 0x45, 0xef, 0x34, 0xcc, 0xe1, 0x53, 0xfa, 0x99,
-	0xe9, 0x30, 0x59, 0xe3, 0xcd, 0xa6, 0xd7, 0x92, 0x41, 0x0d, 0x7a, 0x0a, 0x0c, 0x2a, 0x23, 0x18,
-	0x54, 0xd9, 0x84, 0x65, 0xb9, 0x49, 0xcc, 0x30, 0xde, 0x12, 0x87, 0xb1, 0xb0, 0xfd, 0x7c, 0x34,
-	0x63, 0x89, 0xe0, 0x10, 0xc7, 0xfa, 0x8b, 0x39, 0xff, 0x06, 0xa3, 0x6e, 0x75, 0xa3, 0xf9, 0x85,
-	0x0a, 0xd9, 0xce, 0x68, 0x8c, 0x9d, 0x2b, 0x06, 0xfd, 0x49, 0x9d, 0xd1, 0x80, 0x0c, 0xda, 0xae,
-	0xe5, 0x9a, 0x7d, 0xbe, 0xa7, 0x2a, 0x0c, 0xc8, 0x00, 0x2f, 0x15, 0xe8, 0xd6, 0x89, 0x56, 0xe2,
-	0x36, 0x86, 0x6d, 0xaa, 0xf2, 0x03, 0x32, 0xc0, 0x4d, 0x0c, 0xaf, 0x7a, 0x6c, 0x13, 0xe2, 0xed,
-	0xaa, 0x06, 0x64, 0xb0, 0x67, 0x13, 0x3c, 0x57, 0x36, 0x4f, 0x8e, 0xdb, 0x7d, 0xcb, 0x64, 0x39,
-	0x7f, 0xd6, 0xc8, 0x9b, 0x27, 0xc7, 0xfb, 0x96, 0xc9, 0x8e, 0x91, 0x58, 0x4e, 0x9b, 0x4f, 0x38,
-	0xdf, 0x08, 0x1d, 0x54, 0xbc, 0x03, 0xf3, 0xdd, 0x9e, 0xf3, 0xd4, 0xbb, 0xbd, 0xb8, 0x96, 0x74,
-	0x7b, 0x41, 0x47, 0xbb, 0xb9, 0x4b, 0x39, 0xd9, 0x64, 0xb0, 0x56, 0xda, 0x36, 0xcc, 0x8f, 0x2c,
-	0xcb, 0x3f, 0x13, 0xbe, 0x30, 0xe9, 0xf2, 0xc3, 0x60, 0xac, 0xd4, 0xbb, 0x0d, 0x8e, 0x07, 0x6e,
-	0xbb, 0x37, 0xf2, 0x12, 0x54, 0x5a, 0xac, 0x8d, 0x68, 0x45, 0xd7, 0x74, 0x4d, 0x5a, 0xb1, 0xc8,
-	0x2a, 0x68, 0xb1, 0x86, 0xa7, 0x57, 0x4f, 0x2c, 0xc7, 0x45, 0xa7, 0xc7, 0x0e, 0x2c, 0xfc, 0xb2,
-	0x76, 0x00, 0x0b, 0xe8, 0x2b, 0xf9, 0xd9, 0xb4, 0x9a, 0xe0, 0x36, 0xc4, 0x61, 0xd0, 0x7f, 0xc4,
-	0x35, 0x00, 0x43, 0x9f, 0x50, 0xfe, 0x3c, 0x40, 0x30, 0xca, 0x18, 0xfb, 0x79, 0x43, 0xb6, 0x9f,
-	0xf5, 0x24, 0x41, 0xde, 0xae, 0x4a, 0x30, 0x1e, 0xba, 0xaf, 0x0f, 0x89, 0x9e, 0x69, 0x9d, 0x7d,
-	0x5f, 0x81, 0x65, 0xde, 0x3b, 0x77, 0xb0, 0xc2, 0x74, 0x2b, 0xe9, 0xa6, 0x9b, 0xd9, 0x6b, 0xc6,
-	0xb7, 0x57, 0x21, 0xd2, 0x64, 0xa5, 0x48, 0xb3, 0xed, 0x1d, 0xb7, 0xce, 0x4d, 0x9e, 0x58, 0x3a,
-	0x20, 0xef, 0x30, 0xb6, 0x0f, 0x97, 0x9a, 0xdd, 0xa7, 0xde, 0xa9, 0xf7, 0xa1, 0xd5, 0xef, 0x75,
-	0x4e, 0x65, 0x17, 0xf6, 0x1e, 0x2c, 0xcb, 0xd5, 0x3c, 0xf8, 0x47, 0x13, 0xbe, 0x48, 0x47, 0x46,
-	0xa8, 0xa5, 0x7e, 0x05, 0x2e, 0x27, 0x4a, 0xe3, 0x69, 0x41, 0x1c, 0xa0, 0xa3, 0x51, 0xf7, 0x37,
-	0x08, 0xc8, 0x93, 0xc6, 0x01, 0x3d, 0x0f, 0x57, 0x22, 0x2c, 0xd5, 0x21, 0xcd, 0x1c, 0x02, 0x4c,
-	0x7a, 0x17, 0xf4, 0x49, 0x4c, 0xdc, 0xb3, 0xbe, 0x0b, 0x85, 0x11, 0xad, 0xea, 0x11, 0xcf, 0xb1,
-	0xa6, 0xc1, 0xec, 0xb7, 0xd1, 0x6f, 0xc5, 0xa0, 0xad, 0x0d, 0x69, 0x3a, 0xee, 0xef, 0x00, 0x62,
-	0x92, 0x19, 0xfd, 0x4b, 0xb0, 0x9e, 0xdc, 0x8c, 0x43, 0xbb, 0x0d, 0xb9, 0xd1, 0xac, 0xca, 0xe4,
-	0x2d, 0xf4, 0xd7, 0x63, 0xa6, 0x6c, 0x97, 0xf4, 0x49, 0x30, 0x65, 0x71, 0xa8, 0xe2, 0x54, 0xef,
-	0xb5, 0xe2, 0xaa, 0xdf, 0x81, 0xd5, 0x08, 0x4b, 0x6c, 0xba, 0x56, 0x86, 0x82, 0x7f, 0xa1, 0xc3,
-	0x0f, 0x13, 0xbc, 0xb2, 0xde, 0x41, 0x39, 0x3b, 0x36, 0xe9, 0x92, 0xa1, 0xdb, 0x33, 0xfb, 0xcc,
-	0xde, 0x2a, 0x9f, 0x8c, 0x6d, 0x1f, 0xde, 0x67, 0x01, 0x3a, 0x7e, 0x3d, 0x57, 0x40, 0xd4, 0x4b,
-	0x60, 0x93, 0xa0, 0x1f, 0x43, 0x68, 0xa3, 0xdf, 0x45, 0x15, 0x27, 0x08, 0xe1, 0x2a, 0x7e, 0x1e,
-	0x96, 0x82, 0x16, 0x41, 0x9a, 0xbb, 0x18, 0x10, 0x6b, 0x5d, 0x9d, 0xc4, 0x76, 0x74, 0x17, 0x4f,
-	0x96, 0x3c, 0xb8, 0x95, 0x18, 0xb8, 0x57, 0xa2, 0x11, 0x1a, 0xdb, 0x24, 0xe0, 0xbd, 0x87, 0x46,
-	0x9d, 0x24, 0x66, 0x16, 0xc0, 0x5f, 0x82, 0x8b, 0x71, 0x23, 0x7f, 0xd8, 0xf4, 0xd0, 0xbe, 0x13,
-	0x83, 0x36, 0xe6, 0x80, 0xee, 0xb5, 0x04, 0xa4, 0x55, 0x34, 0xae, 0xd8, 0xfe, 0x67, 0x81, 0xf9,
-	0x57, 0x0a, 0x2c, 0x8a, 0x32, 0x52, 0xb5, 0x0a, 0x1d, 0x1f, 0x65, 0x26, 0x1f, 0x1f, 0x65, 0xc3,
-	0xc7, 0x47, 0x65, 0x28, 0x78, 0xa7, 0x45, 0x7c, 0x4f, 0xe0, 0x97, 0x85, 0x03, 0x9f, 0x79, 0xe9,
-	0xc0, 0xe7, 0x13, 0x58, 0x09, 0xd9, 0x59, 0x3a, 0xa4, 0x57, 0x60, 0xd1, 0xec, 0x74, 0xf0, 0x40,
-	0x01, 0x57, 0x07, 0xc3, 0xba, 0xc0, 0x69, 0xb8, 0xd3, 0x60, 0x87, 0x50, 0xc8, 0x12, 0xc0, 0x05,
-	0x4e, 0xba, 0x4f, 0xe8, 0x26, 0x50, 0x0d, 0x1b, 0x4d, 0x6a, 0x35, 0x8d, 0x6c, 0xeb, 0x43, 0xd2,
-	0x71, 0x83, 0xd3, 0xbc, 0x22, 0xa7, 0xd4, 0x30, 0x2d, 0xfa, 0xd0, 0xb1, 0x86, 0x82, 0xd4, 0x3c,
-	0x2d, 0x53, 0x91, 0xe1, 0x75, 0xe3, 0xfb, 0x4c, 0xc1, 0x80, 0x52, 0xcd, 0xef, 0xa3, 0x90, 0x41,
-	0xcb, 0x1d, 0x71, 0x4b, 0x09, 0x9b, 0x62, 0x76, 0x36, 0x53, 0xac, 0xa1, 0x93, 0x8f, 0x93, 0x21,
-	0x3a, 0x93, 0x54, 0x70, 0x8f, 0xe1, 0xf9, 0x89, 0x5d, 0x71, 0xc0, 0x9f, 0x8d, 0x01, 0x3c, 0x9b,
-	0x63, 0x7a, 0x2f, 0x49, 0x90, 0xec, 0x52, 0x52, 0x81, 0xee, 0xc1, 0x0b, 0x93, 0xfb, 0xe2, 0xa8,
-	0x2b, 0x31, 0xa8, 0x67, 0xf4, 0x4f, 0x15, 0x28, 0x4b, 0xa2, 0xe4, 0x70, 0x92, 0x0a, 0xed, 0x45,
-	0x38, 0x1f, 0xdb, 0x85, 0x1f, 0x5b, 0x2e, 0x48, 0xd5, 0x0f, 0xcc, 0x7e, 0x4f, 0xcc, 0x32, 0x52,
-	0xc9, 0xb8, 0x1c, 0x72, 0x7e, 0x41, 0x27, 0x5c, 0xca, 0xbf, 0x2b, 0xf0, 0x5c, 0xb3, 0xfb, 0x94,
-	0x9d, 0x38, 0x1c, 0xd0, 0x85, 0xe6, 0xf5, 0x3f, 0xf1, 0xc0, 0x43, 0x3e, 0x1c, 0xcc, 0x84, 0x0f,
-	0x07, 0x0f, 0x82, 0xf3, 0xb3, 0x6c, 0xc2, 0x36, 0x32, 0x56, 0xe8, 0xaf, 0xe1, 0x10, 0xad, 0x04,
-	0x6b, 0x61, 0x51, 0x7c, 0xe8, 0x3f, 0x57, 0xe0, 0xac, 0x5f, 0x75, 0x34, 0x1c, 0x7c, 0x5a, 0x83,
-	0x6f, 0x84, 0x07, 0x7f, 0x2b, 0x79, 0xf0, 0xb2, 0xd8, 0x5f, 0xc3, 0xf0, 0xcb, 0x50, 0x8a, 0x0a,
-	0xe3, 0x0a, 0xf8, 0x27, 0x45, 0xd0, 0x0d, 0xbb, 0x1c, 0x4c, 0x35, 0xfe, 0x7a, 0x30, 0x40, 0x76,
-	0x48, 0xf0, 0x7a, 0xf2, 0x00, 0xa5, 0x6e, 0x7f, 0x0d, 0xe3, 0xbb, 0x2d, 0xcc, 0xa1, 0x27, 0x8b,
-	0xaf, 0xf2, 0xd0, 0x09, 0xb5, 0x12, 0x39, 0xa1, 0xbe, 0x25, 0x0c, 0x7f, 0x97, 0xa4, 0x1d, 0xbe,
-	0x7e, 0x4e, 0x10, 0xe9, 0x35, 0xe3, 0x1a, 0xfd, 0xa2, 0xd0, 0xa3, 0xbc, 0x49, 0x89, 0x4b, 0x0a,
-	0x67, 0x3d, 0xd2, 0xd6, 0xdf, 0x10, 0x24, 0x87, 0xce, 0x44, 0x26, 0x22, 0xfe, 0xb2, 0x82, 0x81,
-	0x41, 0x6c, 0xb8, 0x67, 0x5b, 0x03, 0x7e, 0xba, 0xd9, 0x9d, 0x84, 0xf1, 0x3c, 0x14, 0xd9, 0xb3,
-	0x39, 0xe1, 0x1a, 0x8c, 0x11, 0x6a, 0xdd, 0xd9, 0x6f, 0x5e, 0xee, 0xa0, 0xb3, 0x4f, 0xc6, 0x91,
-	0x66, 0x30, 0xf2, 0xac, 0x89, 0x5e, 0x77, 0x86, 0x59, 0x93, 0x3c, 0xad, 0xa8, 0xd6, 0xd0, 0x6e,
-	0x65, 0x62, 0x97, 0xf7, 0x85, 0xb5, 0x15, 0xde, 0xae, 0xcc, 0x7a, 0x4a, 0xaf, 0x1f, 0xc1, 0x39,
-	0xbf, 0xb3, 0xf0, 0xee, 0xed, 0x97, 0xbf, 0x36, 0xd1, 0x1b, 0x18, 0xa7, 0x22, 0xdd, 0x72, 0x94,
-	0xaf, 0x42, 0x9e, 0x89, 0xf7, 0xb6, 0x7b, 0x89, 0x30, 0x3d, 0x3e, 0xfd, 0x27, 0x0a, 0xe6, 0xbb,
-	0x7c, 0x6a, 0xf9, 0xc9, 0x98, 0x6c, 0xeb, 0x13, 0x9d, 0x47, 0xd3, 0x7f, 0xda, 0xca, 0x7c, 0xc7,
-	0xdb, 0xc9, 0xbe, 0x23, 0xb6, 0xf7, 0x4f, 0xfb, 0xb5, 0xeb, 0x1d, 0xdc, 0x7d, 0xc5, 0x0b, 0x0c,
-	0x1c, 0x49, 0xf0, 0xb0, 0xd1, 0x1b, 0x11, 0xf8, 0x4f, 0x1a, 0xbb, 0x7a, 0x3b, 0xa6, 0x0f, 0x83,
-	0xe0, 0xfd, 0x72, 0x2a, 0x9d, 0x84, 0x04, 0x64, 0x22, 0x02, 0x74, 0xcc, 0x42, 0x13, 0x04, 0x70,
-	0x2b, 0xfe, 0x99, 0x82, 0x19, 0xa6, 0xcc, 0x14, 0xb1, 0xa4, 0x89, 0x38, 0x1e, 0x84, 0xe6, 0xe6,
-	0xdd, 0xe9, 0x73, 0x13, 0x16, 0xf0, 0x69, 0x4f, 0xcf, 0x17, 0x04, 0xcf, 0x15, 0x23, 0x93, 0xcf,
-	0xd0, 0xad, 0xe8, 0x89, 0x70, 0xa2, 0x25, 0x07, 0x9c, 0xfa, 0x05, 0x96, 0xc4, 0xb1, 0x73, 0xaf,
-	0xc8, 0x91, 0xc9, 0xfb, 0x2c, 0x3f, 0x8b, 0xd4, 0x72, 0x99, 0x6f, 0x41, 0xbe, 0xc3, 0xea, 0xf8,
-	0x9a, 0xbc, 0x9c, 0x74, 0x9c, 0xe5, 0xdd, 0x5c, 0x78, 0xfc, 0xfa, 0x6b, 0xe8, 0x38, 0x38, 0x39,
-	0xe4, 0x71, 0x84, 0xc3, 0x33, 0x45, 0x3c, 0x3c, 0xd3, 0x0f, 0xd0, 0x41, 0x84, 0x1b, 0x71, 0x30,
-	0x37, 0x61, 0x8e, 0xb2, 0x71, 0x24, 0x93, 0x0f, 0xd6, 0x90, 0x53, 0xff, 0xa9, 0xc2, 0x8e, 0x1d,
-	0x58, 0x7f, 0xf8, 0x44, 0x22, 0x62, 0x2c, 0x6f, 0x01, 0x78, 0x2f, 0x9b, 0x6c, 0x97, 0xf7, 0x3d,
-	0xf5, 0x15, 0x49, 0x93, 0x32, 0x6b, 0xb7, 0xa0, 0x80, 0x4d, 0x09, 0xbf, 0xf0, 0x99, 0xdc, 0x30,
-	0x4f, 0x79, 0xab, 0x43, 0xf9, 0x6d, 0x49, 0x76, 0xa6, 0xb7, 0x25, 0x7a, 0x93, 0xed, 0xd4, 0xe2,
-	0xc7, 0x13, 0x78, 0x65, 0x7c, 0x05, 0xe2, 0x24, 0x7a, 0x65, 0xf6, 0xa6, 0xc4, 0xe0, 0x6c, 0xba,
-	0x23, 0xda, 0x00, 0xd6, 0xed, 0xf4, 0x89, 0x69, 0x07, 0x0a, 0x0a, 0xe0, 0x2a, 0xb3, 0x3d, 0x85,
-	0x39, 0x07, 0x05, 0xf6, 0xb2, 0x87, 0x2f, 0xf8, 0xac, 0x91, 0xc7, 0x72, 0xad, 0xab, 0x5f, 0x62,
-	0x99, 0x7f, 0x54, 0x28, 0x5f, 0xe9, 0x51, 0x50, 0x55, 0xdb, 0x74, 0xc8, 0x6f, 0x1a, 0x14, 0x17,
-	0xca, 0x41, 0x55, 0xb1, 0x5e, 0x7a, 0x59, 0x23, 0xd9, 0xf5, 0x55, 0x58, 0xb6, 0x82, 0xca, 0xc0,
-	0xbc, 0x97, 0x04, 0x6a, 0xad, 0xab, 0x8f, 0x70, 0xc3, 0x12, 0xd7, 0x0d, 0x9f, 0xc2, 0x06, 0x68,
-	0x62, 0x3f, 0xc2, 0x49, 0x75, 0xdc, 0xce, 0x33, 0xf4, 0xd2, 0xc7, 0x58, 0x15, 0xda, 0xb2, 0x53,
-	0x6c, 0xfd, 0x5d, 0xd4, 0xa6, 0xc0, 0x28, 0x07, 0xb3, 0xcb, 0xb0, 0xc0, 0x1d, 0xa6, 0x90, 0x1b,
-	0x01, 0x23, 0xd5, 0xcd, 0x01, 0xd1, 0xad, 0xf0, 0xc0, 0x43, 0xd1, 0xe3, 0x53, 0x07, 0xbc, 0x1b,
-	0x06, 0x2c, 0x67, 0x41, 0x29, 0x15, 0x7d, 0x29, 0x0c, 0x3b, 0x94, 0x14, 0xfd, 0x56, 0x58, 0x8a,
-	0x7c, 0xc6, 0x9d, 0x4e, 0x8a, 0xb6, 0x06, 0x39, 0xf6, 0x32, 0x0a, 0xcd, 0xa9, 0x60, 0xf0, 0x52,
-	0x54, 0x7a, 0xe8, 0x4c, 0xfb, 0x63, 0x6e, 0xe2, 0xd6, 0xb8, 0x7b, 0xc7, 0xec, 0x3c, 0x1d, 0x8f,
-	0x66, 0xc8, 0x30, 0xae, 0xc1, 0x8a, 0xb0, 0x31, 0xc6, 0x87, 0x5d, 0x2c, 0xac, 0x2c, 0x07, 0xe4,
-	0xa3, 0x31, 0xfb, 0x4a, 0xeb, 0xf1, 0xb8, 0xdf, 0xe7, 0x6f, 0x0d, 0xf0, 0xb7, 0xfe, 0x36, 0x37,
-	0xf3, 0x88, 0xe0, 0x20, 0x35, 0x7d, 0x84, 0x74, 0x41, 0x32, 0x23, 0xd4, 0xba, 0xfa, 0x0f, 0x95,
-	0x70, 0xeb, 0x68, 0x16, 0x90, 0xd8, 0x5a, 0xdb, 0x84, 0x67, 0x6d, 0xc6, 0xde, 0x16, 0x2d, 0x8e,
-	0x61, 0x5f, 0xe5, 0x55, 0x0f, 0x7c, 0xc3, 0x8b, 0x1b, 0x67, 0x36, 0x76, 0x9c, 0x49, 0x37, 0xff,
-	0xfa, 0x7d, 0x76, 0x3a, 0x10, 0x03, 0x97, 0x8f, 0x76, 0x03, 0x56, 0x43, 0x90, 0x7c, 0xdc, 0x2b,
-	0x12, 0xa0, 0x5a, 0x57, 0x3f, 0x0d, 0x4f, 0x59, 0x24, 0x39, 0x4f, 0x1e, 0x7a, 0xea, 0x29, 0x3b,
-	0x03, 0xf3, 0xf8, 0xed, 0x01, 0x9f, 0x33, 0x56, 0xf0, 0x7d, 0x53, 0x44, 0x34, 0xb7, 0xa6, 0x01,
-	0x3b, 0xa2, 0x0d, 0xd7, 0x57, 0xfa, 0x7d, 0x0f, 0x9d, 0x0e, 0x4b, 0x8e, 0xdd, 0x89, 0x0c, 0x72,
-	0xc1, 0xb1, 0x3b, 0x0f, 0x66, 0xb5, 0x2b, 0x7e, 0x71, 0x10, 0x2f, 0x8e, 0x23, 0xfa, 0xbe, 0x12,
-	0x86, 0x14, 0x09, 0xbe, 0x69, 0x20, 0x5d, 0x04, 0xe0, 0x39, 0x85, 0x70, 0xae, 0xc9, 0x29, 0xf1,
-	0x88, 0xe3, 0x2d, 0x44, 0x85, 0xac, 0xd9, 0xef, 0xf3, 0x47, 0xfc, 0xf4, 0xa7, 0xfe, 0x8b, 0x0c,
-	0x68, 0x32, 0x40, 0x7c, 0x05, 0x13, 0xbe, 0x9a, 0x8e, 0x80, 0xcc, 0x44, 0x41, 0xbe, 0x08, 0x2b,
-	0x02, 0x0f, 0xda, 0x34, 0x43, 0xb1, 0xe4, 0x73, 0xa1, 0x3d, 0x4b, 0x4f, 0x56, 0xe7, 0x66, 0x79,
-	0xb2, 0x7a, 0x20, 0x7c, 0x1e, 0x38, 0x8f, 0xd9, 0xdf, 0xab, 0x71, 0x99, 0x6b, 0x68, 0x30, 0x9b,
-	0x07, 0xbc, 0x0d, 0x7f, 0xe7, 0xe1, 0x75, 0xa1, 0x55, 0xfc, 0x0b, 0x50, 0xf6, 0x29, 0xd5, 0x4b,
-	0x53, 0x3a, 0x63, 0x7e, 0x99, 0xbd, 0xf0, 0x67, 0x0d, 0xcb, 0x6f, 0xc3, 0x92, 0xd4, 0xfb, 0x4c,
-	0x39, 0xef, 0x6f, 0x87, 0xed, 0x27, 0x9a, 0xc8, 0xbc, 0x03, 0x79, 0xb6, 0x78, 0xbc, 0x74, 0xf7,
-	0xf9, 0x14, 0x03, 0x36, 0xbc, 0x36, 0xfa, 0x7f, 0x67, 0xe0, 0x4c, 0xdc, 0x18, 0x26, 0xaf, 0xd2,
-	0x77, 0x20, 0x67, 0x8d, 0xf0, 0x15, 0x10, 0x7b, 0xc2, 0x73, 0x75, 0x8a, 0xcc, 0xc6, 0x88, 0xe9,
-	0x84, 0x35, 0x12, 0xd4, 0x9a, 0xfd, 0x25, 0xd5, 0x1a, 0xbc, 0xd1, 0xee, 0x5a, 0xfc, 0x7b, 0x58,
-	0xef, 0x8d, 0xf6, 0xae, 0x35, 0xa4, 0x29, 0x39, 0x60, 0xaa, 0xda, 0xc6, 0xef, 0x47, 0x52, 0xbc,
-	0x7a, 0x46, 0x6e, 0x5a, 0xd6, 0x2a, 0xb0, 0xdc, 0xb1, 0x06, 0x23, 0xba, 0x4e, 0xbb, 0xed, 0x94,
-	0x9f, 0x9f, 0x2c, 0xf9, 0x2d, 0xb0, 0x0b, 0xc1, 0xcd, 0xe6, 0x25, 0x37, 0xfb, 0x30, 0xec, 0x19,
-	0xf9, 0xfd, 0xf9, 0x0c, 0x0b, 0xfd, 0x0c, 0xcc, 0xd3, 0x1d, 0x7d, 0x9f, 0x87, 0x51, 0x56, 0xd0,
-	0xff, 0x2d, 0x12, 0x6f, 0xbc, 0x9e, 0xb9, 0x99, 0x3c, 0x84, 0x02, 0xd3, 0x9c, 0xbf, 0xc1, 0x7f,
-	0x3b, 0x95, 0xd2, 0x83, 0xd7, 0x32, 0xbc, 0x35, 0x5f, 0x22, 0x5e, 0x67, 0xe5, 0x47, 0xb0, 0x24,
-	0x55, 0xc5, 0xd8, 0xf7, 0xdb, 0xf2, 0xa3, 0x86, 0xab, 0xe9, 0x04, 0x0b, 0xcb, 0xa0, 0x1b, 0x09,
-	0xc5, 0xa6, 0x6b, 0xf6, 0xad, 0xe3, 0x4f, 0x35, 0xa2, 0xe8, 0x6f, 0x87, 0x63, 0xa0, 0x2f, 0x85,
-	0xeb, 0xb0, 0x0c, 0x85, 0x8e, 0x35, 0x74, 0xc9, 0xd0, 0xf5, 0x3e, 0x13, 0xf3, 0xcb, 0xfa, 0x8f,
-	0x14, 0xbe, 0x29, 0xf3, 0x5b, 0xdf, 0xeb, 0xd1, 0x21, 0x9e, 0xd6, 0x5c, 0x32, 0x48, 0x35, 0xb1,
-	0x92, 0xd3, 0xcb, 0xcc, 0xe2, 0xf4, 0x7e, 0xf5, 0xe5, 0xa4, 0xdf, 0x09, 0x6b, 0x98, 0xa3, 0x9f,
-	0xc1, 0x32, 0xf5, 0x61, 0x58, 0x7f, 0x7e, 0x1f, 0x5c, 0x7f, 0x07, 0xb0, 0xf8, 0x84, 0x91, 0xda,
-	0xfd, 0x9e, 0xe3, 0xbd, 0xd2, 0xdf, 0x98, 0x82, 0x56, 0xd0, 0xa3, 0xb1, 0xc0, 0xdb, 0xef, 0xf7,
-	0x1c, 0x97, 0x46, 0xce, 0xf5, 0xe8, 0xc0, 0x08, 0x7b, 0x31, 0x38, 0xcb, 0x92, 0x7a, 0x00, 0x2b,
-	0x36, 0x63, 0xf7, 0xbf, 0x7c, 0x62, 0x6e, 0xed, 0x95, 0x29, 0xd0, 0x0c, 0xaf, 0x15, 0xfb, 0x14,
-	0x6a, 0xd9, 0x96, 0xca, 0xfc, 0x39, 0x46, 0x12, 0x3e, 0xa6, 0x94, 0x8d, 0xff, 0xcd, 0x40, 0x8e,
-	0xbb, 0xdc, 0x15, 0x58, 0x68, 0xb6, 0x2a, 0xad, 0xa3, 0x66, 0xbb, 0xde, 0xa8, 0x57, 0xd5, 0x67,
-	0x04, 0x42, 0xad, 0x5e, 0x6b, 0xa9, 0x8a, 0xb6, 0x04, 0x45, 0x4e, 0x68, 0xdc, 0x57, 0x33, 0x9a,
-	0x06, 0xcb, 0x5e, 0x71, 0x6f, 0x6f, 0xbf, 0x56, 0xaf, 0xaa, 0x59, 0x4d, 0x85, 0x45, 0x4e, 0xab,
-	0x1a, 0x46, 0xc3, 0x50, 0xe7, 0xb4, 0x12, 0x9c, 0xf1, 0xbb, 0x6d, 0xb5, 0x6b, 0xf5, 0xf6, 0xe7,
-	0x8e, 0x1a, 0xc6, 0xd1, 0x81, 0x3a, 0xaf, 0x9d, 0x85, 0x67, 0x79, 0xcd, 0x6e, 0x75, 0xa7, 0x71,
-	0x70, 0x50, 0x6b, 0x36, 0x6b, 0x8d, 0xba, 0x9a, 0xd3, 0xd6, 0x40, 0xe3, 0x15, 0x07, 0x95, 0x5a,
-	0xbd, 0x55, 0xad, 0x57, 0xea, 0x3b, 0x55, 0x35, 0x2f, 0x34, 0x68, 0xb6, 0x1a, 0x46, 0xe5, 0x6e,
-	0xb5, 0xbd, 0xdb, 0x78, 0x58, 0x57, 0x0b, 0xda, 0x79, 0x38, 0x1b, 0xae, 0xa8, 0xde, 0x35, 0x2a,
-	0xbb, 0xd5, 0x5d, 0xb5, 0x28, 0xb4, 0xaa, 0x57, 0xab, 0xbb, 0xcd, 0xb6, 0x51, 0xbd, 0xd3, 0x68,
-	0xb4, 0x54, 0xd0, 0x2e, 0x40, 0x29, 0xd4, 0xca, 0xa8, 0xde, 0xa9, 0xec, 0xa3, 0xb0, 0x05, 0x6d,
-	0x1d, 0x2e, 0x84, 0xfb, 0x34, 0x6a, 0x0f, 0x28, 0xcf, 0xe1, 0x7e, 0x65, 0xa7, 0xaa, 0x2e, 0x6a,
-	0xcf, 0xc3, 0xe5, 0xb8, 0x91, 0xb5, 0xeb, 0x0d, 0xaf, 0x89, 0xba, 0xa4, 0x2d, 0x03, 0xf8, 0x63,
-	0x79, 0x5f, 0x5d, 0xde, 0xf8, 0xae, 0x02, 0xc0, 0xde, 0x96, 0xe2, 0x87, 0x33, 0x67, 0x40, 0xc5,
-	0x6e, 0x8d, 0x76, 0xeb, 0x83, 0xc3, 0xaa, 0xa7, 0xf9, 0x10, 0x75, 0xaf, 0xb6, 0x5f, 0x55, 0x15,
-	0xed, 0x39, 0x58, 0x15, 0xa9, 0x77, 0xf6, 0x1b, 0x3b, 0x74, 0x1a, 0xd6, 0x40, 0x13, 0xc9, 0x8d,
-	0x3b, 0xef, 0x55, 0x77, 0x5a, 0x6a, 0x56, 0x3b, 0x07, 0xcf, 0x89, 0xf4, 0x9d, 0xfd, 0xa3, 0x66,
-	0xab, 0x6a, 0x54, 0x77, 0xd5, 0xb9, 0x70, 0x4f, 0x77, 0x8d, 0xca, 0xe1, 0x3d, 0x75, 0x7e, 0xe3,
-	0xdb, 0x0a, 0xe4, 0xd8, 0x17, 0x82, 0x74, 0x1e, 0xf7, 0x9a, 0x12, 0xa6, 0x55, 0x58, 0xf2, 0x28,
-	0x77, 0x5a, 0xc6, 0x5e, 0x53, 0x55, 0x44, 0xa6, 0xea, 0xfb, 0xad, 0xd7, 0xd5, 0x8c, 0x48, 0xd9,
-	0x3b, 0x6a, 0x52, 0x83, 0x58, 0x81, 0x05, 0xbf, 0xa3, 0xbd, 0xa6, 0x3a, 0x27, 0x12, 0x1e, 0xec,
-	0x35, 0xd5, 0x79, 0x91, 0xf0, 0xfe, 0x5e, 0x53, 0xcd, 0x89, 0x84, 0xcf, 0xef, 0x35, 0xd5, 0xfc,
-	0xc6, 0x0f, 0x14, 0x78, 0x2e, 0xf6, 0x51, 0xae, 0x76, 0x05, 0x2e, 0x22, 0xf8, 0x36, 0x1f, 0xce,
-	0xce, 0xbd, 0x4a, 0xfd, 0x6e, 0x55, 0xc2, 0x7d, 0x15, 0xae, 0x24, 0xb2, 0x1c, 0x34, 0x76, 0x6b,
-	0x7b, 0xb5, 0xea, 0xae, 0xaa, 0x68, 0x3a, 0x5c, 0x4a, 0x64, 0xab, 0xec, 0x52, 0x4b, 0xca, 0x68,
-	0x2f, 0xc0, 0x7a, 0x22, 0xcf, 0x6e, 0x75, 0xbf, 0xda, 0xaa, 0xee, 0xaa, 0xd9, 0x0d, 0x17, 0x16,
-	0xc5, 0x8f, 0xa8, 0xd0, 0x9a, 0xab, 0x0f, 0xaa, 0x46, 0xad, 0xf5, 0x81, 0x04, 0x8c, 0xda, 0xa5,
-	0x44, 0xaf, 0xec, 0x57, 0x8c, 0x03, 0x55, 0xa1, 0x13, 0x27, 0x57, 0x3c, 0xac, 0x18, 0xf5, 0x5a,
-	0xfd, 0xae, 0x9a, 0xc1, 0xc5, 0x14, 0xea, 0xab, 0x55, 0xdb, 0xfb, 0x40, 0xcd, 0x6e, 0x7c, 0x55,
-	0x81, 0x45, 0xf1, 0x30, 0x85, 0x8a, 0x35, 0xaa, 0xcd, 0xc6, 0x91, 0xb1, 0x23, 0xeb, 0xa3, 0x04,
-	0x67, 0x64, 0xfa, 0x83, 0xc6, 0xfe, 0xd1, 0x01, 0xb5, 0xaf, 0x98, 0x16, 0xbb, 0x55, 0x35, 0x43,
-	0xf1, 0xc8, 0x74, 0x6e, 0x4a, 0x6a, 0x96, 0x8e, 0x41, 0xae, 0x42, 0xcd, 0xa8, 0x73, 0x1b, 0x7f,
-	0xa4, 0xc0, 0x0a, 0x1e, 0xce, 0xb0, 0x0f, 0x1a, 0x10, 0x51, 0x19, 0xd6, 0x2a, 0xfb, 0x55, 0xa3,
-	0xd5, 0xae, 0xec, 0xb4, 0x6a, 0x8d, 0xba, 0x84, 0xea, 0x02, 0x94, 0xa2, 0x75, 0x4c, 0xa7, 0xaa,
-	0x12, 0x5f, 0xbb, 0x63, 0x54, 0x2b, 0x2d, 0x8a, 0x2f, 0xb6, 0xf6, 0xe8, 0x70, 0x97, 0xd6, 0x66,
-	0x37, 0x3e, 0xf4, 0xbe, 0x5d, 0x10, 0x3e, 0x2d, 0xa1, 0x4d, 0xd8, 0xb0, 0xbd, 0x36, 0x87, 0x15,
-	0xa3, 0x72, 0xe0, 0x81, 0x39, 0x0f, 0x67, 0xe3, 0x6a, 0x1b, 0x7b, 0x7b, 0xaa, 0x42, 0x47, 0x11,
-	0x5b, 0x59, 0x57, 0x33, 0x1b, 0xdb, 0x90, 0xe7, 0x7f, 0xdc, 0x40, 0x2b, 0xc0, 0x1c, 0xef, 0x2d,
-	0x0f, 0xd9, 0xfd, 0xc6, 0x43, 0x55, 0xd1, 0x00, 0x72, 0x07, 0xd5, 0xdd, 0xda, 0xd1, 0x81, 0x9a,
-	0xa1, 0xd5, 0xf7, 0x6a, 0x77, 0xef, 0xa9, 0xd9, 0x8d, 0xdf, 0x83, 0xa2, 0xff, 0xd7, 0x0d, 0xa8,
-	0xaa, 0x6b, 0x8d, 0xf6, 0xa1, 0xd1, 0xa0, 0x4b, 0xbe, 0xdd, 0xac, 0x7e, 0xee, 0xa8, 0x5a, 0x6f,
-	0xd5, 0x2a, 0xfb, 0xea, 0x33, 0x74, 0xcd, 0x0a, 0x55, 0x46, 0xa5, 0xbe, 0xdb, 0xa0, 0xc6, 0xb2,
-	0x0a, 0x4b, 0x02, 0x79, 0xf7, 0x0e, 0x33, 0x12, 0x89, 0xd4, 0x36, 0xaa, 0x07, 0x0d, 0xaa, 0x0b,
-	0xea, 0xb1, 0x85, 0x9a, 0x9d, 0x83, 0xa6, 0x3a, 0xb7, 0xf1, 0xdd, 0x0c, 0x2c, 0x08, 0x1f, 0xa0,
-	0x50, 0x39, 0x7c, 0x7c, 0xd4, 0x6f, 0x89, 0x66, 0x23, 0x91, 0x0f, 0xab, 0xf5, 0x5d, 0x6a, 0x93,
-	0xa2, 0x42, 0x58, 0x4d, 0xe5, 0x41, 0xa5, 0xb6, 0x5f, 0xb9, 0xb3, 0xcf, 0x4d, 0x47, 0xae, 0x6b,
-	0xb5, 0x2a, 0x3b, 0xf7, 0xe8, 0x32, 0x89, 0x54, 0xed, 0x56, 0x79, 0xd5, 0x9c, 0xa0, 0xff, 0xa0,
-	0xaa, 0xb5, 0x73, 0x8f, 0x8a, 0x9b, 0xa7, 0x56, 0x2a, 0x55, 0xb2, 0x38, 0x93, 0x8b, 0x00, 0xf4,
-	0x16, 0x64, 0x5e, 0xbb, 0x04, 0x65, 0xa9, 0xa6, 0x65, 0x7c, 0xc0, 0xa5, 0xd1, 0x1e, 0x0b, 0x91,
-	0x96, 0x46, 0x95, 0xba, 0xef, 0xaa, 0x5a, 0xdc, 0xf8, 0x86, 0x02, 0x8b, 0xe2, 0x17, 0xd0, 0x21,
-	0xe1, 0x41, 0xa8, 0xbc, 0x08, 0xe7, 0xc2, 0xf4, 0x56, 0xfb, 0xd0, 0xa8, 0x36, 0xab, 0x75, 0x1a,
-	0x38, 0xcf, 0x80, 0x2a, 0x57, 0x1f, 0x1d, 0x32, 0xc7, 0x2d, 0x53, 0x31, 0x9a, 0x65, 0x43, 0x0a,
-	0xc5, 0xf0, 0xc8, 0x83, 0xd9, 0xdc, 0xc6, 0x17, 0x69, 0xbe, 0x2b, 0xfc, 0xe5, 0x17, 0x16, 0xfa,
-	0x58, 0x7c, 0x62, 0xc6, 0xd5, 0x3e, 0xa8, 0xdc, 0xad, 0x57, 0x5b, 0xb5, 0x1d, 0xf5, 0x19, 0x16,
-	0x48, 0xa5, 0xca, 0x66, 0x93, 0x3a, 0x3b, 0x0c, 0x89, 0x12, 0xbd, 0xfe, 0xe0, 0xa0, 0xaa, 0x66,
-	0x36, 0xae, 0xc3, 0x12, 0x3f, 0x59, 0xad, 0x5b, 0x6e, 0xef, 0xf1, 0x29, 0xe5, 0xe4, 0xab, 0x9d,
-	0xbb, 0x1a, 0x06, 0xf2, 0x99, 0x0d, 0x02, 0x0b, 0xc2, 0x77, 0xd8, 0x74, 0x36, 0xd9, 0xdc, 0x7a,
-	0xb3, 0xf2, 0x7e, 0xab, 0x6a, 0xd4, 0xd1, 0x70, 0xc3, 0x55, 0x34, 0xa2, 0x63, 0x95, 0x42, 0x63,
-	0x6c, 0x6c, 0x55, 0xbb, 0xf9, 0xb0, 0xd6, 0xda, 0xb9, 0xa7, 0x66, 0x36, 0x5a, 0xb0, 0xdc, 0x18,
-	0xd1, 0x2d, 0x67, 0xcf, 0x1a, 0xee, 0xf5, 0xcd, 0x63, 0x87, 0xea, 0xb2, 0x71, 0xd8, 0xde, 0xdb,
-	0xaf, 0xdc, 0x6d, 0xb6, 0x8f, 0xea, 0xf7, 0xeb, 0x08, 0x87, 0x2e, 0x03, 0x9f, 0x8a, 0x73, 0x82,
-	0x6e, 0xd4, 0x27, 0xb1, 0xe9, 0x6e, 0xef, 0x35, 0x8c, 0x1d, 0x3a, 0xcc, 0xdf, 0x09, 0xef, 0x3a,
-	0xd9, 0x0e, 0x51, 0xbb, 0x1c, 0xde, 0x20, 0x31, 0xfa, 0xd1, 0xf0, 0xe9, 0xd0, 0xfa, 0x78, 0xa8,
-	0x3e, 0x83, 0x49, 0x41, 0x0c, 0x83, 0xf7, 0x5b, 0x55, 0x68, 0x44, 0x8a, 0xdd, 0x7c, 0xb2, 0x43,
-	0xaa, 0xc6, 0x48, 0xcd, 0x6c, 0xfc, 0x38, 0xc3, 0xaf, 0x5d, 0x62, 0x52, 0x62, 0x4c, 0x2a, 0x12,
-	0xea, 0x02, 0x18, 0x2f, 0xb2, 0xf7, 0x55, 0x71, 0x4c, 0x75, 0xcb, 0xc5, 0x8b, 0x0f, 0xd2, 0x65,
-	0xfa, 0x4d, 0xe2, 0xa3, 0xfb, 0x54, 0x35, 0x33, 0x49, 0x5c, 0xe5, 0x91, 0x85, 0xdd, 0x64, 0x69,
-	0x6c, 0x4c, 0x62, 0x3a, 0x34, 0xc7, 0x0e, 0xe9, 0xaa, 0x73, 0x93, 0x3a, 0x6a, 0xba, 0xd6, 0x68,
-	0x44, 0xba, 0xea, 0xfc, 0xa4, 0x8e, 0xd8, 0x87, 0x22, 0x6a, 0x6e, 0x12, 0xcf, 0x9e, 0xd9, 0xeb,
-	0x93, 0xae, 0x9a, 0xdf, 0xf8, 0x51, 0xcc, 0xf9, 0xa6, 0x98, 0xfb, 0x6a, 0xd7, 0xd8, 0x63, 0xae,
-	0xa4, 0xfa, 0x40, 0x93, 0x57, 0xc3, 0x49, 0xb2, 0xcc, 0x88, 0xc3, 0x53, 0x95, 0xa8, 0xc2, 0x43,
-	0xb9, 0x37, 0x71, 0xc6, 0x03, 0xc2, 0x32, 0x84, 0x49, 0x7c, 0x54, 0x13, 0x6a, 0x76, 0xfb, 0xe7,
-	0xf3, 0xa0, 0x35, 0x46, 0x64, 0x18, 0x7a, 0xef, 0xfe, 0x15, 0x05, 0x8a, 0xfe, 0x09, 0x8b, 0xf6,
-	0x72, 0x7c, 0xf6, 0x1f, 0x7b, 0x45, 0x58, 0xbe, 0x91, 0x8e, 0x99, 0x1f, 0xfa, 0xad, 0xff, 0xc1,
-	0xcf, 0xfe, 0xf3, 0x4f, 0x32, 0x65, 0xfd, 0xb9, 0xad, 0x93, 0x57, 0xb7, 0xf8, 0x29, 0xdd, 0x16,
-	0xf1, 0xd8, 0x6e, 0x2b, 0x1b, 0xda, 0xef, 0x2b, 0x90, 0xe7, 0x17, 0x1e, 0xda, 0x4b, 0x13, 0xfa,
-	0x96, 0xef, 0x56, 0xca, 0x1b, 0x69, 0x58, 0x39, 0x88, 0x4b, 0x08, 0xa2, 0xa4, 0x3f, 0x2b, 0x82,
-	0xe8, 0x31, 0x26, 0x0a, 0xe1, 0x7b, 0x0a, 0x2c, 0xcb, 0xb7, 0x67, 0xda, 0xcd, 0x09, 0xdd, 0xc7,
-	0x5e, 0x1c, 0x96, 0x5f, 0x9d, 0xa1, 0x05, 0xc7, 0xf5, 0x22, 0xe2, 0x5a, 0xd7, 0xcf, 0x8b, 0xb8,
-	0xf0, 0xf2, 0x49, 0x56, 0xd1, 0xd7, 0x14, 0x80, 0xe0, 0x4e, 0x4c, 0xbb, 0x31, 0x4d, 0x92, 0x78,
-	0x5f, 0x57, 0x7e, 0x25, 0x25, 0x37, 0xc7, 0xa4, 0x23, 0xa6, 0x0b, 0xfa, 0xd9, 0x28, 0x26, 0xfc,
-	0x6e, 0x5d, 0xc2, 0x83, 0xd7, 0x61, 0xd3, 0xf1, 0x88, 0x57, 0x75, 0xd3, 0xf1, 0xc8, 0x77, 0x6c,
-	0x13, 0xf0, 0x10, 0xca, 0x78, 0x5b, 0xd9, 0xd8, 0xfe, 0xe3, 0x25, 0x58, 0x15, 0x8c, 0x9c, 0xff,
-	0x65, 0x98, 0x53, 0xc8, 0xb1, 0x8b, 0x0c, 0xed, 0x5a, 0xf2, 0x9d, 0xbe, 0x74, 0xc7, 0x52, 0xbe,
-	0x3e, 0x9d, 0x91, 0xc3, 0xba, 0x80, 0xb0, 0xd6, 0xf4, 0x55, 0x0a, 0x8b, 0xed, 0xb9, 0xb7, 0xd8,
-	0xf7, 0x98, 0x54, 0x41, 0x7f, 0xa9, 0x80, 0x16, 0x7d, 0xeb, 0xa3, 0xbd, 0x36, 0xad, 0xfb, 0x98,
-	0x17, 0x4a, 0xe5, 0xd7, 0x67, 0x6b, 0x14, 0xa7, 0x36, 0x09, 0xdf, 0x63, 0xdb, 0x1a, 0xf4, 0xba,
-	0x14, 0xe5, 0x29, 0xe4, 0xd8, 0x29, 0xfd, 0x24, 0x05, 0x49, 0x37, 0x1a, 0x93, 0x14, 0x14, 0xba,
-	0x7f, 0x88, 0x53, 0x50, 0x17, 0x59, 0xa8, 0xe8, 0xdf, 0x0d, 0xd6, 0xfc, 0x84, 0x2e, 0x43, 0x4b,
-	0xfe, 0xa5, 0x14, 0x9c, 0x5c, 0xfa, 0x45, 0x94, 0x7e, 0x56, 0xd7, 0x04, 0xe9, 0xc2, 0x82, 0xff,
-	0x43, 0xc9, 0xfd, 0x6d, 0x24, 0xf7, 0x1b, 0x59, 0xe5, 0x2f, 0xa7, 0xe2, 0xe5, 0x28, 0x2e, 0x23,
-	0x8a, 0x73, 0xfa, 0x19, 0x01, 0x85, 0xb4, 0xb0, 0xff, 0x54, 0x09, 0xbe, 0xb4, 0xe3, 0xb6, 0xba,
-	0x35, 0xe3, 0xdb, 0xa0, 0xf2, 0xcd, 0xf4, 0x0d, 0x38, 0xac, 0xab, 0x08, 0xeb, 0xb2, 0x5e, 0x16,
-	0x60, 0x79, 0x0f, 0x44, 0x04, 0x23, 0xfe, 0xbe, 0x02, 0x2b, 0xa1, 0x87, 0x37, 0x5a, 0x0a, 0x61,
-	0xf2, 0xf5, 0x5f, 0xbc, 0x5b, 0x9c, 0xfc, 0xaa, 0x47, 0x72, 0x8b, 0x61, 0x7c, 0xfc, 0x0a, 0x8e,
-	0x02, 0xfc, 0x6b, 0x85, 0x7d, 0x9b, 0x2d, 0xbd, 0x8f, 0xd1, 0xb6, 0x67, 0x7f, 0xc0, 0x53, 0x7e,
-	0x6d, 0xa6, 0x36, 0x1c, 0xe6, 0x75, 0x84, 0xa9, 0xeb, 0x17, 0xe3, 0x60, 0x4a, 0xd3, 0x7c, 0x0a,
-	0x39, 0x96, 0xc0, 0x4e, 0x5a, 0x68, 0xd2, 0xab, 0xd1, 0x49, 0x0b, 0x4d, 0x7e, 0xf2, 0x19, 0xbb,
-	0xd0, 0xd8, 0x1f, 0x42, 0xf0, 0xd7, 0xf8, 0x34, 0xd1, 0xd2, 0x43, 0xd0, 0xc9, 0x6b, 0x7c, 0xaa,
-	0xe8, 0x2e, 0xf1, 0x44, 0x8f, 0x61, 0x1e, 0x1f, 0x1f, 0x6b, 0x2f, 0xa6, 0x7b, 0x08, 0x5d, 0xbe,
-	0x36, 0x95, 0x8f, 0xcb, 0x3d, 0x8f, 0x72, 0x9f, 0xd3, 0x55, 0x41, 0x2e, 0x3e, 0xf3, 0xe5, 0xae,
-	0x85, 0x3f, 0xfa, 0x9d, 0xe4, 0x5a, 0xe4, 0x47, 0xc8, 0x93, 0x5c, 0x4b, 0xf8, 0x05, 0x71, 0x9c,
-	0x6b, 0x19, 0x0f, 0x3d, 0xf1, 0xdb, 0xff, 0x32, 0x07, 0x6b, 0x42, 0x2c, 0x12, 0xae, 0xfb, 0xb5,
-	0xaf, 0x0b, 0x99, 0x4e, 0x6c, 0x14, 0x4c, 0x7c, 0x49, 0x52, 0xde, 0x4c, 0xcb, 0xce, 0x41, 0xbe,
-	0x80, 0x20, 0x2f, 0xe9, 0xe7, 0x28, 0x48, 0xe1, 0x79, 0x82, 0x6c, 0x97, 0x5f, 0x51, 0xfc, 0x10,
-	0x79, 0x63, 0x8a, 0x00, 0xd9, 0xe7, 0xbc, 0x92, 0x92, 0x9b, 0xa3, 0xb9, 0x82, 0x68, 0xce, 0xeb,
-	0x6b, 0x61, 0x34, 0x81, 0xb3, 0xa1, 0x50, 0x78, 0x30, 0x9a, 0x06, 0x45, 0x8e, 0x48, 0xaf, 0xa4,
-	0xe4, 0x9e, 0x06, 0x25, 0x88, 0x4d, 0x14, 0x0a, 0x7b, 0x9a, 0x31, 0x15, 0x8a, 0xf4, 0x3e, 0x64,
-	0x2a, 0x94, 0xd0, 0x7b, 0x8f, 0x44, 0x28, 0x63, 0xe4, 0xa3, 0xc6, 0xf4, 0x2d, 0x90, 0x8c, 0x29,
-	0xf8, 0xa6, 0xc1, 0xd1, 0xbe, 0xa3, 0xc0, 0x22, 0x8f, 0xff, 0x96, 0x5d, 0x79, 0xd8, 0xd4, 0x62,
-	0x4d, 0x24, 0xf9, 0x13, 0xb0, 0xf2, 0x56, 0x6a, 0xfe, 0xb8, 0xb0, 0x11, 0xdc, 0x28, 0x39, 0x7c,
-	0x16, 0xb7, 0xcc, 0x8f, 0x1d, 0x1e, 0x36, 0x96, 0x03, 0x60, 0x9f, 0x8c, 0x93, 0xa2, 0xc6, 0xa4,
-	0x8f, 0xff, 0x12, 0x92, 0xe9, 0x49, 0x5f, 0xf2, 0xe9, 0xd7, 0x10, 0xde, 0x15, 0xfd, 0x42, 0x12,
-	0x3c, 0xca, 0x4d, 0x01, 0xfe, 0x85, 0x02, 0x2b, 0x3e, 0x40, 0xf6, 0xc1, 0x8b, 0x96, 0x4a, 0x9e,
-	0xf4, 0x75, 0x4e, 0x79, 0x7b, 0x96, 0x26, 0x71, 0x21, 0x23, 0x06, 0x23, 0xbb, 0x04, 0xf3, 0x40,
-	0xfa, 0x21, 0x87, 0xcf, 0xf0, 0x14, 0x90, 0x31, 0x9f, 0x69, 0x4d, 0x03, 0x19, 0xf7, 0x41, 0x56,
-	0x32, 0x48, 0xdf, 0x77, 0x78, 0x53, 0xfd, 0x37, 0x0a, 0xac, 0x4a, 0x20, 0x71, 0xb6, 0x5f, 0x4b,
-	0x2b, 0x53, 0x9c, 0xf0, 0xd7, 0x67, 0x6b, 0xc4, 0xa1, 0x6e, 0x20, 0xd4, 0x17, 0xf4, 0xcb, 0x13,
-	0xa0, 0x7a, 0xd3, 0xfe, 0xb7, 0x0a, 0x68, 0x22, 0x58, 0x3e, 0xf3, 0x69, 0x05, 0xcb, 0x93, 0x7f,
-	0x6b, 0xc6, 0x56, 0x1c, 0xef, 0xcb, 0x88, 0xf7, 0xaa, 0xbe, 0x9e, 0x8c, 0x37, 0x30, 0x81, 0x2f,
-	0x07, 0x2e, 0xf1, 0xe5, 0xc9, 0xe2, 0x64, 0x8f, 0x78, 0x23, 0x1d, 0x73, 0x9c, 0x17, 0x12, 0x21,
-	0x05, 0x0e, 0xf1, 0xeb, 0x0a, 0x14, 0xbc, 0x8f, 0xa8, 0xe2, 0x03, 0x57, 0xe2, 0x17, 0x5b, 0xe5,
-	0xcd, 0xb4, 0xec, 0xde, 0x87, 0xdd, 0x08, 0xe7, 0xa2, 0x5e, 0x0a, 0xc3, 0x39, 0xe1, 0x9c, 0xd4,
-	0x2d, 0x7e, 0x23, 0x07, 0xe7, 0x04, 0xb7, 0x18, 0xfa, 0x16, 0xf9, 0x9b, 0x41, 0x54, 0xdb, 0x9a,
-	0xfe, 0xc1, 0x74, 0x8a, 0x64, 0x7a, 0xe2, 0xa7, 0xf1, 0x52, 0xa4, 0xf5, 0xbe, 0x6f, 0x66, 0xdf,
-	0x60, 0x0b, 0xe1, 0xed, 0x9b, 0x41, 0x4c, 0x49, 0x81, 0x49, 0x0e, 0x2b, 0x37, 0xd3, 0x37, 0x48,
-	0x81, 0xc9, 0x0f, 0x2e, 0xda, 0xf7, 0xa4, 0x4d, 0xd0, 0xf6, 0x74, 0x29, 0xe9, 0xd2, 0xe6, 0x29,
-	0xdf, 0xdb, 0xcb, 0x7e, 0x3a, 0x04, 0x4e, 0xca, 0x4e, 0xbe, 0x2d, 0xa4, 0x4b, 0x29, 0x74, 0x10,
-	0xca, 0x98, 0x5e, 0x9d, 0xa1, 0x45, 0x5c, 0x80, 0x0b, 0x21, 0x13, 0x36, 0x8f, 0xdf, 0x0c, 0xd6,
-	0x65, 0x8a, 0xb9, 0x94, 0xd7, 0xe6, 0xcd, 0xf4, 0x0d, 0x52, 0xcc, 0xa5, 0xbf, 0x44, 0xb7, 0xff,
-	0x21, 0x94, 0x28, 0x04, 0xa7, 0x82, 0x53, 0x93, 0xbc, 0xa4, 0x07, 0xa7, 0xe5, 0x57, 0x52, 0x72,
-	0xc7, 0x3a, 0x12, 0xca, 0xc6, 0x1e, 0xa6, 0x08, 0xab, 0xe0, 0xab, 0x0a, 0xe4, 0xbd, 0x9d, 0xe4,
-	0xf4, 0x17, 0x07, 0xd2, 0x36, 0x72, 0x33, 0x2d, 0x7b, 0xfc, 0xb1, 0x51, 0x80, 0x46, 0xd8, 0x3f,
-	0x4e, 0xcb, 0x39, 0x93, 0xde, 0x75, 0x4e, 0xd5, 0xcc, 0x44, 0x17, 0x2b, 0x60, 0x09, 0x5c, 0xec,
-	0xb7, 0x14, 0x28, 0xfa, 0x2f, 0x26, 0xe3, 0xcd, 0x6a, 0xc2, 0x53, 0xce, 0xf2, 0xcd, 0xf4, 0x0d,
-	0xe2, 0xcc, 0x2a, 0x8a, 0xc9, 0xec, 0xf7, 0x3d, 0x58, 0x81, 0x8b, 0x98, 0x06, 0x2b, 0xe2, 0x1f,
-	0x6e, 0xa6, 0x6f, 0x30, 0x0d, 0x56, 0x64, 0xdf, 0xc2, 0x6f, 0xc9, 0x6e, 0xa4, 0x7c, 0xdb, 0x95,
-	0x6e, 0xe2, 0xe4, 0x97, 0x60, 0xc9, 0x13, 0xc7, 0xde, 0x12, 0x79, 0x26, 0xcd, 0x5f, 0x4f, 0x4d,
-	0x35, 0x69, 0xf9, 0x2d, 0xd7, 0x54, 0x93, 0x0e, 0x3d, 0xca, 0x4a, 0x36, 0xe9, 0x0e, 0x63, 0xf4,
-	0xe0, 0xf0, 0x67, 0x44, 0x53, 0xe1, 0xc8, 0x0f, 0x9f, 0xa6, 0xc2, 0x09, 0xbd, 0x71, 0x4a, 0x86,
-	0xc3, 0x5f, 0x2e, 0x51, 0x38, 0x7f, 0xa6, 0xc0, 0x82, 0xf0, 0x14, 0x48, 0x7b, 0x35, 0x85, 0xfe,
-	0xe5, 0x67, 0x4d, 0x09, 0x19, 0xec, 0xc4, 0x97, 0x46, 0xe1, 0x73, 0x75, 0x79, 0xde, 0x48, 0x07,
-	0x99, 0x6f, 0x2b, 0x1b, 0x77, 0x2e, 0xc0, 0xb3, 0x1d, 0x6b, 0x10, 0x16, 0x70, 0xa8, 0x7c, 0x3e,
-	0x6b, 0x8e, 0x7a, 0x8f, 0x72, 0xf8, 0x16, 0xed, 0xb5, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xde,
-	0x1c, 0xd1, 0xc7, 0x5c, 0x65, 0x00, 0x00,
+// OpenStorageAuditClient is the client API for OpenStorageAudit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OpenStorageAuditClient interface {
+	// Enumerate returns the audit events recorded in the cluster
+	Enumerate(ctx context.Context, in *SdkAuditEnumerateRequest, opts ...grpc.CallOption) (*SdkAuditEnumerateResponse, error)
+}
+
+type openStorageAuditClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewOpenStorageAuditClient(cc *grpc.ClientConn) OpenStorageAuditClient {
+	return &openStorageAuditClient{cc}
+}
+
+func (c *openStorageAuditClient) Enumerate(ctx context.Context, in *SdkAuditEnumerateRequest, opts ...grpc.CallOption) (*SdkAuditEnumerateResponse, error) {
+	out := new(SdkAuditEnumerateResponse)
+	err := c.cc.Invoke(ctx, "/openstorage.api.OpenStorageAudit/Enumerate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OpenStorageAuditServer is the server API for OpenStorageAudit service.
+type OpenStorageAuditServer interface {
+	// Enumerate returns the audit events recorded in the cluster
+	Enumerate(context.Context, *SdkAuditEnumerateRequest) (*SdkAuditEnumerateResponse, error)
+}
+
+func RegisterOpenStorageAuditServer(s *grpc.Server, srv OpenStorageAuditServer) {
+	s.RegisterService(&_OpenStorageAudit_serviceDesc, srv)
+}
+
+func _OpenStorageAudit_Enumerate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SdkAuditEnumerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenStorageAuditServer).Enumerate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openstorage.api.OpenStorageAudit/Enumerate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenStorageAuditServer).Enumerate(ctx, req.(*SdkAuditEnumerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _OpenStorageAudit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "openstorage.api.OpenStorageAudit",
+	HandlerType: (*OpenStorageAuditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Enumerate",
+			Handler:    _OpenStorageAudit_Enumerate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/api.proto",
+}
+
+func init() { proto.RegisterFile("api/api.proto", fileDescriptor_api_0a6993e9904e02e0) }
+
+var fileDescriptor_api_0a6993e9904e02e0 = []byte{
+	// 8172 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7d, 0x5f, 0x70, 0x1b, 0x49,
+	0x7a, 0xdf, 0x0e, 0x40, 0x02, 0xc4, 0x47, 0x12, 0x1c, 0x8d, 0x24, 0x12, 0x82, 0x48, 0x91, 0x1a,
+	0xad, 0xfe, 0x2c, 0x57, 0x22, 0x25, 0x4a, 0xda, 0xdb, 0xd5, 0xde, 0x9e, 0x0f, 0x04, 0x40, 0x09,
+	0x2b, 0x12, 0xe0, 0x0d, 0x40, 0x69, 0xef, 0x2e, 0x36, 0x3c, 0x02, 0x5a, 0x24, 0x4e, 0xc0, 0x0c,
+	0x34, 0x33, 0xa0, 0x8e, 0x5b, 0xb1, 0x93, 0x72, 0x55, 0xec, 0x7b, 0xb0, 0xef, 0x72, 0xe5, 0x3f,
+	0x29, 0xa7, 0xe2, 0x73, 0x55, 0x52, 0x49, 0xaa, 0xec, 0x4a, 0xe2, 0x54, 0xf2, 0x16, 0x57, 0xb9,
+	0x5c, 0x95, 0x87, 0x24, 0xe5, 0xf8, 0xc5, 0x6f, 0x49, 0x55, 0x1e, 0x92, 0xbc, 0xa4, 0x92, 0xf2,
+	0x53, 0xf2, 0xe0, 0xb7, 0x54, 0xff, 0x99, 0x99, 0xee, 0xf9, 0x03, 0x0c, 0xb4, 0x5a, 0xfb, 0x45,
+	0x44, 0x77, 0x7f, 0xdd, 0xfd, 0xeb, 0xaf, 0xbf, 0xfe, 0xbe, 0xaf, 0xbb, 0xbf, 0x1e, 0xc1, 0xa2,
+	0x3e, 0xec, 0x6d, 0xeb, 0xc3, 0xde, 0xd6, 0xd0, 0x32, 0x1d, 0x53, 0x59, 0x32, 0x87, 0xc8, 0xb0,
+	0x1d, 0xd3, 0xd2, 0x8f, 0xd1, 0x96, 0x3e, 0xec, 0x15, 0xd7, 0x8f, 0x4d, 0xf3, 0xb8, 0x8f, 0xb6,
+	0x49, 0xf1, 0x8b, 0xd1, 0xcb, 0x6d, 0xa7, 0x37, 0x40, 0xb6, 0xa3, 0x0f, 0x86, 0xb4, 0x46, 0x71,
+	0x95, 0x11, 0x90, 0x76, 0x0c, 0xc3, 0x74, 0x74, 0xa7, 0x67, 0x1a, 0x36, 0x2d, 0x55, 0x7f, 0x23,
+	0x0d, 0x4b, 0x4d, 0xda, 0x9c, 0x86, 0x6c, 0x73, 0x64, 0x75, 0x90, 0x92, 0x87, 0x54, 0xaf, 0x5b,
+	0x90, 0x36, 0xa4, 0x5b, 0x39, 0x2d, 0xd5, 0xeb, 0x2a, 0x0a, 0xcc, 0x0c, 0x75, 0xe7, 0xa4, 0x90,
+	0x22, 0x39, 0xe4, 0xb7, 0xf2, 0x11, 0x64, 0x06, 0xa8, 0xdb, 0x1b, 0x0d, 0x0a, 0xe9, 0x0d, 0xe9,
+	0x56, 0x7e, 0xe7, 0xca, 0x56, 0x00, 0xd8, 0x16, 0x6b, 0xf5, 0x80, 0x50, 0x69, 0x8c, 0x5a, 0x59,
+	0x86, 0x8c, 0x69, 0xf4, 0x7b, 0x06, 0x2a, 0xcc, 0x6c, 0x48, 0xb7, 0xe6, 0x34, 0x96, 0xc2, 0x7d,
+	0xf4, 0xcc, 0xa1, 0x5d, 0x98, 0xdd, 0x90, 0x6e, 0xcd, 0x68, 0xe4, 0xb7, 0x72, 0x19, 0x72, 0x36,
+	0x7a, 0xdd, 0x7e, 0x63, 0xf5, 0x1c, 0x54, 0xc8, 0x6c, 0x48, 0xb7, 0x24, 0x6d, 0xce, 0x46, 0xaf,
+	0x9f, 0xe3, 0xb4, 0x72, 0x09, 0xf0, 0xef, 0xb6, 0x85, 0xf4, 0x6e, 0x21, 0x4b, 0xca, 0xb2, 0x36,
+	0x7a, 0xad, 0x21, 0xbd, 0x8b, 0xfb, 0xb0, 0x74, 0xa3, 0xab, 0x3d, 0x2f, 0xcc, 0x91, 0x02, 0x96,
+	0xc2, 0x7d, 0xd8, 0xbd, 0x2f, 0x51, 0x21, 0x47, 0xfb, 0xc0, 0xbf, 0x71, 0xde, 0xc8, 0x46, 0xdd,
+	0x02, 0xd0, 0x3c, 0xfc, 0x5b, 0xb9, 0x0e, 0x79, 0x8b, 0xb1, 0xa9, 0x6d, 0x0f, 0x11, 0xea, 0x16,
+	0xe6, 0xc9, 0xc8, 0x17, 0xdd, 0xdc, 0x26, 0xce, 0x54, 0xbe, 0x01, 0xb9, 0xbe, 0x6e, 0x3b, 0x6d,
+	0xbb, 0xa3, 0x1b, 0x85, 0x85, 0x0d, 0xe9, 0xd6, 0xfc, 0x4e, 0x71, 0x8b, 0x32, 0x7b, 0xcb, 0x9d,
+	0x8d, 0xad, 0x96, 0x3b, 0x1b, 0xda, 0x1c, 0x26, 0x6e, 0x76, 0x74, 0x43, 0x29, 0xc2, 0xdc, 0x00,
+	0x39, 0x7a, 0x57, 0x77, 0xf4, 0xc2, 0x22, 0xe1, 0x82, 0x97, 0x56, 0xff, 0x3c, 0x05, 0xf3, 0x8c,
+	0x73, 0x87, 0xa6, 0xd9, 0xc7, 0x73, 0x51, 0xab, 0x90, 0xb9, 0x98, 0xd5, 0x52, 0xb5, 0x8a, 0xb2,
+	0x09, 0xe9, 0xb2, 0x69, 0x93, 0xa9, 0xc8, 0xef, 0x14, 0x42, 0x4c, 0x2f, 0x9b, 0x76, 0xeb, 0x6c,
+	0x88, 0x34, 0x4c, 0x84, 0xe7, 0xe8, 0x60, 0xaa, 0x39, 0xa2, 0x7f, 0x95, 0x55, 0xc8, 0x69, 0x7a,
+	0xaf, 0xbb, 0x8f, 0x4e, 0x51, 0x9f, 0x4c, 0x53, 0x4e, 0xf3, 0x33, 0x70, 0x69, 0xcb, 0x74, 0xf4,
+	0x7e, 0x13, 0xb3, 0x32, 0x4b, 0xd8, 0xe6, 0x67, 0x60, 0x7e, 0x1e, 0x61, 0x7e, 0xce, 0x51, 0x7e,
+	0xe2, 0xdf, 0xca, 0xb7, 0x21, 0xd3, 0xd7, 0x5f, 0xa0, 0xbe, 0x5d, 0xc8, 0x6d, 0xa4, 0x6f, 0xcd,
+	0xef, 0xdc, 0x8a, 0xc3, 0x81, 0x47, 0xbc, 0xb5, 0x4f, 0x48, 0xab, 0x86, 0x63, 0x9d, 0x69, 0xac,
+	0x5e, 0xf1, 0x13, 0x98, 0xe7, 0xb2, 0x15, 0x19, 0xd2, 0xaf, 0xd0, 0x19, 0x93, 0x50, 0xfc, 0x53,
+	0xb9, 0x00, 0xb3, 0xa7, 0x7a, 0x7f, 0x84, 0x98, 0x8c, 0xd2, 0xc4, 0xa3, 0xd4, 0xc7, 0x92, 0xfa,
+	0xef, 0x24, 0x58, 0x7c, 0x66, 0xf6, 0x47, 0x03, 0xb4, 0x6f, 0x76, 0x74, 0xc7, 0xb4, 0x30, 0x44,
+	0x43, 0x1f, 0x20, 0x56, 0x9d, 0xfc, 0x56, 0x8e, 0x60, 0xf1, 0x94, 0x10, 0xb5, 0x19, 0xd2, 0x14,
+	0x41, 0x7a, 0x37, 0x84, 0x54, 0x68, 0xca, 0x4d, 0x71, 0x88, 0x17, 0x4e, 0xb9, 0xac, 0xe2, 0xcf,
+	0xc1, 0xb9, 0x10, 0xc9, 0x54, 0xe8, 0x1f, 0x40, 0xa6, 0x49, 0x17, 0xe5, 0x32, 0x64, 0x86, 0xba,
+	0x85, 0x0c, 0x87, 0x55, 0x64, 0x29, 0x22, 0xd4, 0x58, 0x44, 0xd9, 0xe2, 0xc4, 0xbf, 0xd5, 0x15,
+	0x98, 0x7d, 0x6c, 0x99, 0xa3, 0x61, 0x70, 0x25, 0xab, 0xff, 0x2f, 0x05, 0x8b, 0x8d, 0x37, 0x06,
+	0xb2, 0xec, 0x93, 0xde, 0xb0, 0xd4, 0xe9, 0xdb, 0xca, 0x2e, 0x64, 0x8e, 0x31, 0xa9, 0x5d, 0x90,
+	0xc8, 0x88, 0x37, 0x43, 0x23, 0x16, 0xe8, 0xb7, 0x48, 0xbb, 0xee, 0xec, 0xd0, 0x9a, 0xca, 0x73,
+	0x58, 0xec, 0x98, 0xfd, 0xbe, 0xfe, 0xc2, 0xb4, 0x30, 0x57, 0x5c, 0xe6, 0xdd, 0x9b, 0xd0, 0x54,
+	0x99, 0xaf, 0x43, 0x5b, 0x14, 0xdb, 0x29, 0xb6, 0x61, 0x9e, 0xeb, 0x2f, 0x82, 0x71, 0x8f, 0x78,
+	0xc6, 0xe5, 0x77, 0xde, 0x1f, 0xd7, 0x63, 0x07, 0xd9, 0x74, 0x6d, 0xf8, 0xec, 0x2d, 0xbe, 0x04,
+	0x25, 0x8c, 0xe2, 0xdd, 0xf7, 0xa3, 0x1e, 0x41, 0xce, 0xa3, 0xc0, 0xb3, 0x6d, 0xe2, 0x04, 0xeb,
+	0x80, 0x26, 0x94, 0x1d, 0x98, 0xd1, 0x3b, 0x7d, 0xba, 0xb2, 0xe7, 0x23, 0x96, 0xaa, 0xc0, 0x3b,
+	0x8d, 0xd0, 0xaa, 0x3f, 0x92, 0xa0, 0x40, 0x24, 0xab, 0x89, 0xfa, 0xa8, 0xe3, 0x98, 0x96, 0x86,
+	0x5e, 0x8f, 0x7a, 0x16, 0x1a, 0x60, 0xc1, 0x08, 0x8f, 0x62, 0x17, 0xe6, 0xcc, 0x21, 0x22, 0x23,
+	0x65, 0x03, 0xb9, 0x11, 0xea, 0x46, 0x68, 0xae, 0xc1, 0xa8, 0x35, 0xaf, 0x1e, 0x16, 0x43, 0x32,
+	0x2c, 0xbb, 0x90, 0xde, 0x48, 0x63, 0x31, 0xa4, 0x29, 0xf5, 0xf7, 0xd3, 0xb0, 0x40, 0x45, 0x7d,
+	0xaf, 0xd7, 0x77, 0x90, 0xa5, 0x7c, 0x0c, 0xd9, 0x3e, 0x5d, 0x25, 0x05, 0x29, 0x66, 0x48, 0xc2,
+	0x5a, 0xd2, 0x5c, 0x72, 0xe5, 0x10, 0xf2, 0x64, 0x11, 0xb6, 0x6d, 0x06, 0x83, 0xc9, 0xd3, 0x07,
+	0xe3, 0xc1, 0x72, 0x63, 0xd7, 0x16, 0xfb, 0x7c, 0x89, 0xb2, 0x03, 0xb3, 0xb6, 0xa3, 0x3b, 0x88,
+	0xe9, 0xc1, 0xd5, 0x18, 0x24, 0x4d, 0x4c, 0xa3, 0x51, 0x52, 0xe5, 0x21, 0x64, 0xf0, 0x8f, 0x91,
+	0x4d, 0x34, 0x60, 0x7e, 0x67, 0x6d, 0x4c, 0xa5, 0x91, 0xad, 0x31, 0x62, 0x7f, 0x72, 0x67, 0xf9,
+	0xc9, 0xfd, 0x39, 0x58, 0xec, 0x58, 0x48, 0x77, 0x50, 0xb7, 0xad, 0xbf, 0x74, 0x90, 0x55, 0xc8,
+	0x4c, 0x34, 0x17, 0x0b, 0xac, 0x42, 0x09, 0xd3, 0x2b, 0x25, 0xc8, 0xbb, 0x0d, 0xbc, 0x40, 0x2f,
+	0x4d, 0x8b, 0x6a, 0xde, 0xf1, 0x2d, 0xb8, 0x5d, 0xee, 0x92, 0x0a, 0xea, 0xaf, 0xcd, 0x01, 0x30,
+	0xc8, 0x43, 0xd4, 0xc1, 0x6a, 0x1c, 0x0d, 0x4f, 0xd0, 0x00, 0x59, 0x7a, 0x9f, 0xcc, 0xd0, 0x9c,
+	0xe6, 0x67, 0x78, 0xa6, 0x32, 0xc5, 0x99, 0xca, 0x6d, 0xc8, 0xbc, 0x34, 0xad, 0x81, 0xee, 0x30,
+	0x36, 0xae, 0x84, 0x38, 0xb2, 0xd7, 0x24, 0x82, 0xcf, 0xc8, 0x94, 0x35, 0x80, 0x17, 0x7d, 0xb3,
+	0xf3, 0xaa, 0x4d, 0x9a, 0xc2, 0x6c, 0x4c, 0x6b, 0x39, 0x92, 0x43, 0x4c, 0xc5, 0x25, 0x98, 0x3b,
+	0xd1, 0xdb, 0x7d, 0x62, 0x65, 0x66, 0x49, 0x61, 0xf6, 0x44, 0xa7, 0x36, 0x66, 0x13, 0xd2, 0x1d,
+	0xd3, 0x2e, 0x64, 0x26, 0x59, 0xb9, 0x8e, 0x69, 0x2b, 0x9f, 0x00, 0xf4, 0xcc, 0xf6, 0xd0, 0x32,
+	0x5f, 0xf6, 0xfa, 0x94, 0x2d, 0xf9, 0x9d, 0x62, 0xa8, 0x4a, 0xcd, 0x3c, 0xa4, 0x14, 0x5a, 0xae,
+	0xe7, 0xfe, 0xc4, 0xc2, 0xdc, 0x45, 0xdd, 0xd1, 0x10, 0x11, 0x73, 0x35, 0xa7, 0xb1, 0x94, 0xf2,
+	0x21, 0x9c, 0xb3, 0x0d, 0x7d, 0x68, 0x9f, 0x98, 0x4e, 0xbb, 0x67, 0x38, 0xc8, 0x3a, 0xd5, 0xfb,
+	0xc4, 0x6b, 0x58, 0xd4, 0x64, 0xb7, 0xa0, 0xc6, 0xf2, 0x15, 0x2d, 0x68, 0x3a, 0x80, 0x48, 0xeb,
+	0x9d, 0x38, 0x79, 0x19, 0xa2, 0xce, 0x24, 0xbb, 0x81, 0x81, 0xd9, 0x27, 0xba, 0xc5, 0x3c, 0x8f,
+	0x39, 0x8d, 0xa5, 0x94, 0x6f, 0xc2, 0xbc, 0x85, 0x86, 0xfd, 0x5e, 0x47, 0x6f, 0xdb, 0xc8, 0x61,
+	0x4e, 0xc7, 0xe5, 0x50, 0x4f, 0x1a, 0xa5, 0x69, 0x22, 0x47, 0x03, 0xcb, 0xfb, 0x8d, 0x87, 0xa5,
+	0x1f, 0x1f, 0x5b, 0xe8, 0x98, 0xba, 0x36, 0x94, 0xf3, 0x8b, 0x74, 0x58, 0x5c, 0x81, 0x67, 0xe6,
+	0x91, 0xd1, 0xb1, 0xce, 0x86, 0x0e, 0xea, 0x16, 0xf2, 0x4c, 0x3e, 0xdc, 0x0c, 0xe5, 0x0a, 0xc0,
+	0x50, 0xb7, 0xed, 0xe1, 0x89, 0xa5, 0xdb, 0xa8, 0xb0, 0x44, 0x64, 0x9d, 0xcb, 0x11, 0x38, 0x68,
+	0x77, 0x4e, 0x50, 0x77, 0xd4, 0x47, 0x05, 0x99, 0x90, 0x79, 0x1c, 0x6c, 0xb2, 0x7c, 0xbc, 0x66,
+	0xec, 0x8e, 0xde, 0x47, 0x85, 0x73, 0x04, 0x0b, 0x4d, 0x10, 0x1e, 0x38, 0xbd, 0xce, 0xab, 0xb3,
+	0x82, 0xc2, 0x78, 0x40, 0x52, 0xca, 0x6d, 0x98, 0x25, 0x76, 0xa7, 0x70, 0x91, 0x8c, 0x7e, 0x39,
+	0x34, 0x7a, 0x62, 0x32, 0x34, 0x4a, 0x84, 0x7d, 0x39, 0xf2, 0xa3, 0x8d, 0x8c, 0x97, 0xa6, 0xd5,
+	0x41, 0xdd, 0xc2, 0x32, 0x69, 0x6d, 0x91, 0xe4, 0x56, 0x59, 0x26, 0x1e, 0x4f, 0xc7, 0x1c, 0x0c,
+	0x2d, 0x64, 0x63, 0xe7, 0x65, 0x85, 0x90, 0x70, 0x39, 0xd8, 0x65, 0xeb, 0xe8, 0x76, 0x47, 0xef,
+	0xa2, 0x6e, 0xa1, 0x40, 0x5d, 0x36, 0x37, 0xad, 0x14, 0x20, 0xfb, 0x03, 0x73, 0x64, 0x19, 0x7a,
+	0xbf, 0x70, 0x89, 0x14, 0xb9, 0x49, 0x5c, 0x8b, 0x4e, 0xdc, 0xe9, 0x83, 0x42, 0x91, 0xd6, 0x72,
+	0xd3, 0xca, 0xc7, 0x90, 0x33, 0x5d, 0x95, 0x5e, 0xb8, 0xcc, 0x16, 0x73, 0xac, 0xd2, 0xd7, 0x7c,
+	0xe2, 0xaf, 0xee, 0x54, 0xa8, 0x00, 0xbe, 0x84, 0x60, 0x3a, 0xc3, 0xec, 0x22, 0xea, 0x00, 0xe4,
+	0x34, 0x9a, 0x50, 0xff, 0x50, 0x82, 0x25, 0x6d, 0x64, 0x38, 0x3d, 0xaa, 0xe1, 0xd0, 0x81, 0x3e,
+	0xc4, 0x76, 0xde, 0xa2, 0x59, 0x6d, 0xaa, 0x4e, 0xa9, 0xcb, 0xb0, 0x13, 0x96, 0x3f, 0xb1, 0xa2,
+	0x90, 0x66, 0xe2, 0x6e, 0x71, 0x59, 0x78, 0x44, 0x21, 0x92, 0xa9, 0x46, 0xf4, 0x3f, 0xe6, 0x20,
+	0x43, 0x79, 0x12, 0xda, 0xbc, 0x6c, 0x43, 0x86, 0x6e, 0x6b, 0x98, 0x65, 0x0d, 0x6b, 0x2d, 0xea,
+	0x60, 0x69, 0x8c, 0xcc, 0x97, 0xaf, 0x74, 0x12, 0xf9, 0x2a, 0xc2, 0x1c, 0xde, 0x82, 0x98, 0x46,
+	0xff, 0x8c, 0xed, 0x68, 0xbc, 0x34, 0x6f, 0x02, 0x67, 0xa7, 0x33, 0x81, 0x77, 0x61, 0xb6, 0x83,
+	0xd9, 0x91, 0xc0, 0x4e, 0x50, 0x42, 0x65, 0x1b, 0x66, 0xec, 0x21, 0xea, 0x14, 0xb2, 0x31, 0x2a,
+	0xc1, 0x57, 0x3e, 0x1a, 0x21, 0xc4, 0xcc, 0x1c, 0xd9, 0xfa, 0x31, 0x62, 0x9e, 0x3a, 0x4d, 0x88,
+	0x7b, 0x9a, 0xdc, 0x14, 0x7b, 0x1a, 0xdf, 0x38, 0x40, 0x32, 0xe3, 0xe0, 0xdb, 0xd7, 0xf9, 0x69,
+	0xec, 0xab, 0x67, 0xca, 0x17, 0x92, 0x9b, 0xf2, 0x75, 0x98, 0xd7, 0x1d, 0x47, 0xc7, 0xea, 0xa6,
+	0x6d, 0x1a, 0x44, 0xe3, 0xe5, 0x34, 0x70, 0xb3, 0x1a, 0x86, 0x52, 0x86, 0xbc, 0x47, 0x40, 0x5b,
+	0xcf, 0xc7, 0xb4, 0x5e, 0x22, 0x64, 0xb4, 0xf5, 0x45, 0xb7, 0x4e, 0xd3, 0xed, 0xa5, 0x8b, 0x4e,
+	0x7b, 0x1d, 0xd4, 0x26, 0x9b, 0x65, 0xa6, 0x13, 0x69, 0xd6, 0x21, 0xde, 0x32, 0xdf, 0x06, 0xc5,
+	0x46, 0x9d, 0x91, 0x85, 0xda, 0x3c, 0x9d, 0xab, 0x14, 0x49, 0x49, 0xc5, 0xa7, 0xf6, 0x40, 0x53,
+	0xb2, 0x73, 0x1b, 0x69, 0x1f, 0x34, 0x21, 0x78, 0xe2, 0x11, 0xf4, 0x8c, 0x97, 0x66, 0x41, 0x21,
+	0x6b, 0xf1, 0x66, 0x0c, 0x3f, 0x18, 0xf0, 0x9a, 0xf1, 0xd2, 0xa4, 0x0b, 0x10, 0x74, 0x2f, 0x43,
+	0xf9, 0x16, 0x2c, 0x70, 0x56, 0xc5, 0x2e, 0x9c, 0xdf, 0x48, 0x47, 0xca, 0x10, 0x67, 0x56, 0xe6,
+	0x7d, 0xb3, 0x62, 0x2b, 0xd5, 0xa0, 0x5e, 0xb8, 0x40, 0x1a, 0xd8, 0x98, 0xa4, 0x17, 0x44, 0x2d,
+	0x80, 0x25, 0x12, 0x59, 0x96, 0x69, 0x11, 0xc5, 0x9e, 0xd3, 0x68, 0x42, 0xf9, 0x1c, 0x64, 0x66,
+	0x5e, 0x3b, 0xa6, 0x61, 0x8f, 0x06, 0xc8, 0xb2, 0x0b, 0xcb, 0xa4, 0xfd, 0xf5, 0x98, 0xb1, 0x96,
+	0x19, 0x9d, 0xb6, 0x74, 0x2a, 0xa4, 0xed, 0xe2, 0x67, 0xb0, 0x14, 0xe0, 0xc3, 0x54, 0x5a, 0xe6,
+	0xf7, 0x53, 0x30, 0x8b, 0xa1, 0x12, 0x2f, 0x0f, 0xaf, 0x72, 0x9b, 0xd4, 0x9b, 0xd1, 0x68, 0x42,
+	0x59, 0x81, 0x2c, 0xfe, 0xd1, 0x1e, 0xd8, 0xcc, 0x6f, 0xca, 0xe0, 0xe4, 0x81, 0x8d, 0x1d, 0x21,
+	0x52, 0xf0, 0xe2, 0xcc, 0x21, 0x8e, 0x33, 0x2e, 0xcb, 0xe1, 0x9c, 0x5d, 0x9c, 0x81, 0x2d, 0x1d,
+	0x39, 0xe3, 0xa0, 0xae, 0xe6, 0x8c, 0xc6, 0x52, 0xd8, 0x41, 0x22, 0xbf, 0x70, 0x83, 0xf4, 0x5c,
+	0x24, 0x4b, 0xd2, 0x07, 0x36, 0x96, 0x0e, 0x5a, 0x44, 0x9b, 0xcc, 0x90, 0x52, 0x20, 0x59, 0xb4,
+	0xcd, 0x75, 0x98, 0xa7, 0x5e, 0xd1, 0x31, 0xb6, 0x60, 0x6c, 0x9f, 0x0e, 0xc4, 0xf5, 0x21, 0x39,
+	0xca, 0x79, 0x98, 0xed, 0x99, 0xb8, 0xe5, 0x39, 0xf7, 0xc4, 0x85, 0x02, 0x25, 0x0d, 0xb6, 0xc9,
+	0x99, 0x08, 0x3d, 0x27, 0xc9, 0x91, 0x1c, 0xb2, 0x91, 0xc7, 0x8d, 0x32, 0xb7, 0x07, 0xd7, 0x04,
+	0xd6, 0x28, 0xcb, 0x3a, 0xb0, 0xd5, 0xff, 0x93, 0x82, 0xd9, 0x52, 0x1f, 0x59, 0x0e, 0xa7, 0x86,
+	0xd3, 0x44, 0x0d, 0x7f, 0x82, 0x8f, 0x6b, 0x4e, 0x91, 0xd5, 0x73, 0xce, 0x0a, 0xa9, 0x98, 0x05,
+	0xdf, 0x64, 0x04, 0x44, 0x4f, 0x78, 0xe4, 0x18, 0x94, 0x8e, 0xdb, 0x6c, 0x3b, 0x67, 0x43, 0xea,
+	0xc2, 0xa7, 0xb5, 0x1c, 0xc9, 0xc1, 0x84, 0xd8, 0xfc, 0x0e, 0x90, 0x4d, 0x54, 0x19, 0x3d, 0xab,
+	0x70, 0x93, 0xd8, 0xc4, 0x7a, 0x87, 0x61, 0x85, 0xd9, 0x89, 0xca, 0xcc, 0x27, 0xc6, 0x03, 0xb5,
+	0xd8, 0x69, 0x58, 0xbb, 0xd7, 0x25, 0xec, 0xcd, 0x69, 0xe0, 0x66, 0xd5, 0xc8, 0x70, 0xdc, 0x54,
+	0x21, 0x1b, 0x33, 0x1c, 0xf7, 0x3c, 0x8d, 0x0e, 0xc7, 0x25, 0xc7, 0x78, 0x3b, 0x7d, 0x44, 0x9c,
+	0x3b, 0xea, 0x75, 0xba, 0x49, 0x2c, 0x8b, 0x8e, 0xd3, 0x67, 0x6c, 0xc7, 0x3f, 0xf1, 0xd0, 0x47,
+	0x46, 0xef, 0xf5, 0x08, 0xb5, 0x1d, 0xfd, 0x98, 0xf0, 0x3b, 0xa7, 0xe5, 0x68, 0x4e, 0x4b, 0x3f,
+	0x56, 0x3f, 0x82, 0x0c, 0xe1, 0xb6, 0x8d, 0x8d, 0x16, 0xe1, 0x08, 0x33, 0xc9, 0x61, 0xa3, 0x45,
+	0xe8, 0x34, 0x4a, 0xa4, 0xfe, 0x41, 0x0a, 0xa0, 0x34, 0xea, 0xf6, 0x9c, 0xea, 0x29, 0x32, 0xf8,
+	0xb9, 0xa2, 0x26, 0x53, 0xe0, 0x5b, 0x6a, 0x1a, 0xbe, 0xad, 0x40, 0xd6, 0x30, 0xbb, 0x84, 0x67,
+	0x69, 0x7a, 0x4a, 0x81, 0x93, 0x35, 0x32, 0x34, 0x7d, 0xd8, 0x63, 0x13, 0x84, 0x7f, 0x62, 0xff,
+	0x92, 0x6e, 0x2a, 0x7b, 0xa6, 0xc1, 0x36, 0x4b, 0x7e, 0x06, 0x66, 0x92, 0x3d, 0x7a, 0xf1, 0x03,
+	0xd4, 0x71, 0x18, 0xf3, 0xdd, 0x24, 0x39, 0x9c, 0x43, 0x03, 0xd3, 0x41, 0x6d, 0xbd, 0xdb, 0xf5,
+	0x64, 0x1b, 0x1f, 0xce, 0x91, 0xdc, 0x12, 0xcd, 0xc4, 0x0d, 0x58, 0xe8, 0xf5, 0x08, 0xd9, 0x0e,
+	0xe1, 0x72, 0x4e, 0x73, 0x93, 0xb4, 0x69, 0xb2, 0x4b, 0x27, 0x9c, 0x9e, 0xd3, 0xdc, 0xa4, 0xaf,
+	0x80, 0x80, 0x53, 0x40, 0xea, 0x9f, 0xa5, 0x60, 0xa9, 0x41, 0xfa, 0xc6, 0xfc, 0x44, 0x44, 0x63,
+	0xe2, 0x53, 0xc3, 0x91, 0xc7, 0x33, 0xf2, 0x1b, 0x9f, 0x56, 0x32, 0x45, 0xd5, 0x73, 0x4f, 0x63,
+	0xe6, 0x68, 0x46, 0x8d, 0xf8, 0x88, 0xc8, 0xd0, 0x5f, 0xf4, 0x11, 0x65, 0xcc, 0x9c, 0xe6, 0x26,
+	0xa9, 0x9b, 0xeb, 0xed, 0x33, 0x73, 0x9e, 0xa1, 0x5b, 0x86, 0x8c, 0xde, 0xf1, 0x98, 0x93, 0xd6,
+	0x58, 0x8a, 0xac, 0x06, 0x02, 0xb7, 0x8d, 0xf5, 0x16, 0x65, 0x4e, 0x8e, 0xe6, 0x3c, 0x45, 0x64,
+	0xb1, 0xd8, 0xa8, 0x63, 0x21, 0x87, 0x14, 0x53, 0xd6, 0xe4, 0x68, 0x0e, 0x2e, 0x26, 0x5e, 0x7d,
+	0x77, 0x68, 0xf6, 0x0c, 0x07, 0xaf, 0x7c, 0x6c, 0x53, 0xfc, 0x0c, 0xe5, 0x03, 0x90, 0x3b, 0x23,
+	0xcb, 0x42, 0x86, 0xd3, 0x46, 0x46, 0xf7, 0x10, 0x67, 0x12, 0x1e, 0xe5, 0xb4, 0x25, 0x96, 0x5f,
+	0x65, 0xd9, 0xc4, 0x3c, 0x51, 0x18, 0x43, 0xd3, 0xa2, 0x46, 0x3f, 0xad, 0x31, 0x64, 0x87, 0xa6,
+	0xe5, 0x60, 0xfc, 0x16, 0x3a, 0xc6, 0xf8, 0xe9, 0xe1, 0x29, 0x4b, 0xa9, 0xff, 0x5a, 0x82, 0xf3,
+	0x4c, 0x4f, 0x93, 0xed, 0xa9, 0xc6, 0xa6, 0xe5, 0xed, 0xcf, 0x0b, 0xa6, 0xf6, 0xf0, 0x5c, 0x5f,
+	0x29, 0x9d, 0xd0, 0x57, 0x52, 0x6f, 0x40, 0x9e, 0xe6, 0x69, 0xc8, 0x1e, 0x9a, 0x86, 0xcd, 0xd9,
+	0x2a, 0x89, 0x17, 0x95, 0x21, 0x5c, 0x10, 0x87, 0xc6, 0xa8, 0x83, 0x0b, 0xec, 0x09, 0x30, 0xd3,
+	0xd4, 0xb6, 0x18, 0x09, 0x83, 0x1e, 0x67, 0xd2, 0xdc, 0x96, 0xb4, 0xfc, 0xa9, 0x90, 0x56, 0xff,
+	0xa3, 0xe4, 0x6e, 0x06, 0x88, 0x0d, 0x2d, 0x51, 0x19, 0x79, 0x04, 0x19, 0x6a, 0xde, 0x49, 0x9f,
+	0xf9, 0x1d, 0x35, 0xa6, 0x59, 0x4a, 0x7e, 0xa8, 0x5b, 0xfa, 0x40, 0x63, 0x35, 0x94, 0x8f, 0x61,
+	0x76, 0x60, 0x8e, 0x0c, 0xa7, 0x90, 0x4a, 0x5c, 0x95, 0x56, 0xc0, 0xa2, 0x47, 0x7e, 0x50, 0x87,
+	0x85, 0xae, 0xff, 0x1c, 0xc9, 0x71, 0x1d, 0x1a, 0xde, 0xef, 0x99, 0x09, 0xfa, 0x47, 0xea, 0x9f,
+	0xa4, 0x40, 0x66, 0x63, 0x41, 0xce, 0xbb, 0x10, 0x0b, 0x3a, 0xcb, 0xa9, 0xa4, 0x1e, 0xf1, 0x23,
+	0x6f, 0xc5, 0x51, 0xc1, 0x50, 0xc7, 0xf9, 0x96, 0x74, 0xfc, 0xde, 0xaa, 0x7c, 0x02, 0x59, 0x73,
+	0x88, 0x7f, 0xe1, 0x65, 0x8c, 0x35, 0xf0, 0x56, 0x5c, 0x65, 0x6f, 0x68, 0x5b, 0x0d, 0x5a, 0x81,
+	0xfa, 0x63, 0x6e, 0xf5, 0xe2, 0x23, 0x58, 0xe0, 0x0b, 0xa6, 0x72, 0x50, 0x7e, 0xec, 0x4b, 0x03,
+	0x72, 0x5c, 0x19, 0xc1, 0xeb, 0x83, 0x4a, 0x4d, 0x41, 0x8a, 0x59, 0x1f, 0x4c, 0xc8, 0x18, 0xd9,
+	0x3b, 0x14, 0xcf, 0x33, 0x38, 0xd7, 0x34, 0xf4, 0xa1, 0xb8, 0xd2, 0xc3, 0xe6, 0xc6, 0x9b, 0xe2,
+	0xd4, 0x74, 0x53, 0xcc, 0x6f, 0xbe, 0xd2, 0xe2, 0xe6, 0x4b, 0x7d, 0x0d, 0x0a, 0xdf, 0x35, 0xe3,
+	0xc5, 0xf7, 0x61, 0xd9, 0xf5, 0x26, 0x49, 0x81, 0x3f, 0x42, 0xca, 0x9b, 0xeb, 0x71, 0x3e, 0xa5,
+	0xd0, 0x8c, 0x76, 0xe1, 0x34, 0x22, 0x57, 0x75, 0xdc, 0x03, 0x36, 0x62, 0x23, 0x04, 0x7b, 0x20,
+	0x05, 0xec, 0x41, 0xd4, 0x95, 0xda, 0x43, 0xc8, 0xb2, 0x8e, 0x93, 0x68, 0x26, 0x97, 0x56, 0xfd,
+	0x17, 0x92, 0xab, 0x9d, 0x5c, 0x47, 0x37, 0xf2, 0x86, 0x63, 0x15, 0x72, 0xf8, 0xaf, 0x3d, 0xd4,
+	0x3b, 0xae, 0xe4, 0xf8, 0x19, 0xb8, 0x86, 0xe7, 0x5d, 0xe5, 0x34, 0xf2, 0x9b, 0x37, 0xe6, 0x33,
+	0x82, 0x31, 0x5f, 0x03, 0x20, 0xa7, 0x11, 0x6d, 0xd2, 0x89, 0x6b, 0xbb, 0x71, 0x4e, 0x1d, 0xf7,
+	0xe4, 0x15, 0x93, 0x16, 0x33, 0x5c, 0x31, 0xf6, 0x84, 0xd4, 0x2e, 0x28, 0x8f, 0x2d, 0x7d, 0x78,
+	0x52, 0xb1, 0x7a, 0xa7, 0xc8, 0x2a, 0x9f, 0xe8, 0xc6, 0x31, 0xb2, 0x3d, 0x86, 0x48, 0x1c, 0x43,
+	0x1e, 0xc1, 0xcc, 0xab, 0x9e, 0xd1, 0x8d, 0x3d, 0xab, 0x0e, 0x35, 0x83, 0xdb, 0xd7, 0x48, 0x1d,
+	0xf5, 0x26, 0x2c, 0x95, 0xfb, 0x23, 0xdb, 0x41, 0xd6, 0x04, 0x9d, 0xfd, 0x3b, 0x12, 0x2c, 0xe2,
+	0xc5, 0x7c, 0xea, 0xc9, 0xe7, 0x13, 0x98, 0xd3, 0xd0, 0x6b, 0x64, 0x3b, 0x4f, 0x9f, 0x31, 0x77,
+	0xea, 0x76, 0xd8, 0x9d, 0xe2, 0x6b, 0x6c, 0xb9, 0xe4, 0x74, 0x29, 0x7b, 0xb5, 0x8b, 0x9f, 0xc2,
+	0xa2, 0x50, 0xc4, 0x2f, 0xe6, 0xf4, 0xa4, 0xc5, 0xfc, 0x25, 0xe4, 0x85, 0x5e, 0x6c, 0x45, 0x85,
+	0x05, 0xf6, 0xbb, 0x4c, 0x34, 0x34, 0x6d, 0x46, 0xc8, 0x53, 0x2a, 0x81, 0xd1, 0xb0, 0xb3, 0xf3,
+	0x2b, 0xe3, 0x47, 0xa0, 0x89, 0x95, 0xd4, 0x3f, 0x92, 0x60, 0x99, 0x1c, 0x73, 0x4c, 0x5e, 0xbd,
+	0x4f, 0x21, 0xb3, 0xcf, 0x5f, 0x99, 0xdd, 0x8f, 0x3e, 0x2f, 0x09, 0x35, 0x24, 0xde, 0xf3, 0xed,
+	0x7f, 0xe5, 0x7b, 0xbe, 0xff, 0x25, 0xc1, 0x4a, 0xa8, 0x27, 0x36, 0xf3, 0x47, 0x90, 0x73, 0x0f,
+	0x1d, 0xdd, 0x7b, 0xae, 0x6f, 0x4c, 0x86, 0x49, 0x2b, 0x6f, 0x35, 0xdd, 0x9a, 0x14, 0xaa, 0xdf,
+	0x92, 0x2f, 0x50, 0x29, 0x4e, 0xa0, 0x8a, 0x3a, 0xe4, 0xc5, 0x2a, 0x11, 0xc3, 0xf8, 0x84, 0x1f,
+	0xc6, 0xfc, 0xce, 0xb5, 0xb0, 0xc7, 0x12, 0xc2, 0xc1, 0x8f, 0xf5, 0xaf, 0x66, 0xbc, 0x4b, 0xe2,
+	0xba, 0xd9, 0x0d, 0xfb, 0x17, 0x32, 0xa4, 0x3b, 0xc3, 0x11, 0x69, 0x5c, 0xd2, 0xf0, 0x4f, 0xac,
+	0x8c, 0x06, 0x68, 0xd0, 0x76, 0x4c, 0x47, 0xef, 0xb3, 0x0d, 0xe8, 0xdc, 0x00, 0x0d, 0xc8, 0xbd,
+	0x2d, 0xde, 0x67, 0xe2, 0x42, 0xb2, 0xe7, 0xa3, 0x3b, 0xd0, 0xec, 0x00, 0x0d, 0xc8, 0x8e, 0x8f,
+	0x15, 0xbd, 0xb4, 0x10, 0x72, 0xb7, 0xa0, 0x03, 0x34, 0xd8, 0xb3, 0x10, 0x39, 0xbe, 0xd7, 0x4f,
+	0x8f, 0xdb, 0x7d, 0x53, 0xa7, 0x1b, 0xa4, 0xb4, 0x96, 0xd5, 0x4f, 0x8f, 0xf7, 0x4d, 0x9d, 0x9e,
+	0xb9, 0x51, 0x9f, 0x36, 0x1b, 0x73, 0x18, 0x14, 0x38, 0xd5, 0xf9, 0x0c, 0x66, 0xbb, 0x3d, 0xfb,
+	0x95, 0x7b, 0x41, 0x7c, 0x33, 0xee, 0x82, 0x18, 0x8f, 0x76, 0xab, 0x82, 0x29, 0xe9, 0x64, 0xd0,
+	0x5a, 0xf8, 0x50, 0x68, 0x68, 0x9a, 0xde, 0xd1, 0xfb, 0xea, 0xb8, 0xfb, 0x65, 0x8d, 0x92, 0x62,
+	0xed, 0x36, 0x38, 0x1e, 0x38, 0xed, 0xde, 0xd0, 0x75, 0x50, 0x71, 0xb2, 0x46, 0xf6, 0x30, 0xf8,
+	0x26, 0x1e, 0x17, 0x2c, 0xd0, 0x02, 0x9c, 0xac, 0x91, 0xa3, 0xbe, 0x13, 0xd3, 0x76, 0x88, 0xd2,
+	0xa3, 0xa7, 0x3b, 0x5e, 0x5a, 0x39, 0x80, 0x79, 0xa2, 0x2b, 0xd9, 0x15, 0x80, 0x1c, 0xa3, 0x36,
+	0xf8, 0x61, 0xe0, 0x7f, 0xf8, 0x35, 0x00, 0x86, 0x97, 0x51, 0xfc, 0x1e, 0x80, 0x3f, 0xca, 0x08,
+	0xf9, 0xf9, 0x48, 0x94, 0x9f, 0x8d, 0xb8, 0x8e, 0xdc, 0x2d, 0x28, 0x7f, 0xe7, 0xf9, 0x19, 0x2c,
+	0x05, 0xba, 0x9e, 0x6a, 0x9d, 0xfd, 0x4c, 0x82, 0x3c, 0x6b, 0x9d, 0x29, 0x58, 0x6e, 0xba, 0xa5,
+	0x64, 0xd3, 0x4d, 0xe5, 0x35, 0xe5, 0xc9, 0x6b, 0xec, 0xb6, 0x71, 0xc7, 0x3d, 0x9b, 0x9e, 0x19,
+	0x3f, 0xb1, 0x78, 0x40, 0xee, 0xc9, 0x75, 0x1f, 0xae, 0x34, 0xbb, 0xaf, 0xdc, 0xcb, 0x85, 0x43,
+	0xb3, 0xdf, 0xeb, 0x9c, 0x89, 0x2a, 0xec, 0x73, 0xc8, 0x8b, 0xc5, 0x05, 0x29, 0xc6, 0xe1, 0x0b,
+	0x35, 0xa4, 0x05, 0x6a, 0xaa, 0x57, 0x61, 0x3d, 0xb6, 0x37, 0xe6, 0x16, 0x44, 0x01, 0x3a, 0x1a,
+	0x76, 0xff, 0x1a, 0x01, 0xb9, 0xbd, 0x31, 0x40, 0xd7, 0xe0, 0x6a, 0x88, 0xa4, 0x6a, 0x60, 0xcf,
+	0xc1, 0xc7, 0xa4, 0x76, 0x41, 0x1d, 0x47, 0xc4, 0x34, 0xeb, 0xb7, 0x60, 0x6e, 0x88, 0x8b, 0x7a,
+	0xc8, 0x55, 0xac, 0x49, 0x30, 0x7b, 0x75, 0xd4, 0x87, 0x11, 0x68, 0x6b, 0x06, 0x76, 0xc7, 0xbd,
+	0x1d, 0x40, 0x84, 0x33, 0xa3, 0xfe, 0x02, 0x6c, 0xc4, 0x57, 0x63, 0xd0, 0x1e, 0x41, 0x66, 0x38,
+	0x2d, 0x33, 0x59, 0x0d, 0xf5, 0x41, 0xc4, 0x94, 0x55, 0x50, 0x1f, 0x39, 0x68, 0x1c, 0xaa, 0x28,
+	0xd6, 0xbb, 0xb5, 0x18, 0xeb, 0xcb, 0x70, 0x2e, 0x44, 0x12, 0xe9, 0xae, 0xe1, 0xab, 0x23, 0x46,
+	0xe5, 0x1e, 0x26, 0xb8, 0x69, 0xb5, 0x43, 0xfa, 0x29, 0x5b, 0xa8, 0x8b, 0x0c, 0xa7, 0xa7, 0xf7,
+	0xa9, 0xbc, 0x95, 0xbe, 0x1c, 0x59, 0x1e, 0xbc, 0x6f, 0x03, 0x74, 0xbc, 0xf2, 0x82, 0x14, 0xa3,
+	0x25, 0x48, 0x15, 0xbf, 0x1d, 0x8d, 0xab, 0xa3, 0x3e, 0x26, 0x2c, 0x8e, 0xe9, 0x84, 0xb1, 0xf8,
+	0x1a, 0xb9, 0xd6, 0x66, 0x04, 0xbe, 0x9b, 0xbb, 0xe0, 0x67, 0xd6, 0xba, 0x2a, 0x8a, 0x6c, 0xe8,
+	0x31, 0x39, 0x4e, 0x72, 0xe1, 0x96, 0x22, 0xe0, 0x5e, 0x0d, 0x5b, 0x68, 0x52, 0x27, 0x06, 0xef,
+	0x13, 0x22, 0xd4, 0x71, 0xdd, 0x4c, 0x03, 0xf8, 0x17, 0x60, 0x2d, 0x6a, 0xe4, 0xcf, 0x9b, 0x2e,
+	0xda, 0xcf, 0x22, 0xd0, 0x46, 0x9c, 0x66, 0xde, 0x8f, 0x41, 0x5a, 0x25, 0xc2, 0x15, 0xd9, 0xfe,
+	0x34, 0x30, 0xff, 0xa9, 0x04, 0x0b, 0x7c, 0x1f, 0x89, 0x6a, 0x05, 0x8e, 0x8f, 0x52, 0xe3, 0x8f,
+	0x8f, 0xd2, 0xc1, 0xe3, 0xa3, 0x22, 0xcc, 0xb9, 0xa7, 0x45, 0x6c, 0x4f, 0xe0, 0xa5, 0xb9, 0x03,
+	0x9f, 0x59, 0xe1, 0xc0, 0xe7, 0x4b, 0x58, 0x0a, 0xc8, 0x59, 0x32, 0xa4, 0x57, 0x61, 0x41, 0xef,
+	0x74, 0xc8, 0x81, 0x02, 0x59, 0x1d, 0x14, 0xeb, 0x3c, 0xcb, 0x23, 0x3b, 0x8d, 0x75, 0x70, 0x93,
+	0x1c, 0x5c, 0x60, 0x59, 0x4f, 0x11, 0xde, 0x04, 0xca, 0x41, 0xa1, 0x49, 0xcc, 0xa6, 0xa1, 0x65,
+	0xe2, 0x43, 0x3f, 0xff, 0x34, 0x2f, 0xc7, 0x72, 0x6a, 0xc4, 0x2d, 0xfa, 0x81, 0x6d, 0x1a, 0x5c,
+	0xaf, 0x59, 0x9c, 0xc6, 0x5d, 0x06, 0xd7, 0x8d, 0xa7, 0x33, 0x39, 0x01, 0x4a, 0x34, 0xbf, 0x2f,
+	0xe0, 0xea, 0x98, 0x86, 0x98, 0xa4, 0x04, 0x45, 0x31, 0x3d, 0x9d, 0x28, 0xd6, 0x88, 0x92, 0x8f,
+	0xea, 0x83, 0x57, 0x26, 0x89, 0xe0, 0x1e, 0xc3, 0xb5, 0xb1, 0x4d, 0x31, 0xc0, 0xdf, 0x8e, 0x00,
+	0x3c, 0x9d, 0x62, 0xfa, 0x3c, 0xae, 0x23, 0x51, 0xa5, 0x24, 0x02, 0xdd, 0x83, 0xf7, 0xc7, 0xb7,
+	0xc5, 0x50, 0x97, 0x22, 0x50, 0x4f, 0xa9, 0x9f, 0x4a, 0x50, 0x14, 0xba, 0x12, 0xcd, 0x49, 0x22,
+	0xb4, 0x6b, 0x70, 0x39, 0xb2, 0x09, 0xcf, 0xb6, 0xac, 0x0a, 0xc5, 0xcf, 0xf4, 0x7e, 0xaf, 0xab,
+	0x4f, 0xd9, 0xc7, 0x3a, 0xac, 0xc5, 0x34, 0xc2, 0x7a, 0xf9, 0x6f, 0x12, 0x5c, 0x6c, 0x76, 0x5f,
+	0xd1, 0x13, 0x87, 0x03, 0xbc, 0xd0, 0xdc, 0xf6, 0xc7, 0x1e, 0x78, 0x88, 0x87, 0x83, 0xa9, 0xe0,
+	0xe1, 0xe0, 0x81, 0x7f, 0x7e, 0x96, 0x8e, 0xd9, 0x46, 0x46, 0x76, 0xfa, 0x35, 0x1c, 0xa2, 0x15,
+	0x60, 0x39, 0xd8, 0x15, 0x1b, 0xfa, 0x7f, 0x97, 0x60, 0xc5, 0x2b, 0x3a, 0x32, 0x06, 0xef, 0x6a,
+	0xf0, 0x8d, 0xe0, 0xe0, 0x1f, 0xc6, 0x0f, 0x5e, 0xec, 0xf6, 0x6b, 0x18, 0x7e, 0x11, 0x0a, 0xe1,
+	0xce, 0x18, 0x03, 0xfe, 0x54, 0xe2, 0x78, 0x43, 0x6f, 0x52, 0x13, 0x8d, 0xbf, 0xee, 0x0f, 0x90,
+	0x1e, 0x12, 0x3c, 0x88, 0x1f, 0xa0, 0xd0, 0xec, 0xd7, 0x30, 0xbe, 0x47, 0xb0, 0x12, 0xea, 0x8b,
+	0xad, 0xf2, 0xc0, 0x09, 0xb5, 0x14, 0x3a, 0xa1, 0x7e, 0xc8, 0x0d, 0xbf, 0x82, 0x92, 0x0e, 0x5f,
+	0xbd, 0x04, 0x2b, 0xa1, 0x6a, 0x8c, 0xa3, 0x3f, 0xcf, 0xb5, 0x28, 0x6e, 0x52, 0xa2, 0x9c, 0xc2,
+	0x69, 0x8f, 0xb4, 0xd5, 0x8f, 0x60, 0x25, 0xd4, 0x3c, 0x1b, 0xec, 0x58, 0xc4, 0xbf, 0x2a, 0x81,
+	0x1a, 0xa8, 0xb8, 0x67, 0x99, 0x83, 0x67, 0xac, 0x7c, 0x1c, 0xc6, 0xcb, 0x90, 0xa3, 0x91, 0xc9,
+	0xdc, 0x35, 0x18, 0xcd, 0xa8, 0x75, 0xa7, 0xbf, 0x79, 0xd9, 0x25, 0xca, 0x3e, 0x1e, 0x47, 0x92,
+	0xc1, 0x88, 0xb3, 0xc6, 0x6b, 0xdd, 0x29, 0x66, 0x4d, 0xd0, 0xb4, 0x3c, 0x5b, 0x03, 0xbb, 0x95,
+	0xb1, 0x4d, 0x3e, 0x85, 0x42, 0xb8, 0xde, 0x5b, 0x9e, 0xd2, 0xab, 0x7f, 0x26, 0xc1, 0x25, 0xaf,
+	0xb5, 0xe0, 0xf6, 0xed, 0x2b, 0xdc, 0x9b, 0x3c, 0x84, 0xcc, 0x4b, 0x12, 0xc2, 0xcb, 0xc4, 0x2c,
+	0x2e, 0x30, 0x87, 0xc6, 0xf9, 0x6a, 0x8c, 0x18, 0x2f, 0x9e, 0x81, 0xfe, 0x43, 0x7c, 0x9e, 0x3e,
+	0xea, 0x3b, 0x34, 0xc8, 0x61, 0x51, 0x83, 0x81, 0xfe, 0x43, 0x8d, 0xe6, 0x10, 0x97, 0x4a, 0x3f,
+	0x46, 0x6d, 0xc7, 0x7c, 0x85, 0x0c, 0xf7, 0x59, 0x01, 0xce, 0x69, 0xe1, 0x0c, 0xf5, 0x0d, 0xb1,
+	0x8f, 0xa1, 0xd1, 0x30, 0xee, 0xdc, 0x83, 0x2c, 0x1d, 0xb6, 0xbb, 0xcd, 0x8c, 0x65, 0x8f, 0x4b,
+	0xa7, 0xdc, 0x80, 0x25, 0x03, 0xfd, 0xd0, 0x69, 0x73, 0x9d, 0x52, 0x71, 0x5c, 0xc4, 0xd9, 0x87,
+	0x5e, 0xc7, 0x7f, 0xc9, 0x2b, 0x35, 0x71, 0x5f, 0x3e, 0x56, 0xa9, 0x3d, 0xf5, 0x5e, 0x35, 0xa4,
+	0x26, 0x59, 0x2c, 0xa1, 0xd5, 0xa8, 0x07, 0x0e, 0x53, 0x2f, 0x8c, 0xaf, 0x72, 0x52, 0xfa, 0x39,
+	0xac, 0x84, 0x90, 0xbd, 0xad, 0x10, 0x7e, 0x9f, 0x73, 0x06, 0x48, 0x68, 0x4c, 0x22, 0xd6, 0x5d,
+	0x87, 0xbc, 0x61, 0x3a, 0xed, 0xce, 0x68, 0x30, 0xea, 0xeb, 0xf8, 0xdc, 0x99, 0x80, 0x9c, 0xd3,
+	0x16, 0x0d, 0xd3, 0x29, 0x7b, 0x99, 0xea, 0xdf, 0x4f, 0xc1, 0x72, 0xb0, 0x75, 0x06, 0xf4, 0x36,
+	0x0d, 0x03, 0xb3, 0x19, 0xce, 0xe5, 0xc8, 0x13, 0x27, 0x9b, 0x06, 0x80, 0x91, 0x7b, 0x6d, 0x1a,
+	0x2d, 0xe3, 0x9c, 0x58, 0xe6, 0xe8, 0xf8, 0x64, 0x38, 0x72, 0x58, 0x84, 0xce, 0x12, 0xc9, 0x6f,
+	0x79, 0xd9, 0xca, 0x4d, 0x58, 0x22, 0xa1, 0x3a, 0x1c, 0x25, 0x3d, 0x2e, 0xcd, 0xe3, 0x6c, 0x8e,
+	0xb0, 0x00, 0xd9, 0xbe, 0xee, 0x20, 0xa3, 0x73, 0xe6, 0x9e, 0x99, 0xb2, 0x24, 0xde, 0xb8, 0x90,
+	0x26, 0xdc, 0x62, 0x7a, 0x6e, 0x3a, 0x8f, 0xf3, 0xf6, 0x19, 0xc9, 0x35, 0x58, 0xa4, 0x80, 0x5c,
+	0x1a, 0x1a, 0xc0, 0xb3, 0x40, 0x32, 0x5d, 0x22, 0xf7, 0x49, 0x54, 0xd6, 0x7f, 0x12, 0xa5, 0x7e,
+	0x13, 0xd6, 0x3c, 0x8e, 0x94, 0xf5, 0xa1, 0xde, 0xe9, 0x39, 0x67, 0x47, 0x36, 0x39, 0xe9, 0x4b,
+	0xa0, 0x7f, 0x7e, 0x11, 0xae, 0xc4, 0xd5, 0x66, 0x7c, 0xc5, 0x01, 0x27, 0x36, 0x72, 0x23, 0x95,
+	0x68, 0x74, 0x53, 0x0e, 0xe7, 0x78, 0x51, 0x45, 0xe4, 0x08, 0x99, 0x95, 0x53, 0x1e, 0x02, 0xc9,
+	0x22, 0x04, 0xea, 0x06, 0xd7, 0x83, 0x78, 0x7b, 0xc1, 0xfe, 0xaa, 0xaf, 0x60, 0x3d, 0x96, 0x82,
+	0x81, 0x78, 0x02, 0x4b, 0x3a, 0x29, 0x69, 0xb3, 0x98, 0x0d, 0x77, 0x9a, 0xd7, 0xc7, 0xdf, 0x62,
+	0xd8, 0x5a, 0x5e, 0x17, 0xd2, 0xea, 0x7f, 0x96, 0x38, 0x3c, 0xee, 0xa9, 0xbc, 0x68, 0x67, 0xc7,
+	0x0a, 0x6a, 0x33, 0xb0, 0xc6, 0x3f, 0x8d, 0x5f, 0xe3, 0x91, 0xad, 0xbf, 0xeb, 0xc7, 0x4c, 0xbb,
+	0xb0, 0x1e, 0xdb, 0xa1, 0xef, 0xc4, 0xf8, 0xb1, 0xeb, 0xee, 0x88, 0xc0, 0xcd, 0xaa, 0x75, 0xd5,
+	0x76, 0x44, 0x1b, 0x1a, 0xc2, 0x63, 0x4a, 0xc6, 0x93, 0x40, 0x07, 0xa9, 0x50, 0x07, 0x2a, 0x6c,
+	0xc4, 0x77, 0xc0, 0x2c, 0xe8, 0x5f, 0x48, 0x70, 0x35, 0x44, 0x14, 0x32, 0x62, 0x63, 0x71, 0x3c,
+	0x0b, 0xcc, 0xcd, 0xb7, 0x26, 0xcf, 0x4d, 0xb0, 0x83, 0x77, 0x3d, 0x3d, 0xdf, 0x07, 0x75, 0x5c,
+	0x9f, 0x6c, 0x86, 0x1e, 0x86, 0x6f, 0xa3, 0x62, 0xf5, 0xac, 0x4f, 0xa9, 0xfe, 0xf3, 0x14, 0x5c,
+	0x0b, 0xb5, 0x4e, 0x2e, 0xad, 0x44, 0x81, 0xbe, 0x04, 0x73, 0x34, 0xe2, 0xdd, 0xe3, 0x59, 0x96,
+	0xa4, 0x6b, 0x5d, 0xe5, 0x8b, 0x00, 0xcb, 0xbe, 0x3d, 0x99, 0x65, 0xe1, 0x0e, 0x22, 0xed, 0x57,
+	0x01, 0xb2, 0xaf, 0x47, 0x3d, 0x64, 0x77, 0x90, 0x1b, 0xdf, 0xc4, 0x92, 0xca, 0x47, 0xb0, 0xc2,
+	0x7e, 0xb6, 0x9d, 0xde, 0x00, 0x99, 0x23, 0xa7, 0x6d, 0xa3, 0x8e, 0x69, 0x74, 0xdd, 0x68, 0xc7,
+	0x8b, 0xac, 0xb8, 0x45, 0x4b, 0x9b, 0xb4, 0xf0, 0xab, 0x4c, 0xc3, 0x7f, 0x90, 0xe0, 0xfd, 0xf1,
+	0x03, 0x61, 0x33, 0xf1, 0x22, 0x3c, 0x13, 0x95, 0x29, 0x59, 0x32, 0xe9, 0x92, 0xb0, 0xf8, 0xcd,
+	0x04, 0xd7, 0x81, 0xf1, 0x43, 0xf9, 0x65, 0xce, 0x56, 0x7f, 0x87, 0xf2, 0x29, 0xd1, 0xe2, 0xb8,
+	0x09, 0x4b, 0x41, 0x6e, 0x53, 0x65, 0x9d, 0x77, 0x04, 0x36, 0x63, 0x85, 0xef, 0x4e, 0x8f, 0x77,
+	0xfb, 0x92, 0x63, 0x39, 0xb5, 0xae, 0xb0, 0x1b, 0xf4, 0xfa, 0x67, 0x6b, 0xf8, 0x63, 0xce, 0xff,
+	0x3c, 0x32, 0x5e, 0x27, 0x47, 0xa7, 0xae, 0x42, 0x31, 0xaa, 0x26, 0x6b, 0x97, 0x96, 0xb2, 0xcb,
+	0xa5, 0xd0, 0xbd, 0xc4, 0x17, 0x70, 0x39, 0xb2, 0x94, 0x4d, 0xe9, 0x27, 0x38, 0xba, 0x92, 0x94,
+	0xc5, 0xda, 0x0c, 0xf1, 0xf6, 0x4a, 0x73, 0xe9, 0xd5, 0xfb, 0x64, 0xac, 0x2c, 0x3b, 0xe0, 0xd6,
+	0x73, 0x37, 0x54, 0x12, 0x7f, 0x43, 0xa5, 0x1e, 0xc0, 0xa5, 0x88, 0x4a, 0x0c, 0xcc, 0x5d, 0x98,
+	0xc1, 0x64, 0x0c, 0xc9, 0xf8, 0xdb, 0x2b, 0x42, 0xa9, 0xfe, 0xb9, 0x04, 0xeb, 0x7e, 0x7b, 0x24,
+	0x68, 0x33, 0xa4, 0x15, 0x3f, 0x01, 0x70, 0x63, 0xad, 0x2d, 0xa7, 0x20, 0x25, 0x8b, 0xcf, 0x6c,
+	0x62, 0x62, 0xe5, 0x21, 0xcc, 0x91, 0xaa, 0x88, 0x45, 0x55, 0x8c, 0xaf, 0x98, 0xc5, 0xb4, 0x55,
+	0x43, 0x8c, 0x76, 0x4d, 0x4f, 0x15, 0xed, 0xaa, 0x36, 0x61, 0x23, 0x7e, 0x3c, 0xbe, 0xd7, 0x49,
+	0xe2, 0x52, 0xed, 0x58, 0xaf, 0x93, 0x54, 0xb4, 0x35, 0x46, 0xa6, 0xda, 0xbc, 0x0c, 0x90, 0xb2,
+	0x72, 0x1f, 0xe9, 0x96, 0xcf, 0x20, 0x1f, 0xae, 0x34, 0x15, 0x5c, 0x72, 0xa9, 0x8d, 0xdb, 0x73,
+	0x2d, 0x1b, 0xbe, 0xd4, 0xc6, 0xe9, 0x5a, 0x57, 0xbd, 0x02, 0xab, 0xd1, 0x9d, 0x32, 0xb1, 0x0d,
+	0x83, 0xaa, 0x5a, 0xba, 0x8d, 0xfe, 0xba, 0x41, 0xb1, 0x4e, 0x19, 0xa8, 0x7f, 0x2b, 0x85, 0x50,
+	0x3d, 0xd7, 0x9d, 0xce, 0xc9, 0x3b, 0x40, 0x15, 0x88, 0x91, 0x4e, 0x45, 0xc5, 0x48, 0x7b, 0x21,
+	0xdf, 0xe9, 0xa9, 0x42, 0xbe, 0xf1, 0xf9, 0xc3, 0x6a, 0x34, 0x6c, 0x26, 0x32, 0x1f, 0x7b, 0xb1,
+	0x7a, 0x14, 0xf5, 0x46, 0xb4, 0xc8, 0xd0, 0x28, 0x3d, 0xfa, 0xee, 0x84, 0xd2, 0xfb, 0x91, 0xd2,
+	0xa9, 0x98, 0x9d, 0x83, 0x10, 0x29, 0x4d, 0x0f, 0x01, 0xf0, 0x02, 0x0d, 0x29, 0xa2, 0x87, 0x50,
+	0x08, 0x17, 0x31, 0x78, 0x97, 0x60, 0x8e, 0xa9, 0x0b, 0xf7, 0x59, 0x55, 0x96, 0xea, 0x0b, 0x5b,
+	0xbd, 0x0b, 0x17, 0x59, 0xb5, 0xa4, 0x2a, 0xe6, 0x73, 0x58, 0x0e, 0xd6, 0x78, 0x6b, 0xfd, 0x42,
+	0xe5, 0x85, 0x6b, 0xab, 0x4c, 0xe3, 0x7a, 0xdd, 0x41, 0x7d, 0x07, 0xd6, 0x62, 0xca, 0xdf, 0xba,
+	0xcb, 0x3f, 0x95, 0x88, 0x3e, 0xc7, 0x39, 0x74, 0xb7, 0x49, 0xcd, 0xfa, 0xa4, 0x61, 0x2b, 0x8d,
+	0x80, 0xb3, 0xf2, 0x8d, 0x28, 0xcb, 0x1c, 0xd3, 0xea, 0xbb, 0x76, 0xec, 0x1a, 0x70, 0x39, 0xb2,
+	0xb3, 0xb7, 0x66, 0x4a, 0x95, 0xcc, 0x83, 0x10, 0x56, 0x2e, 0x08, 0xc3, 0x75, 0xc8, 0x9b, 0x7e,
+	0xa1, 0xcf, 0x9c, 0x45, 0x2e, 0xb7, 0xd6, 0x55, 0x87, 0xb0, 0x16, 0xd3, 0x0c, 0x43, 0xd6, 0x00,
+	0x85, 0x6f, 0x87, 0x0b, 0xd3, 0x88, 0xba, 0x76, 0x09, 0x84, 0xb9, 0x6b, 0xe7, 0xb8, 0xba, 0x34,
+	0x84, 0x43, 0xfd, 0x16, 0xe1, 0x04, 0x47, 0x28, 0x3a, 0x9f, 0xeb, 0x30, 0xcf, 0xcc, 0x3e, 0x77,
+	0x30, 0x08, 0x34, 0x0b, 0x5f, 0xd9, 0xa9, 0x26, 0xac, 0x46, 0xd7, 0xff, 0xba, 0x00, 0x57, 0x82,
+	0x80, 0xc5, 0x23, 0xc0, 0x84, 0x8c, 0xbe, 0x02, 0xab, 0xd1, 0xad, 0x30, 0x3d, 0xfb, 0xb7, 0x82,
+	0xbd, 0x88, 0x07, 0x49, 0xc9, 0x7a, 0xc1, 0x57, 0xa8, 0xf4, 0x59, 0x00, 0x3b, 0x0f, 0x61, 0xa9,
+	0x70, 0xef, 0x81, 0x80, 0x8e, 0x37, 0x4c, 0xc9, 0x9b, 0xa3, 0xee, 0xae, 0xde, 0x79, 0x15, 0xdc,
+	0x11, 0x4c, 0xf2, 0x14, 0xb9, 0x5b, 0x21, 0xf2, 0xaa, 0x81, 0x8a, 0x7f, 0xde, 0xcf, 0x3e, 0x1a,
+	0xd1, 0xaf, 0xc0, 0xbc, 0x1c, 0xf5, 0xfb, 0xcc, 0xbf, 0x27, 0xbf, 0xd5, 0x4f, 0x61, 0x35, 0xba,
+	0x63, 0xff, 0x5c, 0xf6, 0x05, 0xc9, 0xe7, 0x7a, 0xa6, 0x19, 0xb5, 0x2e, 0x0e, 0x5c, 0x0d, 0xd4,
+	0x0e, 0x6f, 0x43, 0x63, 0x6b, 0x2b, 0x5b, 0x70, 0xde, 0xa2, 0xe4, 0x6d, 0x5e, 0xe2, 0x28, 0xf6,
+	0x73, 0xac, 0xe8, 0x99, 0x27, 0x78, 0x51, 0xe3, 0x4c, 0x47, 0x8e, 0x33, 0x2e, 0xec, 0x55, 0x7d,
+	0x0a, 0x6b, 0x31, 0x70, 0xd9, 0x68, 0x37, 0xe1, 0x5c, 0x00, 0x92, 0x87, 0x7b, 0x49, 0x00, 0x54,
+	0xeb, 0xaa, 0x67, 0xc1, 0x29, 0x0b, 0x9d, 0x4c, 0xc7, 0x0f, 0x3d, 0xf1, 0x94, 0x5d, 0x80, 0x59,
+	0xf2, 0xbe, 0x99, 0xcd, 0x19, 0x4d, 0x78, 0x3e, 0x43, 0xa8, 0x6b, 0x26, 0x4d, 0x03, 0xb8, 0x12,
+	0x55, 0x5e, 0xea, 0xf7, 0x5d, 0x74, 0x2a, 0x2c, 0xda, 0x56, 0x27, 0x34, 0xc8, 0x79, 0xdb, 0xea,
+	0x3c, 0x9b, 0x56, 0xae, 0x58, 0xd4, 0x4c, 0x74, 0x77, 0x0c, 0xd1, 0xcf, 0xa4, 0x20, 0xa4, 0x90,
+	0x53, 0x9c, 0x04, 0xd2, 0x1a, 0x00, 0xf3, 0xf5, 0xb9, 0x4b, 0x7d, 0x96, 0x13, 0x8d, 0x38, 0x5a,
+	0x42, 0xf0, 0x63, 0xa6, 0x7e, 0x9f, 0x3d, 0xf7, 0xc5, 0x3f, 0xd5, 0xbf, 0x4a, 0x81, 0x22, 0x02,
+	0x24, 0x21, 0xe0, 0xc1, 0xb8, 0xcc, 0x10, 0xc8, 0x54, 0x18, 0xe4, 0x0d, 0x58, 0xe2, 0x68, 0x88,
+	0x4c, 0x53, 0x14, 0x8b, 0x1e, 0x15, 0x91, 0x67, 0xe1, 0x91, 0xd6, 0xcc, 0x34, 0x8f, 0xb4, 0x0e,
+	0xb8, 0xcf, 0x0f, 0xcd, 0xc6, 0x7c, 0xa9, 0x25, 0x3c, 0x98, 0xad, 0x03, 0x56, 0x87, 0x05, 0x39,
+	0xbb, 0x4d, 0x28, 0x25, 0x2f, 0xfa, 0x8f, 0x7e, 0xae, 0xe1, 0x83, 0x09, 0x8d, 0x51, 0xbd, 0x4c,
+	0x7d, 0x32, 0x5a, 0x11, 0xc7, 0x49, 0x0b, 0xad, 0x4f, 0x65, 0x9b, 0x7f, 0x11, 0xd6, 0x63, 0x65,
+	0xc3, 0x8b, 0x92, 0xc8, 0xd2, 0xc5, 0xe3, 0xee, 0xf2, 0xaf, 0x25, 0x18, 0xb0, 0xe6, 0xd6, 0x51,
+	0xff, 0x77, 0x0a, 0x2e, 0x44, 0x8d, 0x61, 0xfc, 0x2a, 0xfd, 0x0c, 0x32, 0xe6, 0x90, 0x84, 0xc0,
+	0xd3, 0xf8, 0xf5, 0xeb, 0x13, 0xfa, 0x6c, 0x0c, 0x29, 0x4f, 0x68, 0x25, 0x8e, 0xad, 0xe9, 0xb7,
+	0x64, 0xab, 0xff, 0x9a, 0xb3, 0x6b, 0xb2, 0xef, 0x6d, 0xb9, 0xaf, 0x39, 0x2b, 0xa6, 0x81, 0xb7,
+	0xca, 0x40, 0xb6, 0x90, 0xe4, 0x5c, 0x26, 0xc9, 0xfb, 0x48, 0x42, 0x8d, 0xd3, 0xe4, 0x73, 0x24,
+	0xe6, 0x60, 0xd8, 0x47, 0x0e, 0xea, 0xb6, 0x13, 0x3e, 0x54, 0x5f, 0xf4, 0x6a, 0x90, 0x26, 0x38,
+	0x35, 0x9b, 0x15, 0xd4, 0xec, 0x73, 0xb8, 0x1c, 0x35, 0xb2, 0x69, 0x16, 0xfa, 0x05, 0x98, 0xc5,
+	0xb7, 0x59, 0x7d, 0x66, 0x46, 0x69, 0x42, 0xfd, 0xaf, 0x21, 0x7b, 0xe3, 0xb6, 0xcc, 0xc4, 0xe4,
+	0x39, 0xcc, 0x51, 0xce, 0x79, 0xb7, 0x4c, 0x9f, 0x26, 0x62, 0xba, 0x7f, 0x0a, 0xc4, 0x6a, 0xb3,
+	0x25, 0xe2, 0x36, 0x56, 0x7c, 0x01, 0x8b, 0x42, 0x51, 0x84, 0x7c, 0x7f, 0x2a, 0x46, 0xf4, 0x5e,
+	0x4f, 0xd6, 0x31, 0xb7, 0x0c, 0xba, 0x21, 0x53, 0xac, 0x3b, 0x7a, 0xdf, 0x3c, 0x7e, 0xa7, 0x16,
+	0x45, 0xfd, 0x14, 0xd6, 0x62, 0x7a, 0x61, 0x3c, 0xc4, 0x1f, 0xca, 0x30, 0x0d, 0x07, 0x19, 0x8e,
+	0xbb, 0xf3, 0xf1, 0xd2, 0xea, 0x1f, 0x4b, 0x70, 0x49, 0xac, 0xfd, 0xa4, 0x87, 0x87, 0x78, 0x56,
+	0x73, 0xd0, 0x20, 0xd1, 0xc4, 0xbe, 0xfd, 0xcb, 0xd4, 0xaf, 0xbe, 0x9c, 0xd4, 0x5d, 0x58, 0x8d,
+	0x44, 0x3f, 0x85, 0x64, 0xaa, 0x06, 0xac, 0xc5, 0xb4, 0xc1, 0xf8, 0x77, 0x00, 0x0b, 0x27, 0x34,
+	0xab, 0xdd, 0xef, 0xd9, 0x4e, 0xec, 0x57, 0xb9, 0x62, 0xf9, 0xa8, 0xcd, 0xb3, 0xfa, 0xfb, 0x3d,
+	0xdb, 0xc1, 0x96, 0x73, 0x23, 0x3c, 0x30, 0x44, 0x9f, 0xcb, 0x4c, 0xb3, 0xa4, 0x9e, 0xe1, 0x7b,
+	0x31, 0x42, 0xee, 0x7d, 0x23, 0x81, 0xaa, 0xb5, 0x3b, 0x13, 0xa0, 0x69, 0x6e, 0x2d, 0xd2, 0x31,
+	0xbe, 0x46, 0xe3, 0xd3, 0x2c, 0x16, 0x39, 0x0e, 0x1f, 0xb3, 0xff, 0xff, 0x5e, 0x22, 0x7b, 0x6d,
+	0xfa, 0x64, 0xf9, 0x6f, 0xfe, 0x38, 0x8c, 0x7b, 0x9c, 0x9c, 0x16, 0x1f, 0x27, 0x0b, 0x8f, 0x9a,
+	0x67, 0x02, 0x8f, 0x9a, 0xd5, 0x43, 0xb8, 0x14, 0x31, 0x0a, 0x36, 0xf1, 0xf7, 0x21, 0x83, 0x4e,
+	0xbd, 0x65, 0x13, 0x75, 0x07, 0xec, 0xbf, 0xd8, 0xd6, 0x18, 0xe9, 0xe6, 0x5f, 0xa6, 0x20, 0xc3,
+	0x6c, 0xd1, 0x12, 0xcc, 0x37, 0x5b, 0xa5, 0xd6, 0x51, 0xb3, 0x5d, 0x6f, 0xd4, 0xab, 0xf2, 0x7b,
+	0x5c, 0x46, 0xad, 0x5e, 0x6b, 0xc9, 0x92, 0xb2, 0x08, 0x39, 0x96, 0xd1, 0x78, 0x2a, 0xa7, 0x14,
+	0x05, 0xf2, 0x6e, 0x72, 0x6f, 0x6f, 0xbf, 0x56, 0xaf, 0xca, 0x69, 0x45, 0x86, 0x05, 0x96, 0x57,
+	0xd5, 0xb4, 0x86, 0x26, 0xcf, 0x28, 0x05, 0xb8, 0xe0, 0x35, 0xdb, 0x6a, 0xd7, 0xea, 0xed, 0xef,
+	0x1c, 0x35, 0xb4, 0xa3, 0x03, 0x79, 0x56, 0x59, 0x81, 0xf3, 0xac, 0xa4, 0x52, 0x2d, 0x37, 0x0e,
+	0x0e, 0x6a, 0xcd, 0x66, 0xad, 0x51, 0x97, 0x33, 0xca, 0x32, 0x28, 0xac, 0xe0, 0xa0, 0x54, 0xab,
+	0xb7, 0xaa, 0xf5, 0x52, 0xbd, 0x5c, 0x95, 0xb3, 0x5c, 0x85, 0x66, 0xab, 0xa1, 0x95, 0x1e, 0x57,
+	0xdb, 0x95, 0xc6, 0xf3, 0xba, 0x3c, 0xa7, 0x5c, 0x86, 0x95, 0x60, 0x41, 0xf5, 0xb1, 0x56, 0xaa,
+	0x54, 0x2b, 0x72, 0x8e, 0xab, 0x55, 0xaf, 0x56, 0x2b, 0xcd, 0xb6, 0x56, 0xdd, 0x6d, 0x34, 0x5a,
+	0x32, 0x28, 0xab, 0x50, 0x08, 0xd4, 0xd2, 0xaa, 0xbb, 0xa5, 0x7d, 0xd2, 0xd9, 0xbc, 0xb2, 0x01,
+	0xab, 0xc1, 0x36, 0xb5, 0xda, 0x33, 0x4c, 0x73, 0xb8, 0x5f, 0x2a, 0x57, 0xe5, 0x05, 0xe5, 0x1a,
+	0xac, 0x47, 0x8d, 0xac, 0x5d, 0x6f, 0xb8, 0x55, 0xe4, 0x45, 0x25, 0x0f, 0xe0, 0x8d, 0xe5, 0x0b,
+	0x39, 0xbf, 0xf9, 0xbb, 0x12, 0x00, 0x7d, 0x71, 0x46, 0xbe, 0x3d, 0x70, 0x01, 0x64, 0xd2, 0xac,
+	0xd6, 0x6e, 0x7d, 0xf7, 0xb0, 0xea, 0x72, 0x3e, 0x90, 0xbb, 0x57, 0xdb, 0xaf, 0xca, 0x92, 0x72,
+	0x11, 0xce, 0xf1, 0xb9, 0xbb, 0xfb, 0x8d, 0x32, 0x9e, 0x86, 0x65, 0x50, 0xf8, 0xec, 0xc6, 0xee,
+	0xe7, 0xd5, 0x72, 0x4b, 0x4e, 0x2b, 0x97, 0xe0, 0x22, 0x9f, 0x5f, 0xde, 0x3f, 0x6a, 0xb6, 0xaa,
+	0x5a, 0xb5, 0x22, 0xcf, 0x04, 0x5b, 0x7a, 0xac, 0x95, 0x0e, 0x9f, 0xc8, 0xb3, 0x9b, 0xbf, 0x2d,
+	0x41, 0x86, 0x7e, 0x64, 0x05, 0xcf, 0xe3, 0x5e, 0x53, 0xc0, 0x74, 0x0e, 0x16, 0xdd, 0x9c, 0xdd,
+	0x96, 0xb6, 0xd7, 0x94, 0x25, 0x9e, 0xa8, 0xfa, 0x45, 0xeb, 0x81, 0x9c, 0xe2, 0x73, 0xf6, 0x8e,
+	0x9a, 0x58, 0x20, 0x96, 0x60, 0xde, 0x6b, 0x68, 0xaf, 0x29, 0xcf, 0xf0, 0x19, 0xcf, 0xf6, 0x9a,
+	0xf2, 0x2c, 0x9f, 0xf1, 0xc5, 0x5e, 0x53, 0xce, 0xf0, 0x19, 0xdf, 0xdb, 0x6b, 0xca, 0xd9, 0xcd,
+	0x3f, 0x94, 0xe0, 0x62, 0xe4, 0x53, 0x3d, 0xe5, 0x2a, 0xac, 0x11, 0xf0, 0x6d, 0x36, 0x9c, 0xf2,
+	0x93, 0x52, 0xfd, 0x71, 0x55, 0xc0, 0x7d, 0x1d, 0xae, 0xc6, 0x92, 0x1c, 0x34, 0x2a, 0xb5, 0xbd,
+	0x5a, 0xb5, 0x22, 0x4b, 0x8a, 0x0a, 0x57, 0x62, 0xc9, 0x4a, 0x15, 0x2c, 0x49, 0x29, 0xe5, 0x7d,
+	0xd8, 0x88, 0xa5, 0xa9, 0x54, 0xf7, 0xab, 0xad, 0x6a, 0x45, 0x4e, 0x6f, 0x3a, 0xb0, 0xc0, 0x1f,
+	0x4a, 0x12, 0x69, 0xae, 0x3e, 0xab, 0x6a, 0xb5, 0xd6, 0x77, 0x05, 0x60, 0x58, 0x2e, 0x85, 0xfc,
+	0xd2, 0x7e, 0x49, 0x3b, 0x90, 0x25, 0x3c, 0x71, 0x62, 0xc1, 0xf3, 0x92, 0x56, 0xaf, 0xd5, 0x1f,
+	0xcb, 0x29, 0xb2, 0x98, 0x02, 0x6d, 0xb5, 0x6a, 0x7b, 0xdf, 0x95, 0xd3, 0x9b, 0xbf, 0x2e, 0xe1,
+	0xb7, 0x7d, 0xfe, 0x39, 0x2b, 0xee, 0x56, 0xab, 0x36, 0x1b, 0x47, 0x5a, 0x59, 0xe4, 0x47, 0x01,
+	0x2e, 0x88, 0xf9, 0xcf, 0x1a, 0xfb, 0x47, 0x07, 0x58, 0xbe, 0x22, 0x6a, 0x54, 0xaa, 0x72, 0x0a,
+	0xe3, 0x11, 0xf3, 0x99, 0x28, 0xc9, 0x69, 0x3c, 0x06, 0xb1, 0x88, 0x70, 0x46, 0x9e, 0xd9, 0xfc,
+	0x35, 0x09, 0x96, 0x02, 0x07, 0xa8, 0x4a, 0x11, 0x96, 0x4b, 0xfb, 0x55, 0xad, 0xd5, 0x2e, 0x95,
+	0x5b, 0xb5, 0x46, 0x5d, 0x40, 0xb5, 0x0a, 0x85, 0x70, 0x19, 0xe5, 0xa9, 0x2c, 0x45, 0x97, 0x96,
+	0xb5, 0x6a, 0xa9, 0x85, 0xf1, 0x45, 0x96, 0x1e, 0x1d, 0x56, 0x70, 0x69, 0x7a, 0xf3, 0x07, 0xee,
+	0x8b, 0x66, 0xee, 0xc1, 0x39, 0xae, 0x42, 0x87, 0xed, 0xd6, 0x39, 0x2c, 0x69, 0xa5, 0x03, 0x17,
+	0xcc, 0x65, 0x58, 0x89, 0x2a, 0x6d, 0xec, 0xed, 0xc9, 0x12, 0x1e, 0x45, 0x64, 0x61, 0x5d, 0x4e,
+	0x6d, 0xee, 0x40, 0x96, 0x7d, 0x59, 0x4e, 0x99, 0x83, 0x19, 0xd6, 0x5a, 0x16, 0xd2, 0xfb, 0x8d,
+	0xe7, 0xb2, 0xa4, 0x00, 0x64, 0x0e, 0xaa, 0x95, 0xda, 0xd1, 0x81, 0x9c, 0xc2, 0xc5, 0x4f, 0x6a,
+	0x8f, 0x9f, 0xc8, 0xe9, 0xcd, 0x5f, 0x86, 0x9c, 0xf7, 0x69, 0x39, 0xcc, 0xea, 0x5a, 0xa3, 0x7d,
+	0xa8, 0x35, 0xf0, 0x92, 0x6f, 0x37, 0xab, 0xdf, 0x39, 0xaa, 0xd6, 0x5b, 0xb5, 0xd2, 0xbe, 0xfc,
+	0x1e, 0x5e, 0xb3, 0x5c, 0x91, 0x56, 0xaa, 0x57, 0x1a, 0x58, 0x58, 0xce, 0xc1, 0x22, 0x97, 0x5d,
+	0xd9, 0xa5, 0x42, 0x22, 0x64, 0xb5, 0xb5, 0xea, 0x41, 0x03, 0xf3, 0x02, 0x6b, 0x6c, 0xae, 0xa4,
+	0x7c, 0xd0, 0x94, 0x67, 0x36, 0x7f, 0x37, 0x05, 0xf3, 0xdc, 0xb3, 0x74, 0xdc, 0x0f, 0x1b, 0x1f,
+	0xd6, 0x5b, 0xbc, 0xd8, 0x08, 0xd9, 0x87, 0xd5, 0x7a, 0x05, 0xcb, 0x24, 0xcf, 0x10, 0x5a, 0x52,
+	0x7a, 0x56, 0xaa, 0xed, 0x97, 0x76, 0xf7, 0x99, 0xe8, 0x88, 0x65, 0xad, 0x56, 0xa9, 0xfc, 0x04,
+	0x2f, 0x93, 0x50, 0x51, 0xa5, 0xca, 0x8a, 0x66, 0x38, 0xfe, 0xfb, 0x45, 0xad, 0xf2, 0x13, 0xdc,
+	0xdd, 0x2c, 0x96, 0x52, 0xa1, 0x90, 0xda, 0x99, 0x4c, 0x08, 0xa0, 0xbb, 0x20, 0xb3, 0xca, 0x15,
+	0x28, 0x0a, 0x25, 0x2d, 0xed, 0xbb, 0xac, 0x37, 0xdc, 0xe2, 0x5c, 0xa8, 0xa6, 0x56, 0xc5, 0xea,
+	0xbb, 0x2a, 0xe7, 0x36, 0x7f, 0x22, 0xc1, 0x82, 0xcf, 0x9b, 0x91, 0x1d, 0xe8, 0xdc, 0x37, 0x95,
+	0x6b, 0x70, 0x29, 0x98, 0xdf, 0x6a, 0x1f, 0x6a, 0xd5, 0x66, 0xb5, 0x8e, 0x0d, 0xe7, 0x05, 0x90,
+	0xc5, 0xe2, 0xa3, 0x43, 0xaa, 0xb8, 0xc5, 0x5c, 0x62, 0xcd, 0xd2, 0x01, 0x86, 0x1e, 0x35, 0x7d,
+	0x63, 0x36, 0xb3, 0xf9, 0xf3, 0x78, 0x23, 0xc0, 0x7d, 0x72, 0x97, 0x9a, 0x3e, 0x6a, 0x9f, 0xa8,
+	0x70, 0xb5, 0x0f, 0x4a, 0x8f, 0xeb, 0xd5, 0x56, 0xad, 0x2c, 0xbf, 0x47, 0x0d, 0xa9, 0x50, 0xd8,
+	0x6c, 0x62, 0x65, 0x47, 0x4c, 0xa2, 0x90, 0x5f, 0x7f, 0x76, 0x50, 0x95, 0x53, 0x9b, 0xb7, 0x60,
+	0x91, 0x5d, 0x99, 0xd4, 0x4d, 0xa7, 0xf7, 0xf2, 0x0c, 0x53, 0xb2, 0xd5, 0xce, 0x54, 0x0d, 0x05,
+	0xf9, 0xde, 0x26, 0x82, 0x79, 0xee, 0x53, 0x56, 0x78, 0x36, 0xe9, 0xdc, 0xba, 0xb3, 0xf2, 0x45,
+	0xab, 0xaa, 0xd5, 0x89, 0xe0, 0x06, 0x8b, 0x6a, 0x75, 0x56, 0x24, 0x61, 0x1b, 0x1b, 0x59, 0xd4,
+	0x6e, 0x3e, 0xaf, 0xb5, 0xca, 0x4f, 0xe4, 0xd4, 0x66, 0x0b, 0xf2, 0x0d, 0xd7, 0xfd, 0xd9, 0xeb,
+	0xeb, 0xc7, 0xf8, 0xcd, 0xac, 0xdc, 0x38, 0x6c, 0xef, 0xed, 0x97, 0x1e, 0x37, 0xdb, 0x47, 0xf5,
+	0xa7, 0x75, 0x02, 0x07, 0x2f, 0x03, 0x2f, 0x97, 0xcc, 0x09, 0x51, 0xa3, 0x5e, 0x16, 0x9d, 0xee,
+	0xf6, 0x5e, 0x43, 0x2b, 0xe3, 0x61, 0xbe, 0x81, 0xf3, 0x11, 0xdf, 0x5b, 0xc5, 0x82, 0xd2, 0x78,
+	0x5e, 0xaf, 0x6a, 0xcd, 0x27, 0xb5, 0xc3, 0x76, 0xa9, 0x5c, 0xae, 0x36, 0x99, 0x19, 0xd2, 0xaa,
+	0xa5, 0x8a, 0xfc, 0x9e, 0xb2, 0x0e, 0x97, 0xa3, 0xcb, 0x9f, 0x6b, 0x35, 0xa2, 0xa7, 0x62, 0x09,
+	0x4a, 0x95, 0x83, 0x1a, 0x56, 0x10, 0xd8, 0x92, 0x45, 0x7e, 0x20, 0x15, 0xf7, 0xbd, 0x5f, 0xda,
+	0xad, 0xee, 0xb7, 0x9b, 0xd5, 0xfd, 0x6a, 0xb9, 0xd5, 0xd0, 0xda, 0x8d, 0xc3, 0xaa, 0x56, 0xc2,
+	0x3f, 0x6a, 0x78, 0x80, 0x2a, 0x5c, 0x89, 0x2b, 0xa7, 0xde, 0x87, 0x2c, 0x8d, 0xa3, 0xa9, 0x7e,
+	0x51, 0x6b, 0xb6, 0x9a, 0x72, 0x4a, 0xd9, 0x84, 0x1b, 0x71, 0x34, 0x95, 0x46, 0x95, 0x0a, 0x2e,
+	0x21, 0x96, 0xd3, 0x9b, 0x7f, 0x1b, 0x2e, 0x44, 0x9d, 0x30, 0xe0, 0x61, 0x46, 0xe5, 0x1f, 0x19,
+	0xaf, 0x0c, 0xf3, 0x8d, 0x21, 0xbf, 0x47, 0x7c, 0xa7, 0x08, 0x02, 0xf7, 0xb7, 0x2c, 0x61, 0xc3,
+	0x1d, 0x45, 0xc1, 0x0e, 0x44, 0x1b, 0x43, 0x39, 0xb5, 0xf9, 0x27, 0x29, 0x28, 0x88, 0x34, 0xfe,
+	0x96, 0x8a, 0xf8, 0x5e, 0x31, 0x65, 0x3e, 0x8c, 0x1b, 0xa0, 0xc6, 0x11, 0xd5, 0x4d, 0x87, 0x78,
+	0xf0, 0xa8, 0x4b, 0xc5, 0x30, 0x8e, 0x0e, 0x9f, 0x73, 0xc8, 0xa9, 0x71, 0xdd, 0x95, 0x5e, 0x98,
+	0xa4, 0x99, 0x34, 0x66, 0x7f, 0x1c, 0xd1, 0xa1, 0x3e, 0xb2, 0x51, 0x57, 0x9e, 0x19, 0xd7, 0x50,
+	0xd3, 0x31, 0x87, 0x43, 0xd4, 0x95, 0x67, 0xc7, 0x35, 0x44, 0xc3, 0xd5, 0xe4, 0xcc, 0x38, 0x9a,
+	0x3d, 0xbd, 0xd7, 0x47, 0x5d, 0x39, 0xbb, 0xf9, 0xc7, 0x11, 0xe7, 0xe3, 0xfc, 0xde, 0x49, 0xb9,
+	0x09, 0xd7, 0xc6, 0x95, 0xfb, 0x9c, 0xbc, 0x0e, 0x57, 0xc7, 0x11, 0x92, 0xe1, 0xc9, 0x52, 0x98,
+	0xe1, 0x22, 0x99, 0x86, 0xec, 0xd1, 0x00, 0x51, 0x47, 0x6a, 0x1c, 0x1d, 0xe6, 0x84, 0x9c, 0xde,
+	0xf9, 0xbf, 0x19, 0x50, 0x1a, 0x43, 0x64, 0x04, 0x1e, 0x0b, 0xff, 0x48, 0x82, 0x9c, 0xb7, 0xfb,
+	0x51, 0x3e, 0x8c, 0xde, 0x3d, 0x46, 0x86, 0x7e, 0x14, 0x6f, 0x27, 0x23, 0x66, 0x9b, 0xc6, 0x8d,
+	0x5f, 0xf9, 0x8b, 0xff, 0xf9, 0x9b, 0xa9, 0xa2, 0x7a, 0x71, 0xfb, 0xf4, 0xde, 0x36, 0x3b, 0xe5,
+	0xdd, 0x46, 0x2e, 0xd9, 0x23, 0x69, 0x53, 0xf9, 0xbb, 0x12, 0x64, 0xd9, 0x85, 0x99, 0xf2, 0xc1,
+	0x98, 0xb6, 0xc5, 0xbb, 0xb9, 0xe2, 0x66, 0x12, 0x52, 0x06, 0xe2, 0x0a, 0x01, 0x51, 0x50, 0xcf,
+	0xf3, 0x20, 0x7a, 0x94, 0x08, 0x43, 0xf8, 0x3d, 0x09, 0xf2, 0x62, 0x54, 0x84, 0x72, 0x77, 0x4c,
+	0xf3, 0x91, 0x01, 0x21, 0xc5, 0x7b, 0x53, 0xd4, 0x60, 0xb8, 0x6e, 0x10, 0x5c, 0x1b, 0xea, 0x65,
+	0x1e, 0x17, 0xb9, 0xf2, 0x16, 0x59, 0xf4, 0x1b, 0x12, 0x80, 0x1f, 0xeb, 0xa0, 0xdc, 0x9e, 0xd4,
+	0x13, 0x1f, 0x87, 0x51, 0xbc, 0x93, 0x90, 0x9a, 0x61, 0x52, 0x09, 0xa6, 0x55, 0x75, 0x25, 0x8c,
+	0x89, 0x7c, 0x21, 0x4d, 0xc0, 0x43, 0xc2, 0x1c, 0x26, 0xe3, 0xe1, 0x43, 0x30, 0x8a, 0x77, 0x12,
+	0x52, 0x4f, 0xc6, 0x83, 0x30, 0x21, 0xc6, 0xf3, 0x13, 0x17, 0x0f, 0x09, 0x4f, 0x98, 0x8c, 0x87,
+	0x0f, 0xbe, 0x28, 0xde, 0x49, 0x48, 0x3d, 0x19, 0xcf, 0x1b, 0x4c, 0xf8, 0x48, 0xda, 0xbc, 0x2b,
+	0xed, 0xfc, 0xd1, 0x0c, 0x2c, 0x71, 0xcb, 0x8e, 0x7c, 0x1f, 0xe2, 0xef, 0xf0, 0x4b, 0xee, 0x56,
+	0xdc, 0x3d, 0x7a, 0x48, 0xae, 0x3e, 0x48, 0x40, 0xc9, 0xb0, 0xad, 0x11, 0x6c, 0x2b, 0xaa, 0x82,
+	0xb1, 0x19, 0x66, 0x17, 0x89, 0x62, 0xf4, 0xc6, 0x5f, 0x68, 0x37, 0xe2, 0x1a, 0x0d, 0xac, 0xb2,
+	0x9b, 0x13, 0xe9, 0x58, 0xd7, 0x97, 0x49, 0xd7, 0x17, 0x55, 0xd9, 0xeb, 0x9a, 0x5b, 0x5f, 0xbf,
+	0x29, 0x41, 0x5e, 0x8c, 0x64, 0x50, 0xee, 0x4c, 0x68, 0x58, 0x8c, 0x88, 0x28, 0x6e, 0x25, 0x25,
+	0x8f, 0x9a, 0x25, 0x1e, 0x0e, 0xfb, 0x82, 0x1a, 0x46, 0x85, 0x77, 0x7b, 0x7c, 0x20, 0x81, 0xf2,
+	0x61, 0x5c, 0x27, 0x11, 0xb1, 0x0d, 0xc5, 0xdb, 0xc9, 0x88, 0x19, 0x9e, 0xab, 0x04, 0xcf, 0x65,
+	0x75, 0xd9, 0xc3, 0x43, 0xc3, 0x21, 0xb6, 0x47, 0x84, 0xfa, 0x91, 0xb4, 0xb9, 0xf3, 0x5f, 0x2e,
+	0xc0, 0x39, 0x4e, 0x64, 0xd8, 0x87, 0x74, 0xcf, 0x20, 0x43, 0x6f, 0x73, 0x95, 0x9b, 0xf1, 0x31,
+	0x91, 0xc2, 0x45, 0x73, 0xf1, 0xd6, 0x64, 0x42, 0x37, 0xc6, 0x8f, 0xa0, 0x5a, 0x56, 0xcf, 0x61,
+	0x54, 0xf4, 0xe0, 0x71, 0x9b, 0x7e, 0x91, 0x09, 0xf3, 0xe7, 0x9f, 0x48, 0xa0, 0x84, 0x5f, 0xfb,
+	0x28, 0xf7, 0x27, 0x35, 0x1f, 0xf1, 0x46, 0xa9, 0xf8, 0x60, 0xba, 0x4a, 0x51, 0xb3, 0x28, 0xe0,
+	0x7b, 0x69, 0x99, 0x83, 0x5e, 0x17, 0xa3, 0x3c, 0x83, 0x0c, 0xbd, 0xaa, 0x1c, 0xc7, 0x20, 0xe1,
+	0x5a, 0xb7, 0x78, 0x6b, 0x32, 0xe1, 0x18, 0x06, 0x75, 0x09, 0x09, 0xee, 0xfa, 0x97, 0xfc, 0xf5,
+	0x34, 0xa6, 0xc9, 0xc0, 0x8a, 0xfa, 0x20, 0x01, 0x65, 0xd4, 0x72, 0x66, 0xbd, 0x73, 0xab, 0xea,
+	0xef, 0x09, 0x36, 0x7c, 0x33, 0xbe, 0xdd, 0x90, 0x4a, 0xf9, 0x30, 0x11, 0x2d, 0x43, 0xb1, 0x4e,
+	0x50, 0x5c, 0x52, 0x2f, 0x70, 0x28, 0x04, 0xb5, 0x72, 0x06, 0x19, 0x2a, 0xf3, 0xe3, 0x66, 0x40,
+	0x88, 0xc4, 0x28, 0xde, 0x9a, 0x4c, 0x38, 0x66, 0x06, 0xbc, 0x35, 0xa3, 0x8c, 0xdc, 0x4f, 0xc1,
+	0xde, 0x88, 0x6f, 0x90, 0x7f, 0x10, 0x53, 0xbc, 0x39, 0x91, 0x2e, 0x4a, 0x9f, 0xb1, 0x7e, 0xc9,
+	0x33, 0x16, 0x66, 0xff, 0x16, 0x85, 0x97, 0x1b, 0xca, 0xd6, 0x18, 0xf9, 0x8e, 0x78, 0x20, 0x52,
+	0xdc, 0x4e, 0x4c, 0x3f, 0x06, 0x0f, 0xf9, 0x58, 0xb4, 0xab, 0x5f, 0x03, 0x5f, 0xa9, 0x1a, 0xd3,
+	0x41, 0xe4, 0x8b, 0x90, 0xe2, 0xdd, 0xe4, 0x15, 0xa2, 0xbc, 0x2a, 0x06, 0xc9, 0x7d, 0x2a, 0x82,
+	0x51, 0xfd, 0x43, 0xc9, 0x0f, 0xba, 0x66, 0x3a, 0x6c, 0x7b, 0xca, 0x97, 0x1b, 0xc5, 0xbb, 0xc9,
+	0x2b, 0x30, 0x54, 0xd7, 0x09, 0xaa, 0x75, 0xb5, 0xc8, 0x4f, 0x1c, 0x23, 0xe5, 0x94, 0xdb, 0xcf,
+	0x24, 0x58, 0x0a, 0x3c, 0x8b, 0x50, 0x12, 0x74, 0x26, 0xc6, 0xc6, 0x14, 0xef, 0x4d, 0x51, 0x23,
+	0xca, 0xe7, 0x0b, 0xe2, 0x63, 0xf1, 0x29, 0x18, 0xe0, 0x3f, 0x93, 0xe8, 0x57, 0xfb, 0x84, 0xd7,
+	0x0b, 0xca, 0xce, 0xf4, 0xcf, 0x2b, 0x8a, 0xf7, 0xa7, 0xaa, 0xc3, 0x60, 0xde, 0x22, 0x30, 0x55,
+	0x75, 0x2d, 0x0a, 0xa6, 0xb0, 0xfc, 0xff, 0x95, 0x04, 0xe7, 0x23, 0x82, 0xf2, 0x95, 0x07, 0x6f,
+	0xf3, 0xac, 0xa1, 0xf8, 0xf0, 0xad, 0x22, 0xff, 0xd5, 0x0f, 0x09, 0xdc, 0xeb, 0xea, 0x46, 0x14,
+	0x5c, 0xf2, 0xf0, 0x82, 0x9b, 0xfb, 0x5f, 0x82, 0x2c, 0x8b, 0xa2, 0x1f, 0xa7, 0xb7, 0xc5, 0x40,
+	0xff, 0xe2, 0x07, 0x09, 0x28, 0xc7, 0xe8, 0x6d, 0x16, 0x5e, 0xef, 0xea, 0x6d, 0x2f, 0xde, 0x7e,
+	0x9c, 0xde, 0x0e, 0x86, 0xf3, 0x17, 0x3f, 0x4c, 0x44, 0x3b, 0x46, 0x6f, 0x8f, 0x0c, 0x0e, 0xc7,
+	0x19, 0x64, 0xe8, 0xe9, 0xd3, 0x38, 0xbd, 0x2d, 0x3c, 0x04, 0x2f, 0xde, 0x9a, 0x4c, 0x38, 0x46,
+	0x6f, 0xd3, 0x6f, 0x9b, 0x7a, 0x46, 0x7b, 0x52, 0xd7, 0x15, 0x94, 0xb0, 0xeb, 0x0a, 0x9a, 0xd8,
+	0x75, 0x17, 0xb9, 0x5d, 0x8f, 0x60, 0x96, 0x7c, 0x4f, 0x60, 0x9c, 0xc9, 0xe0, 0xbf, 0x6d, 0x50,
+	0xbc, 0x39, 0x91, 0x6e, 0x8c, 0x8a, 0x26, 0x2f, 0xf7, 0x99, 0xcc, 0xb1, 0x77, 0xfc, 0xe3, 0x64,
+	0x4e, 0xfc, 0xae, 0x40, 0xf1, 0x83, 0x04, 0x94, 0x63, 0x64, 0x6e, 0x64, 0xb8, 0xdd, 0xef, 0xfc,
+	0xa7, 0x19, 0x58, 0xe6, 0x9c, 0x4b, 0x2e, 0x88, 0x51, 0xf9, 0x31, 0xb7, 0xff, 0x8e, 0xf4, 0xca,
+	0x63, 0xe3, 0x63, 0x8b, 0x5b, 0x49, 0xc9, 0x19, 0xc8, 0xf7, 0x09, 0xc8, 0x2b, 0xea, 0x25, 0x0c,
+	0x92, 0x0b, 0xba, 0x14, 0x15, 0xca, 0x8f, 0x24, 0xcf, 0xe7, 0xbd, 0x3d, 0xa1, 0x03, 0x51, 0x77,
+	0xdc, 0x49, 0x48, 0x1d, 0xe5, 0x93, 0xf3, 0x68, 0x7c, 0x4d, 0x81, 0xa1, 0x30, 0xef, 0x72, 0x12,
+	0x14, 0xd1, 0xc5, 0xbc, 0x93, 0x90, 0x7a, 0x12, 0x14, 0xdf, 0xd9, 0xc4, 0x50, 0x98, 0x9b, 0x35,
+	0x09, 0x8a, 0xe8, 0x6b, 0xdd, 0x49, 0x48, 0x3d, 0x09, 0x8a, 0xbf, 0x53, 0xf9, 0x2d, 0x10, 0x84,
+	0xc9, 0xff, 0x4c, 0x89, 0xad, 0xfc, 0x8e, 0x04, 0x0b, 0xcc, 0xa1, 0x37, 0xad, 0xd2, 0xf3, 0x66,
+	0xb4, 0x63, 0x14, 0xff, 0x55, 0xa7, 0xe2, 0x76, 0x62, 0xfa, 0x28, 0x7b, 0xef, 0xc7, 0xc9, 0xd8,
+	0x6c, 0x16, 0xb7, 0xf5, 0x37, 0x36, 0xb3, 0xf7, 0x79, 0x1f, 0xd8, 0x97, 0xa3, 0x38, 0x73, 0x3f,
+	0xee, 0x7b, 0x5e, 0xc5, 0x7b, 0x53, 0xd4, 0x60, 0xf0, 0x6e, 0x12, 0x78, 0x57, 0xd5, 0xd5, 0x38,
+	0x78, 0x98, 0x1a, 0x03, 0xfc, 0xc7, 0x12, 0x2c, 0x79, 0x00, 0xe9, 0x37, 0x6c, 0x94, 0x44, 0xfd,
+	0x09, 0x1f, 0xdc, 0x29, 0xee, 0x4c, 0x53, 0x25, 0xca, 0xd6, 0x47, 0x60, 0xa4, 0xc1, 0x18, 0x2e,
+	0x48, 0xcf, 0x57, 0x60, 0x33, 0x3c, 0x01, 0x64, 0xc4, 0x97, 0x97, 0x8a, 0x3b, 0xd3, 0x54, 0x99,
+	0x04, 0xd2, 0xd3, 0x1d, 0xee, 0x54, 0xff, 0x81, 0x04, 0xe7, 0x04, 0x90, 0x64, 0xb6, 0xef, 0x27,
+	0xed, 0x93, 0x9f, 0xf0, 0x07, 0xd3, 0x55, 0x62, 0x50, 0x37, 0x09, 0xd4, 0xf7, 0xd5, 0xf5, 0x31,
+	0x50, 0xdd, 0x69, 0xff, 0x97, 0x12, 0x28, 0x3c, 0x58, 0x36, 0xf3, 0x49, 0x3b, 0x16, 0x27, 0xff,
+	0xe1, 0x94, 0xb5, 0xa2, 0x9c, 0xa7, 0x68, 0xbc, 0xbe, 0x08, 0xfc, 0xaa, 0xaf, 0x12, 0x3f, 0x1c,
+	0xdf, 0x9d, 0xa8, 0x11, 0x6f, 0x27, 0x23, 0x8e, 0xd2, 0x42, 0x3c, 0x24, 0x5f, 0x21, 0xfe, 0x58,
+	0x82, 0x39, 0xf7, 0xbb, 0x48, 0xca, 0x9d, 0xf1, 0xad, 0x07, 0x3e, 0xc2, 0x54, 0xdc, 0x4a, 0x4a,
+	0xee, 0x7e, 0xab, 0x91, 0xc0, 0x59, 0x53, 0x0b, 0x41, 0x38, 0xa7, 0x8c, 0x12, 0xab, 0xc5, 0x9f,
+	0x64, 0xe0, 0x12, 0xa7, 0x16, 0x03, 0x9f, 0x17, 0xfc, 0xa9, 0x6f, 0xd5, 0xb6, 0x27, 0x7f, 0x03,
+	0x31, 0xc1, 0x2e, 0x68, 0xec, 0xd7, 0x2e, 0x05, 0x4b, 0xeb, 0x7e, 0xb2, 0x90, 0x7e, 0x56, 0x91,
+	0x33, 0x6f, 0x3f, 0xf5, 0x6d, 0x4a, 0x02, 0x4c, 0xa2, 0x59, 0xb9, 0x9b, 0xbc, 0x42, 0x02, 0x4c,
+	0xfe, 0x96, 0xfe, 0xf7, 0x84, 0x53, 0x8d, 0x9d, 0xc9, 0xbd, 0x24, 0xdb, 0xef, 0x4c, 0xf8, 0x84,
+	0xa6, 0xa8, 0xa7, 0x03, 0xe0, 0x04, 0xef, 0xe4, 0xb7, 0x39, 0x77, 0x29, 0x01, 0x0f, 0x02, 0x1e,
+	0xd3, 0xbd, 0x29, 0x6a, 0x44, 0x19, 0xb8, 0x00, 0x32, 0xee, 0x34, 0xe8, 0xa7, 0xfe, 0xba, 0x4c,
+	0x30, 0x97, 0xe2, 0xda, 0xbc, 0x9b, 0xbc, 0x42, 0x82, 0xb9, 0xf4, 0x96, 0xe8, 0xce, 0xbf, 0x09,
+	0x38, 0x0a, 0xfe, 0x5d, 0xd5, 0x44, 0x27, 0x2f, 0xee, 0x19, 0x4d, 0xf1, 0x4e, 0x42, 0xea, 0x48,
+	0x45, 0x82, 0xc9, 0x68, 0xb8, 0x2d, 0xb7, 0x0a, 0x7e, 0x5d, 0x82, 0xac, 0x7b, 0x04, 0x30, 0x39,
+	0x8e, 0x52, 0xd8, 0xff, 0x6f, 0x25, 0x25, 0x8f, 0xbe, 0x3c, 0xf0, 0xd1, 0x70, 0x1b, 0xff, 0x49,
+	0x3e, 0x67, 0xdc, 0x6b, 0x95, 0xe2, 0x9d, 0x84, 0xd4, 0x93, 0x38, 0xe3, 0xab, 0xd8, 0xdf, 0x92,
+	0x20, 0xe7, 0xbd, 0x03, 0x51, 0xb6, 0x13, 0xb5, 0xef, 0x3f, 0x50, 0x29, 0xde, 0x4d, 0x5e, 0x21,
+	0x4a, 0xac, 0xc2, 0x98, 0xf4, 0x7e, 0xdf, 0x85, 0xe5, 0xab, 0x88, 0x49, 0xb0, 0x42, 0xfa, 0xe1,
+	0x6e, 0xf2, 0x0a, 0x93, 0x60, 0x85, 0xf6, 0x2d, 0x2c, 0xc4, 0xe5, 0x76, 0xc2, 0x88, 0xf5, 0x64,
+	0x13, 0x27, 0xc6, 0xb7, 0xc7, 0x4f, 0x1c, 0x8d, 0x90, 0x76, 0x45, 0x9a, 0xc5, 0x84, 0x4f, 0x14,
+	0x69, 0x31, 0x42, 0xbd, 0xb8, 0x95, 0x94, 0x7c, 0x92, 0x48, 0x77, 0x28, 0xa1, 0x0b, 0x87, 0x05,
+	0x47, 0x4f, 0x84, 0x23, 0x86, 0x73, 0x17, 0xb7, 0x92, 0x92, 0x4f, 0x82, 0xc3, 0xe2, 0xb1, 0x31,
+	0x9c, 0x7f, 0x24, 0xc1, 0x3c, 0x17, 0xe0, 0xac, 0xdc, 0x4b, 0xc0, 0x7f, 0x31, 0x58, 0xbb, 0xb8,
+	0x33, 0x4d, 0x95, 0xe8, 0xdb, 0x5e, 0x71, 0xde, 0x50, 0x87, 0x10, 0x63, 0xad, 0xf9, 0x0f, 0x24,
+	0x90, 0x39, 0xad, 0x49, 0x02, 0x8e, 0x95, 0x5f, 0x11, 0x64, 0x3e, 0xf2, 0x60, 0x20, 0x32, 0x30,
+	0xbb, 0xb8, 0x99, 0x84, 0x34, 0xea, 0x44, 0x57, 0xc7, 0x34, 0x82, 0x84, 0xef, 0xae, 0xc2, 0xf9,
+	0x8e, 0x39, 0x08, 0x36, 0x78, 0x28, 0x7d, 0x0f, 0xff, 0x5f, 0x52, 0x2f, 0x32, 0x24, 0x5a, 0xfb,
+	0xfe, 0xff, 0x1f, 0x00, 0x42, 0x90, 0x00, 0xd1, 0x2c, 0x7f, 0x00, 0x00,
 }
@@ -84,6 +84,7 @@ type Client struct {
 	httpClient  *http.Client
 	authstring  string
 	accesstoken string
+	bearerToken string
 	userAgent   string
 }
 
@@ -93,6 +94,12 @@ func (c *Client) SetTLS(tlsConfig *tls.Config) {
 	}
 }
 
+// SetBearerToken sets the token sent as a bearer token in the
+// Authorization header of the requests
+func (c *Client) SetBearerToken(token string) {
+	c.bearerToken = token
+}
+
 // Versions send a request at the /versions REST endpoint.
 func (c *Client) Versions(endpoint string) ([]string, error) {
 	versions := []string{}
@@ -102,22 +109,31 @@ func (c *Client) Versions(endpoint string) ([]string, error) {
 
 // Get returns a Request object setup for GET call.
 func (c *Client) Get() *Request {
-	return NewRequest(c.httpClient, c.base, "GET", c.version, c.authstring, c.userAgent)
+	return c.newRequest("GET")
 }
 
 // Post returns a Request object setup for POST call.
 func (c *Client) Post() *Request {
-	return NewRequest(c.httpClient, c.base, "POST", c.version, c.authstring, c.userAgent)
+	return c.newRequest("POST")
 }
 
 // Put returns a Request object setup for PUT call.
 func (c *Client) Put() *Request {
-	return NewRequest(c.httpClient, c.base, "PUT", c.version, c.authstring, c.userAgent)
+	return c.newRequest("PUT")
 }
 
 // Delete returns a Request object setup for DELETE call.
 func (c *Client) Delete() *Request {
-	return NewRequest(c.httpClient, c.base, "DELETE", c.version, c.authstring, c.userAgent)
+	return c.newRequest("DELETE")
+}
+
+// newRequest returns a request sending the credentials of the client
+func (c *Client) newRequest(verb string) *Request {
+	r := NewRequest(c.httpClient, c.base, verb, c.version, c.authstring, c.userAgent)
+	if len(c.bearerToken) != 0 {
+		r.SetHeader("Authorization", "Bearer "+c.bearerToken)
+	}
+	return r
 }
 
 func unix2HTTP(u *url.URL) {
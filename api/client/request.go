@@ -41,6 +41,7 @@ type Request struct {
 type Response struct {
 	status     string
 	statusCode int
+	header     http.Header
 	err        error
 	body       []byte
 }
@@ -272,6 +273,7 @@ func (r *Request) Do() *Response {
 	return &Response{
 		status:     resp.Status,
 		statusCode: resp.StatusCode,
+		header:     resp.Header,
 		body:       body,
 		err:        parseHTTPStatus(resp, body),
 	}
@@ -287,6 +289,11 @@ func (r Response) StatusCode() int {
 	return r.statusCode
 }
 
+// Header returns the value of the HTTP header of the response.
+func (r Response) Header(key string) string {
+	return r.header.Get(key)
+}
+
 // Unmarshal result into obj
 func (r Response) Unmarshal(v interface{}) error {
 	if r.err != nil {
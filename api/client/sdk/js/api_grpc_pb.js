@@ -6,6 +6,28 @@ var api_pb = require('./api_pb.js');
 var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 var google_api_annotations_pb = require('./google/api/annotations_pb.js');
 
+function serialize_openstorage_api_SdkAuditEnumerateRequest(arg) {
+  if (!(arg instanceof api_pb.SdkAuditEnumerateRequest)) {
+    throw new Error('Expected argument of type openstorage.api.SdkAuditEnumerateRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkAuditEnumerateRequest(buffer_arg) {
+  return api_pb.SdkAuditEnumerateRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkAuditEnumerateResponse(arg) {
+  if (!(arg instanceof api_pb.SdkAuditEnumerateResponse)) {
+    throw new Error('Expected argument of type openstorage.api.SdkAuditEnumerateResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkAuditEnumerateResponse(buffer_arg) {
+  return api_pb.SdkAuditEnumerateResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_openstorage_api_SdkCloudBackupCatalogRequest(arg) {
   if (!(arg instanceof api_pb.SdkCloudBackupCatalogRequest)) {
     throw new Error('Expected argument of type openstorage.api.SdkCloudBackupCatalogRequest');
@@ -270,6 +292,28 @@ function deserialize_openstorage_api_SdkClusterAlertEraseResponse(buffer_arg) {
   return api_pb.SdkClusterAlertEraseResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_openstorage_api_SdkClusterAlertWatchRequest(arg) {
+  if (!(arg instanceof api_pb.SdkClusterAlertWatchRequest)) {
+    throw new Error('Expected argument of type openstorage.api.SdkClusterAlertWatchRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkClusterAlertWatchRequest(buffer_arg) {
+  return api_pb.SdkClusterAlertWatchRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkClusterAlertWatchResponse(arg) {
+  if (!(arg instanceof api_pb.SdkClusterAlertWatchResponse)) {
+    throw new Error('Expected argument of type openstorage.api.SdkClusterAlertWatchResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkClusterAlertWatchResponse(buffer_arg) {
+  return api_pb.SdkClusterAlertWatchResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_openstorage_api_SdkClusterEnumerateRequest(arg) {
   if (!(arg instanceof api_pb.SdkClusterEnumerateRequest)) {
     throw new Error('Expected argument of type openstorage.api.SdkClusterEnumerateRequest');
@@ -490,6 +534,94 @@ function deserialize_openstorage_api_SdkCredentialValidateResponse(buffer_arg) {
   return api_pb.SdkCredentialValidateResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_openstorage_api_SdkNodeEnumerateRequest(arg) {
+  if (!(arg instanceof api_pb.SdkNodeEnumerateRequest)) {
+    throw new Error('Expected argument of type openstorage.api.SdkNodeEnumerateRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkNodeEnumerateRequest(buffer_arg) {
+  return api_pb.SdkNodeEnumerateRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkNodeEnumerateResponse(arg) {
+  if (!(arg instanceof api_pb.SdkNodeEnumerateResponse)) {
+    throw new Error('Expected argument of type openstorage.api.SdkNodeEnumerateResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkNodeEnumerateResponse(buffer_arg) {
+  return api_pb.SdkNodeEnumerateResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkNodeInspectCurrentRequest(arg) {
+  if (!(arg instanceof api_pb.SdkNodeInspectCurrentRequest)) {
+    throw new Error('Expected argument of type openstorage.api.SdkNodeInspectCurrentRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkNodeInspectCurrentRequest(buffer_arg) {
+  return api_pb.SdkNodeInspectCurrentRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkNodeInspectCurrentResponse(arg) {
+  if (!(arg instanceof api_pb.SdkNodeInspectCurrentResponse)) {
+    throw new Error('Expected argument of type openstorage.api.SdkNodeInspectCurrentResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkNodeInspectCurrentResponse(buffer_arg) {
+  return api_pb.SdkNodeInspectCurrentResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkNodeInspectRequest(arg) {
+  if (!(arg instanceof api_pb.SdkNodeInspectRequest)) {
+    throw new Error('Expected argument of type openstorage.api.SdkNodeInspectRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkNodeInspectRequest(buffer_arg) {
+  return api_pb.SdkNodeInspectRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkNodeInspectResponse(arg) {
+  if (!(arg instanceof api_pb.SdkNodeInspectResponse)) {
+    throw new Error('Expected argument of type openstorage.api.SdkNodeInspectResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkNodeInspectResponse(buffer_arg) {
+  return api_pb.SdkNodeInspectResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkNodeUpdateLabelsRequest(arg) {
+  if (!(arg instanceof api_pb.SdkNodeUpdateLabelsRequest)) {
+    throw new Error('Expected argument of type openstorage.api.SdkNodeUpdateLabelsRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkNodeUpdateLabelsRequest(buffer_arg) {
+  return api_pb.SdkNodeUpdateLabelsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkNodeUpdateLabelsResponse(arg) {
+  if (!(arg instanceof api_pb.SdkNodeUpdateLabelsResponse)) {
+    throw new Error('Expected argument of type openstorage.api.SdkNodeUpdateLabelsResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkNodeUpdateLabelsResponse(buffer_arg) {
+  return api_pb.SdkNodeUpdateLabelsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_openstorage_api_SdkObjectstoreCreateRequest(arg) {
   if (!(arg instanceof api_pb.SdkObjectstoreCreateRequest)) {
     throw new Error('Expected argument of type openstorage.api.SdkObjectstoreCreateRequest');
@@ -688,6 +820,28 @@ function deserialize_openstorage_api_SdkSchedulePolicyUpdateResponse(buffer_arg)
   return api_pb.SdkSchedulePolicyUpdateResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_openstorage_api_SdkVolumeActiveRequestsRequest(arg) {
+  if (!(arg instanceof api_pb.SdkVolumeActiveRequestsRequest)) {
+    throw new Error('Expected argument of type openstorage.api.SdkVolumeActiveRequestsRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkVolumeActiveRequestsRequest(buffer_arg) {
+  return api_pb.SdkVolumeActiveRequestsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkVolumeActiveRequestsResponse(arg) {
+  if (!(arg instanceof api_pb.SdkVolumeActiveRequestsResponse)) {
+    throw new Error('Expected argument of type openstorage.api.SdkVolumeActiveRequestsResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkVolumeActiveRequestsResponse(buffer_arg) {
+  return api_pb.SdkVolumeActiveRequestsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_openstorage_api_SdkVolumeAttachRequest(arg) {
   if (!(arg instanceof api_pb.SdkVolumeAttachRequest)) {
     throw new Error('Expected argument of type openstorage.api.SdkVolumeAttachRequest');
@@ -710,6 +864,28 @@ function deserialize_openstorage_api_SdkVolumeAttachResponse(buffer_arg) {
   return api_pb.SdkVolumeAttachResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_openstorage_api_SdkVolumeCapacityUsageRequest(arg) {
+  if (!(arg instanceof api_pb.SdkVolumeCapacityUsageRequest)) {
+    throw new Error('Expected argument of type openstorage.api.SdkVolumeCapacityUsageRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkVolumeCapacityUsageRequest(buffer_arg) {
+  return api_pb.SdkVolumeCapacityUsageRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkVolumeCapacityUsageResponse(arg) {
+  if (!(arg instanceof api_pb.SdkVolumeCapacityUsageResponse)) {
+    throw new Error('Expected argument of type openstorage.api.SdkVolumeCapacityUsageResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkVolumeCapacityUsageResponse(buffer_arg) {
+  return api_pb.SdkVolumeCapacityUsageResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_openstorage_api_SdkVolumeCreateFromVolumeIdRequest(arg) {
   if (!(arg instanceof api_pb.SdkVolumeCreateFromVolumeIdRequest)) {
     throw new Error('Expected argument of type openstorage.api.SdkVolumeCreateFromVolumeIdRequest');
@@ -864,6 +1040,28 @@ function deserialize_openstorage_api_SdkVolumeMountResponse(buffer_arg) {
   return api_pb.SdkVolumeMountResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_openstorage_api_SdkVolumeQuiesceRequest(arg) {
+  if (!(arg instanceof api_pb.SdkVolumeQuiesceRequest)) {
+    throw new Error('Expected argument of type openstorage.api.SdkVolumeQuiesceRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkVolumeQuiesceRequest(buffer_arg) {
+  return api_pb.SdkVolumeQuiesceRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkVolumeQuiesceResponse(arg) {
+  if (!(arg instanceof api_pb.SdkVolumeQuiesceResponse)) {
+    throw new Error('Expected argument of type openstorage.api.SdkVolumeQuiesceResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkVolumeQuiesceResponse(buffer_arg) {
+  return api_pb.SdkVolumeQuiesceResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_openstorage_api_SdkVolumeSnapshotCreateRequest(arg) {
   if (!(arg instanceof api_pb.SdkVolumeSnapshotCreateRequest)) {
     throw new Error('Expected argument of type openstorage.api.SdkVolumeSnapshotCreateRequest');
@@ -908,6 +1106,28 @@ function deserialize_openstorage_api_SdkVolumeSnapshotEnumerateResponse(buffer_a
   return api_pb.SdkVolumeSnapshotEnumerateResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_openstorage_api_SdkVolumeSnapshotGroupCreateRequest(arg) {
+  if (!(arg instanceof api_pb.SdkVolumeSnapshotGroupCreateRequest)) {
+    throw new Error('Expected argument of type openstorage.api.SdkVolumeSnapshotGroupCreateRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkVolumeSnapshotGroupCreateRequest(buffer_arg) {
+  return api_pb.SdkVolumeSnapshotGroupCreateRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkVolumeSnapshotGroupCreateResponse(arg) {
+  if (!(arg instanceof api_pb.SdkVolumeSnapshotGroupCreateResponse)) {
+    throw new Error('Expected argument of type openstorage.api.SdkVolumeSnapshotGroupCreateResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkVolumeSnapshotGroupCreateResponse(buffer_arg) {
+  return api_pb.SdkVolumeSnapshotGroupCreateResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_openstorage_api_SdkVolumeSnapshotRestoreRequest(arg) {
   if (!(arg instanceof api_pb.SdkVolumeSnapshotRestoreRequest)) {
     throw new Error('Expected argument of type openstorage.api.SdkVolumeSnapshotRestoreRequest');
@@ -930,6 +1150,28 @@ function deserialize_openstorage_api_SdkVolumeSnapshotRestoreResponse(buffer_arg
   return api_pb.SdkVolumeSnapshotRestoreResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_openstorage_api_SdkVolumeStatsRequest(arg) {
+  if (!(arg instanceof api_pb.SdkVolumeStatsRequest)) {
+    throw new Error('Expected argument of type openstorage.api.SdkVolumeStatsRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkVolumeStatsRequest(buffer_arg) {
+  return api_pb.SdkVolumeStatsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkVolumeStatsResponse(arg) {
+  if (!(arg instanceof api_pb.SdkVolumeStatsResponse)) {
+    throw new Error('Expected argument of type openstorage.api.SdkVolumeStatsResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkVolumeStatsResponse(buffer_arg) {
+  return api_pb.SdkVolumeStatsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_openstorage_api_SdkVolumeUnmountRequest(arg) {
   if (!(arg instanceof api_pb.SdkVolumeUnmountRequest)) {
     throw new Error('Expected argument of type openstorage.api.SdkVolumeUnmountRequest');
@@ -952,6 +1194,50 @@ function deserialize_openstorage_api_SdkVolumeUnmountResponse(buffer_arg) {
   return api_pb.SdkVolumeUnmountResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_openstorage_api_SdkVolumeUnquiesceRequest(arg) {
+  if (!(arg instanceof api_pb.SdkVolumeUnquiesceRequest)) {
+    throw new Error('Expected argument of type openstorage.api.SdkVolumeUnquiesceRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkVolumeUnquiesceRequest(buffer_arg) {
+  return api_pb.SdkVolumeUnquiesceRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkVolumeUnquiesceResponse(arg) {
+  if (!(arg instanceof api_pb.SdkVolumeUnquiesceResponse)) {
+    throw new Error('Expected argument of type openstorage.api.SdkVolumeUnquiesceResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkVolumeUnquiesceResponse(buffer_arg) {
+  return api_pb.SdkVolumeUnquiesceResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkVolumeUpdateRequest(arg) {
+  if (!(arg instanceof api_pb.SdkVolumeUpdateRequest)) {
+    throw new Error('Expected argument of type openstorage.api.SdkVolumeUpdateRequest');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkVolumeUpdateRequest(buffer_arg) {
+  return api_pb.SdkVolumeUpdateRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_openstorage_api_SdkVolumeUpdateResponse(arg) {
+  if (!(arg instanceof api_pb.SdkVolumeUpdateResponse)) {
+    throw new Error('Expected argument of type openstorage.api.SdkVolumeUpdateResponse');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_openstorage_api_SdkVolumeUpdateResponse(buffer_arg) {
+  return api_pb.SdkVolumeUpdateResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 
 var OpenStorageClusterService = exports.OpenStorageClusterService = {
   // Enumerate lists all the nodes in the cluster.
@@ -1014,9 +1300,73 @@ var OpenStorageClusterService = exports.OpenStorageClusterService = {
     responseSerialize: serialize_openstorage_api_SdkClusterAlertEraseResponse,
     responseDeserialize: deserialize_openstorage_api_SdkClusterAlertEraseResponse,
   },
+  // Watch for alerts being created, updated or deleted in the storage cluster
+  alertWatch: {
+    path: '/openstorage.api.OpenStorageCluster/AlertWatch',
+    requestStream: false,
+    responseStream: true,
+    requestType: api_pb.SdkClusterAlertWatchRequest,
+    responseType: api_pb.SdkClusterAlertWatchResponse,
+    requestSerialize: serialize_openstorage_api_SdkClusterAlertWatchRequest,
+    requestDeserialize: deserialize_openstorage_api_SdkClusterAlertWatchRequest,
+    responseSerialize: serialize_openstorage_api_SdkClusterAlertWatchResponse,
+    responseDeserialize: deserialize_openstorage_api_SdkClusterAlertWatchResponse,
+  },
 };
 
 exports.OpenStorageClusterClient = grpc.makeGenericClientConstructor(OpenStorageClusterService);
+var OpenStorageNodeService = exports.OpenStorageNodeService = {
+  // Enumerate returns the ids of all the nodes in the cluster
+  enumerate: {
+    path: '/openstorage.api.OpenStorageNode/Enumerate',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_pb.SdkNodeEnumerateRequest,
+    responseType: api_pb.SdkNodeEnumerateResponse,
+    requestSerialize: serialize_openstorage_api_SdkNodeEnumerateRequest,
+    requestDeserialize: deserialize_openstorage_api_SdkNodeEnumerateRequest,
+    responseSerialize: serialize_openstorage_api_SdkNodeEnumerateResponse,
+    responseDeserialize: deserialize_openstorage_api_SdkNodeEnumerateResponse,
+  },
+  // Inspect returns information about the specified node
+  inspect: {
+    path: '/openstorage.api.OpenStorageNode/Inspect',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_pb.SdkNodeInspectRequest,
+    responseType: api_pb.SdkNodeInspectResponse,
+    requestSerialize: serialize_openstorage_api_SdkNodeInspectRequest,
+    requestDeserialize: deserialize_openstorage_api_SdkNodeInspectRequest,
+    responseSerialize: serialize_openstorage_api_SdkNodeInspectResponse,
+    responseDeserialize: deserialize_openstorage_api_SdkNodeInspectResponse,
+  },
+  // InspectCurrent returns information about the node servicing the request
+  inspectCurrent: {
+    path: '/openstorage.api.OpenStorageNode/InspectCurrent',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_pb.SdkNodeInspectCurrentRequest,
+    responseType: api_pb.SdkNodeInspectCurrentResponse,
+    requestSerialize: serialize_openstorage_api_SdkNodeInspectCurrentRequest,
+    requestDeserialize: deserialize_openstorage_api_SdkNodeInspectCurrentRequest,
+    responseSerialize: serialize_openstorage_api_SdkNodeInspectCurrentResponse,
+    responseDeserialize: deserialize_openstorage_api_SdkNodeInspectCurrentResponse,
+  },
+  // UpdateLabels adds or changes the labels of the node servicing the request
+  updateLabels: {
+    path: '/openstorage.api.OpenStorageNode/UpdateLabels',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_pb.SdkNodeUpdateLabelsRequest,
+    responseType: api_pb.SdkNodeUpdateLabelsResponse,
+    requestSerialize: serialize_openstorage_api_SdkNodeUpdateLabelsRequest,
+    requestDeserialize: deserialize_openstorage_api_SdkNodeUpdateLabelsRequest,
+    responseSerialize: serialize_openstorage_api_SdkNodeUpdateLabelsResponse,
+    responseDeserialize: deserialize_openstorage_api_SdkNodeUpdateLabelsResponse,
+  },
+};
+
+exports.OpenStorageNodeClient = grpc.makeGenericClientConstructor(OpenStorageNodeService);
 var OpenStorageVolumeService = exports.OpenStorageVolumeService = {
   // Creates a new volume
   create: {
@@ -1078,6 +1428,54 @@ var OpenStorageVolumeService = exports.OpenStorageVolumeService = {
     responseSerialize: serialize_openstorage_api_SdkVolumeEnumerateResponse,
     responseDeserialize: deserialize_openstorage_api_SdkVolumeEnumerateResponse,
   },
+  // Update the labels and specification of an existing volume
+  update: {
+    path: '/openstorage.api.OpenStorageVolume/Update',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_pb.SdkVolumeUpdateRequest,
+    responseType: api_pb.SdkVolumeUpdateResponse,
+    requestSerialize: serialize_openstorage_api_SdkVolumeUpdateRequest,
+    requestDeserialize: deserialize_openstorage_api_SdkVolumeUpdateRequest,
+    responseSerialize: serialize_openstorage_api_SdkVolumeUpdateResponse,
+    responseDeserialize: deserialize_openstorage_api_SdkVolumeUpdateResponse,
+  },
+  // Get the IO statistics of a volume
+  stats: {
+    path: '/openstorage.api.OpenStorageVolume/Stats',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_pb.SdkVolumeStatsRequest,
+    responseType: api_pb.SdkVolumeStatsResponse,
+    requestSerialize: serialize_openstorage_api_SdkVolumeStatsRequest,
+    requestDeserialize: deserialize_openstorage_api_SdkVolumeStatsRequest,
+    responseSerialize: serialize_openstorage_api_SdkVolumeStatsResponse,
+    responseDeserialize: deserialize_openstorage_api_SdkVolumeStatsResponse,
+  },
+  // Get the currently used capacity of a volume
+  capacityUsage: {
+    path: '/openstorage.api.OpenStorageVolume/CapacityUsage',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_pb.SdkVolumeCapacityUsageRequest,
+    responseType: api_pb.SdkVolumeCapacityUsageResponse,
+    requestSerialize: serialize_openstorage_api_SdkVolumeCapacityUsageRequest,
+    requestDeserialize: deserialize_openstorage_api_SdkVolumeCapacityUsageRequest,
+    responseSerialize: serialize_openstorage_api_SdkVolumeCapacityUsageResponse,
+    responseDeserialize: deserialize_openstorage_api_SdkVolumeCapacityUsageResponse,
+  },
+  // Get the IO requests currently in progress in the volume driver
+  activeRequests: {
+    path: '/openstorage.api.OpenStorageVolume/ActiveRequests',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_pb.SdkVolumeActiveRequestsRequest,
+    responseType: api_pb.SdkVolumeActiveRequestsResponse,
+    requestSerialize: serialize_openstorage_api_SdkVolumeActiveRequestsRequest,
+    requestDeserialize: deserialize_openstorage_api_SdkVolumeActiveRequestsRequest,
+    responseSerialize: serialize_openstorage_api_SdkVolumeActiveRequestsResponse,
+    responseDeserialize: deserialize_openstorage_api_SdkVolumeActiveRequestsResponse,
+  },
   // Create a snapshot of a volume. This creates an immutable (read-only),
   // point-in-time snapshot of a volume.
   snapshotCreate: {
@@ -1115,6 +1513,42 @@ var OpenStorageVolumeService = exports.OpenStorageVolumeService = {
     responseSerialize: serialize_openstorage_api_SdkVolumeSnapshotEnumerateResponse,
     responseDeserialize: deserialize_openstorage_api_SdkVolumeSnapshotEnumerateResponse,
   },
+  // Create a consistent snapshot of all the volumes in a group
+  snapshotGroupCreate: {
+    path: '/openstorage.api.OpenStorageVolume/SnapshotGroupCreate',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_pb.SdkVolumeSnapshotGroupCreateRequest,
+    responseType: api_pb.SdkVolumeSnapshotGroupCreateResponse,
+    requestSerialize: serialize_openstorage_api_SdkVolumeSnapshotGroupCreateRequest,
+    requestDeserialize: deserialize_openstorage_api_SdkVolumeSnapshotGroupCreateRequest,
+    responseSerialize: serialize_openstorage_api_SdkVolumeSnapshotGroupCreateResponse,
+    responseDeserialize: deserialize_openstorage_api_SdkVolumeSnapshotGroupCreateResponse,
+  },
+  // Freeze the filesystem of a mounted volume
+  quiesce: {
+    path: '/openstorage.api.OpenStorageVolume/Quiesce',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_pb.SdkVolumeQuiesceRequest,
+    responseType: api_pb.SdkVolumeQuiesceResponse,
+    requestSerialize: serialize_openstorage_api_SdkVolumeQuiesceRequest,
+    requestDeserialize: deserialize_openstorage_api_SdkVolumeQuiesceRequest,
+    responseSerialize: serialize_openstorage_api_SdkVolumeQuiesceResponse,
+    responseDeserialize: deserialize_openstorage_api_SdkVolumeQuiesceResponse,
+  },
+  // Unfreeze the filesystem of a quiesced volume
+  unquiesce: {
+    path: '/openstorage.api.OpenStorageVolume/Unquiesce',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_pb.SdkVolumeUnquiesceRequest,
+    responseType: api_pb.SdkVolumeUnquiesceResponse,
+    requestSerialize: serialize_openstorage_api_SdkVolumeUnquiesceRequest,
+    requestDeserialize: deserialize_openstorage_api_SdkVolumeUnquiesceRequest,
+    responseSerialize: serialize_openstorage_api_SdkVolumeUnquiesceResponse,
+    responseDeserialize: deserialize_openstorage_api_SdkVolumeUnquiesceResponse,
+  },
   // Attach device to host
   attach: {
     path: '/openstorage.api.OpenStorageVolume/Attach',
@@ -1499,3 +1933,19 @@ var OpenStorageCloudBackupService = exports.OpenStorageCloudBackupService = {
 };
 
 exports.OpenStorageCloudBackupClient = grpc.makeGenericClientConstructor(OpenStorageCloudBackupService);
+var OpenStorageAuditService = exports.OpenStorageAuditService = {
+  // Enumerate returns the audit events recorded in the cluster
+  enumerate: {
+    path: '/openstorage.api.OpenStorageAudit/Enumerate',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_pb.SdkAuditEnumerateRequest,
+    responseType: api_pb.SdkAuditEnumerateResponse,
+    requestSerialize: serialize_openstorage_api_SdkAuditEnumerateRequest,
+    requestDeserialize: deserialize_openstorage_api_SdkAuditEnumerateRequest,
+    responseSerialize: serialize_openstorage_api_SdkAuditEnumerateResponse,
+    responseDeserialize: deserialize_openstorage_api_SdkAuditEnumerateResponse,
+  },
+};
+
+exports.OpenStorageAuditClient = grpc.makeGenericClientConstructor(OpenStorageAuditService);
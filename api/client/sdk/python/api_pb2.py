@@ -22,7 +22,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   name='api.proto',
   package='openstorage.api',
   syntax='proto3',
-  serialized_pb=_b('\n\tapi.proto\x12\x0fopenstorage.api\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xa3\x02\n\x0fStorageResource\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04path\x18\x02 \x01(\t\x12.\n\x06medium\x18\x03 \x01(\x0e\x32\x1e.openstorage.api.StorageMedium\x12\x0e\n\x06online\x18\x04 \x01(\x08\x12\x0c\n\x04iops\x18\x05 \x01(\x04\x12\x11\n\tseq_write\x18\x06 \x01(\x01\x12\x10\n\x08seq_read\x18\x07 \x01(\x01\x12\x0e\n\x06randRW\x18\x08 \x01(\x01\x12\x0c\n\x04size\x18\t \x01(\x04\x12\x0c\n\x04used\x18\n \x01(\x04\x12\x16\n\x0erotation_speed\x18\x0b \x01(\t\x12-\n\tlast_scan\x18\x0c \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08metadata\x18\r \x01(\x08\"\x8d\x02\n\x0bStoragePool\x12\n\n\x02ID\x18\x01 \x01(\x05\x12%\n\x03\x43os\x18\x02 \x01(\x0e\x32\x18.openstorage.api.CosType\x12.\n\x06Medium\x18\x03 \x01(\x0e\x32\x1e.openstorage.api.StorageMedium\x12\x11\n\tRaidLevel\x18\x04 \x01(\t\x12\x11\n\tTotalSize\x18\x07 \x01(\x04\x12\x0c\n\x04Used\x18\x08 \x01(\x04\x12\x38\n\x06labels\x18\t \x03(\x0b\x32(.openstorage.api.StoragePool.LabelsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x9b\x01\n\rVolumeLocator\x12\x0c\n\x04name\x18\x01 \x01(\t\x12G\n\rvolume_labels\x18\x02 \x03(\x0b\x32\x30.openstorage.api.VolumeLocator.VolumeLabelsEntry\x1a\x33\n\x11VolumeLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"&\n\x06Source\x12\x0e\n\x06parent\x18\x01 \x01(\t\x12\x0c\n\x04seed\x18\x02 \x01(\t\"\x13\n\x05Group\x12\n\n\x02id\x18\x01 \x01(\t\"\xbf\x05\n\nVolumeSpec\x12\x11\n\tephemeral\x18\x01 \x01(\x08\x12\x0c\n\x04size\x18\x02 \x01(\x04\x12\'\n\x06\x66ormat\x18\x03 \x01(\x0e\x32\x17.openstorage.api.FSType\x12\x12\n\nblock_size\x18\x04 \x01(\x03\x12\x10\n\x08ha_level\x18\x05 \x01(\x03\x12%\n\x03\x63os\x18\x06 \x01(\x0e\x32\x18.openstorage.api.CosType\x12.\n\nio_profile\x18\x07 \x01(\x0e\x32\x1a.openstorage.api.IoProfile\x12\x0e\n\x06\x64\x65\x64upe\x18\x08 \x01(\x08\x12\x19\n\x11snapshot_interval\x18\t \x01(\r\x12\x44\n\rvolume_labels\x18\n \x03(\x0b\x32-.openstorage.api.VolumeSpec.VolumeLabelsEntry\x12\x0e\n\x06shared\x18\x0b \x01(\x08\x12\x30\n\x0breplica_set\x18\x0c \x01(\x0b\x32\x1b.openstorage.api.ReplicaSet\x12\x19\n\x11\x61ggregation_level\x18\r \x01(\r\x12\x11\n\tencrypted\x18\x0e \x01(\x08\x12\x12\n\npassphrase\x18\x0f \x01(\t\x12\x19\n\x11snapshot_schedule\x18\x10 \x01(\t\x12\r\n\x05scale\x18\x11 \x01(\r\x12\x0e\n\x06sticky\x18\x12 \x01(\x08\x12%\n\x05group\x18\x15 \x01(\x0b\x32\x16.openstorage.api.Group\x12\x16\n\x0egroup_enforced\x18\x16 \x01(\x08\x12\x12\n\ncompressed\x18\x17 \x01(\x08\x12\x10\n\x08\x63\x61scaded\x18\x18 \x01(\x08\x12\x0f\n\x07journal\x18\x19 \x01(\x08\x12\x10\n\x08sharedv4\x18\x1a \x01(\x08\x1a\x33\n\x11VolumeLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x1b\n\nReplicaSet\x12\r\n\x05nodes\x18\x01 \x03(\t\"\x91\x01\n\x0fRuntimeStateMap\x12I\n\rruntime_state\x18\x01 \x03(\x0b\x32\x32.openstorage.api.RuntimeStateMap.RuntimeStateEntry\x1a\x33\n\x11RuntimeStateEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xf8\x06\n\x06Volume\x12\n\n\x02id\x18\x01 \x01(\t\x12\'\n\x06source\x18\x02 \x01(\x0b\x32\x17.openstorage.api.Source\x12%\n\x05group\x18\x03 \x01(\x0b\x32\x16.openstorage.api.Group\x12\x10\n\x08readonly\x18\x04 \x01(\x08\x12/\n\x07locator\x18\x05 \x01(\x0b\x32\x1e.openstorage.api.VolumeLocator\x12)\n\x05\x63time\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12)\n\x04spec\x18\x07 \x01(\x0b\x32\x1b.openstorage.api.VolumeSpec\x12\r\n\x05usage\x18\x08 \x01(\x04\x12-\n\tlast_scan\x18\t \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x06\x66ormat\x18\n \x01(\x0e\x32\x17.openstorage.api.FSType\x12-\n\x06status\x18\x0b \x01(\x0e\x32\x1d.openstorage.api.VolumeStatus\x12+\n\x05state\x18\x0c \x01(\x0e\x32\x1c.openstorage.api.VolumeState\x12\x13\n\x0b\x61ttached_on\x18\r \x01(\t\x12\x34\n\x0e\x61ttached_state\x18\x0e \x01(\x0e\x32\x1c.openstorage.api.AttachState\x12\x13\n\x0b\x64\x65vice_path\x18\x0f \x01(\t\x12\x1a\n\x12secure_device_path\x18\x10 \x01(\t\x12\x13\n\x0b\x61ttach_path\x18\x11 \x03(\t\x12<\n\x0b\x61ttach_info\x18\x12 \x03(\x0b\x32\'.openstorage.api.Volume.AttachInfoEntry\x12\x31\n\x0creplica_sets\x18\x13 \x03(\x0b\x32\x1b.openstorage.api.ReplicaSet\x12\x37\n\rruntime_state\x18\x14 \x03(\x0b\x32 .openstorage.api.RuntimeStateMap\x12\r\n\x05\x65rror\x18\x15 \x01(\t\x12\x39\n\x10volume_consumers\x18\x16 \x03(\x0b\x32\x1f.openstorage.api.VolumeConsumer\x1a\x31\n\x0f\x41ttachInfoEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xbf\x01\n\x05Stats\x12\r\n\x05reads\x18\x01 \x01(\x04\x12\x0f\n\x07read_ms\x18\x02 \x01(\x04\x12\x12\n\nread_bytes\x18\x03 \x01(\x04\x12\x0e\n\x06writes\x18\x04 \x01(\x04\x12\x10\n\x08write_ms\x18\x05 \x01(\x04\x12\x13\n\x0bwrite_bytes\x18\x06 \x01(\x04\x12\x13\n\x0bio_progress\x18\x07 \x01(\x04\x12\r\n\x05io_ms\x18\x08 \x01(\x04\x12\x12\n\nbytes_used\x18\t \x01(\x04\x12\x13\n\x0binterval_ms\x18\n \x01(\x04\"\x90\x02\n\x05\x41lert\x12\n\n\x02id\x18\x01 \x01(\x03\x12/\n\x08severity\x18\x02 \x01(\x0e\x32\x1d.openstorage.api.SeverityType\x12\x12\n\nalert_type\x18\x03 \x01(\x03\x12\x0f\n\x07message\x18\x04 \x01(\t\x12-\n\ttimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x13\n\x0bresource_id\x18\x06 \x01(\t\x12/\n\x08resource\x18\x07 \x01(\x0e\x32\x1d.openstorage.api.ResourceType\x12\x0f\n\x07\x63leared\x18\x08 \x01(\x08\x12\x0b\n\x03ttl\x18\t \x01(\x04\x12\x12\n\nunique_tag\x18\n \x01(\t\"/\n\x06\x41lerts\x12%\n\x05\x61lert\x18\x01 \x03(\x0b\x32\x16.openstorage.api.Alert\"\xdd\x01\n\x0fObjectstoreInfo\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x11\n\tvolume_id\x18\x02 \x01(\t\x12\x0f\n\x07\x65nabled\x18\x03 \x01(\x08\x12\x0e\n\x06status\x18\x04 \x01(\t\x12\x0e\n\x06\x61\x63tion\x18\x05 \x01(\x03\x12\x12\n\naccess_key\x18\x06 \x01(\t\x12\x12\n\nsecret_key\x18\x07 \x01(\t\x12\x11\n\tendpoints\x18\x08 \x03(\t\x12\x18\n\x10\x63urrent_endPoint\x18\t \x01(\t\x12\x13\n\x0b\x61\x63\x63\x65ss_port\x18\n \x01(\x03\x12\x0e\n\x06region\x18\x0b \x01(\t\"\x9a\x01\n\x13VolumeCreateRequest\x12/\n\x07locator\x18\x01 \x01(\x0b\x32\x1e.openstorage.api.VolumeLocator\x12\'\n\x06source\x18\x02 \x01(\x0b\x32\x17.openstorage.api.Source\x12)\n\x04spec\x18\x03 \x01(\x0b\x32\x1b.openstorage.api.VolumeSpec\"\x1f\n\x0eVolumeResponse\x12\r\n\x05\x65rror\x18\x01 \x01(\t\"\\\n\x14VolumeCreateResponse\x12\n\n\x02id\x18\x01 \x01(\t\x12\x38\n\x0fvolume_response\x18\x02 \x01(\x0b\x32\x1f.openstorage.api.VolumeResponse\"\xa3\x01\n\x11VolumeStateAction\x12\x32\n\x06\x61ttach\x18\x01 \x01(\x0e\x32\".openstorage.api.VolumeActionParam\x12\x31\n\x05mount\x18\x02 \x01(\x0e\x32\".openstorage.api.VolumeActionParam\x12\x12\n\nmount_path\x18\x03 \x01(\t\x12\x13\n\x0b\x64\x65vice_path\x18\x04 \x01(\t\"\x93\x02\n\x10VolumeSetRequest\x12/\n\x07locator\x18\x01 \x01(\x0b\x32\x1e.openstorage.api.VolumeLocator\x12)\n\x04spec\x18\x02 \x01(\x0b\x32\x1b.openstorage.api.VolumeSpec\x12\x32\n\x06\x61\x63tion\x18\x03 \x01(\x0b\x32\".openstorage.api.VolumeStateAction\x12?\n\x07options\x18\x04 \x03(\x0b\x32..openstorage.api.VolumeSetRequest.OptionsEntry\x1a.\n\x0cOptionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"v\n\x11VolumeSetResponse\x12\'\n\x06volume\x18\x01 \x01(\x0b\x32\x17.openstorage.api.Volume\x12\x38\n\x0fvolume_response\x18\x02 \x01(\x0b\x32\x1f.openstorage.api.VolumeResponse\"b\n\x11SnapCreateRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12/\n\x07locator\x18\x02 \x01(\x0b\x32\x1e.openstorage.api.VolumeLocator\x12\x10\n\x08readonly\x18\x03 \x01(\x08\"[\n\x12SnapCreateResponse\x12\x45\n\x16volume_create_response\x18\x01 \x01(\x0b\x32%.openstorage.api.VolumeCreateResponse\"[\n\nVolumeInfo\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x0c\n\x04path\x18\x02 \x01(\t\x12,\n\x07storage\x18\x03 \x01(\x0b\x32\x1b.openstorage.api.VolumeSpec\"x\n\x0eVolumeConsumer\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12\x0f\n\x07node_id\x18\x04 \x01(\t\x12\x12\n\nowner_name\x18\x05 \x01(\t\x12\x12\n\nowner_type\x18\x06 \x01(\t\"X\n\x12GraphDriverChanges\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x34\n\x04kind\x18\x02 \x01(\x0e\x32&.openstorage.api.GraphDriverChangeType\" \n\x0f\x43lusterResponse\x12\r\n\x05\x65rror\x18\x01 \x01(\t\"\x80\x01\n\rActiveRequest\x12>\n\x08ReqestKV\x18\x01 \x03(\x0b\x32,.openstorage.api.ActiveRequest.ReqestKVEntry\x1a/\n\rReqestKVEntry\x12\x0b\n\x03key\x18\x01 \x01(\x03\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"]\n\x0e\x41\x63tiveRequests\x12\x14\n\x0cRequestCount\x18\x01 \x01(\x03\x12\x35\n\rActiveRequest\x18\x02 \x03(\x0b\x32\x1e.openstorage.api.ActiveRequest\"\x98\x01\n\x16GroupSnapCreateRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x43\n\x06Labels\x18\x02 \x03(\x0b\x32\x33.openstorage.api.GroupSnapCreateRequest.LabelsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xcb\x01\n\x17GroupSnapCreateResponse\x12J\n\tsnapshots\x18\x01 \x03(\x0b\x32\x37.openstorage.api.GroupSnapCreateResponse.SnapshotsEntry\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x1aU\n\x0eSnapshotsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.openstorage.api.SnapCreateResponse:\x02\x38\x01\"\xf7\x03\n\x0bStorageNode\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03\x63pu\x18\x02 \x01(\x01\x12\x11\n\tmem_total\x18\x03 \x01(\x04\x12\x10\n\x08mem_used\x18\x04 \x01(\x04\x12\x10\n\x08mem_free\x18\x05 \x01(\x04\x12\x10\n\x08\x61vg_load\x18\x06 \x01(\x03\x12\'\n\x06status\x18\x07 \x01(\x0e\x32\x17.openstorage.api.Status\x12\x36\n\x05\x64isks\x18\t \x03(\x0b\x32\'.openstorage.api.StorageNode.DisksEntry\x12+\n\x05pools\x18\n \x03(\x0b\x32\x1c.openstorage.api.StoragePool\x12\x0f\n\x07mgmt_ip\x18\x0b \x01(\t\x12\x0f\n\x07\x64\x61ta_ip\x18\x0c \x01(\t\x12\x10\n\x08hostname\x18\x0f \x01(\t\x12\x41\n\x0bnode_labels\x18\x10 \x03(\x0b\x32,.openstorage.api.StorageNode.NodeLabelsEntry\x1aN\n\nDisksEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12/\n\x05value\x18\x02 \x01(\x0b\x32 .openstorage.api.StorageResource:\x02\x38\x01\x1a\x31\n\x0fNodeLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x83\x01\n\x0eStorageCluster\x12\'\n\x06status\x18\x01 \x01(\x0e\x32\x17.openstorage.api.Status\x12\n\n\x02id\x18\x02 \x01(\t\x12\x0f\n\x07node_id\x18\x03 \x01(\t\x12+\n\x05nodes\x18\x04 \x03(\x0b\x32\x1c.openstorage.api.StorageNode\"\\\n\x1eSdkSchedulePolicyCreateRequest\x12:\n\x0eSchedulePolicy\x18\x01 \x01(\x0b\x32\".openstorage.api.SdkSchedulePolicy\"!\n\x1fSdkSchedulePolicyCreateResponse\"\\\n\x1eSdkSchedulePolicyUpdateRequest\x12:\n\x0eSchedulePolicy\x18\x01 \x01(\x0b\x32\".openstorage.api.SdkSchedulePolicy\"!\n\x1fSdkSchedulePolicyUpdateResponse\"#\n!SdkSchedulePolicyEnumerateRequest\"Z\n\"SdkSchedulePolicyEnumerateResponse\x12\x34\n\x08policies\x18\x01 \x03(\x0b\x32\".openstorage.api.SdkSchedulePolicy\"/\n\x1fSdkSchedulePolicyInspectRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"V\n SdkSchedulePolicyInspectResponse\x12\x32\n\x06policy\x18\x01 \x01(\x0b\x32\".openstorage.api.SdkSchedulePolicy\".\n\x1eSdkSchedulePolicyDeleteRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"!\n\x1fSdkSchedulePolicyDeleteResponse\"3\n\x11SdkSchedulePolicy\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x10\n\x08schedule\x18\x02 \x01(\t\"W\n\x1fSdkCredentialCreateAzureRequest\x12\x34\n\ncredential\x18\x01 \x01(\x0b\x32 .openstorage.api.AzureCredential\"9\n SdkCredentialCreateAzureResponse\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"Y\n SdkCredentialCreateGoogleRequest\x12\x35\n\ncredential\x18\x01 \x01(\x0b\x32!.openstorage.api.GoogleCredential\":\n!SdkCredentialCreateGoogleResponse\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"R\n\x1dSdkCredentialCreateAWSRequest\x12\x31\n\ncredential\x18\x01 \x01(\x0b\x32\x1d.openstorage.api.S3Credential\"7\n\x1eSdkCredentialCreateAWSResponse\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"o\n\x0cS3Credential\x12\x15\n\rcredential_id\x18\x01 \x01(\t\x12\x12\n\naccess_key\x18\x02 \x01(\t\x12\x12\n\nsecret_key\x18\x03 \x01(\t\x12\x10\n\x08\x65ndpoint\x18\x04 \x01(\t\x12\x0e\n\x06region\x18\x05 \x01(\t\"S\n\x0f\x41zureCredential\x12\x15\n\rcredential_id\x18\x01 \x01(\t\x12\x14\n\x0c\x61\x63\x63ount_name\x18\x02 \x01(\t\x12\x13\n\x0b\x61\x63\x63ount_key\x18\x03 \x01(\t\"O\n\x10GoogleCredential\x12\x15\n\rcredential_id\x18\x01 \x01(\t\x12\x12\n\nproject_id\x18\x02 \x01(\t\x12\x10\n\x08json_key\x18\x03 \x01(\t\"9\n SdkCredentialEnumerateAWSRequest\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"V\n!SdkCredentialEnumerateAWSResponse\x12\x31\n\ncredential\x18\x01 \x03(\x0b\x32\x1d.openstorage.api.S3Credential\";\n\"SdkCredentialEnumerateAzureRequest\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"[\n#SdkCredentialEnumerateAzureResponse\x12\x34\n\ncredential\x18\x01 \x03(\x0b\x32 .openstorage.api.AzureCredential\"<\n#SdkCredentialEnumerateGoogleRequest\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"]\n$SdkCredentialEnumerateGoogleResponse\x12\x35\n\ncredential\x18\x01 \x03(\x0b\x32!.openstorage.api.GoogleCredential\"3\n\x1aSdkCredentialDeleteRequest\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"\x1d\n\x1bSdkCredentialDeleteResponse\"5\n\x1cSdkCredentialValidateRequest\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"\x1f\n\x1dSdkCredentialValidateResponse\"\xb4\x01\n\x15SdkVolumeMountRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x12\n\nmount_path\x18\x02 \x01(\t\x12\x44\n\x07options\x18\x03 \x03(\x0b\x32\x33.openstorage.api.SdkVolumeMountRequest.OptionsEntry\x1a.\n\x0cOptionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x18\n\x16SdkVolumeMountResponse\"\xb8\x01\n\x17SdkVolumeUnmountRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x12\n\nmount_path\x18\x02 \x01(\t\x12\x46\n\x07options\x18\x03 \x03(\x0b\x32\x35.openstorage.api.SdkVolumeUnmountRequest.OptionsEntry\x1a.\n\x0cOptionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x1a\n\x18SdkVolumeUnmountResponse\"\xa2\x01\n\x16SdkVolumeAttachRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x45\n\x07options\x18\x02 \x03(\x0b\x32\x34.openstorage.api.SdkVolumeAttachRequest.OptionsEntry\x1a.\n\x0cOptionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\".\n\x17SdkVolumeAttachResponse\x12\x13\n\x0b\x64\x65vice_path\x18\x01 \x01(\t\"+\n\x16SdkVolumeDetachRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\"\x19\n\x17SdkVolumeDetachResponse\"Q\n\x16SdkVolumeCreateRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12)\n\x04spec\x18\x02 \x01(\x0b\x32\x1b.openstorage.api.VolumeSpec\",\n\x17SdkVolumeCreateResponse\x12\x11\n\tvolume_id\x18\x01 \x01(\t\"p\n\"SdkVolumeCreateFromVolumeIdRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tparent_id\x18\x02 \x01(\t\x12)\n\x04spec\x18\x03 \x01(\x0b\x32\x1b.openstorage.api.VolumeSpec\"8\n#SdkVolumeCreateFromVolumeIdResponse\x12\x11\n\tvolume_id\x18\x01 \x01(\t\"+\n\x16SdkVolumeDeleteRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\"\x19\n\x17SdkVolumeDeleteResponse\",\n\x17SdkVolumeInspectRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\"C\n\x18SdkVolumeInspectResponse\x12\'\n\x06volume\x18\x01 \x01(\x0b\x32\x17.openstorage.api.Volume\"L\n\x19SdkVolumeEnumerateRequest\x12/\n\x07locator\x18\x01 \x01(\x0b\x32\x1e.openstorage.api.VolumeLocator\"F\n\x1aSdkVolumeEnumerateResponse\x12(\n\x07volumes\x18\x01 \x03(\x0b\x32\x17.openstorage.api.Volume\"\xaf\x01\n\x1eSdkVolumeSnapshotCreateRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12K\n\x06labels\x18\x02 \x03(\x0b\x32;.openstorage.api.SdkVolumeSnapshotCreateRequest.LabelsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"6\n\x1fSdkVolumeSnapshotCreateResponse\x12\x13\n\x0bsnapshot_id\x18\x01 \x01(\t\"I\n\x1fSdkVolumeSnapshotRestoreRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x13\n\x0bsnapshot_id\x18\x02 \x01(\t\"\"\n SdkVolumeSnapshotRestoreResponse\"\xb5\x01\n!SdkVolumeSnapshotEnumerateRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12N\n\x06labels\x18\x02 \x03(\x0b\x32>.openstorage.api.SdkVolumeSnapshotEnumerateRequest.LabelsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"P\n\"SdkVolumeSnapshotEnumerateResponse\x12*\n\tsnapshots\x18\x01 \x03(\x0b\x32\x17.openstorage.api.Volume\"\x1c\n\x1aSdkClusterEnumerateRequest\"O\n\x1bSdkClusterEnumerateResponse\x12\x30\n\x07\x63luster\x18\x01 \x01(\x0b\x32\x1f.openstorage.api.StorageCluster\"+\n\x18SdkClusterInspectRequest\x12\x0f\n\x07node_id\x18\x01 \x01(\t\"G\n\x19SdkClusterInspectResponse\x12*\n\x04node\x18\x01 \x01(\x0b\x32\x1c.openstorage.api.StorageNode\"\xb0\x01\n\x1fSdkClusterAlertEnumerateRequest\x12.\n\ntime_start\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08time_end\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12/\n\x08resource\x18\x03 \x01(\x0e\x32\x1d.openstorage.api.ResourceType\"K\n SdkClusterAlertEnumerateResponse\x12\'\n\x06\x61lerts\x18\x01 \x01(\x0b\x32\x17.openstorage.api.Alerts\"`\n\x1bSdkClusterAlertClearRequest\x12/\n\x08resource\x18\x01 \x01(\x0e\x32\x1d.openstorage.api.ResourceType\x12\x10\n\x08\x61lert_id\x18\x02 \x01(\x03\"\x1e\n\x1cSdkClusterAlertClearResponse\"`\n\x1bSdkClusterAlertEraseRequest\x12/\n\x08resource\x18\x01 \x01(\x0e\x32\x1d.openstorage.api.ResourceType\x12\x10\n\x08\x61lert_id\x18\x02 \x01(\x03\"\x1e\n\x1cSdkClusterAlertEraseResponse\"6\n\x1cSdkObjectstoreInspectRequest\x12\x16\n\x0eobjectstore_id\x18\x01 \x01(\t\"]\n\x1dSdkObjectstoreInspectResponse\x12<\n\x12objectstore_status\x18\x01 \x01(\x0b\x32 .openstorage.api.ObjectstoreInfo\"2\n\x1bSdkObjectstoreCreateRequest\x12\x13\n\x0bvolume_name\x18\x01 \x01(\t\"\\\n\x1cSdkObjectstoreCreateResponse\x12<\n\x12objectstore_status\x18\x01 \x01(\x0b\x32 .openstorage.api.ObjectstoreInfo\"5\n\x1bSdkObjectstoreDeleteRequest\x12\x16\n\x0eobjectstore_id\x18\x01 \x01(\t\"\x1e\n\x1cSdkObjectstoreDeleteResponse\"E\n\x1bSdkObjectstoreUpdateRequest\x12\x16\n\x0eobjectstore_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65nable\x18\x02 \x01(\x08\"\x1e\n\x1cSdkObjectstoreUpdateResponse\"W\n\x1bSdkCloudBackupCreateRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x17\n\x0f\x63redential_uuid\x18\x02 \x01(\t\x12\x0c\n\x04\x66ull\x18\x03 \x01(\x08\"1\n\x1cSdkCloudBackupCreateResponse\x12\x11\n\tbackup_id\x18\x01 \x01(\t\"x\n\x1cSdkCloudBackupRestoreRequest\x12\x11\n\tbackup_id\x18\x01 \x01(\t\x12\x1b\n\x13restore_volume_name\x18\x02 \x01(\t\x12\x17\n\x0f\x63redential_uuid\x18\x03 \x01(\t\x12\x0f\n\x07node_id\x18\x04 \x01(\t\":\n\x1dSdkCloudBackupRestoreResponse\x12\x19\n\x11restore_volume_id\x18\x01 \x01(\t\"X\n\x1bSdkCloudBackupDeleteRequest\x12\x11\n\tbackup_id\x18\x01 \x01(\t\x12\x17\n\x0f\x63redential_uuid\x18\x02 \x01(\t\x12\r\n\x05\x66orce\x18\x03 \x01(\x08\"\x1e\n\x1cSdkCloudBackupDeleteResponse\"P\n\x1eSdkCloudBackupDeleteAllRequest\x12\x15\n\rsrc_volume_id\x18\x01 \x01(\t\x12\x17\n\x0f\x63redential_uuid\x18\x02 \x01(\t\"!\n\x1fSdkCloudBackupDeleteAllResponse\"q\n\x1eSdkCloudBackupEnumerateRequest\x12\x15\n\rsrc_volume_id\x18\x01 \x01(\t\x12\x12\n\ncluster_id\x18\x02 \x01(\t\x12\x17\n\x0f\x63redential_uuid\x18\x03 \x01(\t\x12\x0b\n\x03\x61ll\x18\x04 \x01(\x08\"\xb0\x02\n\x12SdkCloudBackupInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x15\n\rsrc_volume_id\x18\x02 \x01(\t\x12\x17\n\x0fsrc_volume_name\x18\x03 \x01(\t\x12-\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x43\n\x08metadata\x18\x05 \x03(\x0b\x32\x31.openstorage.api.SdkCloudBackupInfo.MetadataEntry\x12\x39\n\x06status\x18\x06 \x01(\x0e\x32).openstorage.api.SdkCloudBackupStatusType\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"W\n\x1fSdkCloudBackupEnumerateResponse\x12\x34\n\x07\x62\x61\x63kups\x18\x01 \x03(\x0b\x32#.openstorage.api.SdkCloudBackupInfo\"\xa4\x02\n\x14SdkCloudBackupStatus\x12\x11\n\tbackup_id\x18\x01 \x01(\t\x12\x35\n\x06optype\x18\x02 \x01(\x0e\x32%.openstorage.api.SdkCloudBackupOpType\x12\x39\n\x06status\x18\x03 \x01(\x0e\x32).openstorage.api.SdkCloudBackupStatusType\x12\x12\n\nbytes_done\x18\x04 \x01(\x04\x12.\n\nstart_time\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x32\n\x0e\x63ompleted_time\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07node_id\x18\x07 \x01(\t\"C\n\x1bSdkCloudBackupStatusRequest\x12\x15\n\rsrc_volume_id\x18\x01 \x01(\t\x12\r\n\x05local\x18\x02 \x01(\x08\"\xc5\x01\n\x1cSdkCloudBackupStatusResponse\x12M\n\x08statuses\x18\x01 \x03(\x0b\x32;.openstorage.api.SdkCloudBackupStatusResponse.StatusesEntry\x1aV\n\rStatusesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x34\n\x05value\x18\x02 \x01(\x0b\x32%.openstorage.api.SdkCloudBackupStatus:\x02\x38\x01\"J\n\x1cSdkCloudBackupCatalogRequest\x12\x11\n\tbackup_id\x18\x01 \x01(\t\x12\x17\n\x0f\x63redential_uuid\x18\x02 \x01(\t\"1\n\x1dSdkCloudBackupCatalogResponse\x12\x10\n\x08\x63ontents\x18\x01 \x03(\t\"\x9c\x01\n\x19SdkCloudBackupHistoryItem\x12\x15\n\rsrc_volume_id\x18\x01 \x01(\t\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x39\n\x06status\x18\x03 \x01(\x0e\x32).openstorage.api.SdkCloudBackupStatusType\"5\n\x1cSdkCloudBackupHistoryRequest\x12\x15\n\rsrc_volume_id\x18\x01 \x01(\t\"a\n\x1dSdkCloudBackupHistoryResponse\x12@\n\x0chistory_list\x18\x01 \x03(\x0b\x32*.openstorage.api.SdkCloudBackupHistoryItem\"\x81\x01\n SdkCloudBackupStateChangeRequest\x12\x15\n\rsrc_volume_id\x18\x01 \x01(\t\x12\x46\n\x0frequested_state\x18\x02 \x01(\x0e\x32-.openstorage.api.SdkCloudBackupRequestedState\"#\n!SdkCloudBackupStateChangeResponse*\xee\x02\n\x06Status\x12\x0f\n\x0bSTATUS_NONE\x10\x00\x12\x0f\n\x0bSTATUS_INIT\x10\x01\x12\r\n\tSTATUS_OK\x10\x02\x12\x12\n\x0eSTATUS_OFFLINE\x10\x03\x12\x10\n\x0cSTATUS_ERROR\x10\x04\x12\x18\n\x14STATUS_NOT_IN_QUORUM\x10\x05\x12\x17\n\x13STATUS_DECOMMISSION\x10\x06\x12\x16\n\x12STATUS_MAINTENANCE\x10\x07\x12\x17\n\x13STATUS_STORAGE_DOWN\x10\x08\x12\x1b\n\x17STATUS_STORAGE_DEGRADED\x10\t\x12\x17\n\x13STATUS_NEEDS_REBOOT\x10\n\x12\x1c\n\x18STATUS_STORAGE_REBALANCE\x10\x0b\x12 \n\x1cSTATUS_STORAGE_DRIVE_REPLACE\x10\x0c\x12#\n\x1fSTATUS_NOT_IN_QUORUM_NO_STORAGE\x10\r\x12\x0e\n\nSTATUS_MAX\x10\x0e*\x99\x01\n\nDriverType\x12\x14\n\x10\x44RIVER_TYPE_NONE\x10\x00\x12\x14\n\x10\x44RIVER_TYPE_FILE\x10\x01\x12\x15\n\x11\x44RIVER_TYPE_BLOCK\x10\x02\x12\x16\n\x12\x44RIVER_TYPE_OBJECT\x10\x03\x12\x19\n\x15\x44RIVER_TYPE_CLUSTERED\x10\x04\x12\x15\n\x11\x44RIVER_TYPE_GRAPH\x10\x05*\x95\x01\n\x06\x46SType\x12\x10\n\x0c\x46S_TYPE_NONE\x10\x00\x12\x11\n\rFS_TYPE_BTRFS\x10\x01\x12\x10\n\x0c\x46S_TYPE_EXT4\x10\x02\x12\x10\n\x0c\x46S_TYPE_FUSE\x10\x03\x12\x0f\n\x0b\x46S_TYPE_NFS\x10\x04\x12\x0f\n\x0b\x46S_TYPE_VFS\x10\x05\x12\x0f\n\x0b\x46S_TYPE_XFS\x10\x06\x12\x0f\n\x0b\x46S_TYPE_ZFS\x10\x07*\xab\x01\n\x15GraphDriverChangeType\x12!\n\x1dGRAPH_DRIVER_CHANGE_TYPE_NONE\x10\x00\x12%\n!GRAPH_DRIVER_CHANGE_TYPE_MODIFIED\x10\x01\x12\"\n\x1eGRAPH_DRIVER_CHANGE_TYPE_ADDED\x10\x02\x12$\n GRAPH_DRIVER_CHANGE_TYPE_DELETED\x10\x03*t\n\x0cSeverityType\x12\x16\n\x12SEVERITY_TYPE_NONE\x10\x00\x12\x17\n\x13SEVERITY_TYPE_ALARM\x10\x01\x12\x19\n\x15SEVERITY_TYPE_WARNING\x10\x02\x12\x18\n\x14SEVERITY_TYPE_NOTIFY\x10\x03*\x8c\x01\n\x0cResourceType\x12\x16\n\x12RESOURCE_TYPE_NONE\x10\x00\x12\x18\n\x14RESOURCE_TYPE_VOLUME\x10\x01\x12\x16\n\x12RESOURCE_TYPE_NODE\x10\x02\x12\x19\n\x15RESOURCE_TYPE_CLUSTER\x10\x03\x12\x17\n\x13RESOURCE_TYPE_DRIVE\x10\x04*\x87\x01\n\x0f\x41lertActionType\x12\x1a\n\x16\x41LERT_ACTION_TYPE_NONE\x10\x00\x12\x1c\n\x18\x41LERT_ACTION_TYPE_DELETE\x10\x01\x12\x1c\n\x18\x41LERT_ACTION_TYPE_CREATE\x10\x02\x12\x1c\n\x18\x41LERT_ACTION_TYPE_UPDATE\x10\x03*j\n\x11VolumeActionParam\x12\x1c\n\x18VOLUME_ACTION_PARAM_NONE\x10\x00\x12\x1b\n\x17VOLUME_ACTION_PARAM_OFF\x10\x01\x12\x1a\n\x16VOLUME_ACTION_PARAM_ON\x10\x02*2\n\x07\x43osType\x12\x08\n\x04NONE\x10\x00\x12\x07\n\x03LOW\x10\x01\x12\n\n\x06MEDIUM\x10\x02\x12\x08\n\x04HIGH\x10\x03*~\n\tIoProfile\x12\x19\n\x15IO_PROFILE_SEQUENTIAL\x10\x00\x12\x15\n\x11IO_PROFILE_RANDOM\x10\x01\x12\x11\n\rIO_PROFILE_DB\x10\x02\x12\x18\n\x14IO_PROFILE_DB_REMOTE\x10\x03\x12\x12\n\x0eIO_PROFILE_CMS\x10\x04*\x99\x02\n\x0bVolumeState\x12\x15\n\x11VOLUME_STATE_NONE\x10\x00\x12\x18\n\x14VOLUME_STATE_PENDING\x10\x01\x12\x1a\n\x16VOLUME_STATE_AVAILABLE\x10\x02\x12\x19\n\x15VOLUME_STATE_ATTACHED\x10\x03\x12\x19\n\x15VOLUME_STATE_DETACHED\x10\x04\x12\x1b\n\x17VOLUME_STATE_DETATCHING\x10\x05\x12\x16\n\x12VOLUME_STATE_ERROR\x10\x06\x12\x18\n\x14VOLUME_STATE_DELETED\x10\x07\x12\x1e\n\x1aVOLUME_STATE_TRY_DETACHING\x10\x08\x12\x18\n\x14VOLUME_STATE_RESTORE\x10\t*\x8f\x01\n\x0cVolumeStatus\x12\x16\n\x12VOLUME_STATUS_NONE\x10\x00\x12\x1d\n\x19VOLUME_STATUS_NOT_PRESENT\x10\x01\x12\x14\n\x10VOLUME_STATUS_UP\x10\x02\x12\x16\n\x12VOLUME_STATUS_DOWN\x10\x03\x12\x1a\n\x16VOLUME_STATUS_DEGRADED\x10\x04*]\n\rStorageMedium\x12\x1b\n\x17STORAGE_MEDIUM_MAGNETIC\x10\x00\x12\x16\n\x12STORAGE_MEDIUM_SSD\x10\x01\x12\x17\n\x13STORAGE_MEDIUM_NVME\x10\x02*(\n\rClusterNotify\x12\x17\n\x13\x43LUSTER_NOTIFY_DOWN\x10\x00*e\n\x0b\x41ttachState\x12\x19\n\x15\x41TTACH_STATE_EXTERNAL\x10\x00\x12\x19\n\x15\x41TTACH_STATE_INTERNAL\x10\x01\x12 \n\x1c\x41TTACH_STATE_INTERNAL_SWITCH\x10\x02*T\n\x0eOperationFlags\x12\x14\n\x10OP_FLAGS_UNKNOWN\x10\x00\x12\x11\n\rOP_FLAGS_NONE\x10\x01\x12\x19\n\x15OP_FLAGS_DETACH_FORCE\x10\x02*|\n\x14SdkCloudBackupOpType\x12\x1f\n\x1bSdkCloudBackupOpTypeUnknown\x10\x00\x12 \n\x1cSdkCloudBackupOpTypeBackupOp\x10\x01\x12!\n\x1dSdkCloudBackupOpTypeRestoreOp\x10\x02*\xbf\x02\n\x18SdkCloudBackupStatusType\x12#\n\x1fSdkCloudBackupStatusTypeUnknown\x10\x00\x12&\n\"SdkCloudBackupStatusTypeNotStarted\x10\x01\x12 \n\x1cSdkCloudBackupStatusTypeDone\x10\x02\x12#\n\x1fSdkCloudBackupStatusTypeAborted\x10\x03\x12\"\n\x1eSdkCloudBackupStatusTypePaused\x10\x04\x12#\n\x1fSdkCloudBackupStatusTypeStopped\x10\x05\x12\"\n\x1eSdkCloudBackupStatusTypeActive\x10\x06\x12\"\n\x1eSdkCloudBackupStatusTypeFailed\x10\x07*\xbc\x01\n\x1cSdkCloudBackupRequestedState\x12\'\n#SdkCloudBackupRequestedStateUnknown\x10\x00\x12%\n!SdkCloudBackupRequestedStatePause\x10\x01\x12&\n\"SdkCloudBackupRequestedStateResume\x10\x02\x12$\n SdkCloudBackupRequestedStateStop\x10\x03\x32\xe2\x05\n\x12OpenStorageCluster\x12\x88\x01\n\tEnumerate\x12+.openstorage.api.SdkClusterEnumerateRequest\x1a,.openstorage.api.SdkClusterEnumerateResponse\" \x82\xd3\xe4\x93\x02\x1a\"\x15/v1/cluster/enumerate:\x01*\x12\x80\x01\n\x07Inspect\x12).openstorage.api.SdkClusterInspectRequest\x1a*.openstorage.api.SdkClusterInspectResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\"\x13/v1/cluster/inspect:\x01*\x12\x9d\x01\n\x0e\x41lertEnumerate\x12\x30.openstorage.api.SdkClusterAlertEnumerateRequest\x1a\x31.openstorage.api.SdkClusterAlertEnumerateResponse\"&\x82\xd3\xe4\x93\x02 \"\x1b/v1/cluster/alert/enumerate:\x01*\x12\x8d\x01\n\nAlertClear\x12,.openstorage.api.SdkClusterAlertClearRequest\x1a-.openstorage.api.SdkClusterAlertClearResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/cluster/alert/clear:\x01*\x12\x8d\x01\n\nAlertErase\x12,.openstorage.api.SdkClusterAlertEraseRequest\x1a-.openstorage.api.SdkClusterAlertEraseResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/cluster/alert/erase:\x01*2\x89\r\n\x11OpenStorageVolume\x12y\n\x06\x43reate\x12\'.openstorage.api.SdkVolumeCreateRequest\x1a(.openstorage.api.SdkVolumeCreateResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x11/v1/volume/create:\x01*\x12\xa3\x01\n\x12\x43reateFromVolumeId\x12\x33.openstorage.api.SdkVolumeCreateFromVolumeIdRequest\x1a\x34.openstorage.api.SdkVolumeCreateFromVolumeIdResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/volume/createfromid:\x01*\x12y\n\x06\x44\x65lete\x12\'.openstorage.api.SdkVolumeDeleteRequest\x1a(.openstorage.api.SdkVolumeDeleteResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x11/v1/volume/delete:\x01*\x12}\n\x07Inspect\x12(.openstorage.api.SdkVolumeInspectRequest\x1a).openstorage.api.SdkVolumeInspectResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x12/v1/volume/inspect:\x01*\x12\x85\x01\n\tEnumerate\x12*.openstorage.api.SdkVolumeEnumerateRequest\x1a+.openstorage.api.SdkVolumeEnumerateResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x14/v1/volume/enumerate:\x01*\x12\x9a\x01\n\x0eSnapshotCreate\x12/.openstorage.api.SdkVolumeSnapshotCreateRequest\x1a\x30.openstorage.api.SdkVolumeSnapshotCreateResponse\"%\x82\xd3\xe4\x93\x02\x1f\"\x1a/v1/volume/snapshot/create:\x01*\x12\x9e\x01\n\x0fSnapshotRestore\x12\x30.openstorage.api.SdkVolumeSnapshotRestoreRequest\x1a\x31.openstorage.api.SdkVolumeSnapshotRestoreResponse\"&\x82\xd3\xe4\x93\x02 \"\x1b/v1/volume/snapshot/restore:\x01*\x12\xa6\x01\n\x11SnapshotEnumerate\x12\x32.openstorage.api.SdkVolumeSnapshotEnumerateRequest\x1a\x33.openstorage.api.SdkVolumeSnapshotEnumerateResponse\"(\x82\xd3\xe4\x93\x02\"\"\x1d/v1/volume/snapshot/enumerate:\x01*\x12y\n\x06\x41ttach\x12\'.openstorage.api.SdkVolumeAttachRequest\x1a(.openstorage.api.SdkVolumeAttachResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x11/v1/volume/attach:\x01*\x12y\n\x06\x44\x65tach\x12\'.openstorage.api.SdkVolumeDetachRequest\x1a(.openstorage.api.SdkVolumeDetachResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x11/v1/volume/detach:\x01*\x12u\n\x05Mount\x12&.openstorage.api.SdkVolumeMountRequest\x1a\'.openstorage.api.SdkVolumeMountResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x10/v1/volume/mount:\x01*\x12}\n\x07Unmount\x12(.openstorage.api.SdkVolumeUnmountRequest\x1a).openstorage.api.SdkVolumeUnmountResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x12/v1/volume/unmount:\x01*2\xca\x04\n\x16OpenStorageObjectstore\x12\x8e\x01\n\x07Inspect\x12-.openstorage.api.SdkObjectstoreInspectRequest\x1a..openstorage.api.SdkObjectstoreInspectResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/objectstore/enumerate:\x01*\x12\x88\x01\n\x06\x43reate\x12,.openstorage.api.SdkObjectstoreCreateRequest\x1a-.openstorage.api.SdkObjectstoreCreateResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/objectstore/create:\x01*\x12\x88\x01\n\x06\x44\x65lete\x12,.openstorage.api.SdkObjectstoreDeleteRequest\x1a-.openstorage.api.SdkObjectstoreDeleteResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/objectstore/delete:\x01*\x12\x88\x01\n\x06Update\x12,.openstorage.api.SdkObjectstoreUpdateRequest\x1a-.openstorage.api.SdkObjectstoreUpdateResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/objectstore/update:\x01*2\x94\n\n\x16OpenStorageCredentials\x12\x96\x01\n\x0c\x43reateForAWS\x12..openstorage.api.SdkCredentialCreateAWSRequest\x1a/.openstorage.api.SdkCredentialCreateAWSResponse\"%\x82\xd3\xe4\x93\x02\x1f\"\x1a/v1/credentials/create/aws:\x01*\x12\x9e\x01\n\x0e\x43reateForAzure\x12\x30.openstorage.api.SdkCredentialCreateAzureRequest\x1a\x31.openstorage.api.SdkCredentialCreateAzureResponse\"\'\x82\xd3\xe4\x93\x02!\"\x1c/v1/credentials/create/azure:\x01*\x12\xa2\x01\n\x0f\x43reateForGoogle\x12\x31.openstorage.api.SdkCredentialCreateGoogleRequest\x1a\x32.openstorage.api.SdkCredentialCreateGoogleResponse\"(\x82\xd3\xe4\x93\x02\"\"\x1d/v1/credentials/create/google:\x01*\x12\xa2\x01\n\x0f\x45numerateForAWS\x12\x31.openstorage.api.SdkCredentialEnumerateAWSRequest\x1a\x32.openstorage.api.SdkCredentialEnumerateAWSResponse\"(\x82\xd3\xe4\x93\x02\"\"\x1d/v1/credentials/enumerate/aws:\x01*\x12\xaa\x01\n\x11\x45numerateForAzure\x12\x33.openstorage.api.SdkCredentialEnumerateAzureRequest\x1a\x34.openstorage.api.SdkCredentialEnumerateAzureResponse\"*\x82\xd3\xe4\x93\x02$\"\x1f/v1/credentials/enumerate/azure:\x01*\x12\xae\x01\n\x12\x45numerateForGoogle\x12\x34.openstorage.api.SdkCredentialEnumerateGoogleRequest\x1a\x35.openstorage.api.SdkCredentialEnumerateGoogleResponse\"+\x82\xd3\xe4\x93\x02%\" /v1/credentials/enumerate/google:\x01*\x12\x86\x01\n\x06\x44\x65lete\x12+.openstorage.api.SdkCredentialDeleteRequest\x1a,.openstorage.api.SdkCredentialDeleteResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/credentials/delete:\x01*\x12\x8e\x01\n\x08Validate\x12-.openstorage.api.SdkCredentialValidateRequest\x1a..openstorage.api.SdkCredentialValidateResponse\"#\x82\xd3\xe4\x93\x02\x1d\"\x18/v1/credentials/validate:\x01*2\x8f\x06\n\x19OpenStorageSchedulePolicy\x12\x91\x01\n\x06\x43reate\x12/.openstorage.api.SdkSchedulePolicyCreateRequest\x1a\x30.openstorage.api.SdkSchedulePolicyCreateResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/schedulepolicy/create:\x01*\x12\x91\x01\n\x06Update\x12/.openstorage.api.SdkSchedulePolicyUpdateRequest\x1a\x30.openstorage.api.SdkSchedulePolicyUpdateResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/schedulepolicy/update:\x01*\x12\x9d\x01\n\tEnumerate\x12\x32.openstorage.api.SdkSchedulePolicyEnumerateRequest\x1a\x33.openstorage.api.SdkSchedulePolicyEnumerateResponse\"\'\x82\xd3\xe4\x93\x02!\"\x1c/v1/schedulepolicy/enumerate:\x01*\x12\x95\x01\n\x07Inspect\x12\x30.openstorage.api.SdkSchedulePolicyInspectRequest\x1a\x31.openstorage.api.SdkSchedulePolicyInspectResponse\"%\x82\xd3\xe4\x93\x02\x1f\"\x1a/v1/schedulepolicy/inspect:\x01*\x12\x91\x01\n\x06\x44\x65lete\x12/.openstorage.api.SdkSchedulePolicyDeleteRequest\x1a\x30.openstorage.api.SdkSchedulePolicyDeleteResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/schedulepolicy/delete:\x01*2\xb3\n\n\x16OpenStorageCloudBackup\x12\x88\x01\n\x06\x43reate\x12,.openstorage.api.SdkCloudBackupCreateRequest\x1a-.openstorage.api.SdkCloudBackupCreateResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/cloudbackup/create:\x01*\x12\x8c\x01\n\x07Restore\x12-.openstorage.api.SdkCloudBackupRestoreRequest\x1a..openstorage.api.SdkCloudBackupRestoreResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/cloudbackup/restore:\x01*\x12\x88\x01\n\x06\x44\x65lete\x12,.openstorage.api.SdkCloudBackupDeleteRequest\x1a-.openstorage.api.SdkCloudBackupDeleteResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/cloudbackup/delete:\x01*\x12\x94\x01\n\tDeleteAll\x12/.openstorage.api.SdkCloudBackupDeleteAllRequest\x1a\x30.openstorage.api.SdkCloudBackupDeleteAllResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/cloudbackup/deleteall:\x01*\x12\x94\x01\n\tEnumerate\x12/.openstorage.api.SdkCloudBackupEnumerateRequest\x1a\x30.openstorage.api.SdkCloudBackupEnumerateResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/cloudbackup/enumerate:\x01*\x12\x88\x01\n\x06Status\x12,.openstorage.api.SdkCloudBackupStatusRequest\x1a-.openstorage.api.SdkCloudBackupStatusResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/cloudbackup/status:\x01*\x12\x8c\x01\n\x07\x43\x61talog\x12-.openstorage.api.SdkCloudBackupCatalogRequest\x1a..openstorage.api.SdkCloudBackupCatalogResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/cloudbackup/catalog:\x01*\x12\x8c\x01\n\x07History\x12-.openstorage.api.SdkCloudBackupHistoryRequest\x1a..openstorage.api.SdkCloudBackupHistoryResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/cloudbackup/history:\x01*\x12\x9c\x01\n\x0bStateChange\x12\x31.openstorage.api.SdkCloudBackupStateChangeRequest\x1a\x32.openstorage.api.SdkCloudBackupStateChangeResponse\"&\x82\xd3\xe4\x93\x02 \"\x1b/v1/cloudbackup/statechange:\x01*B\x1c\n\x13\x63om.openstorage.apiP\x01Z\x03\x61pib\x06proto3')
+  serialized_pb=_b('\n\tapi.proto\x12\x0fopenstorage.api\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xa3\x02\n\x0fStorageResource\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04path\x18\x02 \x01(\t\x12.\n\x06medium\x18\x03 \x01(\x0e\x32\x1e.openstorage.api.StorageMedium\x12\x0e\n\x06online\x18\x04 \x01(\x08\x12\x0c\n\x04iops\x18\x05 \x01(\x04\x12\x11\n\tseq_write\x18\x06 \x01(\x01\x12\x10\n\x08seq_read\x18\x07 \x01(\x01\x12\x0e\n\x06randRW\x18\x08 \x01(\x01\x12\x0c\n\x04size\x18\t \x01(\x04\x12\x0c\n\x04used\x18\n \x01(\x04\x12\x16\n\x0erotation_speed\x18\x0b \x01(\t\x12-\n\tlast_scan\x18\x0c \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08metadata\x18\r \x01(\x08\"\x8d\x02\n\x0bStoragePool\x12\n\n\x02ID\x18\x01 \x01(\x05\x12%\n\x03\x43os\x18\x02 \x01(\x0e\x32\x18.openstorage.api.CosType\x12.\n\x06Medium\x18\x03 \x01(\x0e\x32\x1e.openstorage.api.StorageMedium\x12\x11\n\tRaidLevel\x18\x04 \x01(\t\x12\x11\n\tTotalSize\x18\x07 \x01(\x04\x12\x0c\n\x04Used\x18\x08 \x01(\x04\x12\x38\n\x06labels\x18\t \x03(\x0b\x32(.openstorage.api.StoragePool.LabelsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x9b\x01\n\rVolumeLocator\x12\x0c\n\x04name\x18\x01 \x01(\t\x12G\n\rvolume_labels\x18\x02 \x03(\x0b\x32\x30.openstorage.api.VolumeLocator.VolumeLabelsEntry\x1a\x33\n\x11VolumeLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"&\n\x06Source\x12\x0e\n\x06parent\x18\x01 \x01(\t\x12\x0c\n\x04seed\x18\x02 \x01(\t\"\x13\n\x05Group\x12\n\n\x02id\x18\x01 \x01(\t\"\xc6\x02\n\rOwnershipAcls\x12:\n\x06groups\x18\x01 \x03(\x0b\x32*.openstorage.api.OwnershipAcls.GroupsEntry\x12H\n\rcollaborators\x18\x02 \x03(\x0b\x32\x31.openstorage.api.OwnershipAcls.CollaboratorsEntry\x1aS\n\x0bGroupsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x33\n\x05value\x18\x02 \x01(\x0e\x32$.openstorage.api.OwnershipAccessType:\x02\x38\x01\x1aZ\n\x12\x43ollaboratorsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x33\n\x05value\x18\x02 \x01(\x0e\x32$.openstorage.api.OwnershipAccessType:\x02\x38\x01\"H\n\tOwnership\x12\r\n\x05owner\x18\x01 \x01(\t\x12,\n\x04\x61\x63ls\x18\x02 \x01(\x0b\x32\x1e.openstorage.api.OwnershipAcls\"q\n\x18LabelSelectorRequirement\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x38\n\x08operator\x18\x02 \x01(\x0e\x32&.openstorage.api.LabelSelectorOperator\x12\x0e\n\x06values\x18\x03 \x03(\t\"\xd4\x02\n\x0cVolumeFilter\x12/\n\x07locator\x18\x01 \x01(\x0b\x32\x1e.openstorage.api.VolumeLocator\x12\x41\n\x0elabel_selector\x18\x02 \x03(\x0b\x32).openstorage.api.LabelSelectorRequirement\x12+\n\x05state\x18\x03 \x01(\x0e\x32\x1c.openstorage.api.VolumeState\x12-\n\x06status\x18\x04 \x01(\x0e\x32\x1d.openstorage.api.VolumeStatus\x12\r\n\x05owner\x18\x05 \x01(\t\x12\x31\n\rcreated_after\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x32\n\x0e\x63reated_before\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xee\x05\n\nVolumeSpec\x12\x11\n\tephemeral\x18\x01 \x01(\x08\x12\x0c\n\x04size\x18\x02 \x01(\x04\x12\'\n\x06\x66ormat\x18\x03 \x01(\x0e\x32\x17.openstorage.api.FSType\x12\x12\n\nblock_size\x18\x04 \x01(\x03\x12\x10\n\x08ha_level\x18\x05 \x01(\x03\x12%\n\x03\x63os\x18\x06 \x01(\x0e\x32\x18.openstorage.api.CosType\x12.\n\nio_profile\x18\x07 \x01(\x0e\x32\x1a.openstorage.api.IoProfile\x12\x0e\n\x06\x64\x65\x64upe\x18\x08 \x01(\x08\x12\x19\n\x11snapshot_interval\x18\t \x01(\r\x12\x44\n\rvolume_labels\x18\n \x03(\x0b\x32-.openstorage.api.VolumeSpec.VolumeLabelsEntry\x12\x0e\n\x06shared\x18\x0b \x01(\x08\x12\x30\n\x0breplica_set\x18\x0c \x01(\x0b\x32\x1b.openstorage.api.ReplicaSet\x12\x19\n\x11\x61ggregation_level\x18\r \x01(\r\x12\x11\n\tencrypted\x18\x0e \x01(\x08\x12\x12\n\npassphrase\x18\x0f \x01(\t\x12\x19\n\x11snapshot_schedule\x18\x10 \x01(\t\x12\r\n\x05scale\x18\x11 \x01(\r\x12\x0e\n\x06sticky\x18\x12 \x01(\x08\x12%\n\x05group\x18\x15 \x01(\x0b\x32\x16.openstorage.api.Group\x12\x16\n\x0egroup_enforced\x18\x16 \x01(\x08\x12\x12\n\ncompressed\x18\x17 \x01(\x08\x12\x10\n\x08\x63\x61scaded\x18\x18 \x01(\x08\x12\x0f\n\x07journal\x18\x19 \x01(\x08\x12\x10\n\x08sharedv4\x18\x1a \x01(\x08\x12-\n\townership\x18\x1b \x01(\x0b\x32\x1a.openstorage.api.Ownership\x1a\x33\n\x11VolumeLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x1b\n\nReplicaSet\x12\r\n\x05nodes\x18\x01 \x03(\t\"\x91\x01\n\x0fRuntimeStateMap\x12I\n\rruntime_state\x18\x01 \x03(\x0b\x32\x32.openstorage.api.RuntimeStateMap.RuntimeStateEntry\x1a\x33\n\x11RuntimeStateEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xf8\x06\n\x06Volume\x12\n\n\x02id\x18\x01 \x01(\t\x12\'\n\x06source\x18\x02 \x01(\x0b\x32\x17.openstorage.api.Source\x12%\n\x05group\x18\x03 \x01(\x0b\x32\x16.openstorage.api.Group\x12\x10\n\x08readonly\x18\x04 \x01(\x08\x12/\n\x07locator\x18\x05 \x01(\x0b\x32\x1e.openstorage.api.VolumeLocator\x12)\n\x05\x63time\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12)\n\x04spec\x18\x07 \x01(\x0b\x32\x1b.openstorage.api.VolumeSpec\x12\r\n\x05usage\x18\x08 \x01(\x04\x12-\n\tlast_scan\x18\t \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x06\x66ormat\x18\n \x01(\x0e\x32\x17.openstorage.api.FSType\x12-\n\x06status\x18\x0b \x01(\x0e\x32\x1d.openstorage.api.VolumeStatus\x12+\n\x05state\x18\x0c \x01(\x0e\x32\x1c.openstorage.api.VolumeState\x12\x13\n\x0b\x61ttached_on\x18\r \x01(\t\x12\x34\n\x0e\x61ttached_state\x18\x0e \x01(\x0e\x32\x1c.openstorage.api.AttachState\x12\x13\n\x0b\x64\x65vice_path\x18\x0f \x01(\t\x12\x1a\n\x12secure_device_path\x18\x10 \x01(\t\x12\x13\n\x0b\x61ttach_path\x18\x11 \x03(\t\x12<\n\x0b\x61ttach_info\x18\x12 \x03(\x0b\x32\'.openstorage.api.Volume.AttachInfoEntry\x12\x31\n\x0creplica_sets\x18\x13 \x03(\x0b\x32\x1b.openstorage.api.ReplicaSet\x12\x37\n\rruntime_state\x18\x14 \x03(\x0b\x32 .openstorage.api.RuntimeStateMap\x12\r\n\x05\x65rror\x18\x15 \x01(\t\x12\x39\n\x10volume_consumers\x18\x16 \x03(\x0b\x32\x1f.openstorage.api.VolumeConsumer\x1a\x31\n\x0f\x41ttachInfoEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xbf\x01\n\x05Stats\x12\r\n\x05reads\x18\x01 \x01(\x04\x12\x0f\n\x07read_ms\x18\x02 \x01(\x04\x12\x12\n\nread_bytes\x18\x03 \x01(\x04\x12\x0e\n\x06writes\x18\x04 \x01(\x04\x12\x10\n\x08write_ms\x18\x05 \x01(\x04\x12\x13\n\x0bwrite_bytes\x18\x06 \x01(\x04\x12\x13\n\x0bio_progress\x18\x07 \x01(\x04\x12\r\n\x05io_ms\x18\x08 \x01(\x04\x12\x12\n\nbytes_used\x18\t \x01(\x04\x12\x13\n\x0binterval_ms\x18\n \x01(\x04\"\x90\x02\n\x05\x41lert\x12\n\n\x02id\x18\x01 \x01(\x03\x12/\n\x08severity\x18\x02 \x01(\x0e\x32\x1d.openstorage.api.SeverityType\x12\x12\n\nalert_type\x18\x03 \x01(\x03\x12\x0f\n\x07message\x18\x04 \x01(\t\x12-\n\ttimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x13\n\x0bresource_id\x18\x06 \x01(\t\x12/\n\x08resource\x18\x07 \x01(\x0e\x32\x1d.openstorage.api.ResourceType\x12\x0f\n\x07\x63leared\x18\x08 \x01(\x08\x12\x0b\n\x03ttl\x18\t \x01(\x04\x12\x12\n\nunique_tag\x18\n \x01(\t\"/\n\x06\x41lerts\x12%\n\x05\x61lert\x18\x01 \x03(\x0b\x32\x16.openstorage.api.Alert\"\xd2\x01\n\nAuditEvent\x12\n\n\x02id\x18\x01 \x01(\t\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07node_id\x18\x03 \x01(\t\x12\x0b\n\x03\x61pi\x18\x04 \x01(\t\x12\x11\n\toperation\x18\x05 \x01(\t\x12\x0f\n\x07subject\x18\x06 \x01(\t\x12\x16\n\x0eremote_address\x18\x07 \x01(\t\x12\x0f\n\x07request\x18\x08 \x01(\t\x12\x0f\n\x07success\x18\t \x01(\x08\x12\r\n\x05\x65rror\x18\n \x01(\t\"\xdd\x01\n\x0fObjectstoreInfo\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x11\n\tvolume_id\x18\x02 \x01(\t\x12\x0f\n\x07\x65nabled\x18\x03 \x01(\x08\x12\x0e\n\x06status\x18\x04 \x01(\t\x12\x0e\n\x06\x61\x63tion\x18\x05 \x01(\x03\x12\x12\n\naccess_key\x18\x06 \x01(\t\x12\x12\n\nsecret_key\x18\x07 \x01(\t\x12\x11\n\tendpoints\x18\x08 \x03(\t\x12\x18\n\x10\x63urrent_endPoint\x18\t \x01(\t\x12\x13\n\x0b\x61\x63\x63\x65ss_port\x18\n \x01(\x03\x12\x0e\n\x06region\x18\x0b \x01(\t\"\x9a\x01\n\x13VolumeCreateRequest\x12/\n\x07locator\x18\x01 \x01(\x0b\x32\x1e.openstorage.api.VolumeLocator\x12\'\n\x06source\x18\x02 \x01(\x0b\x32\x17.openstorage.api.Source\x12)\n\x04spec\x18\x03 \x01(\x0b\x32\x1b.openstorage.api.VolumeSpec\"\x1f\n\x0eVolumeResponse\x12\r\n\x05\x65rror\x18\x01 \x01(\t\"\\\n\x14VolumeCreateResponse\x12\n\n\x02id\x18\x01 \x01(\t\x12\x38\n\x0fvolume_response\x18\x02 \x01(\x0b\x32\x1f.openstorage.api.VolumeResponse\"\xa3\x01\n\x11VolumeStateAction\x12\x32\n\x06\x61ttach\x18\x01 \x01(\x0e\x32\".openstorage.api.VolumeActionParam\x12\x31\n\x05mount\x18\x02 \x01(\x0e\x32\".openstorage.api.VolumeActionParam\x12\x12\n\nmount_path\x18\x03 \x01(\t\x12\x13\n\x0b\x64\x65vice_path\x18\x04 \x01(\t\"\x93\x02\n\x10VolumeSetRequest\x12/\n\x07locator\x18\x01 \x01(\x0b\x32\x1e.openstorage.api.VolumeLocator\x12)\n\x04spec\x18\x02 \x01(\x0b\x32\x1b.openstorage.api.VolumeSpec\x12\x32\n\x06\x61\x63tion\x18\x03 \x01(\x0b\x32\".openstorage.api.VolumeStateAction\x12?\n\x07options\x18\x04 \x03(\x0b\x32..openstorage.api.VolumeSetRequest.OptionsEntry\x1a.\n\x0cOptionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"v\n\x11VolumeSetResponse\x12\'\n\x06volume\x18\x01 \x01(\x0b\x32\x17.openstorage.api.Volume\x12\x38\n\x0fvolume_response\x18\x02 \x01(\x0b\x32\x1f.openstorage.api.VolumeResponse\"b\n\x11SnapCreateRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12/\n\x07locator\x18\x02 \x01(\x0b\x32\x1e.openstorage.api.VolumeLocator\x12\x10\n\x08readonly\x18\x03 \x01(\x08\"[\n\x12SnapCreateResponse\x12\x45\n\x16volume_create_response\x18\x01 \x01(\x0b\x32%.openstorage.api.VolumeCreateResponse\"[\n\nVolumeInfo\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x0c\n\x04path\x18\x02 \x01(\t\x12,\n\x07storage\x18\x03 \x01(\x0b\x32\x1b.openstorage.api.VolumeSpec\"x\n\x0eVolumeConsumer\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12\x0f\n\x07node_id\x18\x04 \x01(\t\x12\x12\n\nowner_name\x18\x05 \x01(\t\x12\x12\n\nowner_type\x18\x06 \x01(\t\"X\n\x12GraphDriverChanges\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x34\n\x04kind\x18\x02 \x01(\x0e\x32&.openstorage.api.GraphDriverChangeType\" \n\x0f\x43lusterResponse\x12\r\n\x05\x65rror\x18\x01 \x01(\t\"\x80\x01\n\rActiveRequest\x12>\n\x08ReqestKV\x18\x01 \x03(\x0b\x32,.openstorage.api.ActiveRequest.ReqestKVEntry\x1a/\n\rReqestKVEntry\x12\x0b\n\x03key\x18\x01 \x01(\x03\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"]\n\x0e\x41\x63tiveRequests\x12\x14\n\x0cRequestCount\x18\x01 \x01(\x03\x12\x35\n\rActiveRequest\x18\x02 \x03(\x0b\x32\x1e.openstorage.api.ActiveRequest\"\x98\x01\n\x16GroupSnapCreateRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x43\n\x06Labels\x18\x02 \x03(\x0b\x32\x33.openstorage.api.GroupSnapCreateRequest.LabelsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xcb\x01\n\x17GroupSnapCreateResponse\x12J\n\tsnapshots\x18\x01 \x03(\x0b\x32\x37.openstorage.api.GroupSnapCreateResponse.SnapshotsEntry\x12\r\n\x05\x65rror\x18\x02 \x01(\t\x1aU\n\x0eSnapshotsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.openstorage.api.SnapCreateResponse:\x02\x38\x01\"\xf7\x03\n\x0bStorageNode\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03\x63pu\x18\x02 \x01(\x01\x12\x11\n\tmem_total\x18\x03 \x01(\x04\x12\x10\n\x08mem_used\x18\x04 \x01(\x04\x12\x10\n\x08mem_free\x18\x05 \x01(\x04\x12\x10\n\x08\x61vg_load\x18\x06 \x01(\x03\x12\'\n\x06status\x18\x07 \x01(\x0e\x32\x17.openstorage.api.Status\x12\x36\n\x05\x64isks\x18\t \x03(\x0b\x32\'.openstorage.api.StorageNode.DisksEntry\x12+\n\x05pools\x18\n \x03(\x0b\x32\x1c.openstorage.api.StoragePool\x12\x0f\n\x07mgmt_ip\x18\x0b \x01(\t\x12\x0f\n\x07\x64\x61ta_ip\x18\x0c \x01(\t\x12\x10\n\x08hostname\x18\x0f \x01(\t\x12\x41\n\x0bnode_labels\x18\x10 \x03(\x0b\x32,.openstorage.api.StorageNode.NodeLabelsEntry\x1aN\n\nDisksEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12/\n\x05value\x18\x02 \x01(\x0b\x32 .openstorage.api.StorageResource:\x02\x38\x01\x1a\x31\n\x0fNodeLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x83\x01\n\x0eStorageCluster\x12\'\n\x06status\x18\x01 \x01(\x0e\x32\x17.openstorage.api.Status\x12\n\n\x02id\x18\x02 \x01(\t\x12\x0f\n\x07node_id\x18\x03 \x01(\t\x12+\n\x05nodes\x18\x04 \x03(\x0b\x32\x1c.openstorage.api.StorageNode\"\\\n\x1eSdkSchedulePolicyCreateRequest\x12:\n\x0eSchedulePolicy\x18\x01 \x01(\x0b\x32\".openstorage.api.SdkSchedulePolicy\"!\n\x1fSdkSchedulePolicyCreateResponse\"\\\n\x1eSdkSchedulePolicyUpdateRequest\x12:\n\x0eSchedulePolicy\x18\x01 \x01(\x0b\x32\".openstorage.api.SdkSchedulePolicy\"!\n\x1fSdkSchedulePolicyUpdateResponse\"#\n!SdkSchedulePolicyEnumerateRequest\"Z\n\"SdkSchedulePolicyEnumerateResponse\x12\x34\n\x08policies\x18\x01 \x03(\x0b\x32\".openstorage.api.SdkSchedulePolicy\"/\n\x1fSdkSchedulePolicyInspectRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"V\n SdkSchedulePolicyInspectResponse\x12\x32\n\x06policy\x18\x01 \x01(\x0b\x32\".openstorage.api.SdkSchedulePolicy\".\n\x1eSdkSchedulePolicyDeleteRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"!\n\x1fSdkSchedulePolicyDeleteResponse\"3\n\x11SdkSchedulePolicy\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x10\n\x08schedule\x18\x02 \x01(\t\"W\n\x1fSdkCredentialCreateAzureRequest\x12\x34\n\ncredential\x18\x01 \x01(\x0b\x32 .openstorage.api.AzureCredential\"9\n SdkCredentialCreateAzureResponse\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"Y\n SdkCredentialCreateGoogleRequest\x12\x35\n\ncredential\x18\x01 \x01(\x0b\x32!.openstorage.api.GoogleCredential\":\n!SdkCredentialCreateGoogleResponse\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"R\n\x1dSdkCredentialCreateAWSRequest\x12\x31\n\ncredential\x18\x01 \x01(\x0b\x32\x1d.openstorage.api.S3Credential\"7\n\x1eSdkCredentialCreateAWSResponse\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"o\n\x0cS3Credential\x12\x15\n\rcredential_id\x18\x01 \x01(\t\x12\x12\n\naccess_key\x18\x02 \x01(\t\x12\x12\n\nsecret_key\x18\x03 \x01(\t\x12\x10\n\x08\x65ndpoint\x18\x04 \x01(\t\x12\x0e\n\x06region\x18\x05 \x01(\t\"S\n\x0f\x41zureCredential\x12\x15\n\rcredential_id\x18\x01 \x01(\t\x12\x14\n\x0c\x61\x63\x63ount_name\x18\x02 \x01(\t\x12\x13\n\x0b\x61\x63\x63ount_key\x18\x03 \x01(\t\"O\n\x10GoogleCredential\x12\x15\n\rcredential_id\x18\x01 \x01(\t\x12\x12\n\nproject_id\x18\x02 \x01(\t\x12\x10\n\x08json_key\x18\x03 \x01(\t\"9\n SdkCredentialEnumerateAWSRequest\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"V\n!SdkCredentialEnumerateAWSResponse\x12\x31\n\ncredential\x18\x01 \x03(\x0b\x32\x1d.openstorage.api.S3Credential\";\n\"SdkCredentialEnumerateAzureRequest\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"[\n#SdkCredentialEnumerateAzureResponse\x12\x34\n\ncredential\x18\x01 \x03(\x0b\x32 .openstorage.api.AzureCredential\"<\n#SdkCredentialEnumerateGoogleRequest\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"]\n$SdkCredentialEnumerateGoogleResponse\x12\x35\n\ncredential\x18\x01 \x03(\x0b\x32!.openstorage.api.GoogleCredential\"3\n\x1aSdkCredentialDeleteRequest\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"\x1d\n\x1bSdkCredentialDeleteResponse\"5\n\x1cSdkCredentialValidateRequest\x12\x15\n\rcredential_id\x18\x01 \x01(\t\"\x1f\n\x1dSdkCredentialValidateResponse\"\xb4\x01\n\x15SdkVolumeMountRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x12\n\nmount_path\x18\x02 \x01(\t\x12\x44\n\x07options\x18\x03 \x03(\x0b\x32\x33.openstorage.api.SdkVolumeMountRequest.OptionsEntry\x1a.\n\x0cOptionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x18\n\x16SdkVolumeMountResponse\"\xb8\x01\n\x17SdkVolumeUnmountRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x12\n\nmount_path\x18\x02 \x01(\t\x12\x46\n\x07options\x18\x03 \x03(\x0b\x32\x35.openstorage.api.SdkVolumeUnmountRequest.OptionsEntry\x1a.\n\x0cOptionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x1a\n\x18SdkVolumeUnmountResponse\"\xa2\x01\n\x16SdkVolumeAttachRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x45\n\x07options\x18\x02 \x03(\x0b\x32\x34.openstorage.api.SdkVolumeAttachRequest.OptionsEntry\x1a.\n\x0cOptionsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\".\n\x17SdkVolumeAttachResponse\x12\x13\n\x0b\x64\x65vice_path\x18\x01 \x01(\t\"+\n\x16SdkVolumeDetachRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\"\x19\n\x17SdkVolumeDetachResponse\"Q\n\x16SdkVolumeCreateRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12)\n\x04spec\x18\x02 \x01(\x0b\x32\x1b.openstorage.api.VolumeSpec\",\n\x17SdkVolumeCreateResponse\x12\x11\n\tvolume_id\x18\x01 \x01(\t\"p\n\"SdkVolumeCreateFromVolumeIdRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tparent_id\x18\x02 \x01(\t\x12)\n\x04spec\x18\x03 \x01(\x0b\x32\x1b.openstorage.api.VolumeSpec\"8\n#SdkVolumeCreateFromVolumeIdResponse\x12\x11\n\tvolume_id\x18\x01 \x01(\t\"+\n\x16SdkVolumeDeleteRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\"\x19\n\x17SdkVolumeDeleteResponse\",\n\x17SdkVolumeInspectRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\"C\n\x18SdkVolumeInspectResponse\x12\'\n\x06volume\x18\x01 \x01(\x0b\x32\x17.openstorage.api.Volume\"\xa4\x01\n\x19SdkVolumeEnumerateRequest\x12/\n\x07locator\x18\x01 \x01(\x0b\x32\x1e.openstorage.api.VolumeLocator\x12-\n\x06\x66ilter\x18\x02 \x01(\x0b\x32\x1d.openstorage.api.VolumeFilter\x12\x13\n\x0bmax_results\x18\x03 \x01(\r\x12\x12\n\npage_token\x18\x04 \x01(\t\"_\n\x1aSdkVolumeEnumerateResponse\x12(\n\x07volumes\x18\x01 \x03(\x0b\x32\x17.openstorage.api.Volume\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"\xca\x01\n\x16SdkVolumeUpdateRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x43\n\x06labels\x18\x02 \x03(\x0b\x32\x33.openstorage.api.SdkVolumeUpdateRequest.LabelsEntry\x12)\n\x04spec\x18\x03 \x01(\x0b\x32\x1b.openstorage.api.VolumeSpec\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"B\n\x17SdkVolumeUpdateResponse\x12\'\n\x06volume\x18\x01 \x01(\x0b\x32\x17.openstorage.api.Volume\"B\n\x15SdkVolumeStatsRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x16\n\x0enot_cumulative\x18\x02 \x01(\x08\"\xbe\x01\n\x16SdkVolumeStatsResponse\x12%\n\x05stats\x18\x01 \x01(\x0b\x32\x16.openstorage.api.Stats\x12\x18\n\x10write_throughput\x18\x02 \x01(\x04\x12\x17\n\x0fread_throughput\x18\x03 \x01(\x04\x12\x0f\n\x07latency\x18\x04 \x01(\x04\x12\x14\n\x0cread_latency\x18\x05 \x01(\x04\x12\x15\n\rwrite_latency\x18\x06 \x01(\x04\x12\x0c\n\x04iops\x18\x07 \x01(\x04\"2\n\x1dSdkVolumeCapacityUsageRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\"I\n\x1eSdkVolumeCapacityUsageResponse\x12\x12\n\nused_bytes\x18\x01 \x01(\x04\x12\x13\n\x0btotal_bytes\x18\x02 \x01(\x04\" \n\x1eSdkVolumeActiveRequestsRequest\"[\n\x1fSdkVolumeActiveRequestsResponse\x12\x38\n\x0f\x61\x63tive_requests\x18\x01 \x01(\x0b\x32\x1f.openstorage.api.ActiveRequests\"\xaf\x01\n\x1eSdkVolumeSnapshotCreateRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12K\n\x06labels\x18\x02 \x03(\x0b\x32;.openstorage.api.SdkVolumeSnapshotCreateRequest.LabelsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"6\n\x1fSdkVolumeSnapshotCreateResponse\x12\x13\n\x0bsnapshot_id\x18\x01 \x01(\t\"I\n\x1fSdkVolumeSnapshotRestoreRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x13\n\x0bsnapshot_id\x18\x02 \x01(\t\"\"\n SdkVolumeSnapshotRestoreResponse\"\xb5\x01\n!SdkVolumeSnapshotEnumerateRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12N\n\x06labels\x18\x02 \x03(\x0b\x32>.openstorage.api.SdkVolumeSnapshotEnumerateRequest.LabelsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"P\n\"SdkVolumeSnapshotEnumerateResponse\x12*\n\tsnapshots\x18\x01 \x03(\x0b\x32\x17.openstorage.api.Volume\"\xea\x01\n#SdkVolumeSnapshotGroupCreateRequest\x12\x10\n\x08group_id\x18\x01 \x01(\t\x12P\n\x06labels\x18\x02 \x03(\x0b\x32@.openstorage.api.SdkVolumeSnapshotGroupCreateRequest.LabelsEntry\x12\x0f\n\x07quiesce\x18\x03 \x01(\x08\x12\x1f\n\x17quiesce_timeout_seconds\x18\x04 \x01(\x04\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xb1\x01\n$SdkVolumeSnapshotGroupCreateResponse\x12W\n\tsnapshots\x18\x01 \x03(\x0b\x32\x44.openstorage.api.SdkVolumeSnapshotGroupCreateResponse.SnapshotsEntry\x1a\x30\n\x0eSnapshotsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"Y\n\x17SdkVolumeQuiesceRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x17\n\x0ftimeout_seconds\x18\x02 \x01(\x04\x12\x12\n\nquiesce_id\x18\x03 \x01(\t\"\x1a\n\x18SdkVolumeQuiesceResponse\".\n\x19SdkVolumeUnquiesceRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\"\x1c\n\x1aSdkVolumeUnquiesceResponse\"\x1c\n\x1aSdkClusterEnumerateRequest\"O\n\x1bSdkClusterEnumerateResponse\x12\x30\n\x07\x63luster\x18\x01 \x01(\x0b\x32\x1f.openstorage.api.StorageCluster\"+\n\x18SdkClusterInspectRequest\x12\x0f\n\x07node_id\x18\x01 \x01(\t\"G\n\x19SdkClusterInspectResponse\x12*\n\x04node\x18\x01 \x01(\x0b\x32\x1c.openstorage.api.StorageNode\"\xb0\x01\n\x1fSdkClusterAlertEnumerateRequest\x12.\n\ntime_start\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08time_end\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12/\n\x08resource\x18\x03 \x01(\x0e\x32\x1d.openstorage.api.ResourceType\"K\n SdkClusterAlertEnumerateResponse\x12\'\n\x06\x61lerts\x18\x01 \x01(\x0b\x32\x17.openstorage.api.Alerts\"`\n\x1bSdkClusterAlertClearRequest\x12/\n\x08resource\x18\x01 \x01(\x0e\x32\x1d.openstorage.api.ResourceType\x12\x10\n\x08\x61lert_id\x18\x02 \x01(\x03\"\x1e\n\x1cSdkClusterAlertClearResponse\"`\n\x1bSdkClusterAlertEraseRequest\x12/\n\x08resource\x18\x01 \x01(\x0e\x32\x1d.openstorage.api.ResourceType\x12\x10\n\x08\x61lert_id\x18\x02 \x01(\x03\"\x1e\n\x1cSdkClusterAlertEraseResponse\"\x94\x01\n\x1bSdkClusterAlertWatchRequest\x12/\n\x08resource\x18\x01 \x01(\x0e\x32\x1d.openstorage.api.ResourceType\x12\x13\n\x0bresource_id\x18\x02 \x01(\t\x12/\n\x08severity\x18\x03 \x01(\x0e\x32\x1d.openstorage.api.SeverityType\"w\n\x1cSdkClusterAlertWatchResponse\x12\x30\n\x06\x61\x63tion\x18\x01 \x01(\x0e\x32 .openstorage.api.AlertActionType\x12%\n\x05\x61lert\x18\x02 \x01(\x0b\x32\x16.openstorage.api.Alert\"\x19\n\x17SdkNodeEnumerateRequest\",\n\x18SdkNodeEnumerateResponse\x12\x10\n\x08node_ids\x18\x01 \x03(\t\"(\n\x15SdkNodeInspectRequest\x12\x0f\n\x07node_id\x18\x01 \x01(\t\"D\n\x16SdkNodeInspectResponse\x12*\n\x04node\x18\x01 \x01(\x0b\x32\x1c.openstorage.api.StorageNode\"\x1e\n\x1cSdkNodeInspectCurrentRequest\"K\n\x1dSdkNodeInspectCurrentResponse\x12*\n\x04node\x18\x01 \x01(\x0b\x32\x1c.openstorage.api.StorageNode\"\xa5\x01\n\x1aSdkNodeUpdateLabelsRequest\x12\x0f\n\x07node_id\x18\x01 \x01(\t\x12G\n\x06labels\x18\x02 \x03(\x0b\x32\x37.openstorage.api.SdkNodeUpdateLabelsRequest.LabelsEntry\x1a-\n\x0bLabelsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"I\n\x1bSdkNodeUpdateLabelsResponse\x12*\n\x04node\x18\x01 \x01(\x0b\x32\x1c.openstorage.api.StorageNode\"6\n\x1cSdkObjectstoreInspectRequest\x12\x16\n\x0eobjectstore_id\x18\x01 \x01(\t\"]\n\x1dSdkObjectstoreInspectResponse\x12<\n\x12objectstore_status\x18\x01 \x01(\x0b\x32 .openstorage.api.ObjectstoreInfo\"2\n\x1bSdkObjectstoreCreateRequest\x12\x13\n\x0bvolume_name\x18\x01 \x01(\t\"\\\n\x1cSdkObjectstoreCreateResponse\x12<\n\x12objectstore_status\x18\x01 \x01(\x0b\x32 .openstorage.api.ObjectstoreInfo\"5\n\x1bSdkObjectstoreDeleteRequest\x12\x16\n\x0eobjectstore_id\x18\x01 \x01(\t\"\x1e\n\x1cSdkObjectstoreDeleteResponse\"E\n\x1bSdkObjectstoreUpdateRequest\x12\x16\n\x0eobjectstore_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65nable\x18\x02 \x01(\x08\"\x1e\n\x1cSdkObjectstoreUpdateResponse\"W\n\x1bSdkCloudBackupCreateRequest\x12\x11\n\tvolume_id\x18\x01 \x01(\t\x12\x17\n\x0f\x63redential_uuid\x18\x02 \x01(\t\x12\x0c\n\x04\x66ull\x18\x03 \x01(\x08\"1\n\x1cSdkCloudBackupCreateResponse\x12\x11\n\tbackup_id\x18\x01 \x01(\t\"x\n\x1cSdkCloudBackupRestoreRequest\x12\x11\n\tbackup_id\x18\x01 \x01(\t\x12\x1b\n\x13restore_volume_name\x18\x02 \x01(\t\x12\x17\n\x0f\x63redential_uuid\x18\x03 \x01(\t\x12\x0f\n\x07node_id\x18\x04 \x01(\t\":\n\x1dSdkCloudBackupRestoreResponse\x12\x19\n\x11restore_volume_id\x18\x01 \x01(\t\"X\n\x1bSdkCloudBackupDeleteRequest\x12\x11\n\tbackup_id\x18\x01 \x01(\t\x12\x17\n\x0f\x63redential_uuid\x18\x02 \x01(\t\x12\r\n\x05\x66orce\x18\x03 \x01(\x08\"\x1e\n\x1cSdkCloudBackupDeleteResponse\"P\n\x1eSdkCloudBackupDeleteAllRequest\x12\x15\n\rsrc_volume_id\x18\x01 \x01(\t\x12\x17\n\x0f\x63redential_uuid\x18\x02 \x01(\t\"!\n\x1fSdkCloudBackupDeleteAllResponse\"q\n\x1eSdkCloudBackupEnumerateRequest\x12\x15\n\rsrc_volume_id\x18\x01 \x01(\t\x12\x12\n\ncluster_id\x18\x02 \x01(\t\x12\x17\n\x0f\x63redential_uuid\x18\x03 \x01(\t\x12\x0b\n\x03\x61ll\x18\x04 \x01(\x08\"\xb0\x02\n\x12SdkCloudBackupInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x15\n\rsrc_volume_id\x18\x02 \x01(\t\x12\x17\n\x0fsrc_volume_name\x18\x03 \x01(\t\x12-\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x43\n\x08metadata\x18\x05 \x03(\x0b\x32\x31.openstorage.api.SdkCloudBackupInfo.MetadataEntry\x12\x39\n\x06status\x18\x06 \x01(\x0e\x32).openstorage.api.SdkCloudBackupStatusType\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"W\n\x1fSdkCloudBackupEnumerateResponse\x12\x34\n\x07\x62\x61\x63kups\x18\x01 \x03(\x0b\x32#.openstorage.api.SdkCloudBackupInfo\"\xa4\x02\n\x14SdkCloudBackupStatus\x12\x11\n\tbackup_id\x18\x01 \x01(\t\x12\x35\n\x06optype\x18\x02 \x01(\x0e\x32%.openstorage.api.SdkCloudBackupOpType\x12\x39\n\x06status\x18\x03 \x01(\x0e\x32).openstorage.api.SdkCloudBackupStatusType\x12\x12\n\nbytes_done\x18\x04 \x01(\x04\x12.\n\nstart_time\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x32\n\x0e\x63ompleted_time\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07node_id\x18\x07 \x01(\t\"C\n\x1bSdkCloudBackupStatusRequest\x12\x15\n\rsrc_volume_id\x18\x01 \x01(\t\x12\r\n\x05local\x18\x02 \x01(\x08\"\xc5\x01\n\x1cSdkCloudBackupStatusResponse\x12M\n\x08statuses\x18\x01 \x03(\x0b\x32;.openstorage.api.SdkCloudBackupStatusResponse.StatusesEntry\x1aV\n\rStatusesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x34\n\x05value\x18\x02 \x01(\x0b\x32%.openstorage.api.SdkCloudBackupStatus:\x02\x38\x01\"J\n\x1cSdkCloudBackupCatalogRequest\x12\x11\n\tbackup_id\x18\x01 \x01(\t\x12\x17\n\x0f\x63redential_uuid\x18\x02 \x01(\t\"1\n\x1dSdkCloudBackupCatalogResponse\x12\x10\n\x08\x63ontents\x18\x01 \x03(\t\"\x9c\x01\n\x19SdkCloudBackupHistoryItem\x12\x15\n\rsrc_volume_id\x18\x01 \x01(\t\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x39\n\x06status\x18\x03 \x01(\x0e\x32).openstorage.api.SdkCloudBackupStatusType\"5\n\x1cSdkCloudBackupHistoryRequest\x12\x15\n\rsrc_volume_id\x18\x01 \x01(\t\"a\n\x1dSdkCloudBackupHistoryResponse\x12@\n\x0chistory_list\x18\x01 \x03(\x0b\x32*.openstorage.api.SdkCloudBackupHistoryItem\"\x81\x01\n SdkCloudBackupStateChangeRequest\x12\x15\n\rsrc_volume_id\x18\x01 \x01(\t\x12\x46\n\x0frequested_state\x18\x02 \x01(\x0e\x32-.openstorage.api.SdkCloudBackupRequestedState\"#\n!SdkCloudBackupStateChangeResponse\"\x9c\x01\n\x18SdkAuditEnumerateRequest\x12.\n\ntime_start\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08time_end\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07subject\x18\x03 \x01(\t\x12\x11\n\toperation\x18\x04 \x01(\t\"H\n\x19SdkAuditEnumerateResponse\x12+\n\x06\x65vents\x18\x01 \x03(\x0b\x32\x1b.openstorage.api.AuditEvent*\xee\x02\n\x06Status\x12\x0f\n\x0bSTATUS_NONE\x10\x00\x12\x0f\n\x0bSTATUS_INIT\x10\x01\x12\r\n\tSTATUS_OK\x10\x02\x12\x12\n\x0eSTATUS_OFFLINE\x10\x03\x12\x10\n\x0cSTATUS_ERROR\x10\x04\x12\x18\n\x14STATUS_NOT_IN_QUORUM\x10\x05\x12\x17\n\x13STATUS_DECOMMISSION\x10\x06\x12\x16\n\x12STATUS_MAINTENANCE\x10\x07\x12\x17\n\x13STATUS_STORAGE_DOWN\x10\x08\x12\x1b\n\x17STATUS_STORAGE_DEGRADED\x10\t\x12\x17\n\x13STATUS_NEEDS_REBOOT\x10\n\x12\x1c\n\x18STATUS_STORAGE_REBALANCE\x10\x0b\x12 \n\x1cSTATUS_STORAGE_DRIVE_REPLACE\x10\x0c\x12#\n\x1fSTATUS_NOT_IN_QUORUM_NO_STORAGE\x10\r\x12\x0e\n\nSTATUS_MAX\x10\x0e*\x99\x01\n\nDriverType\x12\x14\n\x10\x44RIVER_TYPE_NONE\x10\x00\x12\x14\n\x10\x44RIVER_TYPE_FILE\x10\x01\x12\x15\n\x11\x44RIVER_TYPE_BLOCK\x10\x02\x12\x16\n\x12\x44RIVER_TYPE_OBJECT\x10\x03\x12\x19\n\x15\x44RIVER_TYPE_CLUSTERED\x10\x04\x12\x15\n\x11\x44RIVER_TYPE_GRAPH\x10\x05*\x95\x01\n\x06\x46SType\x12\x10\n\x0c\x46S_TYPE_NONE\x10\x00\x12\x11\n\rFS_TYPE_BTRFS\x10\x01\x12\x10\n\x0c\x46S_TYPE_EXT4\x10\x02\x12\x10\n\x0c\x46S_TYPE_FUSE\x10\x03\x12\x0f\n\x0b\x46S_TYPE_NFS\x10\x04\x12\x0f\n\x0b\x46S_TYPE_VFS\x10\x05\x12\x0f\n\x0b\x46S_TYPE_XFS\x10\x06\x12\x0f\n\x0b\x46S_TYPE_ZFS\x10\x07*\xab\x01\n\x15GraphDriverChangeType\x12!\n\x1dGRAPH_DRIVER_CHANGE_TYPE_NONE\x10\x00\x12%\n!GRAPH_DRIVER_CHANGE_TYPE_MODIFIED\x10\x01\x12\"\n\x1eGRAPH_DRIVER_CHANGE_TYPE_ADDED\x10\x02\x12$\n GRAPH_DRIVER_CHANGE_TYPE_DELETED\x10\x03*t\n\x0cSeverityType\x12\x16\n\x12SEVERITY_TYPE_NONE\x10\x00\x12\x17\n\x13SEVERITY_TYPE_ALARM\x10\x01\x12\x19\n\x15SEVERITY_TYPE_WARNING\x10\x02\x12\x18\n\x14SEVERITY_TYPE_NOTIFY\x10\x03*\x8c\x01\n\x0cResourceType\x12\x16\n\x12RESOURCE_TYPE_NONE\x10\x00\x12\x18\n\x14RESOURCE_TYPE_VOLUME\x10\x01\x12\x16\n\x12RESOURCE_TYPE_NODE\x10\x02\x12\x19\n\x15RESOURCE_TYPE_CLUSTER\x10\x03\x12\x17\n\x13RESOURCE_TYPE_DRIVE\x10\x04*\x87\x01\n\x0f\x41lertActionType\x12\x1a\n\x16\x41LERT_ACTION_TYPE_NONE\x10\x00\x12\x1c\n\x18\x41LERT_ACTION_TYPE_DELETE\x10\x01\x12\x1c\n\x18\x41LERT_ACTION_TYPE_CREATE\x10\x02\x12\x1c\n\x18\x41LERT_ACTION_TYPE_UPDATE\x10\x03*j\n\x11VolumeActionParam\x12\x1c\n\x18VOLUME_ACTION_PARAM_NONE\x10\x00\x12\x1b\n\x17VOLUME_ACTION_PARAM_OFF\x10\x01\x12\x1a\n\x16VOLUME_ACTION_PARAM_ON\x10\x02*2\n\x07\x43osType\x12\x08\n\x04NONE\x10\x00\x12\x07\n\x03LOW\x10\x01\x12\n\n\x06MEDIUM\x10\x02\x12\x08\n\x04HIGH\x10\x03*~\n\tIoProfile\x12\x19\n\x15IO_PROFILE_SEQUENTIAL\x10\x00\x12\x15\n\x11IO_PROFILE_RANDOM\x10\x01\x12\x11\n\rIO_PROFILE_DB\x10\x02\x12\x18\n\x14IO_PROFILE_DB_REMOTE\x10\x03\x12\x12\n\x0eIO_PROFILE_CMS\x10\x04*\x99\x02\n\x0bVolumeState\x12\x15\n\x11VOLUME_STATE_NONE\x10\x00\x12\x18\n\x14VOLUME_STATE_PENDING\x10\x01\x12\x1a\n\x16VOLUME_STATE_AVAILABLE\x10\x02\x12\x19\n\x15VOLUME_STATE_ATTACHED\x10\x03\x12\x19\n\x15VOLUME_STATE_DETACHED\x10\x04\x12\x1b\n\x17VOLUME_STATE_DETATCHING\x10\x05\x12\x16\n\x12VOLUME_STATE_ERROR\x10\x06\x12\x18\n\x14VOLUME_STATE_DELETED\x10\x07\x12\x1e\n\x1aVOLUME_STATE_TRY_DETACHING\x10\x08\x12\x18\n\x14VOLUME_STATE_RESTORE\x10\t*\x8f\x01\n\x0cVolumeStatus\x12\x16\n\x12VOLUME_STATUS_NONE\x10\x00\x12\x1d\n\x19VOLUME_STATUS_NOT_PRESENT\x10\x01\x12\x14\n\x10VOLUME_STATUS_UP\x10\x02\x12\x16\n\x12VOLUME_STATUS_DOWN\x10\x03\x12\x1a\n\x16VOLUME_STATUS_DEGRADED\x10\x04*]\n\rStorageMedium\x12\x1b\n\x17STORAGE_MEDIUM_MAGNETIC\x10\x00\x12\x16\n\x12STORAGE_MEDIUM_SSD\x10\x01\x12\x17\n\x13STORAGE_MEDIUM_NVME\x10\x02*(\n\rClusterNotify\x12\x17\n\x13\x43LUSTER_NOTIFY_DOWN\x10\x00*e\n\x0b\x41ttachState\x12\x19\n\x15\x41TTACH_STATE_EXTERNAL\x10\x00\x12\x19\n\x15\x41TTACH_STATE_INTERNAL\x10\x01\x12 \n\x1c\x41TTACH_STATE_INTERNAL_SWITCH\x10\x02*T\n\x0eOperationFlags\x12\x14\n\x10OP_FLAGS_UNKNOWN\x10\x00\x12\x11\n\rOP_FLAGS_NONE\x10\x01\x12\x19\n\x15OP_FLAGS_DETACH_FORCE\x10\x02*w\n\x13OwnershipAccessType\x12\x1e\n\x1aOWNERSHIP_ACCESS_TYPE_READ\x10\x00\x12\x1f\n\x1bOWNERSHIP_ACCESS_TYPE_WRITE\x10\x01\x12\x1f\n\x1bOWNERSHIP_ACCESS_TYPE_ADMIN\x10\x02*\xab\x01\n\x15LabelSelectorOperator\x12\x1e\n\x1aLABEL_SELECTOR_OPERATOR_IN\x10\x00\x12\"\n\x1eLABEL_SELECTOR_OPERATOR_NOT_IN\x10\x01\x12\"\n\x1eLABEL_SELECTOR_OPERATOR_EXISTS\x10\x02\x12*\n&LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST\x10\x03*|\n\x14SdkCloudBackupOpType\x12\x1f\n\x1bSdkCloudBackupOpTypeUnknown\x10\x00\x12 \n\x1cSdkCloudBackupOpTypeBackupOp\x10\x01\x12!\n\x1dSdkCloudBackupOpTypeRestoreOp\x10\x02*\xbf\x02\n\x18SdkCloudBackupStatusType\x12#\n\x1fSdkCloudBackupStatusTypeUnknown\x10\x00\x12&\n\"SdkCloudBackupStatusTypeNotStarted\x10\x01\x12 \n\x1cSdkCloudBackupStatusTypeDone\x10\x02\x12#\n\x1fSdkCloudBackupStatusTypeAborted\x10\x03\x12\"\n\x1eSdkCloudBackupStatusTypePaused\x10\x04\x12#\n\x1fSdkCloudBackupStatusTypeStopped\x10\x05\x12\"\n\x1eSdkCloudBackupStatusTypeActive\x10\x06\x12\"\n\x1eSdkCloudBackupStatusTypeFailed\x10\x07*\xbc\x01\n\x1cSdkCloudBackupRequestedState\x12\'\n#SdkCloudBackupRequestedStateUnknown\x10\x00\x12%\n!SdkCloudBackupRequestedStatePause\x10\x01\x12&\n\"SdkCloudBackupRequestedStateResume\x10\x02\x12$\n SdkCloudBackupRequestedStateStop\x10\x03\x32\xf4\x06\n\x12OpenStorageCluster\x12\x88\x01\n\tEnumerate\x12+.openstorage.api.SdkClusterEnumerateRequest\x1a,.openstorage.api.SdkClusterEnumerateResponse\" \x82\xd3\xe4\x93\x02\x1a\"\x15/v1/cluster/enumerate:\x01*\x12\x80\x01\n\x07Inspect\x12).openstorage.api.SdkClusterInspectRequest\x1a*.openstorage.api.SdkClusterInspectResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\"\x13/v1/cluster/inspect:\x01*\x12\x9d\x01\n\x0e\x41lertEnumerate\x12\x30.openstorage.api.SdkClusterAlertEnumerateRequest\x1a\x31.openstorage.api.SdkClusterAlertEnumerateResponse\"&\x82\xd3\xe4\x93\x02 \"\x1b/v1/cluster/alert/enumerate:\x01*\x12\x8d\x01\n\nAlertClear\x12,.openstorage.api.SdkClusterAlertClearRequest\x1a-.openstorage.api.SdkClusterAlertClearResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/cluster/alert/clear:\x01*\x12\x8d\x01\n\nAlertErase\x12,.openstorage.api.SdkClusterAlertEraseRequest\x1a-.openstorage.api.SdkClusterAlertEraseResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/cluster/alert/erase:\x01*\x12\x8f\x01\n\nAlertWatch\x12,.openstorage.api.SdkClusterAlertWatchRequest\x1a-.openstorage.api.SdkClusterAlertWatchResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/cluster/alert/watch:\x01*0\x01\x32\xb0\x04\n\x0fOpenStorageNode\x12\x7f\n\tEnumerate\x12(.openstorage.api.SdkNodeEnumerateRequest\x1a).openstorage.api.SdkNodeEnumerateResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x12/v1/node/enumerate:\x01*\x12w\n\x07Inspect\x12&.openstorage.api.SdkNodeInspectRequest\x1a\'.openstorage.api.SdkNodeInspectResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x10/v1/node/inspect:\x01*\x12\x93\x01\n\x0eInspectCurrent\x12-.openstorage.api.SdkNodeInspectCurrentRequest\x1a..openstorage.api.SdkNodeInspectCurrentResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/node/inspectcurrent:\x01*\x12\x8c\x01\n\x0cUpdateLabels\x12+.openstorage.api.SdkNodeUpdateLabelsRequest\x1a,.openstorage.api.SdkNodeUpdateLabelsResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/node/labels/update:\x01*2\xda\x14\n\x11OpenStorageVolume\x12y\n\x06\x43reate\x12\'.openstorage.api.SdkVolumeCreateRequest\x1a(.openstorage.api.SdkVolumeCreateResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x11/v1/volume/create:\x01*\x12\xa3\x01\n\x12\x43reateFromVolumeId\x12\x33.openstorage.api.SdkVolumeCreateFromVolumeIdRequest\x1a\x34.openstorage.api.SdkVolumeCreateFromVolumeIdResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/volume/createfromid:\x01*\x12y\n\x06\x44\x65lete\x12\'.openstorage.api.SdkVolumeDeleteRequest\x1a(.openstorage.api.SdkVolumeDeleteResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x11/v1/volume/delete:\x01*\x12}\n\x07Inspect\x12(.openstorage.api.SdkVolumeInspectRequest\x1a).openstorage.api.SdkVolumeInspectResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x12/v1/volume/inspect:\x01*\x12\x85\x01\n\tEnumerate\x12*.openstorage.api.SdkVolumeEnumerateRequest\x1a+.openstorage.api.SdkVolumeEnumerateResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x14/v1/volume/enumerate:\x01*\x12y\n\x06Update\x12\'.openstorage.api.SdkVolumeUpdateRequest\x1a(.openstorage.api.SdkVolumeUpdateResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x11/v1/volume/update:\x01*\x12u\n\x05Stats\x12&.openstorage.api.SdkVolumeStatsRequest\x1a\'.openstorage.api.SdkVolumeStatsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x10/v1/volume/stats:\x01*\x12\x8d\x01\n\rCapacityUsage\x12..openstorage.api.SdkVolumeCapacityUsageRequest\x1a/.openstorage.api.SdkVolumeCapacityUsageResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x10/v1/volume/usage:\x01*\x12\x93\x01\n\x0e\x41\x63tiveRequests\x12/.openstorage.api.SdkVolumeActiveRequestsRequest\x1a\x30.openstorage.api.SdkVolumeActiveRequestsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\"\x13/v1/volume/requests:\x01*\x12\x9a\x01\n\x0eSnapshotCreate\x12/.openstorage.api.SdkVolumeSnapshotCreateRequest\x1a\x30.openstorage.api.SdkVolumeSnapshotCreateResponse\"%\x82\xd3\xe4\x93\x02\x1f\"\x1a/v1/volume/snapshot/create:\x01*\x12\x9e\x01\n\x0fSnapshotRestore\x12\x30.openstorage.api.SdkVolumeSnapshotRestoreRequest\x1a\x31.openstorage.api.SdkVolumeSnapshotRestoreResponse\"&\x82\xd3\xe4\x93\x02 \"\x1b/v1/volume/snapshot/restore:\x01*\x12\xa6\x01\n\x11SnapshotEnumerate\x12\x32.openstorage.api.SdkVolumeSnapshotEnumerateRequest\x1a\x33.openstorage.api.SdkVolumeSnapshotEnumerateResponse\"(\x82\xd3\xe4\x93\x02\"\"\x1d/v1/volume/snapshot/enumerate:\x01*\x12\xaf\x01\n\x13SnapshotGroupCreate\x12\x34.openstorage.api.SdkVolumeSnapshotGroupCreateRequest\x1a\x35.openstorage.api.SdkVolumeSnapshotGroupCreateResponse\"+\x82\xd3\xe4\x93\x02%\" /v1/volume/snapshot/group/create:\x01*\x12}\n\x07Quiesce\x12(.openstorage.api.SdkVolumeQuiesceRequest\x1a).openstorage.api.SdkVolumeQuiesceResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x12/v1/volume/quiesce:\x01*\x12\x85\x01\n\tUnquiesce\x12*.openstorage.api.SdkVolumeUnquiesceRequest\x1a+.openstorage.api.SdkVolumeUnquiesceResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x14/v1/volume/unquiesce:\x01*\x12y\n\x06\x41ttach\x12\'.openstorage.api.SdkVolumeAttachRequest\x1a(.openstorage.api.SdkVolumeAttachResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x11/v1/volume/attach:\x01*\x12y\n\x06\x44\x65tach\x12\'.openstorage.api.SdkVolumeDetachRequest\x1a(.openstorage.api.SdkVolumeDetachResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x11/v1/volume/detach:\x01*\x12u\n\x05Mount\x12&.openstorage.api.SdkVolumeMountRequest\x1a\'.openstorage.api.SdkVolumeMountResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x10/v1/volume/mount:\x01*\x12}\n\x07Unmount\x12(.openstorage.api.SdkVolumeUnmountRequest\x1a).openstorage.api.SdkVolumeUnmountResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x12/v1/volume/unmount:\x01*2\xca\x04\n\x16OpenStorageObjectstore\x12\x8e\x01\n\x07Inspect\x12-.openstorage.api.SdkObjectstoreInspectRequest\x1a..openstorage.api.SdkObjectstoreInspectResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/objectstore/enumerate:\x01*\x12\x88\x01\n\x06\x43reate\x12,.openstorage.api.SdkObjectstoreCreateRequest\x1a-.openstorage.api.SdkObjectstoreCreateResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/objectstore/create:\x01*\x12\x88\x01\n\x06\x44\x65lete\x12,.openstorage.api.SdkObjectstoreDeleteRequest\x1a-.openstorage.api.SdkObjectstoreDeleteResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/objectstore/delete:\x01*\x12\x88\x01\n\x06Update\x12,.openstorage.api.SdkObjectstoreUpdateRequest\x1a-.openstorage.api.SdkObjectstoreUpdateResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/objectstore/update:\x01*2\x94\n\n\x16OpenStorageCredentials\x12\x96\x01\n\x0c\x43reateForAWS\x12..openstorage.api.SdkCredentialCreateAWSRequest\x1a/.openstorage.api.SdkCredentialCreateAWSResponse\"%\x82\xd3\xe4\x93\x02\x1f\"\x1a/v1/credentials/create/aws:\x01*\x12\x9e\x01\n\x0e\x43reateForAzure\x12\x30.openstorage.api.SdkCredentialCreateAzureRequest\x1a\x31.openstorage.api.SdkCredentialCreateAzureResponse\"\'\x82\xd3\xe4\x93\x02!\"\x1c/v1/credentials/create/azure:\x01*\x12\xa2\x01\n\x0f\x43reateForGoogle\x12\x31.openstorage.api.SdkCredentialCreateGoogleRequest\x1a\x32.openstorage.api.SdkCredentialCreateGoogleResponse\"(\x82\xd3\xe4\x93\x02\"\"\x1d/v1/credentials/create/google:\x01*\x12\xa2\x01\n\x0f\x45numerateForAWS\x12\x31.openstorage.api.SdkCredentialEnumerateAWSRequest\x1a\x32.openstorage.api.SdkCredentialEnumerateAWSResponse\"(\x82\xd3\xe4\x93\x02\"\"\x1d/v1/credentials/enumerate/aws:\x01*\x12\xaa\x01\n\x11\x45numerateForAzure\x12\x33.openstorage.api.SdkCredentialEnumerateAzureRequest\x1a\x34.openstorage.api.SdkCredentialEnumerateAzureResponse\"*\x82\xd3\xe4\x93\x02$\"\x1f/v1/credentials/enumerate/azure:\x01*\x12\xae\x01\n\x12\x45numerateForGoogle\x12\x34.openstorage.api.SdkCredentialEnumerateGoogleRequest\x1a\x35.openstorage.api.SdkCredentialEnumerateGoogleResponse\"+\x82\xd3\xe4\x93\x02%\" /v1/credentials/enumerate/google:\x01*\x12\x86\x01\n\x06\x44\x65lete\x12+.openstorage.api.SdkCredentialDeleteRequest\x1a,.openstorage.api.SdkCredentialDeleteResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/credentials/delete:\x01*\x12\x8e\x01\n\x08Validate\x12-.openstorage.api.SdkCredentialValidateRequest\x1a..openstorage.api.SdkCredentialValidateResponse\"#\x82\xd3\xe4\x93\x02\x1d\"\x18/v1/credentials/validate:\x01*2\x8f\x06\n\x19OpenStorageSchedulePolicy\x12\x91\x01\n\x06\x43reate\x12/.openstorage.api.SdkSchedulePolicyCreateRequest\x1a\x30.openstorage.api.SdkSchedulePolicyCreateResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/schedulepolicy/create:\x01*\x12\x91\x01\n\x06Update\x12/.openstorage.api.SdkSchedulePolicyUpdateRequest\x1a\x30.openstorage.api.SdkSchedulePolicyUpdateResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/schedulepolicy/update:\x01*\x12\x9d\x01\n\tEnumerate\x12\x32.openstorage.api.SdkSchedulePolicyEnumerateRequest\x1a\x33.openstorage.api.SdkSchedulePolicyEnumerateResponse\"\'\x82\xd3\xe4\x93\x02!\"\x1c/v1/schedulepolicy/enumerate:\x01*\x12\x95\x01\n\x07Inspect\x12\x30.openstorage.api.SdkSchedulePolicyInspectRequest\x1a\x31.openstorage.api.SdkSchedulePolicyInspectResponse\"%\x82\xd3\xe4\x93\x02\x1f\"\x1a/v1/schedulepolicy/inspect:\x01*\x12\x91\x01\n\x06\x44\x65lete\x12/.openstorage.api.SdkSchedulePolicyDeleteRequest\x1a\x30.openstorage.api.SdkSchedulePolicyDeleteResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/schedulepolicy/delete:\x01*2\xb3\n\n\x16OpenStorageCloudBackup\x12\x88\x01\n\x06\x43reate\x12,.openstorage.api.SdkCloudBackupCreateRequest\x1a-.openstorage.api.SdkCloudBackupCreateResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/cloudbackup/create:\x01*\x12\x8c\x01\n\x07Restore\x12-.openstorage.api.SdkCloudBackupRestoreRequest\x1a..openstorage.api.SdkCloudBackupRestoreResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/cloudbackup/restore:\x01*\x12\x88\x01\n\x06\x44\x65lete\x12,.openstorage.api.SdkCloudBackupDeleteRequest\x1a-.openstorage.api.SdkCloudBackupDeleteResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/cloudbackup/delete:\x01*\x12\x94\x01\n\tDeleteAll\x12/.openstorage.api.SdkCloudBackupDeleteAllRequest\x1a\x30.openstorage.api.SdkCloudBackupDeleteAllResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/cloudbackup/deleteall:\x01*\x12\x94\x01\n\tEnumerate\x12/.openstorage.api.SdkCloudBackupEnumerateRequest\x1a\x30.openstorage.api.SdkCloudBackupEnumerateResponse\"$\x82\xd3\xe4\x93\x02\x1e\"\x19/v1/cloudbackup/enumerate:\x01*\x12\x88\x01\n\x06Status\x12,.openstorage.api.SdkCloudBackupStatusRequest\x1a-.openstorage.api.SdkCloudBackupStatusResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x16/v1/cloudbackup/status:\x01*\x12\x8c\x01\n\x07\x43\x61talog\x12-.openstorage.api.SdkCloudBackupCatalogRequest\x1a..openstorage.api.SdkCloudBackupCatalogResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/cloudbackup/catalog:\x01*\x12\x8c\x01\n\x07History\x12-.openstorage.api.SdkCloudBackupHistoryRequest\x1a..openstorage.api.SdkCloudBackupHistoryResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x17/v1/cloudbackup/history:\x01*\x12\x9c\x01\n\x0bStateChange\x12\x31.openstorage.api.SdkCloudBackupStateChangeRequest\x1a\x32.openstorage.api.SdkCloudBackupStateChangeResponse\"&\x82\xd3\xe4\x93\x02 \"\x1b/v1/cloudbackup/statechange:\x01*2\x97\x01\n\x10OpenStorageAudit\x12\x82\x01\n\tEnumerate\x12).openstorage.api.SdkAuditEnumerateRequest\x1a*.openstorage.api.SdkAuditEnumerateResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\"\x13/v1/audit/enumerate:\x01*B\x1c\n\x13\x63om.openstorage.apiP\x01Z\x03\x61pib\x06proto3')
   ,
   dependencies=[google_dot_protobuf_dot_timestamp__pb2.DESCRIPTOR,google_dot_api_dot_annotations__pb2.DESCRIPTOR,])
 
@@ -95,8 +95,8 @@ _STATUS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=13446,
-  serialized_end=13812,
+  serialized_start=17125,
+  serialized_end=17491,
 )
 _sym_db.RegisterEnumDescriptor(_STATUS)
 
@@ -134,8 +134,8 @@ _DRIVERTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=13815,
-  serialized_end=13968,
+  serialized_start=17494,
+  serialized_end=17647,
 )
 _sym_db.RegisterEnumDescriptor(_DRIVERTYPE)
 
@@ -181,8 +181,8 @@ _FSTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=13971,
-  serialized_end=14120,
+  serialized_start=17650,
+  serialized_end=17799,
 )
 _sym_db.RegisterEnumDescriptor(_FSTYPE)
 
@@ -212,8 +212,8 @@ _GRAPHDRIVERCHANGETYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=14123,
-  serialized_end=14294,
+  serialized_start=17802,
+  serialized_end=17973,
 )
 _sym_db.RegisterEnumDescriptor(_GRAPHDRIVERCHANGETYPE)
 
@@ -243,8 +243,8 @@ _SEVERITYTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=14296,
-  serialized_end=14412,
+  serialized_start=17975,
+  serialized_end=18091,
 )
 _sym_db.RegisterEnumDescriptor(_SEVERITYTYPE)
 
@@ -278,8 +278,8 @@ _RESOURCETYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=14415,
-  serialized_end=14555,
+  serialized_start=18094,
+  serialized_end=18234,
 )
 _sym_db.RegisterEnumDescriptor(_RESOURCETYPE)
 
@@ -309,8 +309,8 @@ _ALERTACTIONTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=14558,
-  serialized_end=14693,
+  serialized_start=18237,
+  serialized_end=18372,
 )
 _sym_db.RegisterEnumDescriptor(_ALERTACTIONTYPE)
 
@@ -336,8 +336,8 @@ _VOLUMEACTIONPARAM = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=14695,
-  serialized_end=14801,
+  serialized_start=18374,
+  serialized_end=18480,
 )
 _sym_db.RegisterEnumDescriptor(_VOLUMEACTIONPARAM)
 
@@ -367,8 +367,8 @@ _COSTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=14803,
-  serialized_end=14853,
+  serialized_start=18482,
+  serialized_end=18532,
 )
 _sym_db.RegisterEnumDescriptor(_COSTYPE)
 
@@ -402,8 +402,8 @@ _IOPROFILE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=14855,
-  serialized_end=14981,
+  serialized_start=18534,
+  serialized_end=18660,
 )
 _sym_db.RegisterEnumDescriptor(_IOPROFILE)
 
@@ -457,8 +457,8 @@ _VOLUMESTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=14984,
-  serialized_end=15265,
+  serialized_start=18663,
+  serialized_end=18944,
 )
 _sym_db.RegisterEnumDescriptor(_VOLUMESTATE)
 
@@ -492,8 +492,8 @@ _VOLUMESTATUS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=15268,
-  serialized_end=15411,
+  serialized_start=18947,
+  serialized_end=19090,
 )
 _sym_db.RegisterEnumDescriptor(_VOLUMESTATUS)
 
@@ -519,8 +519,8 @@ _STORAGEMEDIUM = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=15413,
-  serialized_end=15506,
+  serialized_start=19092,
+  serialized_end=19185,
 )
 _sym_db.RegisterEnumDescriptor(_STORAGEMEDIUM)
 
@@ -538,8 +538,8 @@ _CLUSTERNOTIFY = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=15508,
-  serialized_end=15548,
+  serialized_start=19187,
+  serialized_end=19227,
 )
 _sym_db.RegisterEnumDescriptor(_CLUSTERNOTIFY)
 
@@ -565,8 +565,8 @@ _ATTACHSTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=15550,
-  serialized_end=15651,
+  serialized_start=19229,
+  serialized_end=19330,
 )
 _sym_db.RegisterEnumDescriptor(_ATTACHSTATE)
 
@@ -592,12 +592,70 @@ _OPERATIONFLAGS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=15653,
-  serialized_end=15737,
+  serialized_start=19332,
+  serialized_end=19416,
 )
 _sym_db.RegisterEnumDescriptor(_OPERATIONFLAGS)
 
 OperationFlags = enum_type_wrapper.EnumTypeWrapper(_OPERATIONFLAGS)
+_OWNERSHIPACCESSTYPE = _descriptor.EnumDescriptor(
+  name='OwnershipAccessType',
+  full_name='openstorage.api.OwnershipAccessType',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='OWNERSHIP_ACCESS_TYPE_READ', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='OWNERSHIP_ACCESS_TYPE_WRITE', index=1, number=1,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='OWNERSHIP_ACCESS_TYPE_ADMIN', index=2, number=2,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=19418,
+  serialized_end=19537,
+)
+_sym_db.RegisterEnumDescriptor(_OWNERSHIPACCESSTYPE)
+
+OwnershipAccessType = enum_type_wrapper.EnumTypeWrapper(_OWNERSHIPACCESSTYPE)
+_LABELSELECTOROPERATOR = _descriptor.EnumDescriptor(
+  name='LabelSelectorOperator',
+  full_name='openstorage.api.LabelSelectorOperator',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='LABEL_SELECTOR_OPERATOR_IN', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='LABEL_SELECTOR_OPERATOR_NOT_IN', index=1, number=1,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='LABEL_SELECTOR_OPERATOR_EXISTS', index=2, number=2,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST', index=3, number=3,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=19540,
+  serialized_end=19711,
+)
+_sym_db.RegisterEnumDescriptor(_LABELSELECTOROPERATOR)
+
+LabelSelectorOperator = enum_type_wrapper.EnumTypeWrapper(_LABELSELECTOROPERATOR)
 _SDKCLOUDBACKUPOPTYPE = _descriptor.EnumDescriptor(
   name='SdkCloudBackupOpType',
   full_name='openstorage.api.SdkCloudBackupOpType',
@@ -619,8 +677,8 @@ _SDKCLOUDBACKUPOPTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=15739,
-  serialized_end=15863,
+  serialized_start=19713,
+  serialized_end=19837,
 )
 _sym_db.RegisterEnumDescriptor(_SDKCLOUDBACKUPOPTYPE)
 
@@ -666,8 +724,8 @@ _SDKCLOUDBACKUPSTATUSTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=15866,
-  serialized_end=16185,
+  serialized_start=19840,
+  serialized_end=20159,
 )
 _sym_db.RegisterEnumDescriptor(_SDKCLOUDBACKUPSTATUSTYPE)
 
@@ -697,8 +755,8 @@ _SDKCLOUDBACKUPREQUESTEDSTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   options=None,
-  serialized_start=16188,
-  serialized_end=16376,
+  serialized_start=20162,
+  serialized_end=20350,
 )
 _sym_db.RegisterEnumDescriptor(_SDKCLOUDBACKUPREQUESTEDSTATE)
 
@@ -786,6 +844,13 @@ ATTACH_STATE_INTERNAL_SWITCH = 2
 OP_FLAGS_UNKNOWN = 0
 OP_FLAGS_NONE = 1
 OP_FLAGS_DETACH_FORCE = 2
+OWNERSHIP_ACCESS_TYPE_READ = 0
+OWNERSHIP_ACCESS_TYPE_WRITE = 1
+OWNERSHIP_ACCESS_TYPE_ADMIN = 2
+LABEL_SELECTOR_OPERATOR_IN = 0
+LABEL_SELECTOR_OPERATOR_NOT_IN = 1
+LABEL_SELECTOR_OPERATOR_EXISTS = 2
+LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST = 3
 SdkCloudBackupOpTypeUnknown = 0
 SdkCloudBackupOpTypeBackupOp = 1
 SdkCloudBackupOpTypeRestoreOp = 2
@@ -1173,6 +1238,274 @@ _GROUP = _descriptor.Descriptor(
 )
 
 
+_OWNERSHIPACLS_GROUPSENTRY = _descriptor.Descriptor(
+  name='GroupsEntry',
+  full_name='openstorage.api.OwnershipAcls.GroupsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='openstorage.api.OwnershipAcls.GroupsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='openstorage.api.OwnershipAcls.GroupsEntry.value', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1030,
+  serialized_end=1113,
+)
+
+_OWNERSHIPACLS_COLLABORATORSENTRY = _descriptor.Descriptor(
+  name='CollaboratorsEntry',
+  full_name='openstorage.api.OwnershipAcls.CollaboratorsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='openstorage.api.OwnershipAcls.CollaboratorsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='openstorage.api.OwnershipAcls.CollaboratorsEntry.value', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1115,
+  serialized_end=1205,
+)
+
+_OWNERSHIPACLS = _descriptor.Descriptor(
+  name='OwnershipAcls',
+  full_name='openstorage.api.OwnershipAcls',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='groups', full_name='openstorage.api.OwnershipAcls.groups', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='collaborators', full_name='openstorage.api.OwnershipAcls.collaborators', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_OWNERSHIPACLS_GROUPSENTRY, _OWNERSHIPACLS_COLLABORATORSENTRY, ],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=879,
+  serialized_end=1205,
+)
+
+
+_OWNERSHIP = _descriptor.Descriptor(
+  name='Ownership',
+  full_name='openstorage.api.Ownership',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='owner', full_name='openstorage.api.Ownership.owner', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='acls', full_name='openstorage.api.Ownership.acls', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1207,
+  serialized_end=1279,
+)
+
+
+_LABELSELECTORREQUIREMENT = _descriptor.Descriptor(
+  name='LabelSelectorRequirement',
+  full_name='openstorage.api.LabelSelectorRequirement',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='openstorage.api.LabelSelectorRequirement.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='operator', full_name='openstorage.api.LabelSelectorRequirement.operator', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='values', full_name='openstorage.api.LabelSelectorRequirement.values', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1281,
+  serialized_end=1394,
+)
+
+
+_VOLUMEFILTER = _descriptor.Descriptor(
+  name='VolumeFilter',
+  full_name='openstorage.api.VolumeFilter',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='locator', full_name='openstorage.api.VolumeFilter.locator', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='label_selector', full_name='openstorage.api.VolumeFilter.label_selector', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='state', full_name='openstorage.api.VolumeFilter.state', index=2,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='openstorage.api.VolumeFilter.status', index=3,
+      number=4, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='owner', full_name='openstorage.api.VolumeFilter.owner', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='created_after', full_name='openstorage.api.VolumeFilter.created_after', index=5,
+      number=6, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='created_before', full_name='openstorage.api.VolumeFilter.created_before', index=6,
+      number=7, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1397,
+  serialized_end=1737,
+)
+
+
 _VOLUMESPEC_VOLUMELABELSENTRY = _descriptor.Descriptor(
   name='VolumeLabelsEntry',
   full_name='openstorage.api.VolumeSpec.VolumeLabelsEntry',
@@ -1385,7 +1718,14 @@ _VOLUMESPEC = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
-  ],
+    _descriptor.FieldDescriptor(
+      name='ownership', full_name='openstorage.api.VolumeSpec.ownership', index=24,
+      number=27, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
   extensions=[
   ],
   nested_types=[_VOLUMESPEC_VOLUMELABELSENTRY, ],
@@ -1397,8 +1737,8 @@ _VOLUMESPEC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=879,
-  serialized_end=1582,
+  serialized_start=1740,
+  serialized_end=2490,
 )
 
 
@@ -1428,8 +1768,8 @@ _REPLICASET = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1584,
-  serialized_end=1611,
+  serialized_start=2492,
+  serialized_end=2519,
 )
 
 
@@ -1466,8 +1806,8 @@ _RUNTIMESTATEMAP_RUNTIMESTATEENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1708,
-  serialized_end=1759,
+  serialized_start=2616,
+  serialized_end=2667,
 )
 
 _RUNTIMESTATEMAP = _descriptor.Descriptor(
@@ -1496,8 +1836,8 @@ _RUNTIMESTATEMAP = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1614,
-  serialized_end=1759,
+  serialized_start=2522,
+  serialized_end=2667,
 )
 
 
@@ -1534,8 +1874,8 @@ _VOLUME_ATTACHINFOENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2601,
-  serialized_end=2650,
+  serialized_start=3509,
+  serialized_end=3558,
 )
 
 _VOLUME = _descriptor.Descriptor(
@@ -1711,8 +2051,8 @@ _VOLUME = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1762,
-  serialized_end=2650,
+  serialized_start=2670,
+  serialized_end=3558,
 )
 
 
@@ -1805,8 +2145,8 @@ _STATS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2653,
-  serialized_end=2844,
+  serialized_start=3561,
+  serialized_end=3752,
 )
 
 
@@ -1899,8 +2239,8 @@ _ALERT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2847,
-  serialized_end=3119,
+  serialized_start=3755,
+  serialized_end=4027,
 )
 
 
@@ -1930,8 +2270,102 @@ _ALERTS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3121,
-  serialized_end=3168,
+  serialized_start=4029,
+  serialized_end=4076,
+)
+
+
+_AUDITEVENT = _descriptor.Descriptor(
+  name='AuditEvent',
+  full_name='openstorage.api.AuditEvent',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='openstorage.api.AuditEvent.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='openstorage.api.AuditEvent.timestamp', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='node_id', full_name='openstorage.api.AuditEvent.node_id', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='api', full_name='openstorage.api.AuditEvent.api', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='operation', full_name='openstorage.api.AuditEvent.operation', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='subject', full_name='openstorage.api.AuditEvent.subject', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='remote_address', full_name='openstorage.api.AuditEvent.remote_address', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='request', full_name='openstorage.api.AuditEvent.request', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='success', full_name='openstorage.api.AuditEvent.success', index=8,
+      number=9, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='openstorage.api.AuditEvent.error', index=9,
+      number=10, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4079,
+  serialized_end=4289,
 )
 
 
@@ -2031,8 +2465,8 @@ _OBJECTSTOREINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3171,
-  serialized_end=3392,
+  serialized_start=4292,
+  serialized_end=4513,
 )
 
 
@@ -2076,8 +2510,8 @@ _VOLUMECREATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3395,
-  serialized_end=3549,
+  serialized_start=4516,
+  serialized_end=4670,
 )
 
 
@@ -2107,8 +2541,8 @@ _VOLUMERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3551,
-  serialized_end=3582,
+  serialized_start=4672,
+  serialized_end=4703,
 )
 
 
@@ -2145,8 +2579,8 @@ _VOLUMECREATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3584,
-  serialized_end=3676,
+  serialized_start=4705,
+  serialized_end=4797,
 )
 
 
@@ -2197,8 +2631,8 @@ _VOLUMESTATEACTION = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3679,
-  serialized_end=3842,
+  serialized_start=4800,
+  serialized_end=4963,
 )
 
 
@@ -2235,8 +2669,8 @@ _VOLUMESETREQUEST_OPTIONSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4074,
-  serialized_end=4120,
+  serialized_start=5195,
+  serialized_end=5241,
 )
 
 _VOLUMESETREQUEST = _descriptor.Descriptor(
@@ -2286,8 +2720,8 @@ _VOLUMESETREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3845,
-  serialized_end=4120,
+  serialized_start=4966,
+  serialized_end=5241,
 )
 
 
@@ -2324,8 +2758,8 @@ _VOLUMESETRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4122,
-  serialized_end=4240,
+  serialized_start=5243,
+  serialized_end=5361,
 )
 
 
@@ -2369,8 +2803,8 @@ _SNAPCREATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4242,
-  serialized_end=4340,
+  serialized_start=5363,
+  serialized_end=5461,
 )
 
 
@@ -2400,8 +2834,8 @@ _SNAPCREATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4342,
-  serialized_end=4433,
+  serialized_start=5463,
+  serialized_end=5554,
 )
 
 
@@ -2445,8 +2879,8 @@ _VOLUMEINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4435,
-  serialized_end=4526,
+  serialized_start=5556,
+  serialized_end=5647,
 )
 
 
@@ -2511,8 +2945,8 @@ _VOLUMECONSUMER = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4528,
-  serialized_end=4648,
+  serialized_start=5649,
+  serialized_end=5769,
 )
 
 
@@ -2549,8 +2983,8 @@ _GRAPHDRIVERCHANGES = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4650,
-  serialized_end=4738,
+  serialized_start=5771,
+  serialized_end=5859,
 )
 
 
@@ -2580,8 +3014,8 @@ _CLUSTERRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4740,
-  serialized_end=4772,
+  serialized_start=5861,
+  serialized_end=5893,
 )
 
 
@@ -2618,8 +3052,8 @@ _ACTIVEREQUEST_REQESTKVENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4856,
-  serialized_end=4903,
+  serialized_start=5977,
+  serialized_end=6024,
 )
 
 _ACTIVEREQUEST = _descriptor.Descriptor(
@@ -2648,8 +3082,8 @@ _ACTIVEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4775,
-  serialized_end=4903,
+  serialized_start=5896,
+  serialized_end=6024,
 )
 
 
@@ -2686,8 +3120,8 @@ _ACTIVEREQUESTS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4905,
-  serialized_end=4998,
+  serialized_start=6026,
+  serialized_end=6119,
 )
 
 
@@ -2761,8 +3195,8 @@ _GROUPSNAPCREATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5001,
-  serialized_end=5153,
+  serialized_start=6122,
+  serialized_end=6274,
 )
 
 
@@ -2799,8 +3233,8 @@ _GROUPSNAPCREATERESPONSE_SNAPSHOTSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5274,
-  serialized_end=5359,
+  serialized_start=6395,
+  serialized_end=6480,
 )
 
 _GROUPSNAPCREATERESPONSE = _descriptor.Descriptor(
@@ -2836,8 +3270,8 @@ _GROUPSNAPCREATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5156,
-  serialized_end=5359,
+  serialized_start=6277,
+  serialized_end=6480,
 )
 
 
@@ -2874,8 +3308,8 @@ _STORAGENODE_DISKSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5736,
-  serialized_end=5814,
+  serialized_start=6857,
+  serialized_end=6935,
 )
 
 _STORAGENODE_NODELABELSENTRY = _descriptor.Descriptor(
@@ -2911,8 +3345,8 @@ _STORAGENODE_NODELABELSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5816,
-  serialized_end=5865,
+  serialized_start=6937,
+  serialized_end=6986,
 )
 
 _STORAGENODE = _descriptor.Descriptor(
@@ -3025,8 +3459,8 @@ _STORAGENODE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5362,
-  serialized_end=5865,
+  serialized_start=6483,
+  serialized_end=6986,
 )
 
 
@@ -3077,8 +3511,8 @@ _STORAGECLUSTER = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5868,
-  serialized_end=5999,
+  serialized_start=6989,
+  serialized_end=7120,
 )
 
 
@@ -3108,8 +3542,8 @@ _SDKSCHEDULEPOLICYCREATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6001,
-  serialized_end=6093,
+  serialized_start=7122,
+  serialized_end=7214,
 )
 
 
@@ -3132,8 +3566,8 @@ _SDKSCHEDULEPOLICYCREATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6095,
-  serialized_end=6128,
+  serialized_start=7216,
+  serialized_end=7249,
 )
 
 
@@ -3163,8 +3597,8 @@ _SDKSCHEDULEPOLICYUPDATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6130,
-  serialized_end=6222,
+  serialized_start=7251,
+  serialized_end=7343,
 )
 
 
@@ -3187,8 +3621,8 @@ _SDKSCHEDULEPOLICYUPDATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6224,
-  serialized_end=6257,
+  serialized_start=7345,
+  serialized_end=7378,
 )
 
 
@@ -3211,8 +3645,8 @@ _SDKSCHEDULEPOLICYENUMERATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6259,
-  serialized_end=6294,
+  serialized_start=7380,
+  serialized_end=7415,
 )
 
 
@@ -3242,8 +3676,8 @@ _SDKSCHEDULEPOLICYENUMERATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6296,
-  serialized_end=6386,
+  serialized_start=7417,
+  serialized_end=7507,
 )
 
 
@@ -3273,8 +3707,8 @@ _SDKSCHEDULEPOLICYINSPECTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6388,
-  serialized_end=6435,
+  serialized_start=7509,
+  serialized_end=7556,
 )
 
 
@@ -3304,8 +3738,8 @@ _SDKSCHEDULEPOLICYINSPECTRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6437,
-  serialized_end=6523,
+  serialized_start=7558,
+  serialized_end=7644,
 )
 
 
@@ -3335,8 +3769,8 @@ _SDKSCHEDULEPOLICYDELETEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6525,
-  serialized_end=6571,
+  serialized_start=7646,
+  serialized_end=7692,
 )
 
 
@@ -3359,8 +3793,8 @@ _SDKSCHEDULEPOLICYDELETERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6573,
-  serialized_end=6606,
+  serialized_start=7694,
+  serialized_end=7727,
 )
 
 
@@ -3397,8 +3831,8 @@ _SDKSCHEDULEPOLICY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6608,
-  serialized_end=6659,
+  serialized_start=7729,
+  serialized_end=7780,
 )
 
 
@@ -3428,8 +3862,8 @@ _SDKCREDENTIALCREATEAZUREREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6661,
-  serialized_end=6748,
+  serialized_start=7782,
+  serialized_end=7869,
 )
 
 
@@ -3459,8 +3893,8 @@ _SDKCREDENTIALCREATEAZURERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6750,
-  serialized_end=6807,
+  serialized_start=7871,
+  serialized_end=7928,
 )
 
 
@@ -3490,8 +3924,8 @@ _SDKCREDENTIALCREATEGOOGLEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6809,
-  serialized_end=6898,
+  serialized_start=7930,
+  serialized_end=8019,
 )
 
 
@@ -3521,8 +3955,8 @@ _SDKCREDENTIALCREATEGOOGLERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6900,
-  serialized_end=6958,
+  serialized_start=8021,
+  serialized_end=8079,
 )
 
 
@@ -3552,8 +3986,8 @@ _SDKCREDENTIALCREATEAWSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6960,
-  serialized_end=7042,
+  serialized_start=8081,
+  serialized_end=8163,
 )
 
 
@@ -3583,8 +4017,8 @@ _SDKCREDENTIALCREATEAWSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7044,
-  serialized_end=7099,
+  serialized_start=8165,
+  serialized_end=8220,
 )
 
 
@@ -3642,8 +4076,8 @@ _S3CREDENTIAL = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7101,
-  serialized_end=7212,
+  serialized_start=8222,
+  serialized_end=8333,
 )
 
 
@@ -3687,8 +4121,8 @@ _AZURECREDENTIAL = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7214,
-  serialized_end=7297,
+  serialized_start=8335,
+  serialized_end=8418,
 )
 
 
@@ -3732,8 +4166,8 @@ _GOOGLECREDENTIAL = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7299,
-  serialized_end=7378,
+  serialized_start=8420,
+  serialized_end=8499,
 )
 
 
@@ -3763,8 +4197,8 @@ _SDKCREDENTIALENUMERATEAWSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7380,
-  serialized_end=7437,
+  serialized_start=8501,
+  serialized_end=8558,
 )
 
 
@@ -3794,8 +4228,8 @@ _SDKCREDENTIALENUMERATEAWSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7439,
-  serialized_end=7525,
+  serialized_start=8560,
+  serialized_end=8646,
 )
 
 
@@ -3825,8 +4259,8 @@ _SDKCREDENTIALENUMERATEAZUREREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7527,
-  serialized_end=7586,
+  serialized_start=8648,
+  serialized_end=8707,
 )
 
 
@@ -3856,8 +4290,8 @@ _SDKCREDENTIALENUMERATEAZURERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7588,
-  serialized_end=7679,
+  serialized_start=8709,
+  serialized_end=8800,
 )
 
 
@@ -3887,8 +4321,8 @@ _SDKCREDENTIALENUMERATEGOOGLEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7681,
-  serialized_end=7741,
+  serialized_start=8802,
+  serialized_end=8862,
 )
 
 
@@ -3918,8 +4352,8 @@ _SDKCREDENTIALENUMERATEGOOGLERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7743,
-  serialized_end=7836,
+  serialized_start=8864,
+  serialized_end=8957,
 )
 
 
@@ -3949,8 +4383,8 @@ _SDKCREDENTIALDELETEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7838,
-  serialized_end=7889,
+  serialized_start=8959,
+  serialized_end=9010,
 )
 
 
@@ -3973,8 +4407,8 @@ _SDKCREDENTIALDELETERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7891,
-  serialized_end=7920,
+  serialized_start=9012,
+  serialized_end=9041,
 )
 
 
@@ -4004,8 +4438,8 @@ _SDKCREDENTIALVALIDATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7922,
-  serialized_end=7975,
+  serialized_start=9043,
+  serialized_end=9096,
 )
 
 
@@ -4028,8 +4462,8 @@ _SDKCREDENTIALVALIDATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7977,
-  serialized_end=8008,
+  serialized_start=9098,
+  serialized_end=9129,
 )
 
 
@@ -4066,8 +4500,8 @@ _SDKVOLUMEMOUNTREQUEST_OPTIONSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4074,
-  serialized_end=4120,
+  serialized_start=5195,
+  serialized_end=5241,
 )
 
 _SDKVOLUMEMOUNTREQUEST = _descriptor.Descriptor(
@@ -4110,8 +4544,8 @@ _SDKVOLUMEMOUNTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8011,
-  serialized_end=8191,
+  serialized_start=9132,
+  serialized_end=9312,
 )
 
 
@@ -4134,8 +4568,8 @@ _SDKVOLUMEMOUNTRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8193,
-  serialized_end=8217,
+  serialized_start=9314,
+  serialized_end=9338,
 )
 
 
@@ -4172,8 +4606,8 @@ _SDKVOLUMEUNMOUNTREQUEST_OPTIONSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4074,
-  serialized_end=4120,
+  serialized_start=5195,
+  serialized_end=5241,
 )
 
 _SDKVOLUMEUNMOUNTREQUEST = _descriptor.Descriptor(
@@ -4216,8 +4650,8 @@ _SDKVOLUMEUNMOUNTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8220,
-  serialized_end=8404,
+  serialized_start=9341,
+  serialized_end=9525,
 )
 
 
@@ -4240,8 +4674,8 @@ _SDKVOLUMEUNMOUNTRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8406,
-  serialized_end=8432,
+  serialized_start=9527,
+  serialized_end=9553,
 )
 
 
@@ -4278,8 +4712,8 @@ _SDKVOLUMEATTACHREQUEST_OPTIONSENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4074,
-  serialized_end=4120,
+  serialized_start=5195,
+  serialized_end=5241,
 )
 
 _SDKVOLUMEATTACHREQUEST = _descriptor.Descriptor(
@@ -4315,8 +4749,8 @@ _SDKVOLUMEATTACHREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8435,
-  serialized_end=8597,
+  serialized_start=9556,
+  serialized_end=9718,
 )
 
 
@@ -4346,8 +4780,8 @@ _SDKVOLUMEATTACHRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8599,
-  serialized_end=8645,
+  serialized_start=9720,
+  serialized_end=9766,
 )
 
 
@@ -4377,8 +4811,8 @@ _SDKVOLUMEDETACHREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8647,
-  serialized_end=8690,
+  serialized_start=9768,
+  serialized_end=9811,
 )
 
 
@@ -4401,8 +4835,8 @@ _SDKVOLUMEDETACHRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8692,
-  serialized_end=8717,
+  serialized_start=9813,
+  serialized_end=9838,
 )
 
 
@@ -4439,8 +4873,8 @@ _SDKVOLUMECREATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8719,
-  serialized_end=8800,
+  serialized_start=9840,
+  serialized_end=9921,
 )
 
 
@@ -4470,8 +4904,8 @@ _SDKVOLUMECREATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8802,
-  serialized_end=8846,
+  serialized_start=9923,
+  serialized_end=9967,
 )
 
 
@@ -4515,8 +4949,8 @@ _SDKVOLUMECREATEFROMVOLUMEIDREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8848,
-  serialized_end=8960,
+  serialized_start=9969,
+  serialized_end=10081,
 )
 
 
@@ -4546,8 +4980,8 @@ _SDKVOLUMECREATEFROMVOLUMEIDRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8962,
-  serialized_end=9018,
+  serialized_start=10083,
+  serialized_end=10139,
 )
 
 
@@ -4577,8 +5011,8 @@ _SDKVOLUMEDELETEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9020,
-  serialized_end=9063,
+  serialized_start=10141,
+  serialized_end=10184,
 )
 
 
@@ -4601,8 +5035,8 @@ _SDKVOLUMEDELETERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9065,
-  serialized_end=9090,
+  serialized_start=10186,
+  serialized_end=10211,
 )
 
 
@@ -4632,8 +5066,8 @@ _SDKVOLUMEINSPECTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9092,
-  serialized_end=9136,
+  serialized_start=10213,
+  serialized_end=10257,
 )
 
 
@@ -4663,8 +5097,8 @@ _SDKVOLUMEINSPECTRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9138,
-  serialized_end=9205,
+  serialized_start=10259,
+  serialized_end=10326,
 )
 
 
@@ -4682,6 +5116,27 @@ _SDKVOLUMEENUMERATEREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='filter', full_name='openstorage.api.SdkVolumeEnumerateRequest.filter', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='max_results', full_name='openstorage.api.SdkVolumeEnumerateRequest.max_results', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='page_token', full_name='openstorage.api.SdkVolumeEnumerateRequest.page_token', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -4694,8 +5149,8 @@ _SDKVOLUMEENUMERATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9207,
-  serialized_end=9283,
+  serialized_start=10329,
+  serialized_end=10493,
 )
 
 
@@ -4713,6 +5168,13 @@ _SDKVOLUMEENUMERATERESPONSE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='next_page_token', full_name='openstorage.api.SdkVolumeEnumerateResponse.next_page_token', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -4725,27 +5187,27 @@ _SDKVOLUMEENUMERATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9285,
-  serialized_end=9355,
+  serialized_start=10495,
+  serialized_end=10590,
 )
 
 
-_SDKVOLUMESNAPSHOTCREATEREQUEST_LABELSENTRY = _descriptor.Descriptor(
+_SDKVOLUMEUPDATEREQUEST_LABELSENTRY = _descriptor.Descriptor(
   name='LabelsEntry',
-  full_name='openstorage.api.SdkVolumeSnapshotCreateRequest.LabelsEntry',
+  full_name='openstorage.api.SdkVolumeUpdateRequest.LabelsEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='openstorage.api.SdkVolumeSnapshotCreateRequest.LabelsEntry.key', index=0,
+      name='key', full_name='openstorage.api.SdkVolumeUpdateRequest.LabelsEntry.key', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='value', full_name='openstorage.api.SdkVolumeSnapshotCreateRequest.LabelsEntry.value', index=1,
+      name='value', full_name='openstorage.api.SdkVolumeUpdateRequest.LabelsEntry.value', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -4767,31 +5229,38 @@ _SDKVOLUMESNAPSHOTCREATEREQUEST_LABELSENTRY = _descriptor.Descriptor(
   serialized_end=657,
 )
 
-_SDKVOLUMESNAPSHOTCREATEREQUEST = _descriptor.Descriptor(
-  name='SdkVolumeSnapshotCreateRequest',
-  full_name='openstorage.api.SdkVolumeSnapshotCreateRequest',
+_SDKVOLUMEUPDATEREQUEST = _descriptor.Descriptor(
+  name='SdkVolumeUpdateRequest',
+  full_name='openstorage.api.SdkVolumeUpdateRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='volume_id', full_name='openstorage.api.SdkVolumeSnapshotCreateRequest.volume_id', index=0,
+      name='volume_id', full_name='openstorage.api.SdkVolumeUpdateRequest.volume_id', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='labels', full_name='openstorage.api.SdkVolumeSnapshotCreateRequest.labels', index=1,
+      name='labels', full_name='openstorage.api.SdkVolumeUpdateRequest.labels', index=1,
       number=2, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='spec', full_name='openstorage.api.SdkVolumeUpdateRequest.spec', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
-  nested_types=[_SDKVOLUMESNAPSHOTCREATEREQUEST_LABELSENTRY, ],
+  nested_types=[_SDKVOLUMEUPDATEREQUEST_LABELSENTRY, ],
   enum_types=[
   ],
   options=None,
@@ -4800,25 +5269,63 @@ _SDKVOLUMESNAPSHOTCREATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9358,
-  serialized_end=9533,
+  serialized_start=10593,
+  serialized_end=10795,
 )
 
 
-_SDKVOLUMESNAPSHOTCREATERESPONSE = _descriptor.Descriptor(
-  name='SdkVolumeSnapshotCreateResponse',
-  full_name='openstorage.api.SdkVolumeSnapshotCreateResponse',
+_SDKVOLUMEUPDATERESPONSE = _descriptor.Descriptor(
+  name='SdkVolumeUpdateResponse',
+  full_name='openstorage.api.SdkVolumeUpdateResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='snapshot_id', full_name='openstorage.api.SdkVolumeSnapshotCreateResponse.snapshot_id', index=0,
+      name='volume', full_name='openstorage.api.SdkVolumeUpdateResponse.volume', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10797,
+  serialized_end=10863,
+)
+
+
+_SDKVOLUMESTATSREQUEST = _descriptor.Descriptor(
+  name='SdkVolumeStatsRequest',
+  full_name='openstorage.api.SdkVolumeStatsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='volume_id', full_name='openstorage.api.SdkVolumeStatsRequest.volume_id', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='not_cumulative', full_name='openstorage.api.SdkVolumeStatsRequest.not_cumulative', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -4831,32 +5338,1001 @@ _SDKVOLUMESNAPSHOTCREATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9535,
-  serialized_end=9589,
+  serialized_start=10865,
+  serialized_end=10931,
 )
 
 
-_SDKVOLUMESNAPSHOTRESTOREREQUEST = _descriptor.Descriptor(
-  name='SdkVolumeSnapshotRestoreRequest',
-  full_name='openstorage.api.SdkVolumeSnapshotRestoreRequest',
+_SDKVOLUMESTATSRESPONSE = _descriptor.Descriptor(
+  name='SdkVolumeStatsResponse',
+  full_name='openstorage.api.SdkVolumeStatsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='volume_id', full_name='openstorage.api.SdkVolumeSnapshotRestoreRequest.volume_id', index=0,
+      name='stats', full_name='openstorage.api.SdkVolumeStatsResponse.stats', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='write_throughput', full_name='openstorage.api.SdkVolumeStatsResponse.write_throughput', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='read_throughput', full_name='openstorage.api.SdkVolumeStatsResponse.read_throughput', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='latency', full_name='openstorage.api.SdkVolumeStatsResponse.latency', index=3,
+      number=4, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='read_latency', full_name='openstorage.api.SdkVolumeStatsResponse.read_latency', index=4,
+      number=5, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='write_latency', full_name='openstorage.api.SdkVolumeStatsResponse.write_latency', index=5,
+      number=6, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='iops', full_name='openstorage.api.SdkVolumeStatsResponse.iops', index=6,
+      number=7, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10934,
+  serialized_end=11124,
+)
+
+
+_SDKVOLUMECAPACITYUSAGEREQUEST = _descriptor.Descriptor(
+  name='SdkVolumeCapacityUsageRequest',
+  full_name='openstorage.api.SdkVolumeCapacityUsageRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='volume_id', full_name='openstorage.api.SdkVolumeCapacityUsageRequest.volume_id', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11126,
+  serialized_end=11176,
+)
+
+
+_SDKVOLUMECAPACITYUSAGERESPONSE = _descriptor.Descriptor(
+  name='SdkVolumeCapacityUsageResponse',
+  full_name='openstorage.api.SdkVolumeCapacityUsageResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
     _descriptor.FieldDescriptor(
-      name='snapshot_id', full_name='openstorage.api.SdkVolumeSnapshotRestoreRequest.snapshot_id', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='used_bytes', full_name='openstorage.api.SdkVolumeCapacityUsageResponse.used_bytes', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='total_bytes', full_name='openstorage.api.SdkVolumeCapacityUsageResponse.total_bytes', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11178,
+  serialized_end=11251,
+)
+
+
+_SDKVOLUMEACTIVEREQUESTSREQUEST = _descriptor.Descriptor(
+  name='SdkVolumeActiveRequestsRequest',
+  full_name='openstorage.api.SdkVolumeActiveRequestsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11253,
+  serialized_end=11285,
+)
+
+
+_SDKVOLUMEACTIVEREQUESTSRESPONSE = _descriptor.Descriptor(
+  name='SdkVolumeActiveRequestsResponse',
+  full_name='openstorage.api.SdkVolumeActiveRequestsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='active_requests', full_name='openstorage.api.SdkVolumeActiveRequestsResponse.active_requests', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11287,
+  serialized_end=11378,
+)
+
+
+_SDKVOLUMESNAPSHOTCREATEREQUEST_LABELSENTRY = _descriptor.Descriptor(
+  name='LabelsEntry',
+  full_name='openstorage.api.SdkVolumeSnapshotCreateRequest.LabelsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='openstorage.api.SdkVolumeSnapshotCreateRequest.LabelsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='openstorage.api.SdkVolumeSnapshotCreateRequest.LabelsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=612,
+  serialized_end=657,
+)
+
+_SDKVOLUMESNAPSHOTCREATEREQUEST = _descriptor.Descriptor(
+  name='SdkVolumeSnapshotCreateRequest',
+  full_name='openstorage.api.SdkVolumeSnapshotCreateRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='volume_id', full_name='openstorage.api.SdkVolumeSnapshotCreateRequest.volume_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='openstorage.api.SdkVolumeSnapshotCreateRequest.labels', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_SDKVOLUMESNAPSHOTCREATEREQUEST_LABELSENTRY, ],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11381,
+  serialized_end=11556,
+)
+
+
+_SDKVOLUMESNAPSHOTCREATERESPONSE = _descriptor.Descriptor(
+  name='SdkVolumeSnapshotCreateResponse',
+  full_name='openstorage.api.SdkVolumeSnapshotCreateResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='snapshot_id', full_name='openstorage.api.SdkVolumeSnapshotCreateResponse.snapshot_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11558,
+  serialized_end=11612,
+)
+
+
+_SDKVOLUMESNAPSHOTRESTOREREQUEST = _descriptor.Descriptor(
+  name='SdkVolumeSnapshotRestoreRequest',
+  full_name='openstorage.api.SdkVolumeSnapshotRestoreRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='volume_id', full_name='openstorage.api.SdkVolumeSnapshotRestoreRequest.volume_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='snapshot_id', full_name='openstorage.api.SdkVolumeSnapshotRestoreRequest.snapshot_id', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11614,
+  serialized_end=11687,
+)
+
+
+_SDKVOLUMESNAPSHOTRESTORERESPONSE = _descriptor.Descriptor(
+  name='SdkVolumeSnapshotRestoreResponse',
+  full_name='openstorage.api.SdkVolumeSnapshotRestoreResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11689,
+  serialized_end=11723,
+)
+
+
+_SDKVOLUMESNAPSHOTENUMERATEREQUEST_LABELSENTRY = _descriptor.Descriptor(
+  name='LabelsEntry',
+  full_name='openstorage.api.SdkVolumeSnapshotEnumerateRequest.LabelsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='openstorage.api.SdkVolumeSnapshotEnumerateRequest.LabelsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='openstorage.api.SdkVolumeSnapshotEnumerateRequest.LabelsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=612,
+  serialized_end=657,
+)
+
+_SDKVOLUMESNAPSHOTENUMERATEREQUEST = _descriptor.Descriptor(
+  name='SdkVolumeSnapshotEnumerateRequest',
+  full_name='openstorage.api.SdkVolumeSnapshotEnumerateRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='volume_id', full_name='openstorage.api.SdkVolumeSnapshotEnumerateRequest.volume_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='openstorage.api.SdkVolumeSnapshotEnumerateRequest.labels', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_SDKVOLUMESNAPSHOTENUMERATEREQUEST_LABELSENTRY, ],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11726,
+  serialized_end=11907,
+)
+
+
+_SDKVOLUMESNAPSHOTENUMERATERESPONSE = _descriptor.Descriptor(
+  name='SdkVolumeSnapshotEnumerateResponse',
+  full_name='openstorage.api.SdkVolumeSnapshotEnumerateResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='snapshots', full_name='openstorage.api.SdkVolumeSnapshotEnumerateResponse.snapshots', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11909,
+  serialized_end=11989,
+)
+
+
+_SDKVOLUMESNAPSHOTGROUPCREATEREQUEST_LABELSENTRY = _descriptor.Descriptor(
+  name='LabelsEntry',
+  full_name='openstorage.api.SdkVolumeSnapshotGroupCreateRequest.LabelsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='openstorage.api.SdkVolumeSnapshotGroupCreateRequest.LabelsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='openstorage.api.SdkVolumeSnapshotGroupCreateRequest.LabelsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=612,
+  serialized_end=657,
+)
+
+_SDKVOLUMESNAPSHOTGROUPCREATEREQUEST = _descriptor.Descriptor(
+  name='SdkVolumeSnapshotGroupCreateRequest',
+  full_name='openstorage.api.SdkVolumeSnapshotGroupCreateRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='group_id', full_name='openstorage.api.SdkVolumeSnapshotGroupCreateRequest.group_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='labels', full_name='openstorage.api.SdkVolumeSnapshotGroupCreateRequest.labels', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='quiesce', full_name='openstorage.api.SdkVolumeSnapshotGroupCreateRequest.quiesce', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='quiesce_timeout_seconds', full_name='openstorage.api.SdkVolumeSnapshotGroupCreateRequest.quiesce_timeout_seconds', index=3,
+      number=4, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_SDKVOLUMESNAPSHOTGROUPCREATEREQUEST_LABELSENTRY, ],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=11992,
+  serialized_end=12226,
+)
+
+
+_SDKVOLUMESNAPSHOTGROUPCREATERESPONSE_SNAPSHOTSENTRY = _descriptor.Descriptor(
+  name='SnapshotsEntry',
+  full_name='openstorage.api.SdkVolumeSnapshotGroupCreateResponse.SnapshotsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='openstorage.api.SdkVolumeSnapshotGroupCreateResponse.SnapshotsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='openstorage.api.SdkVolumeSnapshotGroupCreateResponse.SnapshotsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12358,
+  serialized_end=12406,
+)
+
+_SDKVOLUMESNAPSHOTGROUPCREATERESPONSE = _descriptor.Descriptor(
+  name='SdkVolumeSnapshotGroupCreateResponse',
+  full_name='openstorage.api.SdkVolumeSnapshotGroupCreateResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='snapshots', full_name='openstorage.api.SdkVolumeSnapshotGroupCreateResponse.snapshots', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_SDKVOLUMESNAPSHOTGROUPCREATERESPONSE_SNAPSHOTSENTRY, ],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12229,
+  serialized_end=12406,
+)
+
+
+_SDKVOLUMEQUIESCEREQUEST = _descriptor.Descriptor(
+  name='SdkVolumeQuiesceRequest',
+  full_name='openstorage.api.SdkVolumeQuiesceRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='volume_id', full_name='openstorage.api.SdkVolumeQuiesceRequest.volume_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='timeout_seconds', full_name='openstorage.api.SdkVolumeQuiesceRequest.timeout_seconds', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='quiesce_id', full_name='openstorage.api.SdkVolumeQuiesceRequest.quiesce_id', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12408,
+  serialized_end=12497,
+)
+
+
+_SDKVOLUMEQUIESCERESPONSE = _descriptor.Descriptor(
+  name='SdkVolumeQuiesceResponse',
+  full_name='openstorage.api.SdkVolumeQuiesceResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12499,
+  serialized_end=12525,
+)
+
+
+_SDKVOLUMEUNQUIESCEREQUEST = _descriptor.Descriptor(
+  name='SdkVolumeUnquiesceRequest',
+  full_name='openstorage.api.SdkVolumeUnquiesceRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='volume_id', full_name='openstorage.api.SdkVolumeUnquiesceRequest.volume_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12527,
+  serialized_end=12573,
+)
+
+
+_SDKVOLUMEUNQUIESCERESPONSE = _descriptor.Descriptor(
+  name='SdkVolumeUnquiesceResponse',
+  full_name='openstorage.api.SdkVolumeUnquiesceResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12575,
+  serialized_end=12603,
+)
+
+
+_SDKCLUSTERENUMERATEREQUEST = _descriptor.Descriptor(
+  name='SdkClusterEnumerateRequest',
+  full_name='openstorage.api.SdkClusterEnumerateRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12605,
+  serialized_end=12633,
+)
+
+
+_SDKCLUSTERENUMERATERESPONSE = _descriptor.Descriptor(
+  name='SdkClusterEnumerateResponse',
+  full_name='openstorage.api.SdkClusterEnumerateResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cluster', full_name='openstorage.api.SdkClusterEnumerateResponse.cluster', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12635,
+  serialized_end=12714,
+)
+
+
+_SDKCLUSTERINSPECTREQUEST = _descriptor.Descriptor(
+  name='SdkClusterInspectRequest',
+  full_name='openstorage.api.SdkClusterInspectRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node_id', full_name='openstorage.api.SdkClusterInspectRequest.node_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12716,
+  serialized_end=12759,
+)
+
+
+_SDKCLUSTERINSPECTRESPONSE = _descriptor.Descriptor(
+  name='SdkClusterInspectResponse',
+  full_name='openstorage.api.SdkClusterInspectResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node', full_name='openstorage.api.SdkClusterInspectResponse.node', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12761,
+  serialized_end=12832,
+)
+
+
+_SDKCLUSTERALERTENUMERATEREQUEST = _descriptor.Descriptor(
+  name='SdkClusterAlertEnumerateRequest',
+  full_name='openstorage.api.SdkClusterAlertEnumerateRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='time_start', full_name='openstorage.api.SdkClusterAlertEnumerateRequest.time_start', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='time_end', full_name='openstorage.api.SdkClusterAlertEnumerateRequest.time_end', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='resource', full_name='openstorage.api.SdkClusterAlertEnumerateRequest.resource', index=2,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=12835,
+  serialized_end=13011,
+)
+
+
+_SDKCLUSTERALERTENUMERATERESPONSE = _descriptor.Descriptor(
+  name='SdkClusterAlertEnumerateResponse',
+  full_name='openstorage.api.SdkClusterAlertEnumerateResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='alerts', full_name='openstorage.api.SdkClusterAlertEnumerateResponse.alerts', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13013,
+  serialized_end=13088,
+)
+
+
+_SDKCLUSTERALERTCLEARREQUEST = _descriptor.Descriptor(
+  name='SdkClusterAlertClearRequest',
+  full_name='openstorage.api.SdkClusterAlertClearRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='resource', full_name='openstorage.api.SdkClusterAlertClearRequest.resource', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='alert_id', full_name='openstorage.api.SdkClusterAlertClearRequest.alert_id', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=13090,
+  serialized_end=13186,
+)
+
+
+_SDKCLUSTERALERTCLEARRESPONSE = _descriptor.Descriptor(
+  name='SdkClusterAlertClearResponse',
+  full_name='openstorage.api.SdkClusterAlertClearResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
   ],
   extensions=[
   ],
@@ -4869,18 +6345,32 @@ _SDKVOLUMESNAPSHOTRESTOREREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9591,
-  serialized_end=9664,
+  serialized_start=13188,
+  serialized_end=13218,
 )
 
 
-_SDKVOLUMESNAPSHOTRESTORERESPONSE = _descriptor.Descriptor(
-  name='SdkVolumeSnapshotRestoreResponse',
-  full_name='openstorage.api.SdkVolumeSnapshotRestoreResponse',
+_SDKCLUSTERALERTERASEREQUEST = _descriptor.Descriptor(
+  name='SdkClusterAlertEraseRequest',
+  full_name='openstorage.api.SdkClusterAlertEraseRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='resource', full_name='openstorage.api.SdkClusterAlertEraseRequest.resource', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='alert_id', full_name='openstorage.api.SdkClusterAlertEraseRequest.alert_id', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -4893,73 +6383,67 @@ _SDKVOLUMESNAPSHOTRESTORERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9666,
-  serialized_end=9700,
+  serialized_start=13220,
+  serialized_end=13316,
 )
 
 
-_SDKVOLUMESNAPSHOTENUMERATEREQUEST_LABELSENTRY = _descriptor.Descriptor(
-  name='LabelsEntry',
-  full_name='openstorage.api.SdkVolumeSnapshotEnumerateRequest.LabelsEntry',
+_SDKCLUSTERALERTERASERESPONSE = _descriptor.Descriptor(
+  name='SdkClusterAlertEraseResponse',
+  full_name='openstorage.api.SdkClusterAlertEraseResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
-    _descriptor.FieldDescriptor(
-      name='key', full_name='openstorage.api.SdkVolumeSnapshotEnumerateRequest.LabelsEntry.key', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='value', full_name='openstorage.api.SdkVolumeSnapshotEnumerateRequest.LabelsEntry.value', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
+  options=None,
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=612,
-  serialized_end=657,
+  serialized_start=13318,
+  serialized_end=13348,
 )
 
-_SDKVOLUMESNAPSHOTENUMERATEREQUEST = _descriptor.Descriptor(
-  name='SdkVolumeSnapshotEnumerateRequest',
-  full_name='openstorage.api.SdkVolumeSnapshotEnumerateRequest',
+
+_SDKCLUSTERALERTWATCHREQUEST = _descriptor.Descriptor(
+  name='SdkClusterAlertWatchRequest',
+  full_name='openstorage.api.SdkClusterAlertWatchRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='volume_id', full_name='openstorage.api.SdkVolumeSnapshotEnumerateRequest.volume_id', index=0,
-      number=1, type=9, cpp_type=9, label=1,
+      name='resource', full_name='openstorage.api.SdkClusterAlertWatchRequest.resource', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='resource_id', full_name='openstorage.api.SdkClusterAlertWatchRequest.resource_id', index=1,
+      number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='labels', full_name='openstorage.api.SdkVolumeSnapshotEnumerateRequest.labels', index=1,
-      number=2, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
+      name='severity', full_name='openstorage.api.SdkClusterAlertWatchRequest.severity', index=2,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
-  nested_types=[_SDKVOLUMESNAPSHOTENUMERATEREQUEST_LABELSENTRY, ],
+  nested_types=[],
   enum_types=[
   ],
   options=None,
@@ -4968,22 +6452,29 @@ _SDKVOLUMESNAPSHOTENUMERATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9703,
-  serialized_end=9884,
+  serialized_start=13351,
+  serialized_end=13499,
 )
 
 
-_SDKVOLUMESNAPSHOTENUMERATERESPONSE = _descriptor.Descriptor(
-  name='SdkVolumeSnapshotEnumerateResponse',
-  full_name='openstorage.api.SdkVolumeSnapshotEnumerateResponse',
+_SDKCLUSTERALERTWATCHRESPONSE = _descriptor.Descriptor(
+  name='SdkClusterAlertWatchResponse',
+  full_name='openstorage.api.SdkClusterAlertWatchResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='snapshots', full_name='openstorage.api.SdkVolumeSnapshotEnumerateResponse.snapshots', index=0,
-      number=1, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
+      name='action', full_name='openstorage.api.SdkClusterAlertWatchResponse.action', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='alert', full_name='openstorage.api.SdkClusterAlertWatchResponse.alert', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -4999,14 +6490,14 @@ _SDKVOLUMESNAPSHOTENUMERATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9886,
-  serialized_end=9966,
+  serialized_start=13501,
+  serialized_end=13620,
 )
 
 
-_SDKCLUSTERENUMERATEREQUEST = _descriptor.Descriptor(
-  name='SdkClusterEnumerateRequest',
-  full_name='openstorage.api.SdkClusterEnumerateRequest',
+_SDKNODEENUMERATEREQUEST = _descriptor.Descriptor(
+  name='SdkNodeEnumerateRequest',
+  full_name='openstorage.api.SdkNodeEnumerateRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
@@ -5023,22 +6514,22 @@ _SDKCLUSTERENUMERATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9968,
-  serialized_end=9996,
+  serialized_start=13622,
+  serialized_end=13647,
 )
 
 
-_SDKCLUSTERENUMERATERESPONSE = _descriptor.Descriptor(
-  name='SdkClusterEnumerateResponse',
-  full_name='openstorage.api.SdkClusterEnumerateResponse',
+_SDKNODEENUMERATERESPONSE = _descriptor.Descriptor(
+  name='SdkNodeEnumerateResponse',
+  full_name='openstorage.api.SdkNodeEnumerateResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='cluster', full_name='openstorage.api.SdkClusterEnumerateResponse.cluster', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='node_ids', full_name='openstorage.api.SdkNodeEnumerateResponse.node_ids', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -5054,20 +6545,20 @@ _SDKCLUSTERENUMERATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9998,
-  serialized_end=10077,
+  serialized_start=13649,
+  serialized_end=13693,
 )
 
 
-_SDKCLUSTERINSPECTREQUEST = _descriptor.Descriptor(
-  name='SdkClusterInspectRequest',
-  full_name='openstorage.api.SdkClusterInspectRequest',
+_SDKNODEINSPECTREQUEST = _descriptor.Descriptor(
+  name='SdkNodeInspectRequest',
+  full_name='openstorage.api.SdkNodeInspectRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='node_id', full_name='openstorage.api.SdkClusterInspectRequest.node_id', index=0,
+      name='node_id', full_name='openstorage.api.SdkNodeInspectRequest.node_id', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -5085,20 +6576,20 @@ _SDKCLUSTERINSPECTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10079,
-  serialized_end=10122,
+  serialized_start=13695,
+  serialized_end=13735,
 )
 
 
-_SDKCLUSTERINSPECTRESPONSE = _descriptor.Descriptor(
-  name='SdkClusterInspectResponse',
-  full_name='openstorage.api.SdkClusterInspectResponse',
+_SDKNODEINSPECTRESPONSE = _descriptor.Descriptor(
+  name='SdkNodeInspectResponse',
+  full_name='openstorage.api.SdkNodeInspectResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='node', full_name='openstorage.api.SdkClusterInspectResponse.node', index=0,
+      name='node', full_name='openstorage.api.SdkNodeInspectResponse.node', index=0,
       number=1, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
@@ -5116,39 +6607,18 @@ _SDKCLUSTERINSPECTRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10124,
-  serialized_end=10195,
+  serialized_start=13737,
+  serialized_end=13805,
 )
 
 
-_SDKCLUSTERALERTENUMERATEREQUEST = _descriptor.Descriptor(
-  name='SdkClusterAlertEnumerateRequest',
-  full_name='openstorage.api.SdkClusterAlertEnumerateRequest',
+_SDKNODEINSPECTCURRENTREQUEST = _descriptor.Descriptor(
+  name='SdkNodeInspectCurrentRequest',
+  full_name='openstorage.api.SdkNodeInspectCurrentRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
-    _descriptor.FieldDescriptor(
-      name='time_start', full_name='openstorage.api.SdkClusterAlertEnumerateRequest.time_start', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='time_end', full_name='openstorage.api.SdkClusterAlertEnumerateRequest.time_end', index=1,
-      number=2, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='resource', full_name='openstorage.api.SdkClusterAlertEnumerateRequest.resource', index=2,
-      number=3, type=14, cpp_type=8, label=1,
-      has_default_value=False, default_value=0,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -5161,20 +6631,20 @@ _SDKCLUSTERALERTENUMERATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10198,
-  serialized_end=10374,
+  serialized_start=13807,
+  serialized_end=13837,
 )
 
 
-_SDKCLUSTERALERTENUMERATERESPONSE = _descriptor.Descriptor(
-  name='SdkClusterAlertEnumerateResponse',
-  full_name='openstorage.api.SdkClusterAlertEnumerateResponse',
+_SDKNODEINSPECTCURRENTRESPONSE = _descriptor.Descriptor(
+  name='SdkNodeInspectCurrentResponse',
+  full_name='openstorage.api.SdkNodeInspectCurrentResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='alerts', full_name='openstorage.api.SdkClusterAlertEnumerateResponse.alerts', index=0,
+      name='node', full_name='openstorage.api.SdkNodeInspectCurrentResponse.node', index=0,
       number=1, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
@@ -5192,29 +6662,29 @@ _SDKCLUSTERALERTENUMERATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10376,
-  serialized_end=10451,
+  serialized_start=13839,
+  serialized_end=13914,
 )
 
 
-_SDKCLUSTERALERTCLEARREQUEST = _descriptor.Descriptor(
-  name='SdkClusterAlertClearRequest',
-  full_name='openstorage.api.SdkClusterAlertClearRequest',
+_SDKNODEUPDATELABELSREQUEST_LABELSENTRY = _descriptor.Descriptor(
+  name='LabelsEntry',
+  full_name='openstorage.api.SdkNodeUpdateLabelsRequest.LabelsEntry',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='resource', full_name='openstorage.api.SdkClusterAlertClearRequest.resource', index=0,
-      number=1, type=14, cpp_type=8, label=1,
-      has_default_value=False, default_value=0,
+      name='key', full_name='openstorage.api.SdkNodeUpdateLabelsRequest.LabelsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='alert_id', full_name='openstorage.api.SdkClusterAlertClearRequest.alert_id', index=1,
-      number=2, type=3, cpp_type=2, label=1,
-      has_default_value=False, default_value=0,
+      name='value', full_name='openstorage.api.SdkNodeUpdateLabelsRequest.LabelsEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -5224,66 +6694,41 @@ _SDKCLUSTERALERTCLEARREQUEST = _descriptor.Descriptor(
   nested_types=[],
   enum_types=[
   ],
-  options=None,
-  is_extendable=False,
-  syntax='proto3',
-  extension_ranges=[],
-  oneofs=[
-  ],
-  serialized_start=10453,
-  serialized_end=10549,
-)
-
-
-_SDKCLUSTERALERTCLEARRESPONSE = _descriptor.Descriptor(
-  name='SdkClusterAlertClearResponse',
-  full_name='openstorage.api.SdkClusterAlertClearResponse',
-  filename=None,
-  file=DESCRIPTOR,
-  containing_type=None,
-  fields=[
-  ],
-  extensions=[
-  ],
-  nested_types=[],
-  enum_types=[
-  ],
-  options=None,
+  options=_descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001')),
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10551,
-  serialized_end=10581,
+  serialized_start=612,
+  serialized_end=657,
 )
 
-
-_SDKCLUSTERALERTERASEREQUEST = _descriptor.Descriptor(
-  name='SdkClusterAlertEraseRequest',
-  full_name='openstorage.api.SdkClusterAlertEraseRequest',
+_SDKNODEUPDATELABELSREQUEST = _descriptor.Descriptor(
+  name='SdkNodeUpdateLabelsRequest',
+  full_name='openstorage.api.SdkNodeUpdateLabelsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='resource', full_name='openstorage.api.SdkClusterAlertEraseRequest.resource', index=0,
-      number=1, type=14, cpp_type=8, label=1,
-      has_default_value=False, default_value=0,
+      name='node_id', full_name='openstorage.api.SdkNodeUpdateLabelsRequest.node_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='alert_id', full_name='openstorage.api.SdkClusterAlertEraseRequest.alert_id', index=1,
-      number=2, type=3, cpp_type=2, label=1,
-      has_default_value=False, default_value=0,
+      name='labels', full_name='openstorage.api.SdkNodeUpdateLabelsRequest.labels', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_SDKNODEUPDATELABELSREQUEST_LABELSENTRY, ],
   enum_types=[
   ],
   options=None,
@@ -5292,18 +6737,25 @@ _SDKCLUSTERALERTERASEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10583,
-  serialized_end=10679,
+  serialized_start=13917,
+  serialized_end=14082,
 )
 
 
-_SDKCLUSTERALERTERASERESPONSE = _descriptor.Descriptor(
-  name='SdkClusterAlertEraseResponse',
-  full_name='openstorage.api.SdkClusterAlertEraseResponse',
+_SDKNODEUPDATELABELSRESPONSE = _descriptor.Descriptor(
+  name='SdkNodeUpdateLabelsResponse',
+  full_name='openstorage.api.SdkNodeUpdateLabelsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='node', full_name='openstorage.api.SdkNodeUpdateLabelsResponse.node', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -5316,8 +6768,8 @@ _SDKCLUSTERALERTERASERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10681,
-  serialized_end=10711,
+  serialized_start=14084,
+  serialized_end=14157,
 )
 
 
@@ -5347,8 +6799,8 @@ _SDKOBJECTSTOREINSPECTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10713,
-  serialized_end=10767,
+  serialized_start=14159,
+  serialized_end=14213,
 )
 
 
@@ -5378,8 +6830,8 @@ _SDKOBJECTSTOREINSPECTRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10769,
-  serialized_end=10862,
+  serialized_start=14215,
+  serialized_end=14308,
 )
 
 
@@ -5409,8 +6861,8 @@ _SDKOBJECTSTORECREATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10864,
-  serialized_end=10914,
+  serialized_start=14310,
+  serialized_end=14360,
 )
 
 
@@ -5440,8 +6892,8 @@ _SDKOBJECTSTORECREATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10916,
-  serialized_end=11008,
+  serialized_start=14362,
+  serialized_end=14454,
 )
 
 
@@ -5471,8 +6923,8 @@ _SDKOBJECTSTOREDELETEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11010,
-  serialized_end=11063,
+  serialized_start=14456,
+  serialized_end=14509,
 )
 
 
@@ -5495,8 +6947,8 @@ _SDKOBJECTSTOREDELETERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11065,
-  serialized_end=11095,
+  serialized_start=14511,
+  serialized_end=14541,
 )
 
 
@@ -5533,8 +6985,8 @@ _SDKOBJECTSTOREUPDATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11097,
-  serialized_end=11166,
+  serialized_start=14543,
+  serialized_end=14612,
 )
 
 
@@ -5557,8 +7009,8 @@ _SDKOBJECTSTOREUPDATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11168,
-  serialized_end=11198,
+  serialized_start=14614,
+  serialized_end=14644,
 )
 
 
@@ -5602,8 +7054,8 @@ _SDKCLOUDBACKUPCREATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11200,
-  serialized_end=11287,
+  serialized_start=14646,
+  serialized_end=14733,
 )
 
 
@@ -5633,8 +7085,8 @@ _SDKCLOUDBACKUPCREATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11289,
-  serialized_end=11338,
+  serialized_start=14735,
+  serialized_end=14784,
 )
 
 
@@ -5685,8 +7137,8 @@ _SDKCLOUDBACKUPRESTOREREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11340,
-  serialized_end=11460,
+  serialized_start=14786,
+  serialized_end=14906,
 )
 
 
@@ -5716,8 +7168,8 @@ _SDKCLOUDBACKUPRESTORERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11462,
-  serialized_end=11520,
+  serialized_start=14908,
+  serialized_end=14966,
 )
 
 
@@ -5761,8 +7213,8 @@ _SDKCLOUDBACKUPDELETEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11522,
-  serialized_end=11610,
+  serialized_start=14968,
+  serialized_end=15056,
 )
 
 
@@ -5785,8 +7237,8 @@ _SDKCLOUDBACKUPDELETERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11612,
-  serialized_end=11642,
+  serialized_start=15058,
+  serialized_end=15088,
 )
 
 
@@ -5823,8 +7275,8 @@ _SDKCLOUDBACKUPDELETEALLREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11644,
-  serialized_end=11724,
+  serialized_start=15090,
+  serialized_end=15170,
 )
 
 
@@ -5847,8 +7299,8 @@ _SDKCLOUDBACKUPDELETEALLRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11726,
-  serialized_end=11759,
+  serialized_start=15172,
+  serialized_end=15205,
 )
 
 
@@ -5899,8 +7351,8 @@ _SDKCLOUDBACKUPENUMERATEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11761,
-  serialized_end=11874,
+  serialized_start=15207,
+  serialized_end=15320,
 )
 
 
@@ -5937,8 +7389,8 @@ _SDKCLOUDBACKUPINFO_METADATAENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12134,
-  serialized_end=12181,
+  serialized_start=15580,
+  serialized_end=15627,
 )
 
 _SDKCLOUDBACKUPINFO = _descriptor.Descriptor(
@@ -6002,8 +7454,8 @@ _SDKCLOUDBACKUPINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11877,
-  serialized_end=12181,
+  serialized_start=15323,
+  serialized_end=15627,
 )
 
 
@@ -6033,8 +7485,8 @@ _SDKCLOUDBACKUPENUMERATERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12183,
-  serialized_end=12270,
+  serialized_start=15629,
+  serialized_end=15716,
 )
 
 
@@ -6106,8 +7558,8 @@ _SDKCLOUDBACKUPSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12273,
-  serialized_end=12565,
+  serialized_start=15719,
+  serialized_end=16011,
 )
 
 
@@ -6144,8 +7596,8 @@ _SDKCLOUDBACKUPSTATUSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12567,
-  serialized_end=12634,
+  serialized_start=16013,
+  serialized_end=16080,
 )
 
 
@@ -6182,8 +7634,8 @@ _SDKCLOUDBACKUPSTATUSRESPONSE_STATUSESENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12748,
-  serialized_end=12834,
+  serialized_start=16194,
+  serialized_end=16280,
 )
 
 _SDKCLOUDBACKUPSTATUSRESPONSE = _descriptor.Descriptor(
@@ -6212,8 +7664,8 @@ _SDKCLOUDBACKUPSTATUSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12637,
-  serialized_end=12834,
+  serialized_start=16083,
+  serialized_end=16280,
 )
 
 
@@ -6250,8 +7702,8 @@ _SDKCLOUDBACKUPCATALOGREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12836,
-  serialized_end=12910,
+  serialized_start=16282,
+  serialized_end=16356,
 )
 
 
@@ -6281,8 +7733,8 @@ _SDKCLOUDBACKUPCATALOGRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12912,
-  serialized_end=12961,
+  serialized_start=16358,
+  serialized_end=16407,
 )
 
 
@@ -6326,8 +7778,8 @@ _SDKCLOUDBACKUPHISTORYITEM = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12964,
-  serialized_end=13120,
+  serialized_start=16410,
+  serialized_end=16566,
 )
 
 
@@ -6357,25 +7809,87 @@ _SDKCLOUDBACKUPHISTORYREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=13122,
-  serialized_end=13175,
+  serialized_start=16568,
+  serialized_end=16621,
+)
+
+
+_SDKCLOUDBACKUPHISTORYRESPONSE = _descriptor.Descriptor(
+  name='SdkCloudBackupHistoryResponse',
+  full_name='openstorage.api.SdkCloudBackupHistoryResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='history_list', full_name='openstorage.api.SdkCloudBackupHistoryResponse.history_list', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16623,
+  serialized_end=16720,
+)
+
+
+_SDKCLOUDBACKUPSTATECHANGEREQUEST = _descriptor.Descriptor(
+  name='SdkCloudBackupStateChangeRequest',
+  full_name='openstorage.api.SdkCloudBackupStateChangeRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='src_volume_id', full_name='openstorage.api.SdkCloudBackupStateChangeRequest.src_volume_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='requested_state', full_name='openstorage.api.SdkCloudBackupStateChangeRequest.requested_state', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=16723,
+  serialized_end=16852,
 )
 
 
-_SDKCLOUDBACKUPHISTORYRESPONSE = _descriptor.Descriptor(
-  name='SdkCloudBackupHistoryResponse',
-  full_name='openstorage.api.SdkCloudBackupHistoryResponse',
+_SDKCLOUDBACKUPSTATECHANGERESPONSE = _descriptor.Descriptor(
+  name='SdkCloudBackupStateChangeResponse',
+  full_name='openstorage.api.SdkCloudBackupStateChangeResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
-    _descriptor.FieldDescriptor(
-      name='history_list', full_name='openstorage.api.SdkCloudBackupHistoryResponse.history_list', index=0,
-      number=1, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -6388,29 +7902,43 @@ _SDKCLOUDBACKUPHISTORYRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=13177,
-  serialized_end=13274,
+  serialized_start=16854,
+  serialized_end=16889,
 )
 
 
-_SDKCLOUDBACKUPSTATECHANGEREQUEST = _descriptor.Descriptor(
-  name='SdkCloudBackupStateChangeRequest',
-  full_name='openstorage.api.SdkCloudBackupStateChangeRequest',
+_SDKAUDITENUMERATEREQUEST = _descriptor.Descriptor(
+  name='SdkAuditEnumerateRequest',
+  full_name='openstorage.api.SdkAuditEnumerateRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='src_volume_id', full_name='openstorage.api.SdkCloudBackupStateChangeRequest.src_volume_id', index=0,
-      number=1, type=9, cpp_type=9, label=1,
+      name='time_start', full_name='openstorage.api.SdkAuditEnumerateRequest.time_start', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='time_end', full_name='openstorage.api.SdkAuditEnumerateRequest.time_end', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='subject', full_name='openstorage.api.SdkAuditEnumerateRequest.subject', index=2,
+      number=3, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='requested_state', full_name='openstorage.api.SdkCloudBackupStateChangeRequest.requested_state', index=1,
-      number=2, type=14, cpp_type=8, label=1,
-      has_default_value=False, default_value=0,
+      name='operation', full_name='openstorage.api.SdkAuditEnumerateRequest.operation', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -6426,18 +7954,25 @@ _SDKCLOUDBACKUPSTATECHANGEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=13277,
-  serialized_end=13406,
+  serialized_start=16892,
+  serialized_end=17048,
 )
 
 
-_SDKCLOUDBACKUPSTATECHANGERESPONSE = _descriptor.Descriptor(
-  name='SdkCloudBackupStateChangeResponse',
-  full_name='openstorage.api.SdkCloudBackupStateChangeResponse',
+_SDKAUDITENUMERATERESPONSE = _descriptor.Descriptor(
+  name='SdkAuditEnumerateResponse',
+  full_name='openstorage.api.SdkAuditEnumerateResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='events', full_name='openstorage.api.SdkAuditEnumerateResponse.events', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -6450,8 +7985,8 @@ _SDKCLOUDBACKUPSTATECHANGERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=13408,
-  serialized_end=13443,
+  serialized_start=17050,
+  serialized_end=17122,
 )
 
 _STORAGERESOURCE.fields_by_name['medium'].enum_type = _STORAGEMEDIUM
@@ -6462,6 +7997,20 @@ _STORAGEPOOL.fields_by_name['Medium'].enum_type = _STORAGEMEDIUM
 _STORAGEPOOL.fields_by_name['labels'].message_type = _STORAGEPOOL_LABELSENTRY
 _VOLUMELOCATOR_VOLUMELABELSENTRY.containing_type = _VOLUMELOCATOR
 _VOLUMELOCATOR.fields_by_name['volume_labels'].message_type = _VOLUMELOCATOR_VOLUMELABELSENTRY
+_OWNERSHIPACLS_GROUPSENTRY.fields_by_name['value'].enum_type = _OWNERSHIPACCESSTYPE
+_OWNERSHIPACLS_GROUPSENTRY.containing_type = _OWNERSHIPACLS
+_OWNERSHIPACLS_COLLABORATORSENTRY.fields_by_name['value'].enum_type = _OWNERSHIPACCESSTYPE
+_OWNERSHIPACLS_COLLABORATORSENTRY.containing_type = _OWNERSHIPACLS
+_OWNERSHIPACLS.fields_by_name['groups'].message_type = _OWNERSHIPACLS_GROUPSENTRY
+_OWNERSHIPACLS.fields_by_name['collaborators'].message_type = _OWNERSHIPACLS_COLLABORATORSENTRY
+_OWNERSHIP.fields_by_name['acls'].message_type = _OWNERSHIPACLS
+_LABELSELECTORREQUIREMENT.fields_by_name['operator'].enum_type = _LABELSELECTOROPERATOR
+_VOLUMEFILTER.fields_by_name['locator'].message_type = _VOLUMELOCATOR
+_VOLUMEFILTER.fields_by_name['label_selector'].message_type = _LABELSELECTORREQUIREMENT
+_VOLUMEFILTER.fields_by_name['state'].enum_type = _VOLUMESTATE
+_VOLUMEFILTER.fields_by_name['status'].enum_type = _VOLUMESTATUS
+_VOLUMEFILTER.fields_by_name['created_after'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_VOLUMEFILTER.fields_by_name['created_before'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
 _VOLUMESPEC_VOLUMELABELSENTRY.containing_type = _VOLUMESPEC
 _VOLUMESPEC.fields_by_name['format'].enum_type = _FSTYPE
 _VOLUMESPEC.fields_by_name['cos'].enum_type = _COSTYPE
@@ -6469,6 +8018,7 @@ _VOLUMESPEC.fields_by_name['io_profile'].enum_type = _IOPROFILE
 _VOLUMESPEC.fields_by_name['volume_labels'].message_type = _VOLUMESPEC_VOLUMELABELSENTRY
 _VOLUMESPEC.fields_by_name['replica_set'].message_type = _REPLICASET
 _VOLUMESPEC.fields_by_name['group'].message_type = _GROUP
+_VOLUMESPEC.fields_by_name['ownership'].message_type = _OWNERSHIP
 _RUNTIMESTATEMAP_RUNTIMESTATEENTRY.containing_type = _RUNTIMESTATEMAP
 _RUNTIMESTATEMAP.fields_by_name['runtime_state'].message_type = _RUNTIMESTATEMAP_RUNTIMESTATEENTRY
 _VOLUME_ATTACHINFOENTRY.containing_type = _VOLUME
@@ -6490,6 +8040,7 @@ _ALERT.fields_by_name['severity'].enum_type = _SEVERITYTYPE
 _ALERT.fields_by_name['timestamp'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
 _ALERT.fields_by_name['resource'].enum_type = _RESOURCETYPE
 _ALERTS.fields_by_name['alert'].message_type = _ALERT
+_AUDITEVENT.fields_by_name['timestamp'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
 _VOLUMECREATEREQUEST.fields_by_name['locator'].message_type = _VOLUMELOCATOR
 _VOLUMECREATEREQUEST.fields_by_name['source'].message_type = _SOURCE
 _VOLUMECREATEREQUEST.fields_by_name['spec'].message_type = _VOLUMESPEC
@@ -6544,12 +8095,23 @@ _SDKVOLUMECREATEREQUEST.fields_by_name['spec'].message_type = _VOLUMESPEC
 _SDKVOLUMECREATEFROMVOLUMEIDREQUEST.fields_by_name['spec'].message_type = _VOLUMESPEC
 _SDKVOLUMEINSPECTRESPONSE.fields_by_name['volume'].message_type = _VOLUME
 _SDKVOLUMEENUMERATEREQUEST.fields_by_name['locator'].message_type = _VOLUMELOCATOR
+_SDKVOLUMEENUMERATEREQUEST.fields_by_name['filter'].message_type = _VOLUMEFILTER
 _SDKVOLUMEENUMERATERESPONSE.fields_by_name['volumes'].message_type = _VOLUME
+_SDKVOLUMEUPDATEREQUEST_LABELSENTRY.containing_type = _SDKVOLUMEUPDATEREQUEST
+_SDKVOLUMEUPDATEREQUEST.fields_by_name['labels'].message_type = _SDKVOLUMEUPDATEREQUEST_LABELSENTRY
+_SDKVOLUMEUPDATEREQUEST.fields_by_name['spec'].message_type = _VOLUMESPEC
+_SDKVOLUMEUPDATERESPONSE.fields_by_name['volume'].message_type = _VOLUME
+_SDKVOLUMESTATSRESPONSE.fields_by_name['stats'].message_type = _STATS
+_SDKVOLUMEACTIVEREQUESTSRESPONSE.fields_by_name['active_requests'].message_type = _ACTIVEREQUESTS
 _SDKVOLUMESNAPSHOTCREATEREQUEST_LABELSENTRY.containing_type = _SDKVOLUMESNAPSHOTCREATEREQUEST
 _SDKVOLUMESNAPSHOTCREATEREQUEST.fields_by_name['labels'].message_type = _SDKVOLUMESNAPSHOTCREATEREQUEST_LABELSENTRY
 _SDKVOLUMESNAPSHOTENUMERATEREQUEST_LABELSENTRY.containing_type = _SDKVOLUMESNAPSHOTENUMERATEREQUEST
 _SDKVOLUMESNAPSHOTENUMERATEREQUEST.fields_by_name['labels'].message_type = _SDKVOLUMESNAPSHOTENUMERATEREQUEST_LABELSENTRY
 _SDKVOLUMESNAPSHOTENUMERATERESPONSE.fields_by_name['snapshots'].message_type = _VOLUME
+_SDKVOLUMESNAPSHOTGROUPCREATEREQUEST_LABELSENTRY.containing_type = _SDKVOLUMESNAPSHOTGROUPCREATEREQUEST
+_SDKVOLUMESNAPSHOTGROUPCREATEREQUEST.fields_by_name['labels'].message_type = _SDKVOLUMESNAPSHOTGROUPCREATEREQUEST_LABELSENTRY
+_SDKVOLUMESNAPSHOTGROUPCREATERESPONSE_SNAPSHOTSENTRY.containing_type = _SDKVOLUMESNAPSHOTGROUPCREATERESPONSE
+_SDKVOLUMESNAPSHOTGROUPCREATERESPONSE.fields_by_name['snapshots'].message_type = _SDKVOLUMESNAPSHOTGROUPCREATERESPONSE_SNAPSHOTSENTRY
 _SDKCLUSTERENUMERATERESPONSE.fields_by_name['cluster'].message_type = _STORAGECLUSTER
 _SDKCLUSTERINSPECTRESPONSE.fields_by_name['node'].message_type = _STORAGENODE
 _SDKCLUSTERALERTENUMERATEREQUEST.fields_by_name['time_start'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
@@ -6558,6 +8120,15 @@ _SDKCLUSTERALERTENUMERATEREQUEST.fields_by_name['resource'].enum_type = _RESOURC
 _SDKCLUSTERALERTENUMERATERESPONSE.fields_by_name['alerts'].message_type = _ALERTS
 _SDKCLUSTERALERTCLEARREQUEST.fields_by_name['resource'].enum_type = _RESOURCETYPE
 _SDKCLUSTERALERTERASEREQUEST.fields_by_name['resource'].enum_type = _RESOURCETYPE
+_SDKCLUSTERALERTWATCHREQUEST.fields_by_name['resource'].enum_type = _RESOURCETYPE
+_SDKCLUSTERALERTWATCHREQUEST.fields_by_name['severity'].enum_type = _SEVERITYTYPE
+_SDKCLUSTERALERTWATCHRESPONSE.fields_by_name['action'].enum_type = _ALERTACTIONTYPE
+_SDKCLUSTERALERTWATCHRESPONSE.fields_by_name['alert'].message_type = _ALERT
+_SDKNODEINSPECTRESPONSE.fields_by_name['node'].message_type = _STORAGENODE
+_SDKNODEINSPECTCURRENTRESPONSE.fields_by_name['node'].message_type = _STORAGENODE
+_SDKNODEUPDATELABELSREQUEST_LABELSENTRY.containing_type = _SDKNODEUPDATELABELSREQUEST
+_SDKNODEUPDATELABELSREQUEST.fields_by_name['labels'].message_type = _SDKNODEUPDATELABELSREQUEST_LABELSENTRY
+_SDKNODEUPDATELABELSRESPONSE.fields_by_name['node'].message_type = _STORAGENODE
 _SDKOBJECTSTOREINSPECTRESPONSE.fields_by_name['objectstore_status'].message_type = _OBJECTSTOREINFO
 _SDKOBJECTSTORECREATERESPONSE.fields_by_name['objectstore_status'].message_type = _OBJECTSTOREINFO
 _SDKCLOUDBACKUPINFO_METADATAENTRY.containing_type = _SDKCLOUDBACKUPINFO
@@ -6576,11 +8147,18 @@ _SDKCLOUDBACKUPHISTORYITEM.fields_by_name['timestamp'].message_type = google_dot
 _SDKCLOUDBACKUPHISTORYITEM.fields_by_name['status'].enum_type = _SDKCLOUDBACKUPSTATUSTYPE
 _SDKCLOUDBACKUPHISTORYRESPONSE.fields_by_name['history_list'].message_type = _SDKCLOUDBACKUPHISTORYITEM
 _SDKCLOUDBACKUPSTATECHANGEREQUEST.fields_by_name['requested_state'].enum_type = _SDKCLOUDBACKUPREQUESTEDSTATE
+_SDKAUDITENUMERATEREQUEST.fields_by_name['time_start'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_SDKAUDITENUMERATEREQUEST.fields_by_name['time_end'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_SDKAUDITENUMERATERESPONSE.fields_by_name['events'].message_type = _AUDITEVENT
 DESCRIPTOR.message_types_by_name['StorageResource'] = _STORAGERESOURCE
 DESCRIPTOR.message_types_by_name['StoragePool'] = _STORAGEPOOL
 DESCRIPTOR.message_types_by_name['VolumeLocator'] = _VOLUMELOCATOR
 DESCRIPTOR.message_types_by_name['Source'] = _SOURCE
 DESCRIPTOR.message_types_by_name['Group'] = _GROUP
+DESCRIPTOR.message_types_by_name['OwnershipAcls'] = _OWNERSHIPACLS
+DESCRIPTOR.message_types_by_name['Ownership'] = _OWNERSHIP
+DESCRIPTOR.message_types_by_name['LabelSelectorRequirement'] = _LABELSELECTORREQUIREMENT
+DESCRIPTOR.message_types_by_name['VolumeFilter'] = _VOLUMEFILTER
 DESCRIPTOR.message_types_by_name['VolumeSpec'] = _VOLUMESPEC
 DESCRIPTOR.message_types_by_name['ReplicaSet'] = _REPLICASET
 DESCRIPTOR.message_types_by_name['RuntimeStateMap'] = _RUNTIMESTATEMAP
@@ -6588,6 +8166,7 @@ DESCRIPTOR.message_types_by_name['Volume'] = _VOLUME
 DESCRIPTOR.message_types_by_name['Stats'] = _STATS
 DESCRIPTOR.message_types_by_name['Alert'] = _ALERT
 DESCRIPTOR.message_types_by_name['Alerts'] = _ALERTS
+DESCRIPTOR.message_types_by_name['AuditEvent'] = _AUDITEVENT
 DESCRIPTOR.message_types_by_name['ObjectstoreInfo'] = _OBJECTSTOREINFO
 DESCRIPTOR.message_types_by_name['VolumeCreateRequest'] = _VOLUMECREATEREQUEST
 DESCRIPTOR.message_types_by_name['VolumeResponse'] = _VOLUMERESPONSE
@@ -6655,12 +8234,26 @@ DESCRIPTOR.message_types_by_name['SdkVolumeInspectRequest'] = _SDKVOLUMEINSPECTR
 DESCRIPTOR.message_types_by_name['SdkVolumeInspectResponse'] = _SDKVOLUMEINSPECTRESPONSE
 DESCRIPTOR.message_types_by_name['SdkVolumeEnumerateRequest'] = _SDKVOLUMEENUMERATEREQUEST
 DESCRIPTOR.message_types_by_name['SdkVolumeEnumerateResponse'] = _SDKVOLUMEENUMERATERESPONSE
+DESCRIPTOR.message_types_by_name['SdkVolumeUpdateRequest'] = _SDKVOLUMEUPDATEREQUEST
+DESCRIPTOR.message_types_by_name['SdkVolumeUpdateResponse'] = _SDKVOLUMEUPDATERESPONSE
+DESCRIPTOR.message_types_by_name['SdkVolumeStatsRequest'] = _SDKVOLUMESTATSREQUEST
+DESCRIPTOR.message_types_by_name['SdkVolumeStatsResponse'] = _SDKVOLUMESTATSRESPONSE
+DESCRIPTOR.message_types_by_name['SdkVolumeCapacityUsageRequest'] = _SDKVOLUMECAPACITYUSAGEREQUEST
+DESCRIPTOR.message_types_by_name['SdkVolumeCapacityUsageResponse'] = _SDKVOLUMECAPACITYUSAGERESPONSE
+DESCRIPTOR.message_types_by_name['SdkVolumeActiveRequestsRequest'] = _SDKVOLUMEACTIVEREQUESTSREQUEST
+DESCRIPTOR.message_types_by_name['SdkVolumeActiveRequestsResponse'] = _SDKVOLUMEACTIVEREQUESTSRESPONSE
 DESCRIPTOR.message_types_by_name['SdkVolumeSnapshotCreateRequest'] = _SDKVOLUMESNAPSHOTCREATEREQUEST
 DESCRIPTOR.message_types_by_name['SdkVolumeSnapshotCreateResponse'] = _SDKVOLUMESNAPSHOTCREATERESPONSE
 DESCRIPTOR.message_types_by_name['SdkVolumeSnapshotRestoreRequest'] = _SDKVOLUMESNAPSHOTRESTOREREQUEST
 DESCRIPTOR.message_types_by_name['SdkVolumeSnapshotRestoreResponse'] = _SDKVOLUMESNAPSHOTRESTORERESPONSE
 DESCRIPTOR.message_types_by_name['SdkVolumeSnapshotEnumerateRequest'] = _SDKVOLUMESNAPSHOTENUMERATEREQUEST
 DESCRIPTOR.message_types_by_name['SdkVolumeSnapshotEnumerateResponse'] = _SDKVOLUMESNAPSHOTENUMERATERESPONSE
+DESCRIPTOR.message_types_by_name['SdkVolumeSnapshotGroupCreateRequest'] = _SDKVOLUMESNAPSHOTGROUPCREATEREQUEST
+DESCRIPTOR.message_types_by_name['SdkVolumeSnapshotGroupCreateResponse'] = _SDKVOLUMESNAPSHOTGROUPCREATERESPONSE
+DESCRIPTOR.message_types_by_name['SdkVolumeQuiesceRequest'] = _SDKVOLUMEQUIESCEREQUEST
+DESCRIPTOR.message_types_by_name['SdkVolumeQuiesceResponse'] = _SDKVOLUMEQUIESCERESPONSE
+DESCRIPTOR.message_types_by_name['SdkVolumeUnquiesceRequest'] = _SDKVOLUMEUNQUIESCEREQUEST
+DESCRIPTOR.message_types_by_name['SdkVolumeUnquiesceResponse'] = _SDKVOLUMEUNQUIESCERESPONSE
 DESCRIPTOR.message_types_by_name['SdkClusterEnumerateRequest'] = _SDKCLUSTERENUMERATEREQUEST
 DESCRIPTOR.message_types_by_name['SdkClusterEnumerateResponse'] = _SDKCLUSTERENUMERATERESPONSE
 DESCRIPTOR.message_types_by_name['SdkClusterInspectRequest'] = _SDKCLUSTERINSPECTREQUEST
@@ -6671,6 +8264,16 @@ DESCRIPTOR.message_types_by_name['SdkClusterAlertClearRequest'] = _SDKCLUSTERALE
 DESCRIPTOR.message_types_by_name['SdkClusterAlertClearResponse'] = _SDKCLUSTERALERTCLEARRESPONSE
 DESCRIPTOR.message_types_by_name['SdkClusterAlertEraseRequest'] = _SDKCLUSTERALERTERASEREQUEST
 DESCRIPTOR.message_types_by_name['SdkClusterAlertEraseResponse'] = _SDKCLUSTERALERTERASERESPONSE
+DESCRIPTOR.message_types_by_name['SdkClusterAlertWatchRequest'] = _SDKCLUSTERALERTWATCHREQUEST
+DESCRIPTOR.message_types_by_name['SdkClusterAlertWatchResponse'] = _SDKCLUSTERALERTWATCHRESPONSE
+DESCRIPTOR.message_types_by_name['SdkNodeEnumerateRequest'] = _SDKNODEENUMERATEREQUEST
+DESCRIPTOR.message_types_by_name['SdkNodeEnumerateResponse'] = _SDKNODEENUMERATERESPONSE
+DESCRIPTOR.message_types_by_name['SdkNodeInspectRequest'] = _SDKNODEINSPECTREQUEST
+DESCRIPTOR.message_types_by_name['SdkNodeInspectResponse'] = _SDKNODEINSPECTRESPONSE
+DESCRIPTOR.message_types_by_name['SdkNodeInspectCurrentRequest'] = _SDKNODEINSPECTCURRENTREQUEST
+DESCRIPTOR.message_types_by_name['SdkNodeInspectCurrentResponse'] = _SDKNODEINSPECTCURRENTRESPONSE
+DESCRIPTOR.message_types_by_name['SdkNodeUpdateLabelsRequest'] = _SDKNODEUPDATELABELSREQUEST
+DESCRIPTOR.message_types_by_name['SdkNodeUpdateLabelsResponse'] = _SDKNODEUPDATELABELSRESPONSE
 DESCRIPTOR.message_types_by_name['SdkObjectstoreInspectRequest'] = _SDKOBJECTSTOREINSPECTREQUEST
 DESCRIPTOR.message_types_by_name['SdkObjectstoreInspectResponse'] = _SDKOBJECTSTOREINSPECTRESPONSE
 DESCRIPTOR.message_types_by_name['SdkObjectstoreCreateRequest'] = _SDKOBJECTSTORECREATEREQUEST
@@ -6700,6 +8303,8 @@ DESCRIPTOR.message_types_by_name['SdkCloudBackupHistoryRequest'] = _SDKCLOUDBACK
 DESCRIPTOR.message_types_by_name['SdkCloudBackupHistoryResponse'] = _SDKCLOUDBACKUPHISTORYRESPONSE
 DESCRIPTOR.message_types_by_name['SdkCloudBackupStateChangeRequest'] = _SDKCLOUDBACKUPSTATECHANGEREQUEST
 DESCRIPTOR.message_types_by_name['SdkCloudBackupStateChangeResponse'] = _SDKCLOUDBACKUPSTATECHANGERESPONSE
+DESCRIPTOR.message_types_by_name['SdkAuditEnumerateRequest'] = _SDKAUDITENUMERATEREQUEST
+DESCRIPTOR.message_types_by_name['SdkAuditEnumerateResponse'] = _SDKAUDITENUMERATERESPONSE
 DESCRIPTOR.enum_types_by_name['Status'] = _STATUS
 DESCRIPTOR.enum_types_by_name['DriverType'] = _DRIVERTYPE
 DESCRIPTOR.enum_types_by_name['FSType'] = _FSTYPE
@@ -6716,6 +8321,8 @@ DESCRIPTOR.enum_types_by_name['StorageMedium'] = _STORAGEMEDIUM
 DESCRIPTOR.enum_types_by_name['ClusterNotify'] = _CLUSTERNOTIFY
 DESCRIPTOR.enum_types_by_name['AttachState'] = _ATTACHSTATE
 DESCRIPTOR.enum_types_by_name['OperationFlags'] = _OPERATIONFLAGS
+DESCRIPTOR.enum_types_by_name['OwnershipAccessType'] = _OWNERSHIPACCESSTYPE
+DESCRIPTOR.enum_types_by_name['LabelSelectorOperator'] = _LABELSELECTOROPERATOR
 DESCRIPTOR.enum_types_by_name['SdkCloudBackupOpType'] = _SDKCLOUDBACKUPOPTYPE
 DESCRIPTOR.enum_types_by_name['SdkCloudBackupStatusType'] = _SDKCLOUDBACKUPSTATUSTYPE
 DESCRIPTOR.enum_types_by_name['SdkCloudBackupRequestedState'] = _SDKCLOUDBACKUPREQUESTEDSTATE
@@ -6772,6 +8379,50 @@ Group = _reflection.GeneratedProtocolMessageType('Group', (_message.Message,), d
   ))
 _sym_db.RegisterMessage(Group)
 
+OwnershipAcls = _reflection.GeneratedProtocolMessageType('OwnershipAcls', (_message.Message,), dict(
+
+  GroupsEntry = _reflection.GeneratedProtocolMessageType('GroupsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _OWNERSHIPACLS_GROUPSENTRY,
+    __module__ = 'api_pb2'
+    # @@protoc_insertion_point(class_scope:openstorage.api.OwnershipAcls.GroupsEntry)
+    ))
+  ,
+
+  CollaboratorsEntry = _reflection.GeneratedProtocolMessageType('CollaboratorsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _OWNERSHIPACLS_COLLABORATORSENTRY,
+    __module__ = 'api_pb2'
+    # @@protoc_insertion_point(class_scope:openstorage.api.OwnershipAcls.CollaboratorsEntry)
+    ))
+  ,
+  DESCRIPTOR = _OWNERSHIPACLS,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.OwnershipAcls)
+  ))
+_sym_db.RegisterMessage(OwnershipAcls)
+_sym_db.RegisterMessage(OwnershipAcls.GroupsEntry)
+_sym_db.RegisterMessage(OwnershipAcls.CollaboratorsEntry)
+
+Ownership = _reflection.GeneratedProtocolMessageType('Ownership', (_message.Message,), dict(
+  DESCRIPTOR = _OWNERSHIP,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.Ownership)
+  ))
+_sym_db.RegisterMessage(Ownership)
+
+LabelSelectorRequirement = _reflection.GeneratedProtocolMessageType('LabelSelectorRequirement', (_message.Message,), dict(
+  DESCRIPTOR = _LABELSELECTORREQUIREMENT,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.LabelSelectorRequirement)
+  ))
+_sym_db.RegisterMessage(LabelSelectorRequirement)
+
+VolumeFilter = _reflection.GeneratedProtocolMessageType('VolumeFilter', (_message.Message,), dict(
+  DESCRIPTOR = _VOLUMEFILTER,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.VolumeFilter)
+  ))
+_sym_db.RegisterMessage(VolumeFilter)
+
 VolumeSpec = _reflection.GeneratedProtocolMessageType('VolumeSpec', (_message.Message,), dict(
 
   VolumeLabelsEntry = _reflection.GeneratedProtocolMessageType('VolumeLabelsEntry', (_message.Message,), dict(
@@ -6845,6 +8496,13 @@ Alerts = _reflection.GeneratedProtocolMessageType('Alerts', (_message.Message,),
   ))
 _sym_db.RegisterMessage(Alerts)
 
+AuditEvent = _reflection.GeneratedProtocolMessageType('AuditEvent', (_message.Message,), dict(
+  DESCRIPTOR = _AUDITEVENT,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.AuditEvent)
+  ))
+_sym_db.RegisterMessage(AuditEvent)
+
 ObjectstoreInfo = _reflection.GeneratedProtocolMessageType('ObjectstoreInfo', (_message.Message,), dict(
   DESCRIPTOR = _OBJECTSTOREINFO,
   __module__ = 'api_pb2'
@@ -7386,6 +9044,70 @@ SdkVolumeEnumerateResponse = _reflection.GeneratedProtocolMessageType('SdkVolume
   ))
 _sym_db.RegisterMessage(SdkVolumeEnumerateResponse)
 
+SdkVolumeUpdateRequest = _reflection.GeneratedProtocolMessageType('SdkVolumeUpdateRequest', (_message.Message,), dict(
+
+  LabelsEntry = _reflection.GeneratedProtocolMessageType('LabelsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _SDKVOLUMEUPDATEREQUEST_LABELSENTRY,
+    __module__ = 'api_pb2'
+    # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeUpdateRequest.LabelsEntry)
+    ))
+  ,
+  DESCRIPTOR = _SDKVOLUMEUPDATEREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeUpdateRequest)
+  ))
+_sym_db.RegisterMessage(SdkVolumeUpdateRequest)
+_sym_db.RegisterMessage(SdkVolumeUpdateRequest.LabelsEntry)
+
+SdkVolumeUpdateResponse = _reflection.GeneratedProtocolMessageType('SdkVolumeUpdateResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SDKVOLUMEUPDATERESPONSE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeUpdateResponse)
+  ))
+_sym_db.RegisterMessage(SdkVolumeUpdateResponse)
+
+SdkVolumeStatsRequest = _reflection.GeneratedProtocolMessageType('SdkVolumeStatsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SDKVOLUMESTATSREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeStatsRequest)
+  ))
+_sym_db.RegisterMessage(SdkVolumeStatsRequest)
+
+SdkVolumeStatsResponse = _reflection.GeneratedProtocolMessageType('SdkVolumeStatsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SDKVOLUMESTATSRESPONSE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeStatsResponse)
+  ))
+_sym_db.RegisterMessage(SdkVolumeStatsResponse)
+
+SdkVolumeCapacityUsageRequest = _reflection.GeneratedProtocolMessageType('SdkVolumeCapacityUsageRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SDKVOLUMECAPACITYUSAGEREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeCapacityUsageRequest)
+  ))
+_sym_db.RegisterMessage(SdkVolumeCapacityUsageRequest)
+
+SdkVolumeCapacityUsageResponse = _reflection.GeneratedProtocolMessageType('SdkVolumeCapacityUsageResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SDKVOLUMECAPACITYUSAGERESPONSE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeCapacityUsageResponse)
+  ))
+_sym_db.RegisterMessage(SdkVolumeCapacityUsageResponse)
+
+SdkVolumeActiveRequestsRequest = _reflection.GeneratedProtocolMessageType('SdkVolumeActiveRequestsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SDKVOLUMEACTIVEREQUESTSREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeActiveRequestsRequest)
+  ))
+_sym_db.RegisterMessage(SdkVolumeActiveRequestsRequest)
+
+SdkVolumeActiveRequestsResponse = _reflection.GeneratedProtocolMessageType('SdkVolumeActiveRequestsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SDKVOLUMEACTIVEREQUESTSRESPONSE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeActiveRequestsResponse)
+  ))
+_sym_db.RegisterMessage(SdkVolumeActiveRequestsResponse)
+
 SdkVolumeSnapshotCreateRequest = _reflection.GeneratedProtocolMessageType('SdkVolumeSnapshotCreateRequest', (_message.Message,), dict(
 
   LabelsEntry = _reflection.GeneratedProtocolMessageType('LabelsEntry', (_message.Message,), dict(
@@ -7444,6 +9166,64 @@ SdkVolumeSnapshotEnumerateResponse = _reflection.GeneratedProtocolMessageType('S
   ))
 _sym_db.RegisterMessage(SdkVolumeSnapshotEnumerateResponse)
 
+SdkVolumeSnapshotGroupCreateRequest = _reflection.GeneratedProtocolMessageType('SdkVolumeSnapshotGroupCreateRequest', (_message.Message,), dict(
+
+  LabelsEntry = _reflection.GeneratedProtocolMessageType('LabelsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _SDKVOLUMESNAPSHOTGROUPCREATEREQUEST_LABELSENTRY,
+    __module__ = 'api_pb2'
+    # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeSnapshotGroupCreateRequest.LabelsEntry)
+    ))
+  ,
+  DESCRIPTOR = _SDKVOLUMESNAPSHOTGROUPCREATEREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeSnapshotGroupCreateRequest)
+  ))
+_sym_db.RegisterMessage(SdkVolumeSnapshotGroupCreateRequest)
+_sym_db.RegisterMessage(SdkVolumeSnapshotGroupCreateRequest.LabelsEntry)
+
+SdkVolumeSnapshotGroupCreateResponse = _reflection.GeneratedProtocolMessageType('SdkVolumeSnapshotGroupCreateResponse', (_message.Message,), dict(
+
+  SnapshotsEntry = _reflection.GeneratedProtocolMessageType('SnapshotsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _SDKVOLUMESNAPSHOTGROUPCREATERESPONSE_SNAPSHOTSENTRY,
+    __module__ = 'api_pb2'
+    # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeSnapshotGroupCreateResponse.SnapshotsEntry)
+    ))
+  ,
+  DESCRIPTOR = _SDKVOLUMESNAPSHOTGROUPCREATERESPONSE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeSnapshotGroupCreateResponse)
+  ))
+_sym_db.RegisterMessage(SdkVolumeSnapshotGroupCreateResponse)
+_sym_db.RegisterMessage(SdkVolumeSnapshotGroupCreateResponse.SnapshotsEntry)
+
+SdkVolumeQuiesceRequest = _reflection.GeneratedProtocolMessageType('SdkVolumeQuiesceRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SDKVOLUMEQUIESCEREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeQuiesceRequest)
+  ))
+_sym_db.RegisterMessage(SdkVolumeQuiesceRequest)
+
+SdkVolumeQuiesceResponse = _reflection.GeneratedProtocolMessageType('SdkVolumeQuiesceResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SDKVOLUMEQUIESCERESPONSE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeQuiesceResponse)
+  ))
+_sym_db.RegisterMessage(SdkVolumeQuiesceResponse)
+
+SdkVolumeUnquiesceRequest = _reflection.GeneratedProtocolMessageType('SdkVolumeUnquiesceRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SDKVOLUMEUNQUIESCEREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeUnquiesceRequest)
+  ))
+_sym_db.RegisterMessage(SdkVolumeUnquiesceRequest)
+
+SdkVolumeUnquiesceResponse = _reflection.GeneratedProtocolMessageType('SdkVolumeUnquiesceResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SDKVOLUMEUNQUIESCERESPONSE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkVolumeUnquiesceResponse)
+  ))
+_sym_db.RegisterMessage(SdkVolumeUnquiesceResponse)
+
 SdkClusterEnumerateRequest = _reflection.GeneratedProtocolMessageType('SdkClusterEnumerateRequest', (_message.Message,), dict(
   DESCRIPTOR = _SDKCLUSTERENUMERATEREQUEST,
   __module__ = 'api_pb2'
@@ -7514,6 +9294,84 @@ SdkClusterAlertEraseResponse = _reflection.GeneratedProtocolMessageType('SdkClus
   ))
 _sym_db.RegisterMessage(SdkClusterAlertEraseResponse)
 
+SdkClusterAlertWatchRequest = _reflection.GeneratedProtocolMessageType('SdkClusterAlertWatchRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SDKCLUSTERALERTWATCHREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkClusterAlertWatchRequest)
+  ))
+_sym_db.RegisterMessage(SdkClusterAlertWatchRequest)
+
+SdkClusterAlertWatchResponse = _reflection.GeneratedProtocolMessageType('SdkClusterAlertWatchResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SDKCLUSTERALERTWATCHRESPONSE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkClusterAlertWatchResponse)
+  ))
+_sym_db.RegisterMessage(SdkClusterAlertWatchResponse)
+
+SdkNodeEnumerateRequest = _reflection.GeneratedProtocolMessageType('SdkNodeEnumerateRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SDKNODEENUMERATEREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkNodeEnumerateRequest)
+  ))
+_sym_db.RegisterMessage(SdkNodeEnumerateRequest)
+
+SdkNodeEnumerateResponse = _reflection.GeneratedProtocolMessageType('SdkNodeEnumerateResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SDKNODEENUMERATERESPONSE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkNodeEnumerateResponse)
+  ))
+_sym_db.RegisterMessage(SdkNodeEnumerateResponse)
+
+SdkNodeInspectRequest = _reflection.GeneratedProtocolMessageType('SdkNodeInspectRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SDKNODEINSPECTREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkNodeInspectRequest)
+  ))
+_sym_db.RegisterMessage(SdkNodeInspectRequest)
+
+SdkNodeInspectResponse = _reflection.GeneratedProtocolMessageType('SdkNodeInspectResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SDKNODEINSPECTRESPONSE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkNodeInspectResponse)
+  ))
+_sym_db.RegisterMessage(SdkNodeInspectResponse)
+
+SdkNodeInspectCurrentRequest = _reflection.GeneratedProtocolMessageType('SdkNodeInspectCurrentRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SDKNODEINSPECTCURRENTREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkNodeInspectCurrentRequest)
+  ))
+_sym_db.RegisterMessage(SdkNodeInspectCurrentRequest)
+
+SdkNodeInspectCurrentResponse = _reflection.GeneratedProtocolMessageType('SdkNodeInspectCurrentResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SDKNODEINSPECTCURRENTRESPONSE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkNodeInspectCurrentResponse)
+  ))
+_sym_db.RegisterMessage(SdkNodeInspectCurrentResponse)
+
+SdkNodeUpdateLabelsRequest = _reflection.GeneratedProtocolMessageType('SdkNodeUpdateLabelsRequest', (_message.Message,), dict(
+
+  LabelsEntry = _reflection.GeneratedProtocolMessageType('LabelsEntry', (_message.Message,), dict(
+    DESCRIPTOR = _SDKNODEUPDATELABELSREQUEST_LABELSENTRY,
+    __module__ = 'api_pb2'
+    # @@protoc_insertion_point(class_scope:openstorage.api.SdkNodeUpdateLabelsRequest.LabelsEntry)
+    ))
+  ,
+  DESCRIPTOR = _SDKNODEUPDATELABELSREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkNodeUpdateLabelsRequest)
+  ))
+_sym_db.RegisterMessage(SdkNodeUpdateLabelsRequest)
+_sym_db.RegisterMessage(SdkNodeUpdateLabelsRequest.LabelsEntry)
+
+SdkNodeUpdateLabelsResponse = _reflection.GeneratedProtocolMessageType('SdkNodeUpdateLabelsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SDKNODEUPDATELABELSRESPONSE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkNodeUpdateLabelsResponse)
+  ))
+_sym_db.RegisterMessage(SdkNodeUpdateLabelsResponse)
+
 SdkObjectstoreInspectRequest = _reflection.GeneratedProtocolMessageType('SdkObjectstoreInspectRequest', (_message.Message,), dict(
   DESCRIPTOR = _SDKOBJECTSTOREINSPECTREQUEST,
   __module__ = 'api_pb2'
@@ -7733,6 +9591,20 @@ SdkCloudBackupStateChangeResponse = _reflection.GeneratedProtocolMessageType('Sd
   ))
 _sym_db.RegisterMessage(SdkCloudBackupStateChangeResponse)
 
+SdkAuditEnumerateRequest = _reflection.GeneratedProtocolMessageType('SdkAuditEnumerateRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SDKAUDITENUMERATEREQUEST,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkAuditEnumerateRequest)
+  ))
+_sym_db.RegisterMessage(SdkAuditEnumerateRequest)
+
+SdkAuditEnumerateResponse = _reflection.GeneratedProtocolMessageType('SdkAuditEnumerateResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SDKAUDITENUMERATERESPONSE,
+  __module__ = 'api_pb2'
+  # @@protoc_insertion_point(class_scope:openstorage.api.SdkAuditEnumerateResponse)
+  ))
+_sym_db.RegisterMessage(SdkAuditEnumerateResponse)
+
 
 DESCRIPTOR.has_options = True
 DESCRIPTOR._options = _descriptor._ParseOptions(descriptor_pb2.FileOptions(), _b('\n\023com.openstorage.apiP\001Z\003api'))
@@ -7740,6 +9612,10 @@ _STORAGEPOOL_LABELSENTRY.has_options = True
 _STORAGEPOOL_LABELSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
 _VOLUMELOCATOR_VOLUMELABELSENTRY.has_options = True
 _VOLUMELOCATOR_VOLUMELABELSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_OWNERSHIPACLS_GROUPSENTRY.has_options = True
+_OWNERSHIPACLS_GROUPSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_OWNERSHIPACLS_COLLABORATORSENTRY.has_options = True
+_OWNERSHIPACLS_COLLABORATORSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
 _VOLUMESPEC_VOLUMELABELSENTRY.has_options = True
 _VOLUMESPEC_VOLUMELABELSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
 _RUNTIMESTATEMAP_RUNTIMESTATEENTRY.has_options = True
@@ -7764,10 +9640,18 @@ _SDKVOLUMEUNMOUNTREQUEST_OPTIONSENTRY.has_options = True
 _SDKVOLUMEUNMOUNTREQUEST_OPTIONSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
 _SDKVOLUMEATTACHREQUEST_OPTIONSENTRY.has_options = True
 _SDKVOLUMEATTACHREQUEST_OPTIONSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_SDKVOLUMEUPDATEREQUEST_LABELSENTRY.has_options = True
+_SDKVOLUMEUPDATEREQUEST_LABELSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
 _SDKVOLUMESNAPSHOTCREATEREQUEST_LABELSENTRY.has_options = True
 _SDKVOLUMESNAPSHOTCREATEREQUEST_LABELSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
 _SDKVOLUMESNAPSHOTENUMERATEREQUEST_LABELSENTRY.has_options = True
 _SDKVOLUMESNAPSHOTENUMERATEREQUEST_LABELSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_SDKVOLUMESNAPSHOTGROUPCREATEREQUEST_LABELSENTRY.has_options = True
+_SDKVOLUMESNAPSHOTGROUPCREATEREQUEST_LABELSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_SDKVOLUMESNAPSHOTGROUPCREATERESPONSE_SNAPSHOTSENTRY.has_options = True
+_SDKVOLUMESNAPSHOTGROUPCREATERESPONSE_SNAPSHOTSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
+_SDKNODEUPDATELABELSREQUEST_LABELSENTRY.has_options = True
+_SDKNODEUPDATELABELSREQUEST_LABELSENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
 _SDKCLOUDBACKUPINFO_METADATAENTRY.has_options = True
 _SDKCLOUDBACKUPINFO_METADATAENTRY._options = _descriptor._ParseOptions(descriptor_pb2.MessageOptions(), _b('8\001'))
 _SDKCLOUDBACKUPSTATUSRESPONSE_STATUSESENTRY.has_options = True
@@ -7779,8 +9663,8 @@ _OPENSTORAGECLUSTER = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=0,
   options=None,
-  serialized_start=16379,
-  serialized_end=17117,
+  serialized_start=20353,
+  serialized_end=21237,
   methods=[
   _descriptor.MethodDescriptor(
     name='Enumerate',
@@ -7827,20 +9711,80 @@ _OPENSTORAGECLUSTER = _descriptor.ServiceDescriptor(
     output_type=_SDKCLUSTERALERTERASERESPONSE,
     options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\034\"\027/v1/cluster/alert/erase:\001*')),
   ),
+  _descriptor.MethodDescriptor(
+    name='AlertWatch',
+    full_name='openstorage.api.OpenStorageCluster.AlertWatch',
+    index=5,
+    containing_service=None,
+    input_type=_SDKCLUSTERALERTWATCHREQUEST,
+    output_type=_SDKCLUSTERALERTWATCHRESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\034\"\027/v1/cluster/alert/watch:\001*')),
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_OPENSTORAGECLUSTER)
 
 DESCRIPTOR.services_by_name['OpenStorageCluster'] = _OPENSTORAGECLUSTER
 
 
+_OPENSTORAGENODE = _descriptor.ServiceDescriptor(
+  name='OpenStorageNode',
+  full_name='openstorage.api.OpenStorageNode',
+  file=DESCRIPTOR,
+  index=1,
+  options=None,
+  serialized_start=21240,
+  serialized_end=21800,
+  methods=[
+  _descriptor.MethodDescriptor(
+    name='Enumerate',
+    full_name='openstorage.api.OpenStorageNode.Enumerate',
+    index=0,
+    containing_service=None,
+    input_type=_SDKNODEENUMERATEREQUEST,
+    output_type=_SDKNODEENUMERATERESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\027\"\022/v1/node/enumerate:\001*')),
+  ),
+  _descriptor.MethodDescriptor(
+    name='Inspect',
+    full_name='openstorage.api.OpenStorageNode.Inspect',
+    index=1,
+    containing_service=None,
+    input_type=_SDKNODEINSPECTREQUEST,
+    output_type=_SDKNODEINSPECTRESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\025\"\020/v1/node/inspect:\001*')),
+  ),
+  _descriptor.MethodDescriptor(
+    name='InspectCurrent',
+    full_name='openstorage.api.OpenStorageNode.InspectCurrent',
+    index=2,
+    containing_service=None,
+    input_type=_SDKNODEINSPECTCURRENTREQUEST,
+    output_type=_SDKNODEINSPECTCURRENTRESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\034\"\027/v1/node/inspectcurrent:\001*')),
+  ),
+  _descriptor.MethodDescriptor(
+    name='UpdateLabels',
+    full_name='openstorage.api.OpenStorageNode.UpdateLabels',
+    index=3,
+    containing_service=None,
+    input_type=_SDKNODEUPDATELABELSREQUEST,
+    output_type=_SDKNODEUPDATELABELSRESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\033\"\026/v1/node/labels/update:\001*')),
+  ),
+])
+_sym_db.RegisterServiceDescriptor(_OPENSTORAGENODE)
+
+DESCRIPTOR.services_by_name['OpenStorageNode'] = _OPENSTORAGENODE
+
+
 _OPENSTORAGEVOLUME = _descriptor.ServiceDescriptor(
   name='OpenStorageVolume',
   full_name='openstorage.api.OpenStorageVolume',
   file=DESCRIPTOR,
-  index=1,
+  index=2,
   options=None,
-  serialized_start=17120,
-  serialized_end=18793,
+  serialized_start=21803,
+  serialized_end=24453,
   methods=[
   _descriptor.MethodDescriptor(
     name='Create',
@@ -7887,10 +9831,46 @@ _OPENSTORAGEVOLUME = _descriptor.ServiceDescriptor(
     output_type=_SDKVOLUMEENUMERATERESPONSE,
     options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\031\"\024/v1/volume/enumerate:\001*')),
   ),
+  _descriptor.MethodDescriptor(
+    name='Update',
+    full_name='openstorage.api.OpenStorageVolume.Update',
+    index=5,
+    containing_service=None,
+    input_type=_SDKVOLUMEUPDATEREQUEST,
+    output_type=_SDKVOLUMEUPDATERESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\026\"\021/v1/volume/update:\001*')),
+  ),
+  _descriptor.MethodDescriptor(
+    name='Stats',
+    full_name='openstorage.api.OpenStorageVolume.Stats',
+    index=6,
+    containing_service=None,
+    input_type=_SDKVOLUMESTATSREQUEST,
+    output_type=_SDKVOLUMESTATSRESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\025\"\020/v1/volume/stats:\001*')),
+  ),
+  _descriptor.MethodDescriptor(
+    name='CapacityUsage',
+    full_name='openstorage.api.OpenStorageVolume.CapacityUsage',
+    index=7,
+    containing_service=None,
+    input_type=_SDKVOLUMECAPACITYUSAGEREQUEST,
+    output_type=_SDKVOLUMECAPACITYUSAGERESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\025\"\020/v1/volume/usage:\001*')),
+  ),
+  _descriptor.MethodDescriptor(
+    name='ActiveRequests',
+    full_name='openstorage.api.OpenStorageVolume.ActiveRequests',
+    index=8,
+    containing_service=None,
+    input_type=_SDKVOLUMEACTIVEREQUESTSREQUEST,
+    output_type=_SDKVOLUMEACTIVEREQUESTSRESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\030\"\023/v1/volume/requests:\001*')),
+  ),
   _descriptor.MethodDescriptor(
     name='SnapshotCreate',
     full_name='openstorage.api.OpenStorageVolume.SnapshotCreate',
-    index=5,
+    index=9,
     containing_service=None,
     input_type=_SDKVOLUMESNAPSHOTCREATEREQUEST,
     output_type=_SDKVOLUMESNAPSHOTCREATERESPONSE,
@@ -7899,7 +9879,7 @@ _OPENSTORAGEVOLUME = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='SnapshotRestore',
     full_name='openstorage.api.OpenStorageVolume.SnapshotRestore',
-    index=6,
+    index=10,
     containing_service=None,
     input_type=_SDKVOLUMESNAPSHOTRESTOREREQUEST,
     output_type=_SDKVOLUMESNAPSHOTRESTORERESPONSE,
@@ -7908,16 +9888,43 @@ _OPENSTORAGEVOLUME = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='SnapshotEnumerate',
     full_name='openstorage.api.OpenStorageVolume.SnapshotEnumerate',
-    index=7,
+    index=11,
     containing_service=None,
     input_type=_SDKVOLUMESNAPSHOTENUMERATEREQUEST,
     output_type=_SDKVOLUMESNAPSHOTENUMERATERESPONSE,
     options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\"\"\035/v1/volume/snapshot/enumerate:\001*')),
   ),
+  _descriptor.MethodDescriptor(
+    name='SnapshotGroupCreate',
+    full_name='openstorage.api.OpenStorageVolume.SnapshotGroupCreate',
+    index=12,
+    containing_service=None,
+    input_type=_SDKVOLUMESNAPSHOTGROUPCREATEREQUEST,
+    output_type=_SDKVOLUMESNAPSHOTGROUPCREATERESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002%\" /v1/volume/snapshot/group/create:\001*')),
+  ),
+  _descriptor.MethodDescriptor(
+    name='Quiesce',
+    full_name='openstorage.api.OpenStorageVolume.Quiesce',
+    index=13,
+    containing_service=None,
+    input_type=_SDKVOLUMEQUIESCEREQUEST,
+    output_type=_SDKVOLUMEQUIESCERESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\027\"\022/v1/volume/quiesce:\001*')),
+  ),
+  _descriptor.MethodDescriptor(
+    name='Unquiesce',
+    full_name='openstorage.api.OpenStorageVolume.Unquiesce',
+    index=14,
+    containing_service=None,
+    input_type=_SDKVOLUMEUNQUIESCEREQUEST,
+    output_type=_SDKVOLUMEUNQUIESCERESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\031\"\024/v1/volume/unquiesce:\001*')),
+  ),
   _descriptor.MethodDescriptor(
     name='Attach',
     full_name='openstorage.api.OpenStorageVolume.Attach',
-    index=8,
+    index=15,
     containing_service=None,
     input_type=_SDKVOLUMEATTACHREQUEST,
     output_type=_SDKVOLUMEATTACHRESPONSE,
@@ -7926,7 +9933,7 @@ _OPENSTORAGEVOLUME = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='Detach',
     full_name='openstorage.api.OpenStorageVolume.Detach',
-    index=9,
+    index=16,
     containing_service=None,
     input_type=_SDKVOLUMEDETACHREQUEST,
     output_type=_SDKVOLUMEDETACHRESPONSE,
@@ -7935,7 +9942,7 @@ _OPENSTORAGEVOLUME = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='Mount',
     full_name='openstorage.api.OpenStorageVolume.Mount',
-    index=10,
+    index=17,
     containing_service=None,
     input_type=_SDKVOLUMEMOUNTREQUEST,
     output_type=_SDKVOLUMEMOUNTRESPONSE,
@@ -7944,7 +9951,7 @@ _OPENSTORAGEVOLUME = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='Unmount',
     full_name='openstorage.api.OpenStorageVolume.Unmount',
-    index=11,
+    index=18,
     containing_service=None,
     input_type=_SDKVOLUMEUNMOUNTREQUEST,
     output_type=_SDKVOLUMEUNMOUNTRESPONSE,
@@ -7960,10 +9967,10 @@ _OPENSTORAGEOBJECTSTORE = _descriptor.ServiceDescriptor(
   name='OpenStorageObjectstore',
   full_name='openstorage.api.OpenStorageObjectstore',
   file=DESCRIPTOR,
-  index=2,
+  index=3,
   options=None,
-  serialized_start=18796,
-  serialized_end=19382,
+  serialized_start=24456,
+  serialized_end=25042,
   methods=[
   _descriptor.MethodDescriptor(
     name='Inspect',
@@ -8011,10 +10018,10 @@ _OPENSTORAGECREDENTIALS = _descriptor.ServiceDescriptor(
   name='OpenStorageCredentials',
   full_name='openstorage.api.OpenStorageCredentials',
   file=DESCRIPTOR,
-  index=3,
+  index=4,
   options=None,
-  serialized_start=19385,
-  serialized_end=20685,
+  serialized_start=25045,
+  serialized_end=26345,
   methods=[
   _descriptor.MethodDescriptor(
     name='CreateForAWS',
@@ -8098,10 +10105,10 @@ _OPENSTORAGESCHEDULEPOLICY = _descriptor.ServiceDescriptor(
   name='OpenStorageSchedulePolicy',
   full_name='openstorage.api.OpenStorageSchedulePolicy',
   file=DESCRIPTOR,
-  index=4,
+  index=5,
   options=None,
-  serialized_start=20688,
-  serialized_end=21471,
+  serialized_start=26348,
+  serialized_end=27131,
   methods=[
   _descriptor.MethodDescriptor(
     name='Create',
@@ -8158,10 +10165,10 @@ _OPENSTORAGECLOUDBACKUP = _descriptor.ServiceDescriptor(
   name='OpenStorageCloudBackup',
   full_name='openstorage.api.OpenStorageCloudBackup',
   file=DESCRIPTOR,
-  index=5,
+  index=6,
   options=None,
-  serialized_start=21474,
-  serialized_end=22805,
+  serialized_start=27134,
+  serialized_end=28465,
   methods=[
   _descriptor.MethodDescriptor(
     name='Create',
@@ -8249,4 +10256,28 @@ _sym_db.RegisterServiceDescriptor(_OPENSTORAGECLOUDBACKUP)
 
 DESCRIPTOR.services_by_name['OpenStorageCloudBackup'] = _OPENSTORAGECLOUDBACKUP
 
+
+_OPENSTORAGEAUDIT = _descriptor.ServiceDescriptor(
+  name='OpenStorageAudit',
+  full_name='openstorage.api.OpenStorageAudit',
+  file=DESCRIPTOR,
+  index=7,
+  options=None,
+  serialized_start=28468,
+  serialized_end=28619,
+  methods=[
+  _descriptor.MethodDescriptor(
+    name='Enumerate',
+    full_name='openstorage.api.OpenStorageAudit.Enumerate',
+    index=0,
+    containing_service=None,
+    input_type=_SDKAUDITENUMERATEREQUEST,
+    output_type=_SDKAUDITENUMERATERESPONSE,
+    options=_descriptor._ParseOptions(descriptor_pb2.MethodOptions(), _b('\202\323\344\223\002\030\"\023/v1/audit/enumerate:\001*')),
+  ),
+])
+_sym_db.RegisterServiceDescriptor(_OPENSTORAGEAUDIT)
+
+DESCRIPTOR.services_by_name['OpenStorageAudit'] = _OPENSTORAGEAUDIT
+
 # @@protoc_insertion_point(module_scope)
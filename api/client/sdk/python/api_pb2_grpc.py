@@ -39,6 +39,11 @@ class OpenStorageClusterStub(object):
         request_serializer=api__pb2.SdkClusterAlertEraseRequest.SerializeToString,
         response_deserializer=api__pb2.SdkClusterAlertEraseResponse.FromString,
         )
+    self.AlertWatch = channel.unary_stream(
+        '/openstorage.api.OpenStorageCluster/AlertWatch',
+        request_serializer=api__pb2.SdkClusterAlertWatchRequest.SerializeToString,
+        response_deserializer=api__pb2.SdkClusterAlertWatchResponse.FromString,
+        )
 
 
 class OpenStorageClusterServicer(object):
@@ -80,6 +85,13 @@ class OpenStorageClusterServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def AlertWatch(self, request, context):
+    """Watch for alerts being created, updated or deleted in the storage cluster
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_OpenStorageClusterServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -108,12 +120,110 @@ def add_OpenStorageClusterServicer_to_server(servicer, server):
           request_deserializer=api__pb2.SdkClusterAlertEraseRequest.FromString,
           response_serializer=api__pb2.SdkClusterAlertEraseResponse.SerializeToString,
       ),
+      'AlertWatch': grpc.unary_stream_rpc_method_handler(
+          servicer.AlertWatch,
+          request_deserializer=api__pb2.SdkClusterAlertWatchRequest.FromString,
+          response_serializer=api__pb2.SdkClusterAlertWatchResponse.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'openstorage.api.OpenStorageCluster', rpc_method_handlers)
   server.add_generic_rpc_handlers((generic_handler,))
 
 
+class OpenStorageNodeStub(object):
+  # missing associated documentation comment in .proto file
+  pass
+
+  def __init__(self, channel):
+    """Constructor.
+
+    Args:
+      channel: A grpc.Channel.
+    """
+    self.Enumerate = channel.unary_unary(
+        '/openstorage.api.OpenStorageNode/Enumerate',
+        request_serializer=api__pb2.SdkNodeEnumerateRequest.SerializeToString,
+        response_deserializer=api__pb2.SdkNodeEnumerateResponse.FromString,
+        )
+    self.Inspect = channel.unary_unary(
+        '/openstorage.api.OpenStorageNode/Inspect',
+        request_serializer=api__pb2.SdkNodeInspectRequest.SerializeToString,
+        response_deserializer=api__pb2.SdkNodeInspectResponse.FromString,
+        )
+    self.InspectCurrent = channel.unary_unary(
+        '/openstorage.api.OpenStorageNode/InspectCurrent',
+        request_serializer=api__pb2.SdkNodeInspectCurrentRequest.SerializeToString,
+        response_deserializer=api__pb2.SdkNodeInspectCurrentResponse.FromString,
+        )
+    self.UpdateLabels = channel.unary_unary(
+        '/openstorage.api.OpenStorageNode/UpdateLabels',
+        request_serializer=api__pb2.SdkNodeUpdateLabelsRequest.SerializeToString,
+        response_deserializer=api__pb2.SdkNodeUpdateLabelsResponse.FromString,
+        )
+
+
+class OpenStorageNodeServicer(object):
+  # missing associated documentation comment in .proto file
+  pass
+
+  def Enumerate(self, request, context):
+    """Enumerate returns the ids of all the nodes in the cluster
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Inspect(self, request, context):
+    """Inspect returns information about the specified node
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def InspectCurrent(self, request, context):
+    """InspectCurrent returns information about the node servicing the request
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def UpdateLabels(self, request, context):
+    """UpdateLabels adds or changes the labels of the node servicing the request
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+
+def add_OpenStorageNodeServicer_to_server(servicer, server):
+  rpc_method_handlers = {
+      'Enumerate': grpc.unary_unary_rpc_method_handler(
+          servicer.Enumerate,
+          request_deserializer=api__pb2.SdkNodeEnumerateRequest.FromString,
+          response_serializer=api__pb2.SdkNodeEnumerateResponse.SerializeToString,
+      ),
+      'Inspect': grpc.unary_unary_rpc_method_handler(
+          servicer.Inspect,
+          request_deserializer=api__pb2.SdkNodeInspectRequest.FromString,
+          response_serializer=api__pb2.SdkNodeInspectResponse.SerializeToString,
+      ),
+      'InspectCurrent': grpc.unary_unary_rpc_method_handler(
+          servicer.InspectCurrent,
+          request_deserializer=api__pb2.SdkNodeInspectCurrentRequest.FromString,
+          response_serializer=api__pb2.SdkNodeInspectCurrentResponse.SerializeToString,
+      ),
+      'UpdateLabels': grpc.unary_unary_rpc_method_handler(
+          servicer.UpdateLabels,
+          request_deserializer=api__pb2.SdkNodeUpdateLabelsRequest.FromString,
+          response_serializer=api__pb2.SdkNodeUpdateLabelsResponse.SerializeToString,
+      ),
+  }
+  generic_handler = grpc.method_handlers_generic_handler(
+      'openstorage.api.OpenStorageNode', rpc_method_handlers)
+  server.add_generic_rpc_handlers((generic_handler,))
+
+
 class OpenStorageVolumeStub(object):
   # missing associated documentation comment in .proto file
   pass
@@ -149,6 +259,26 @@ class OpenStorageVolumeStub(object):
         request_serializer=api__pb2.SdkVolumeEnumerateRequest.SerializeToString,
         response_deserializer=api__pb2.SdkVolumeEnumerateResponse.FromString,
         )
+    self.Update = channel.unary_unary(
+        '/openstorage.api.OpenStorageVolume/Update',
+        request_serializer=api__pb2.SdkVolumeUpdateRequest.SerializeToString,
+        response_deserializer=api__pb2.SdkVolumeUpdateResponse.FromString,
+        )
+    self.Stats = channel.unary_unary(
+        '/openstorage.api.OpenStorageVolume/Stats',
+        request_serializer=api__pb2.SdkVolumeStatsRequest.SerializeToString,
+        response_deserializer=api__pb2.SdkVolumeStatsResponse.FromString,
+        )
+    self.CapacityUsage = channel.unary_unary(
+        '/openstorage.api.OpenStorageVolume/CapacityUsage',
+        request_serializer=api__pb2.SdkVolumeCapacityUsageRequest.SerializeToString,
+        response_deserializer=api__pb2.SdkVolumeCapacityUsageResponse.FromString,
+        )
+    self.ActiveRequests = channel.unary_unary(
+        '/openstorage.api.OpenStorageVolume/ActiveRequests',
+        request_serializer=api__pb2.SdkVolumeActiveRequestsRequest.SerializeToString,
+        response_deserializer=api__pb2.SdkVolumeActiveRequestsResponse.FromString,
+        )
     self.SnapshotCreate = channel.unary_unary(
         '/openstorage.api.OpenStorageVolume/SnapshotCreate',
         request_serializer=api__pb2.SdkVolumeSnapshotCreateRequest.SerializeToString,
@@ -164,6 +294,21 @@ class OpenStorageVolumeStub(object):
         request_serializer=api__pb2.SdkVolumeSnapshotEnumerateRequest.SerializeToString,
         response_deserializer=api__pb2.SdkVolumeSnapshotEnumerateResponse.FromString,
         )
+    self.SnapshotGroupCreate = channel.unary_unary(
+        '/openstorage.api.OpenStorageVolume/SnapshotGroupCreate',
+        request_serializer=api__pb2.SdkVolumeSnapshotGroupCreateRequest.SerializeToString,
+        response_deserializer=api__pb2.SdkVolumeSnapshotGroupCreateResponse.FromString,
+        )
+    self.Quiesce = channel.unary_unary(
+        '/openstorage.api.OpenStorageVolume/Quiesce',
+        request_serializer=api__pb2.SdkVolumeQuiesceRequest.SerializeToString,
+        response_deserializer=api__pb2.SdkVolumeQuiesceResponse.FromString,
+        )
+    self.Unquiesce = channel.unary_unary(
+        '/openstorage.api.OpenStorageVolume/Unquiesce',
+        request_serializer=api__pb2.SdkVolumeUnquiesceRequest.SerializeToString,
+        response_deserializer=api__pb2.SdkVolumeUnquiesceResponse.FromString,
+        )
     self.Attach = channel.unary_unary(
         '/openstorage.api.OpenStorageVolume/Attach',
         request_serializer=api__pb2.SdkVolumeAttachRequest.SerializeToString,
@@ -225,6 +370,34 @@ class OpenStorageVolumeServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def Update(self, request, context):
+    """Update the labels and specification of an existing volume
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Stats(self, request, context):
+    """Get the IO statistics of a volume
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def CapacityUsage(self, request, context):
+    """Get the currently used capacity of a volume
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ActiveRequests(self, request, context):
+    """Get the IO requests currently in progress in the volume driver
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def SnapshotCreate(self, request, context):
     """Create a snapshot of a volume. This creates an immutable (read-only),
     point-in-time snapshot of a volume.
@@ -247,6 +420,27 @@ class OpenStorageVolumeServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def SnapshotGroupCreate(self, request, context):
+    """Create a consistent snapshot of all the volumes in a group
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Quiesce(self, request, context):
+    """Freeze the filesystem of a mounted volume
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Unquiesce(self, request, context):
+    """Unfreeze the filesystem of a quiesced volume
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def Attach(self, request, context):
     """Attach device to host
     """
@@ -303,6 +497,26 @@ def add_OpenStorageVolumeServicer_to_server(servicer, server):
           request_deserializer=api__pb2.SdkVolumeEnumerateRequest.FromString,
           response_serializer=api__pb2.SdkVolumeEnumerateResponse.SerializeToString,
       ),
+      'Update': grpc.unary_unary_rpc_method_handler(
+          servicer.Update,
+          request_deserializer=api__pb2.SdkVolumeUpdateRequest.FromString,
+          response_serializer=api__pb2.SdkVolumeUpdateResponse.SerializeToString,
+      ),
+      'Stats': grpc.unary_unary_rpc_method_handler(
+          servicer.Stats,
+          request_deserializer=api__pb2.SdkVolumeStatsRequest.FromString,
+          response_serializer=api__pb2.SdkVolumeStatsResponse.SerializeToString,
+      ),
+      'CapacityUsage': grpc.unary_unary_rpc_method_handler(
+          servicer.CapacityUsage,
+          request_deserializer=api__pb2.SdkVolumeCapacityUsageRequest.FromString,
+          response_serializer=api__pb2.SdkVolumeCapacityUsageResponse.SerializeToString,
+      ),
+      'ActiveRequests': grpc.unary_unary_rpc_method_handler(
+          servicer.ActiveRequests,
+          request_deserializer=api__pb2.SdkVolumeActiveRequestsRequest.FromString,
+          response_serializer=api__pb2.SdkVolumeActiveRequestsResponse.SerializeToString,
+      ),
       'SnapshotCreate': grpc.unary_unary_rpc_method_handler(
           servicer.SnapshotCreate,
           request_deserializer=api__pb2.SdkVolumeSnapshotCreateRequest.FromString,
@@ -318,6 +532,21 @@ def add_OpenStorageVolumeServicer_to_server(servicer, server):
           request_deserializer=api__pb2.SdkVolumeSnapshotEnumerateRequest.FromString,
           response_serializer=api__pb2.SdkVolumeSnapshotEnumerateResponse.SerializeToString,
       ),
+      'SnapshotGroupCreate': grpc.unary_unary_rpc_method_handler(
+          servicer.SnapshotGroupCreate,
+          request_deserializer=api__pb2.SdkVolumeSnapshotGroupCreateRequest.FromString,
+          response_serializer=api__pb2.SdkVolumeSnapshotGroupCreateResponse.SerializeToString,
+      ),
+      'Quiesce': grpc.unary_unary_rpc_method_handler(
+          servicer.Quiesce,
+          request_deserializer=api__pb2.SdkVolumeQuiesceRequest.FromString,
+          response_serializer=api__pb2.SdkVolumeQuiesceResponse.SerializeToString,
+      ),
+      'Unquiesce': grpc.unary_unary_rpc_method_handler(
+          servicer.Unquiesce,
+          request_deserializer=api__pb2.SdkVolumeUnquiesceRequest.FromString,
+          response_serializer=api__pb2.SdkVolumeUnquiesceResponse.SerializeToString,
+      ),
       'Attach': grpc.unary_unary_rpc_method_handler(
           servicer.Attach,
           request_deserializer=api__pb2.SdkVolumeAttachRequest.FromString,
@@ -890,3 +1119,45 @@ def add_OpenStorageCloudBackupServicer_to_server(servicer, server):
   generic_handler = grpc.method_handlers_generic_handler(
       'openstorage.api.OpenStorageCloudBackup', rpc_method_handlers)
   server.add_generic_rpc_handlers((generic_handler,))
+
+
+class OpenStorageAuditStub(object):
+  # missing associated documentation comment in .proto file
+  pass
+
+  def __init__(self, channel):
+    """Constructor.
+
+    Args:
+      channel: A grpc.Channel.
+    """
+    self.Enumerate = channel.unary_unary(
+        '/openstorage.api.OpenStorageAudit/Enumerate',
+        request_serializer=api__pb2.SdkAuditEnumerateRequest.SerializeToString,
+        response_deserializer=api__pb2.SdkAuditEnumerateResponse.FromString,
+        )
+
+
+class OpenStorageAuditServicer(object):
+  # missing associated documentation comment in .proto file
+  pass
+
+  def Enumerate(self, request, context):
+    """Enumerate returns the audit events recorded in the cluster
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+
+def add_OpenStorageAuditServicer_to_server(servicer, server):
+  rpc_method_handlers = {
+      'Enumerate': grpc.unary_unary_rpc_method_handler(
+          servicer.Enumerate,
+          request_deserializer=api__pb2.SdkAuditEnumerateRequest.FromString,
+          response_serializer=api__pb2.SdkAuditEnumerateResponse.SerializeToString,
+      ),
+  }
+  generic_handler = grpc.method_handlers_generic_handler(
+      'openstorage.api.OpenStorageAudit', rpc_method_handlers)
+  server.add_generic_rpc_handlers((generic_handler,))
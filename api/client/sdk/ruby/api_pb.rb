@@ -41,6 +41,28 @@ Google::Protobuf::DescriptorPool.generated_pool.build do
   add_message "openstorage.api.Group" do
     optional :id, :string, 1
   end
+  add_message "openstorage.api.OwnershipAcls" do
+    map :groups, :string, :enum, 1, "openstorage.api.OwnershipAccessType"
+    map :collaborators, :string, :enum, 2, "openstorage.api.OwnershipAccessType"
+  end
+  add_message "openstorage.api.Ownership" do
+    optional :owner, :string, 1
+    optional :acls, :message, 2, "openstorage.api.OwnershipAcls"
+  end
+  add_message "openstorage.api.LabelSelectorRequirement" do
+    optional :key, :string, 1
+    optional :operator, :enum, 2, "openstorage.api.LabelSelectorOperator"
+    repeated :values, :string, 3
+  end
+  add_message "openstorage.api.VolumeFilter" do
+    optional :locator, :message, 1, "openstorage.api.VolumeLocator"
+    repeated :label_selector, :message, 2, "openstorage.api.LabelSelectorRequirement"
+    optional :state, :enum, 3, "openstorage.api.VolumeState"
+    optional :status, :enum, 4, "openstorage.api.VolumeStatus"
+    optional :owner, :string, 5
+    optional :created_after, :message, 6, "google.protobuf.Timestamp"
+    optional :created_before, :message, 7, "google.protobuf.Timestamp"
+  end
   add_message "openstorage.api.VolumeSpec" do
     optional :ephemeral, :bool, 1
     optional :size, :uint64, 2
@@ -66,6 +88,7 @@ Google::Protobuf::DescriptorPool.generated_pool.build do
     optional :cascaded, :bool, 24
     optional :journal, :bool, 25
     optional :sharedv4, :bool, 26
+    optional :ownership, :message, 27, "openstorage.api.Ownership"
   end
   add_message "openstorage.api.ReplicaSet" do
     repeated :nodes, :string, 1
@@ -124,6 +147,18 @@ Google::Protobuf::DescriptorPool.generated_pool.build do
   add_message "openstorage.api.Alerts" do
     repeated :alert, :message, 1, "openstorage.api.Alert"
   end
+  add_message "openstorage.api.AuditEvent" do
+    optional :id, :string, 1
+    optional :timestamp, :message, 2, "google.protobuf.Timestamp"
+    optional :node_id, :string, 3
+    optional :api, :string, 4
+    optional :operation, :string, 5
+    optional :subject, :string, 6
+    optional :remote_address, :string, 7
+    optional :request, :string, 8
+    optional :success, :bool, 9
+    optional :error, :string, 10
+  end
   add_message "openstorage.api.ObjectstoreInfo" do
     optional :uuid, :string, 1
     optional :volume_id, :string, 2
@@ -376,9 +411,46 @@ Google::Protobuf::DescriptorPool.generated_pool.build do
   end
   add_message "openstorage.api.SdkVolumeEnumerateRequest" do
     optional :locator, :message, 1, "openstorage.api.VolumeLocator"
+    optional :filter, :message, 2, "openstorage.api.VolumeFilter"
+    optional :max_results, :uint32, 3
+    optional :page_token, :string, 4
   end
   add_message "openstorage.api.SdkVolumeEnumerateResponse" do
     repeated :volumes, :message, 1, "openstorage.api.Volume"
+    optional :next_page_token, :string, 2
+  end
+  add_message "openstorage.api.SdkVolumeUpdateRequest" do
+    optional :volume_id, :string, 1
+    map :labels, :string, :string, 2
+    optional :spec, :message, 3, "openstorage.api.VolumeSpec"
+  end
+  add_message "openstorage.api.SdkVolumeUpdateResponse" do
+    optional :volume, :message, 1, "openstorage.api.Volume"
+  end
+  add_message "openstorage.api.SdkVolumeStatsRequest" do
+    optional :volume_id, :string, 1
+    optional :not_cumulative, :bool, 2
+  end
+  add_message "openstorage.api.SdkVolumeStatsResponse" do
+    optional :stats, :message, 1, "openstorage.api.Stats"
+    optional :write_throughput, :uint64, 2
+    optional :read_throughput, :uint64, 3
+    optional :latency, :uint64, 4
+    optional :read_latency, :uint64, 5
+    optional :write_latency, :uint64, 6
+    optional :iops, :uint64, 7
+  end
+  add_message "openstorage.api.SdkVolumeCapacityUsageRequest" do
+    optional :volume_id, :string, 1
+  end
+  add_message "openstorage.api.SdkVolumeCapacityUsageResponse" do
+    optional :used_bytes, :uint64, 1
+    optional :total_bytes, :uint64, 2
+  end
+  add_message "openstorage.api.SdkVolumeActiveRequestsRequest" do
+  end
+  add_message "openstorage.api.SdkVolumeActiveRequestsResponse" do
+    optional :active_requests, :message, 1, "openstorage.api.ActiveRequests"
   end
   add_message "openstorage.api.SdkVolumeSnapshotCreateRequest" do
     optional :volume_id, :string, 1
@@ -400,6 +472,27 @@ Google::Protobuf::DescriptorPool.generated_pool.build do
   add_message "openstorage.api.SdkVolumeSnapshotEnumerateResponse" do
     repeated :snapshots, :message, 1, "openstorage.api.Volume"
   end
+  add_message "openstorage.api.SdkVolumeSnapshotGroupCreateRequest" do
+    optional :group_id, :string, 1
+    map :labels, :string, :string, 2
+    optional :quiesce, :bool, 3
+    optional :quiesce_timeout_seconds, :uint64, 4
+  end
+  add_message "openstorage.api.SdkVolumeSnapshotGroupCreateResponse" do
+    map :snapshots, :string, :string, 1
+  end
+  add_message "openstorage.api.SdkVolumeQuiesceRequest" do
+    optional :volume_id, :string, 1
+    optional :timeout_seconds, :uint64, 2
+    optional :quiesce_id, :string, 3
+  end
+  add_message "openstorage.api.SdkVolumeQuiesceResponse" do
+  end
+  add_message "openstorage.api.SdkVolumeUnquiesceRequest" do
+    optional :volume_id, :string, 1
+  end
+  add_message "openstorage.api.SdkVolumeUnquiesceResponse" do
+  end
   add_message "openstorage.api.SdkClusterEnumerateRequest" do
   end
   add_message "openstorage.api.SdkClusterEnumerateResponse" do
@@ -431,6 +524,38 @@ Google::Protobuf::DescriptorPool.generated_pool.build do
   end
   add_message "openstorage.api.SdkClusterAlertEraseResponse" do
   end
+  add_message "openstorage.api.SdkClusterAlertWatchRequest" do
+    optional :resource, :enum, 1, "openstorage.api.ResourceType"
+    optional :resource_id, :string, 2
+    optional :severity, :enum, 3, "openstorage.api.SeverityType"
+  end
+  add_message "openstorage.api.SdkClusterAlertWatchResponse" do
+    optional :action, :enum, 1, "openstorage.api.AlertActionType"
+    optional :alert, :message, 2, "openstorage.api.Alert"
+  end
+  add_message "openstorage.api.SdkNodeEnumerateRequest" do
+  end
+  add_message "openstorage.api.SdkNodeEnumerateResponse" do
+    repeated :node_ids, :string, 1
+  end
+  add_message "openstorage.api.SdkNodeInspectRequest" do
+    optional :node_id, :string, 1
+  end
+  add_message "openstorage.api.SdkNodeInspectResponse" do
+    optional :node, :message, 1, "openstorage.api.StorageNode"
+  end
+  add_message "openstorage.api.SdkNodeInspectCurrentRequest" do
+  end
+  add_message "openstorage.api.SdkNodeInspectCurrentResponse" do
+    optional :node, :message, 1, "openstorage.api.StorageNode"
+  end
+  add_message "openstorage.api.SdkNodeUpdateLabelsRequest" do
+    optional :node_id, :string, 1
+    map :labels, :string, :string, 2
+  end
+  add_message "openstorage.api.SdkNodeUpdateLabelsResponse" do
+    optional :node, :message, 1, "openstorage.api.StorageNode"
+  end
   add_message "openstorage.api.SdkObjectstoreInspectRequest" do
     optional :objectstore_id, :string, 1
   end
@@ -541,6 +666,15 @@ Google::Protobuf::DescriptorPool.generated_pool.build do
   end
   add_message "openstorage.api.SdkCloudBackupStateChangeResponse" do
   end
+  add_message "openstorage.api.SdkAuditEnumerateRequest" do
+    optional :time_start, :message, 1, "google.protobuf.Timestamp"
+    optional :time_end, :message, 2, "google.protobuf.Timestamp"
+    optional :subject, :string, 3
+    optional :operation, :string, 4
+  end
+  add_message "openstorage.api.SdkAuditEnumerateResponse" do
+    repeated :events, :message, 1, "openstorage.api.AuditEvent"
+  end
   add_enum "openstorage.api.Status" do
     value :STATUS_NONE, 0
     value :STATUS_INIT, 1
@@ -656,6 +790,17 @@ Google::Protobuf::DescriptorPool.generated_pool.build do
     value :OP_FLAGS_NONE, 1
     value :OP_FLAGS_DETACH_FORCE, 2
   end
+  add_enum "openstorage.api.OwnershipAccessType" do
+    value :OWNERSHIP_ACCESS_TYPE_READ, 0
+    value :OWNERSHIP_ACCESS_TYPE_WRITE, 1
+    value :OWNERSHIP_ACCESS_TYPE_ADMIN, 2
+  end
+  add_enum "openstorage.api.LabelSelectorOperator" do
+    value :LABEL_SELECTOR_OPERATOR_IN, 0
+    value :LABEL_SELECTOR_OPERATOR_NOT_IN, 1
+    value :LABEL_SELECTOR_OPERATOR_EXISTS, 2
+    value :LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST, 3
+  end
   add_enum "openstorage.api.SdkCloudBackupOpType" do
     value :SdkCloudBackupOpTypeUnknown, 0
     value :SdkCloudBackupOpTypeBackupOp, 1
@@ -686,6 +831,10 @@ module Openstorage
     VolumeLocator = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.VolumeLocator").msgclass
     Source = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.Source").msgclass
     Group = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.Group").msgclass
+    OwnershipAcls = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.OwnershipAcls").msgclass
+    Ownership = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.Ownership").msgclass
+    LabelSelectorRequirement = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.LabelSelectorRequirement").msgclass
+    VolumeFilter = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.VolumeFilter").msgclass
     VolumeSpec = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.VolumeSpec").msgclass
     ReplicaSet = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.ReplicaSet").msgclass
     RuntimeStateMap = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.RuntimeStateMap").msgclass
@@ -693,6 +842,7 @@ module Openstorage
     Stats = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.Stats").msgclass
     Alert = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.Alert").msgclass
     Alerts = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.Alerts").msgclass
+    AuditEvent = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.AuditEvent").msgclass
     ObjectstoreInfo = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.ObjectstoreInfo").msgclass
     VolumeCreateRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.VolumeCreateRequest").msgclass
     VolumeResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.VolumeResponse").msgclass
@@ -760,12 +910,26 @@ module Openstorage
     SdkVolumeInspectResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeInspectResponse").msgclass
     SdkVolumeEnumerateRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeEnumerateRequest").msgclass
     SdkVolumeEnumerateResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeEnumerateResponse").msgclass
+    SdkVolumeUpdateRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeUpdateRequest").msgclass
+    SdkVolumeUpdateResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeUpdateResponse").msgclass
+    SdkVolumeStatsRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeStatsRequest").msgclass
+    SdkVolumeStatsResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeStatsResponse").msgclass
+    SdkVolumeCapacityUsageRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeCapacityUsageRequest").msgclass
+    SdkVolumeCapacityUsageResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeCapacityUsageResponse").msgclass
+    SdkVolumeActiveRequestsRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeActiveRequestsRequest").msgclass
+    SdkVolumeActiveRequestsResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeActiveRequestsResponse").msgclass
     SdkVolumeSnapshotCreateRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeSnapshotCreateRequest").msgclass
     SdkVolumeSnapshotCreateResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeSnapshotCreateResponse").msgclass
     SdkVolumeSnapshotRestoreRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeSnapshotRestoreRequest").msgclass
     SdkVolumeSnapshotRestoreResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeSnapshotRestoreResponse").msgclass
     SdkVolumeSnapshotEnumerateRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeSnapshotEnumerateRequest").msgclass
     SdkVolumeSnapshotEnumerateResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeSnapshotEnumerateResponse").msgclass
+    SdkVolumeSnapshotGroupCreateRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeSnapshotGroupCreateRequest").msgclass
+    SdkVolumeSnapshotGroupCreateResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeSnapshotGroupCreateResponse").msgclass
+    SdkVolumeQuiesceRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeQuiesceRequest").msgclass
+    SdkVolumeQuiesceResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeQuiesceResponse").msgclass
+    SdkVolumeUnquiesceRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeUnquiesceRequest").msgclass
+    SdkVolumeUnquiesceResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkVolumeUnquiesceResponse").msgclass
     SdkClusterEnumerateRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkClusterEnumerateRequest").msgclass
     SdkClusterEnumerateResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkClusterEnumerateResponse").msgclass
     SdkClusterInspectRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkClusterInspectRequest").msgclass
@@ -776,6 +940,16 @@ module Openstorage
     SdkClusterAlertClearResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkClusterAlertClearResponse").msgclass
     SdkClusterAlertEraseRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkClusterAlertEraseRequest").msgclass
     SdkClusterAlertEraseResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkClusterAlertEraseResponse").msgclass
+    SdkClusterAlertWatchRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkClusterAlertWatchRequest").msgclass
+    SdkClusterAlertWatchResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkClusterAlertWatchResponse").msgclass
+    SdkNodeEnumerateRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkNodeEnumerateRequest").msgclass
+    SdkNodeEnumerateResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkNodeEnumerateResponse").msgclass
+    SdkNodeInspectRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkNodeInspectRequest").msgclass
+    SdkNodeInspectResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkNodeInspectResponse").msgclass
+    SdkNodeInspectCurrentRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkNodeInspectCurrentRequest").msgclass
+    SdkNodeInspectCurrentResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkNodeInspectCurrentResponse").msgclass
+    SdkNodeUpdateLabelsRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkNodeUpdateLabelsRequest").msgclass
+    SdkNodeUpdateLabelsResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkNodeUpdateLabelsResponse").msgclass
     SdkObjectstoreInspectRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkObjectstoreInspectRequest").msgclass
     SdkObjectstoreInspectResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkObjectstoreInspectResponse").msgclass
     SdkObjectstoreCreateRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkObjectstoreCreateRequest").msgclass
@@ -805,6 +979,8 @@ module Openstorage
     SdkCloudBackupHistoryResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkCloudBackupHistoryResponse").msgclass
     SdkCloudBackupStateChangeRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkCloudBackupStateChangeRequest").msgclass
     SdkCloudBackupStateChangeResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkCloudBackupStateChangeResponse").msgclass
+    SdkAuditEnumerateRequest = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkAuditEnumerateRequest").msgclass
+    SdkAuditEnumerateResponse = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkAuditEnumerateResponse").msgclass
     Status = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.Status").enummodule
     DriverType = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.DriverType").enummodule
     FSType = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.FSType").enummodule
@@ -821,6 +997,8 @@ module Openstorage
     ClusterNotify = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.ClusterNotify").enummodule
     AttachState = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.AttachState").enummodule
     OperationFlags = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.OperationFlags").enummodule
+    OwnershipAccessType = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.OwnershipAccessType").enummodule
+    LabelSelectorOperator = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.LabelSelectorOperator").enummodule
     SdkCloudBackupOpType = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkCloudBackupOpType").enummodule
     SdkCloudBackupStatusType = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkCloudBackupStatusType").enummodule
     SdkCloudBackupRequestedState = Google::Protobuf::DescriptorPool.generated_pool.lookup("openstorage.api.SdkCloudBackupRequestedState").enummodule
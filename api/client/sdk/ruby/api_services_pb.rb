@@ -25,6 +25,29 @@ module Openstorage
         rpc :AlertClear, SdkClusterAlertClearRequest, SdkClusterAlertClearResponse
         # Erases an alert for a given resource
         rpc :AlertErase, SdkClusterAlertEraseRequest, SdkClusterAlertEraseResponse
+        # Watch for alerts being created, updated or deleted in the storage cluster
+        rpc :AlertWatch, SdkClusterAlertWatchRequest, stream(SdkClusterAlertWatchResponse)
+      end
+
+      Stub = Service.rpc_stub_class
+    end
+    module OpenStorageNode
+      class Service
+
+        include GRPC::GenericService
+
+        self.marshal_class_method = :encode
+        self.unmarshal_class_method = :decode
+        self.service_name = 'openstorage.api.OpenStorageNode'
+
+        # Enumerate returns the ids of all the nodes in the cluster
+        rpc :Enumerate, SdkNodeEnumerateRequest, SdkNodeEnumerateResponse
+        # Inspect returns information about the specified node
+        rpc :Inspect, SdkNodeInspectRequest, SdkNodeInspectResponse
+        # InspectCurrent returns information about the node servicing the request
+        rpc :InspectCurrent, SdkNodeInspectCurrentRequest, SdkNodeInspectCurrentResponse
+        # UpdateLabels adds or changes the labels of the node servicing the request
+        rpc :UpdateLabels, SdkNodeUpdateLabelsRequest, SdkNodeUpdateLabelsResponse
       end
 
       Stub = Service.rpc_stub_class
@@ -48,6 +71,14 @@ module Openstorage
         rpc :Inspect, SdkVolumeInspectRequest, SdkVolumeInspectResponse
         # Get a list of volumes
         rpc :Enumerate, SdkVolumeEnumerateRequest, SdkVolumeEnumerateResponse
+        # Update the labels and specification of an existing volume
+        rpc :Update, SdkVolumeUpdateRequest, SdkVolumeUpdateResponse
+        # Get the IO statistics of a volume
+        rpc :Stats, SdkVolumeStatsRequest, SdkVolumeStatsResponse
+        # Get the currently used capacity of a volume
+        rpc :CapacityUsage, SdkVolumeCapacityUsageRequest, SdkVolumeCapacityUsageResponse
+        # Get the IO requests currently in progress in the volume driver
+        rpc :ActiveRequests, SdkVolumeActiveRequestsRequest, SdkVolumeActiveRequestsResponse
         # Create a snapshot of a volume. This creates an immutable (read-only),
         # point-in-time snapshot of a volume.
         rpc :SnapshotCreate, SdkVolumeSnapshotCreateRequest, SdkVolumeSnapshotCreateResponse
@@ -55,6 +86,12 @@ module Openstorage
         rpc :SnapshotRestore, SdkVolumeSnapshotRestoreRequest, SdkVolumeSnapshotRestoreResponse
         # List the number of snapshots for a specific volume
         rpc :SnapshotEnumerate, SdkVolumeSnapshotEnumerateRequest, SdkVolumeSnapshotEnumerateResponse
+        # Create a consistent snapshot of all the volumes in a group
+        rpc :SnapshotGroupCreate, SdkVolumeSnapshotGroupCreateRequest, SdkVolumeSnapshotGroupCreateResponse
+        # Freeze the filesystem of a mounted volume
+        rpc :Quiesce, SdkVolumeQuiesceRequest, SdkVolumeQuiesceResponse
+        # Unfreeze the filesystem of a quiesced volume
+        rpc :Unquiesce, SdkVolumeUnquiesceRequest, SdkVolumeUnquiesceResponse
         # Attach device to host
         rpc :Attach, SdkVolumeAttachRequest, SdkVolumeAttachResponse
         # Detaches the volume from the node.
@@ -174,6 +211,21 @@ module Openstorage
         rpc :StateChange, SdkCloudBackupStateChangeRequest, SdkCloudBackupStateChangeResponse
       end
 
+      Stub = Service.rpc_stub_class
+    end
+    module OpenStorageAudit
+      class Service
+
+        include GRPC::GenericService
+
+        self.marshal_class_method = :encode
+        self.unmarshal_class_method = :decode
+        self.service_name = 'openstorage.api.OpenStorageAudit'
+
+        # Enumerate returns the audit events recorded in the cluster
+        rpc :Enumerate, SdkAuditEnumerateRequest, SdkAuditEnumerateResponse
+      end
+
       Stub = Service.rpc_stub_class
     end
   end
@@ -7,9 +7,11 @@ import (
 	"io"
 	"io/ioutil"
 	"strconv"
+	"time"
 
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/api/client"
+	"github.com/libopenstorage/openstorage/pkg/proto/time"
 	"github.com/libopenstorage/openstorage/volume"
 )
 
@@ -284,6 +286,56 @@ func (v *volumeClient) Enumerate(locator *api.VolumeLocator,
 	return volumes, nil
 }
 
+// EnumerateWithFilter returns a page of the volumes selected by the filter.
+func (v *volumeClient) EnumerateWithFilter(
+	filter *api.VolumeFilter,
+	pageToken string,
+	maxResults int,
+) ([]*api.Volume, string, error) {
+	var volumes []*api.Volume
+	req := v.c.Get().Resource(volumePath)
+	if name := filter.GetLocator().GetName(); name != "" {
+		req.QueryOption(api.OptName, name)
+	}
+	if labels := filter.GetLocator().GetVolumeLabels(); len(labels) != 0 {
+		req.QueryOptionLabel(api.OptLabel, labels)
+	}
+	if len(filter.GetLabelSelector()) != 0 {
+		req.QueryOption(api.OptLabelSelector, api.FormatLabelSelector(filter.GetLabelSelector()))
+	}
+	if filter.GetState() != api.VolumeState_VOLUME_STATE_NONE {
+		req.QueryOption(api.OptState, filter.GetState().SimpleString())
+	}
+	if filter.GetStatus() != api.VolumeStatus_VOLUME_STATUS_NONE {
+		req.QueryOption(api.OptStatus, filter.GetStatus().SimpleString())
+	}
+	if owner := filter.GetOwner(); owner != "" {
+		req.QueryOption(api.OptOwner, owner)
+	}
+	if filter.GetCreatedAfter() != nil {
+		req.QueryOption(api.OptCreatedAfter,
+			prototime.TimestampToTime(filter.GetCreatedAfter()).Format(time.RFC3339Nano))
+	}
+	if filter.GetCreatedBefore() != nil {
+		req.QueryOption(api.OptCreatedBefore,
+			prototime.TimestampToTime(filter.GetCreatedBefore()).Format(time.RFC3339Nano))
+	}
+	if pageToken != "" {
+		req.QueryOption(api.OptPageToken, pageToken)
+	}
+	// The max results are always sent so that the server paginates
+	req.QueryOption(api.OptMaxResults, strconv.Itoa(maxResults))
+	resp := req.Do()
+	if resp.Error() != nil {
+		return nil, "", resp.FormatError()
+	}
+	if err := resp.Unmarshal(&volumes); err != nil {
+		return nil, "", err
+	}
+
+	return volumes, resp.Header(api.NextPageTokenHeader), nil
+}
+
 // Enumerate snaps for specified volume
 // Count indicates the number of snaps populated.
 func (v *volumeClient) SnapEnumerate(ids []string,
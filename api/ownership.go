@@ -0,0 +1,81 @@
+package api
+
+// IsPublic returns true if the resource has no owner and
+// therefore is accessible to all users.
+func (o *Ownership) IsPublic() bool {
+	return o == nil || len(o.Owner) == 0
+}
+
+// IsOwner returns true if the user owns the resource.
+func (o *Ownership) IsOwner(user string) bool {
+	return !o.IsPublic() && o.Owner == user
+}
+
+// IsPermitted returns true if the user, directly or through one of its
+// groups, has been granted at least the access requested. The owner has
+// full access and public resources are accessible to all users.
+func (o *Ownership) IsPermitted(
+	user string,
+	groups []string,
+	access OwnershipAccessType,
+) bool {
+	if o.IsPublic() || o.IsOwner(user) {
+		return true
+	}
+
+	acls := o.GetAcls()
+	if granted, ok := acls.GetCollaborators()[user]; ok && granted >= access {
+		return true
+	}
+	for _, group := range groups {
+		if granted, ok := acls.GetGroups()[group]; ok && granted >= access {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPermittedToUpdate returns true if the user can change the ownership
+// to the one requested. Users with admin access can change the acls, but
+// only the owner can transfer an owned resource to another user.
+func (o *Ownership) IsPermittedToUpdate(
+	user string,
+	groups []string,
+	requested *Ownership,
+) bool {
+	if !o.IsPermitted(user, groups, OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_ADMIN) {
+		return false
+	}
+	if !o.IsPublic() && requested.GetOwner() != o.GetOwner() {
+		return o.IsOwner(user)
+	}
+	return true
+}
+
+// Copy makes a deep copy of the Ownership
+func (o *Ownership) Copy() *Ownership {
+	if o == nil {
+		return nil
+	}
+	ownership := &Ownership{
+		Owner: o.Owner,
+	}
+	if o.Acls != nil {
+		ownership.Acls = &OwnershipAcls{
+			Groups:        copyAccessTypeMap(o.Acls.Groups),
+			Collaborators: copyAccessTypeMap(o.Acls.Collaborators),
+		}
+	}
+	return ownership
+}
+
+func copyAccessTypeMap(m map[string]OwnershipAccessType) map[string]OwnershipAccessType {
+	if m == nil {
+		return nil
+	}
+	c := make(map[string]OwnershipAccessType, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,117 @@
+/*
+Package api contains the external OpenStorage apis
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOwnershipIsPermitted(t *testing.T) {
+	o := &Ownership{
+		Owner: "owner",
+		Acls: &OwnershipAcls{
+			Collaborators: map[string]OwnershipAccessType{
+				"reader": OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ,
+			},
+			Groups: map[string]OwnershipAccessType{
+				"writers": OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE,
+			},
+		},
+	}
+
+	tests := []struct {
+		user     string
+		groups   []string
+		access   OwnershipAccessType
+		expected bool
+	}{
+		{"owner", nil, OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_ADMIN, true},
+		{"reader", nil, OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ, true},
+		{"reader", nil, OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE, false},
+		{"writer", []string{"writers"}, OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ, true},
+		{"writer", []string{"writers"}, OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE, true},
+		{"writer", []string{"writers"}, OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_ADMIN, false},
+		{"stranger", []string{"others"}, OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ, false},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, o.IsPermitted(test.user, test.groups, test.access),
+			"user %s access %v", test.user, test.access)
+	}
+
+	// Resources without an owner are public
+	var public *Ownership
+	assert.True(t, public.IsPublic())
+	assert.True(t, public.IsPermitted("anyone", nil, OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_ADMIN))
+	assert.True(t, (&Ownership{}).IsPermitted("anyone", nil, OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_ADMIN))
+}
+
+func TestOwnershipIsPermittedToUpdate(t *testing.T) {
+	o := &Ownership{
+		Owner: "owner",
+		Acls: &OwnershipAcls{
+			Collaborators: map[string]OwnershipAccessType{
+				"manager": OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_ADMIN,
+				"writer":  OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE,
+			},
+		},
+	}
+	newAcls := &Ownership{
+		Owner: "owner",
+		Acls: &OwnershipAcls{
+			Collaborators: map[string]OwnershipAccessType{
+				"writer": OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_ADMIN,
+			},
+		},
+	}
+	newOwner := &Ownership{Owner: "manager"}
+
+	assert.True(t, o.IsPermittedToUpdate("owner", nil, newAcls))
+	assert.True(t, o.IsPermittedToUpdate("owner", nil, newOwner))
+	assert.True(t, o.IsPermittedToUpdate("manager", nil, newAcls))
+	assert.False(t, o.IsPermittedToUpdate("manager", nil, newOwner))
+	assert.False(t, o.IsPermittedToUpdate("writer", nil, newAcls))
+
+	// Anyone can take ownership of a public resource
+	var public *Ownership
+	assert.True(t, public.IsPermittedToUpdate("anyone", nil, newOwner))
+}
+
+func TestOwnershipCopy(t *testing.T) {
+	var o *Ownership
+	assert.Nil(t, o.Copy())
+
+	o = &Ownership{
+		Owner: "owner",
+		Acls: &OwnershipAcls{
+			Groups: map[string]OwnershipAccessType{
+				"group": OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ,
+			},
+		},
+	}
+	c := o.Copy()
+	assert.Equal(t, o, c)
+	c.Acls.Groups["group"] = OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_ADMIN
+	assert.Equal(t, OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ, o.Acls.Groups["group"])
+
+	// VolumeSpec.Copy makes a deep copy of the ownership
+	spec := &VolumeSpec{Ownership: o}
+	specCopy := spec.Copy()
+	specCopy.Ownership.Owner = "other"
+	assert.Equal(t, "owner", spec.Ownership.Owner)
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/audit"
+)
+
+// auditor, when set, records the requests made to the REST servers
+// which change anything
+var auditor *audit.Auditor
+
+// readOnlyPluginPaths are the paths of the plugin requests which are
+// made with POST but do not change anything
+var readOnlyPluginPaths = map[string]bool{
+	"/Plugin.Activate":             true,
+	volDriverPath("Path"):          true,
+	volDriverPath("List"):          true,
+	volDriverPath("Get"):           true,
+	volDriverPath("Capabilities"):  true,
+	graphDriverPath("Exists"):      true,
+	graphDriverPath("Status"):      true,
+	graphDriverPath("GetMetadata"): true,
+	graphDriverPath("Diff"):        true,
+	graphDriverPath("Changes"):     true,
+	graphDriverPath("DiffSize"):    true,
+}
+
+// SetAuditor sets the auditor of the REST servers. It must be called
+// before the servers are started.
+func SetAuditor(a *audit.Auditor) {
+	auditor = a
+}
+
+// isAudited returns true if the route can change anything
+func isAudited(route *Route) bool {
+	return route.verb != "GET" && !readOnlyPluginPaths[route.path]
+}
+
+// auditResponseWriter saves the status and the body of the response
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *auditResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// audited wraps the handler of the route so that its requests are recorded
+func audited(a *audit.Auditor, route *Route) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body []byte
+		if r.Body != nil {
+			body, _ = ioutil.ReadAll(r.Body)
+			r.Body.Close()
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		aw := &auditResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		route.fn(aw, r)
+
+		event := &api.AuditEvent{
+			Api:           audit.APIRest,
+			Operation:     route.verb + " " + route.path,
+			RemoteAddress: r.RemoteAddr,
+			Request:       auditRequest(r, body),
+		}
+		// The claims are saved in the request by the authentication
+		if claims, ok := requestClaims(r); ok {
+			event.Subject = claims.Subject
+		}
+		event.Error = responseError(aw.status, aw.body.Bytes())
+		event.Success = len(event.Error) == 0
+		a.Record(event)
+	}
+}
+
+// auditRequest returns the path, the query and the body of the
+// request in JSON with the values of the secrets redacted
+func auditRequest(r *http.Request, body []byte) string {
+	request := map[string]interface{}{
+		"path": r.URL.Path,
+	}
+	if len(r.URL.RawQuery) != 0 {
+		request["query"] = r.URL.Query()
+	}
+	if len(body) != 0 {
+		var v interface{}
+		if err := json.Unmarshal(body, &v); err == nil {
+			request["body"] = v
+		} else {
+			request["body"] = string(body)
+		}
+	}
+	b, err := json.Marshal(request)
+	if err != nil {
+		return ""
+	}
+	return audit.Redact(b)
+}
+
+// responseError returns the error of a failed request. Some handlers
+// report errors in the body of the response with a success status.
+func responseError(status int, body []byte) string {
+	if status >= http.StatusBadRequest {
+		return string(bytes.TrimSpace(body))
+	}
+
+	var resp struct {
+		Err            string `json:"Err"`
+		Error          string `json:"error"`
+		VolumeResponse struct {
+			Error string `json:"error"`
+		} `json:"volume_response"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return ""
+	}
+	switch {
+	case len(resp.Err) != 0:
+		return resp.Err
+	case len(resp.Error) != 0:
+		return resp.Error
+	}
+	return resp.VolumeResponse.Error
+}
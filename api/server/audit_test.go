@@ -0,0 +1,85 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/audit"
+)
+
+// testAuditLogger saves the events in memory
+type testAuditLogger struct {
+	events []*api.AuditEvent
+}
+
+func (l *testAuditLogger) Log(event *api.AuditEvent) error {
+	l.events = append(l.events, event)
+	return nil
+}
+
+func TestAuditIsAudited(t *testing.T) {
+	assert.True(t, isAudited(&Route{verb: "POST", path: "/v1/osd-volumes"}))
+	assert.True(t, isAudited(&Route{verb: "DELETE", path: "/v1/osd-volumes/{id}"}))
+	assert.True(t, isAudited(&Route{verb: "POST", path: volDriverPath("Mount")}))
+	assert.False(t, isAudited(&Route{verb: "GET", path: "/v1/osd-volumes"}))
+	assert.False(t, isAudited(&Route{verb: "POST", path: volDriverPath("List")}))
+	assert.False(t, isAudited(&Route{verb: "POST", path: "/Plugin.Activate"}))
+}
+
+func TestAuditRecord(t *testing.T) {
+	logger := &testAuditLogger{}
+	a := audit.New("mynode", nil, logger)
+
+	var status int
+	var response string
+	route := &Route{
+		verb: "POST",
+		path: "/v1/osd-volumes",
+		fn: func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			fmt.Fprint(w, response)
+		},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(audited(a, route)))
+	defer ts.Close()
+
+	// Successful request with the secrets redacted
+	status, response = http.StatusOK, `{"id":"myid"}`
+	res, err := http.Post(ts.URL+"/v1/osd-volumes?passphrase=mypass", "application/json",
+		strings.NewReader(`{"locator":{"name":"myvol"},"spec":{"passphrase":"mysecret"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	require.Len(t, logger.events, 1)
+	event := logger.events[0]
+	assert.Equal(t, "mynode", event.GetNodeId())
+	assert.Equal(t, audit.APIRest, event.GetApi())
+	assert.Equal(t, "POST /v1/osd-volumes", event.GetOperation())
+	assert.NotEmpty(t, event.GetRemoteAddress())
+	assert.Contains(t, event.GetRequest(), "myvol")
+	assert.NotContains(t, event.GetRequest(), "mysecret")
+	assert.NotContains(t, event.GetRequest(), "mypass")
+	assert.True(t, event.GetSuccess())
+
+	// Failed request
+	status, response = http.StatusNotFound, "Volume myid not found"
+	_, err = http.Post(ts.URL+"/v1/osd-volumes", "application/json", nil)
+	require.NoError(t, err)
+	require.Len(t, logger.events, 2)
+	assert.False(t, logger.events[1].GetSuccess())
+	assert.Equal(t, response, logger.events[1].GetError())
+
+	// Failure reported in the body of the response
+	status, response = http.StatusOK, `{"volume_response":{"error":"failed"}}`
+	_, err = http.Post(ts.URL+"/v1/osd-volumes", "application/json", nil)
+	require.NoError(t, err)
+	require.Len(t, logger.events, 3)
+	assert.False(t, logger.events[2].GetSuccess())
+	assert.Equal(t, "failed", logger.events[2].GetError())
+}
@@ -548,11 +548,14 @@ func (c *clusterApi) enumerateAlerts(w http.ResponseWriter, r *http.Request) {
 
 	timeEnd := params["timeend"]
 	if timeEnd != nil {
-		tS, err = time.Parse(api.TimeLayout, timeEnd[0])
+		tE, err = time.Parse(api.TimeLayout, timeEnd[0])
 		if err != nil {
 			c.sendError(c.name, method, w, "Invalid timeend param", http.StatusBadRequest)
 			return
 		}
+		// The time layout has a precision of a second so that the range
+		// includes the whole last second
+		tE = tE.Add(time.Second)
 	}
 
 	inst, err := cluster.Inst()
@@ -416,6 +416,34 @@ func TestEnumerateAlertsSuccess(t *testing.T) {
 	assert.EqualValues(t, api.ResourceType_RESOURCE_TYPE_NODE, resp.Alert[0].GetResource())
 }
 
+func TestEnumerateAlertsTimeRange(t *testing.T) {
+
+	// Create a new global test cluster
+	ts, tc := testClusterServer(t)
+	defer ts.Close()
+	defer tc.Finish()
+
+	endTime := time.Now().UTC()
+	startTime := endTime.Add(-30 * time.Second)
+
+	// the range received includes the whole second of the end time
+	tc.MockCluster().
+		EXPECT().
+		EnumerateAlerts(gomock.Any(), gomock.Any(), api.ResourceType_RESOURCE_TYPE_VOLUME).
+		Do(func(tS, tE time.Time, resource api.ResourceType) {
+			assert.Equal(t, startTime.Unix(), tS.Unix())
+			assert.True(t, tE.After(endTime))
+			assert.True(t, tE.Before(endTime.Add(time.Second)))
+		}).
+		Return(&api.Alerts{}, nil)
+
+	c, err := clusterclient.NewClusterClient(ts.URL, "v1")
+	assert.NoError(t, err)
+	restClient := clusterclient.ClusterManager(c)
+	_, err = restClient.EnumerateAlerts(startTime, endTime, api.ResourceType_RESOURCE_TYPE_VOLUME)
+	assert.NoError(t, err)
+}
+
 func TestClearAlertSuccess(t *testing.T) {
 
 	// Create a new global test cluster
@@ -0,0 +1,167 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/audit"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/libopenstorage/openstorage/pkg/proto/time"
+)
+
+// readOnlyMethods are the methods, in addition to the public and viewer
+// methods, which do not change anything and therefore are not audited
+var readOnlyMethods = []string{
+	"/openstorage.api.OpenStorageCredentials/Enumerate*",
+	"/openstorage.api.OpenStorageCredentials/Validate",
+	"/openstorage.api.OpenStorageAudit/*",
+}
+
+// AuditServer is an implementation of the gRPC OpenStorageAudit interface
+type AuditServer struct {
+	auditor *audit.Auditor
+}
+
+// Enumerate returns the audit events recorded in the cluster
+func (s *AuditServer) Enumerate(
+	ctx context.Context,
+	req *api.SdkAuditEnumerateRequest,
+) (*api.SdkAuditEnumerateResponse, error) {
+
+	if s.auditor == nil {
+		return nil, status.Error(codes.Unimplemented, "Auditing is not enabled")
+	}
+
+	filter := &audit.Filter{
+		Subject:   req.GetSubject(),
+		Operation: req.GetOperation(),
+	}
+	if req.GetTimeStart() != nil {
+		filter.TimeStart = prototime.TimestampToTime(req.GetTimeStart())
+	}
+	if req.GetTimeEnd() != nil {
+		filter.TimeEnd = prototime.TimestampToTime(req.GetTimeEnd())
+	}
+
+	events, err := s.auditor.Enumerate(filter)
+	if err == audit.ErrNotSupported {
+		return nil, status.Errorf(
+			codes.Unimplemented,
+			"Audit events cannot be enumerated: %v",
+			err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to enumerate audit events: %v",
+			err.Error())
+	}
+
+	return &api.SdkAuditEnumerateResponse{
+		Events: events,
+	}, nil
+}
+
+// auditInterceptor records the calls to the methods which change anything
+type auditInterceptor struct {
+	auditor   *audit.Auditor
+	marshaler *jsonpb.Marshaler
+}
+
+func newAuditInterceptor(auditor *audit.Auditor) *auditInterceptor {
+	return &auditInterceptor{
+		auditor:   auditor,
+		marshaler: &jsonpb.Marshaler{OrigName: true},
+	}
+}
+
+func (a *auditInterceptor) unary(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if isAudited(info.FullMethod) {
+		a.record(ctx, info.FullMethod, req, err)
+	}
+	return resp, err
+}
+
+// record records the call with the identity of the caller
+func (a *auditInterceptor) record(
+	ctx context.Context,
+	fullMethod string,
+	req interface{},
+	err error,
+) {
+	event := &api.AuditEvent{
+		Api:           audit.APISdk,
+		Operation:     fullMethod,
+		RemoteAddress: remoteAddress(ctx),
+		Success:       err == nil,
+	}
+	if claims, ok := auth.ClaimsFromContext(ctx); ok {
+		event.Subject = claims.Subject
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	if msg, ok := req.(proto.Message); ok {
+		if data, err := a.marshaler.MarshalToString(msg); err == nil {
+			event.Request = audit.Redact([]byte(data))
+		}
+	}
+	a.auditor.Record(event)
+}
+
+// isAudited returns true if the method can change anything
+func isAudited(fullMethod string) bool {
+	for _, methods := range [][]string{publicMethods, viewerMethods, readOnlyMethods} {
+		for _, method := range methods {
+			if match, _ := path.Match(method, fullMethod); match {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// remoteAddress returns the address of the caller. The REST gateway
+// forwards the address of its callers in the x-forwarded-for metadata.
+func remoteAddress(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if fwd := md["x-forwarded-for"]; len(fwd) != 0 {
+			return strings.TrimSpace(strings.Split(fwd[0], ",")[0])
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
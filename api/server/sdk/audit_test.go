@@ -0,0 +1,173 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/audit"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+)
+
+func newTestAuditServer(t *testing.T) *testServer {
+	a, err := auth.NewJwtAuth(&auth.JwtAuthConfig{
+		SharedSecret: testAuthSecret,
+	})
+	assert.NoError(t, err)
+	kv, err := kvdb.New(mem.Name, "audit", []string{}, nil, logrus.Panicf)
+	assert.NoError(t, err)
+
+	return newTestServerWithConfig(t,
+		&ServerConfig{
+			Auth:    a,
+			Auditor: audit.New("mynode", audit.NewKvdbStore(kv, 0)),
+		},
+		grpc.WithInsecure())
+}
+
+func TestSdkAuditRecord(t *testing.T) {
+	s := newTestAuditServer(t)
+	defer s.Stop()
+
+	id := "myid"
+	vol := &api.Volume{
+		Id:   id,
+		Spec: &api.VolumeSpec{Size: 1234},
+	}
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{vol}, nil).
+		Times(2)
+	s.MockDriver().
+		EXPECT().
+		Delete(id).
+		Return(nil).
+		Times(1)
+
+	ctx := contextWithToken(newTestToken(t, "myadmin", auth.RoleAdmin))
+	volumes := api.NewOpenStorageVolumeClient(s.Conn())
+	creds := api.NewOpenStorageCredentialsClient(s.Conn())
+	audits := api.NewOpenStorageAuditClient(s.Conn())
+
+	// Reads are not recorded
+	_, err := volumes.Inspect(ctx, &api.SdkVolumeInspectRequest{VolumeId: id})
+	assert.NoError(t, err)
+
+	_, err = volumes.Delete(ctx, &api.SdkVolumeDeleteRequest{VolumeId: id})
+	assert.NoError(t, err)
+
+	// Failures are recorded with the secrets redacted
+	_, err = creds.CreateForAWS(ctx, &api.SdkCredentialCreateAWSRequest{
+		Credential: &api.S3Credential{
+			AccessKey: "myaccess",
+			SecretKey: "mysecret",
+		},
+	})
+	assert.Error(t, err)
+
+	r, err := audits.Enumerate(ctx, &api.SdkAuditEnumerateRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, r.GetEvents(), 2)
+
+	event := r.GetEvents()[0]
+	assert.NotEmpty(t, event.GetId())
+	assert.NotNil(t, event.GetTimestamp())
+	assert.Equal(t, "mynode", event.GetNodeId())
+	assert.Equal(t, audit.APISdk, event.GetApi())
+	assert.Equal(t, "/openstorage.api.OpenStorageVolume/Delete", event.GetOperation())
+	assert.Equal(t, "myadmin", event.GetSubject())
+	assert.NotEmpty(t, event.GetRemoteAddress())
+	assert.Contains(t, event.GetRequest(), id)
+	assert.True(t, event.GetSuccess())
+	assert.Empty(t, event.GetError())
+
+	event = r.GetEvents()[1]
+	assert.Equal(t, "/openstorage.api.OpenStorageCredentials/CreateForAWS", event.GetOperation())
+	assert.False(t, event.GetSuccess())
+	assert.NotEmpty(t, event.GetError())
+	assert.NotContains(t, event.GetRequest(), "mysecret")
+	assert.Contains(t, event.GetRequest(), "[REDACTED]")
+
+	// Filter on the operation
+	r, err = audits.Enumerate(ctx, &api.SdkAuditEnumerateRequest{
+		Operation: "/openstorage.api.OpenStorageVolume/Delete",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, r.GetEvents(), 1)
+
+	// Only administrators can read the audit events
+	ctx = contextWithToken(newTestToken(t, "myviewer", auth.RoleViewer))
+	_, err = audits.Enumerate(ctx, &api.SdkAuditEnumerateRequest{})
+	assertPermissionDenied(t, err)
+}
+
+func TestSdkAuditRecordDenied(t *testing.T) {
+	s := newTestAuditServer(t)
+	defer s.Stop()
+
+	id := "myid"
+	volumes := api.NewOpenStorageVolumeClient(s.Conn())
+	audits := api.NewOpenStorageAuditClient(s.Conn())
+
+	// Calls denied by the authorization are recorded
+	ctx := contextWithToken(newTestToken(t, "myviewer", auth.RoleViewer))
+	_, err := volumes.Delete(ctx, &api.SdkVolumeDeleteRequest{VolumeId: id})
+	assertPermissionDenied(t, err)
+	_, err = volumes.Delete(context.Background(), &api.SdkVolumeDeleteRequest{VolumeId: id})
+	assert.Error(t, err)
+
+	ctx = contextWithToken(newTestToken(t, "myadmin", auth.RoleAdmin))
+	r, err := audits.Enumerate(ctx, &api.SdkAuditEnumerateRequest{})
+	assert.NoError(t, err)
+	require.Len(t, r.GetEvents(), 2)
+
+	event := r.GetEvents()[0]
+	assert.Equal(t, "/openstorage.api.OpenStorageVolume/Delete", event.GetOperation())
+	assert.Equal(t, "myviewer", event.GetSubject())
+	assert.Contains(t, event.GetRequest(), id)
+	assert.False(t, event.GetSuccess())
+	assert.Contains(t, event.GetError(), "not authorized")
+
+	event = r.GetEvents()[1]
+	assert.Empty(t, event.GetSubject())
+	assert.False(t, event.GetSuccess())
+	assert.Contains(t, event.GetError(), "Access denied")
+}
+
+func TestSdkAuditNotEnabled(t *testing.T) {
+	s := newTestServer(t)
+	defer s.Stop()
+
+	c := api.NewOpenStorageAuditClient(s.Conn())
+	_, err := c.Enumerate(context.Background(), &api.SdkAuditEnumerateRequest{})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Unimplemented, serverError.Code())
+}
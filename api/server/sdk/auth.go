@@ -0,0 +1,201 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/libopenstorage/openstorage/pkg/auth"
+)
+
+const (
+	// authMetadataKey is the gRPC metadata key holding the bearer token.
+	// The REST gateway forwards the HTTP Authorization header using this key.
+	authMetadataKey = "authorization"
+	bearerPrefix    = "bearer "
+)
+
+// publicMethods can be called without a token
+var publicMethods = []string{
+	"/grpc.reflection.*/*",
+}
+
+// viewerMethods are the methods which only read information
+var viewerMethods = []string{
+	"/openstorage.api.OpenStorageCluster/Enumerate",
+	"/openstorage.api.OpenStorageCluster/Inspect",
+	"/openstorage.api.OpenStorageCluster/AlertEnumerate",
+	"/openstorage.api.OpenStorageCluster/AlertWatch",
+	"/openstorage.api.OpenStorageNode/Enumerate",
+	"/openstorage.api.OpenStorageNode/Inspect*",
+	"/openstorage.api.OpenStorageVolume/Enumerate",
+	"/openstorage.api.OpenStorageVolume/Inspect",
+	"/openstorage.api.OpenStorageVolume/Stats",
+	"/openstorage.api.OpenStorageVolume/CapacityUsage",
+	"/openstorage.api.OpenStorageVolume/ActiveRequests",
+	"/openstorage.api.OpenStorageVolume/SnapshotEnumerate",
+	"/openstorage.api.OpenStorageObjectstore/Inspect",
+	"/openstorage.api.OpenStorageSchedulePolicy/Enumerate",
+	"/openstorage.api.OpenStorageSchedulePolicy/Inspect",
+	"/openstorage.api.OpenStorageCloudBackup/Enumerate",
+	"/openstorage.api.OpenStorageCloudBackup/Status",
+	"/openstorage.api.OpenStorageCloudBackup/History",
+}
+
+// DefaultRoles returns the roles used to authorize calls to the SDK
+// when authentication is enabled and no roles have been configured.
+func DefaultRoles() []auth.Role {
+	return []auth.Role{
+		{
+			Name:    auth.RoleAdmin,
+			Methods: []string{"/*/*"},
+		},
+		{
+			Name: auth.RoleVolumeUser,
+			Methods: append([]string{
+				"/openstorage.api.OpenStorageVolume/*",
+				// Only the backup calls checking the access to the volume
+				"/openstorage.api.OpenStorageCloudBackup/Create",
+				"/openstorage.api.OpenStorageCloudBackup/DeleteAll",
+				"/openstorage.api.OpenStorageCloudBackup/StateChange",
+			}, viewerMethods...),
+		},
+		{
+			Name:    auth.RoleViewer,
+			Methods: append([]string{}, viewerMethods...),
+		},
+	}
+}
+
+// authInterceptor authenticates and authorizes every call to the server
+type authInterceptor struct {
+	authenticator auth.Authenticator
+	roles         auth.RoleManager
+	// audit, if set, records the calls which are denied, as those
+	// never reach the audit interceptor
+	audit *auditInterceptor
+}
+
+// authServerStream overrides the context of a stream with the
+// context holding the claims of the caller
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func newAuthInterceptor(
+	authenticator auth.Authenticator,
+	roles []auth.Role,
+) (*authInterceptor, error) {
+	if roles == nil {
+		roles = DefaultRoles()
+	}
+	rm, err := auth.NewRoleManager(roles)
+	if err != nil {
+		return nil, err
+	}
+	return &authInterceptor{
+		authenticator: authenticator,
+		roles:         rm,
+	}, nil
+}
+
+func (a *authInterceptor) unary(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		if a.audit != nil && isAudited(info.FullMethod) {
+			a.audit.record(ctx, info.FullMethod, req, err)
+		}
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authInterceptor) stream(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := a.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authServerStream{
+		ServerStream: ss,
+		ctx:          ctx,
+	})
+}
+
+// authorize validates the token of the caller and checks that its roles
+// allow calling the method. It returns a context holding the claims of
+// the caller, which is also returned with the error when the caller is
+// authenticated but not authorized.
+func (a *authInterceptor) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	for _, method := range publicMethods {
+		if match, _ := path.Match(method, fullMethod); match {
+			return ctx, nil
+		}
+	}
+
+	rawtoken, err := tokenFromContext(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	claims, err := a.authenticator.AuthenticateToken(rawtoken)
+	if err != nil {
+		return ctx, status.Errorf(codes.Unauthenticated, "Access denied: %v", err)
+	}
+	if err := a.roles.Verify(claims.Roles, fullMethod); err != nil {
+		return auth.ContextSaveClaims(ctx, claims), status.Errorf(
+			codes.PermissionDenied,
+			"User %s is not authorized: %v",
+			claims.Subject,
+			err.Error())
+	}
+
+	return auth.ContextSaveClaims(ctx, claims), nil
+}
+
+// tokenFromContext returns the bearer token sent by the caller
+func tokenFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md[authMetadataKey]) == 0 {
+		return "", status.Error(codes.Unauthenticated, "Access denied: missing authorization token")
+	}
+	header := md[authMetadataKey][0]
+	if !strings.HasPrefix(strings.ToLower(header), bearerPrefix) {
+		return "", status.Error(codes.Unauthenticated, "Access denied: authorization must be a bearer token")
+	}
+	return strings.TrimSpace(header[len(bearerPrefix):]), nil
+}
@@ -0,0 +1,227 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+)
+
+var testAuthSecret = []byte("sdk-test-secret")
+
+func newTestAuthServer(t *testing.T) *testServer {
+	a, err := auth.NewJwtAuth(&auth.JwtAuthConfig{
+		SharedSecret: testAuthSecret,
+	})
+	assert.NoError(t, err)
+	return newTestServerWithConfig(t, &ServerConfig{Auth: a}, grpc.WithInsecure())
+}
+
+func newTestToken(t *testing.T, subject string, roles ...string) string {
+	return newTestTokenWithGroups(t, subject, nil, roles...)
+}
+
+func newTestTokenWithGroups(
+	t *testing.T,
+	subject string,
+	groups []string,
+	roles ...string,
+) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":    subject,
+		"roles":  roles,
+		"groups": groups,
+		"exp":    time.Now().Add(time.Minute).Unix(),
+	}).SignedString(testAuthSecret)
+	assert.NoError(t, err)
+	return token
+}
+
+func contextWithToken(token string) context.Context {
+	return metadata.NewOutgoingContext(
+		context.Background(),
+		metadata.Pairs("authorization", "bearer "+token))
+}
+
+func TestSdkAuthUnauthenticated(t *testing.T) {
+	s := newTestAuthServer(t)
+	defer s.Stop()
+
+	c := api.NewOpenStorageClusterClient(s.Conn())
+
+	// No token
+	_, err := c.Enumerate(context.Background(), &api.SdkClusterEnumerateRequest{})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Unauthenticated)
+
+	// Token signed with another secret
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":   "user",
+		"roles": []string{auth.RoleAdmin},
+	}).SignedString([]byte("other"))
+	assert.NoError(t, err)
+	_, err = c.Enumerate(contextWithToken(token), &api.SdkClusterEnumerateRequest{})
+	assert.Error(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Unauthenticated)
+
+	// Not a bearer token
+	ctx := metadata.NewOutgoingContext(
+		context.Background(),
+		metadata.Pairs("authorization", "basic abc"))
+	_, err = c.Enumerate(ctx, &api.SdkClusterEnumerateRequest{})
+	assert.Error(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Unauthenticated)
+}
+
+func TestSdkAuthRoles(t *testing.T) {
+	s := newTestAuthServer(t)
+	defer s.Stop()
+
+	id := "myvol"
+	cluster := api.Cluster{
+		Id:     "someid",
+		NodeId: "somenodeid",
+	}
+	s.MockCluster().EXPECT().Enumerate().Return(cluster, nil).Times(1)
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{&api.Volume{}}, nil).
+		Times(1)
+	s.MockDriver().
+		EXPECT().
+		Delete(id).
+		Return(nil).
+		Times(1)
+
+	cc := api.NewOpenStorageClusterClient(s.Conn())
+	vc := api.NewOpenStorageVolumeClient(s.Conn())
+
+	// Viewer can read
+	viewer := contextWithToken(newTestToken(t, "viewer1", auth.RoleViewer))
+	r, err := cc.Enumerate(viewer, &api.SdkClusterEnumerateRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "someid", r.GetCluster().GetId())
+
+	// Viewer cannot delete
+	_, err = vc.Delete(viewer, &api.SdkVolumeDeleteRequest{VolumeId: id})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.PermissionDenied)
+	assert.Contains(t, serverError.Message(), "viewer1")
+
+	// Unknown roles cannot do anything
+	nobody := contextWithToken(newTestToken(t, "nobody", "unknown"))
+	_, err = vc.Delete(nobody, &api.SdkVolumeDeleteRequest{VolumeId: id})
+	assert.Error(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.PermissionDenied)
+
+	// Volume user can delete
+	user := contextWithToken(newTestToken(t, "user1", auth.RoleVolumeUser))
+	_, err = vc.Delete(user, &api.SdkVolumeDeleteRequest{VolumeId: id})
+	assert.NoError(t, err)
+}
+
+func TestSdkAuthStream(t *testing.T) {
+	s := newTestAuthServer(t)
+	defer s.Stop()
+
+	c := api.NewOpenStorageClusterClient(s.Conn())
+
+	// No token
+	stream, err := c.AlertWatch(context.Background(), &api.SdkClusterAlertWatchRequest{})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Unauthenticated)
+
+	// Viewer is allowed to watch
+	s.MockCluster().
+		EXPECT().
+		Enumerate().
+		Return(api.Cluster{Id: s.AlertClusterId()}, nil).
+		AnyTimes()
+	ctx, cancel := context.WithCancel(
+		contextWithToken(newTestToken(t, "viewer1", auth.RoleViewer)))
+	stream, err = c.AlertWatch(ctx, &api.SdkClusterAlertWatchRequest{})
+	assert.NoError(t, err)
+	err = s.Alert().Raise(&api.Alert{
+		Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "authvol",
+		Severity:   api.SeverityType_SEVERITY_TYPE_NOTIFY,
+	})
+	assert.NoError(t, err)
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "authvol", resp.GetAlert().GetResourceId())
+	cancel()
+}
+
+func TestSdkAuthGateway(t *testing.T) {
+	s := newTestAuthServer(t)
+	defer s.Stop()
+
+	cluster := api.Cluster{
+		Id:     "someid",
+		NodeId: "somenodeid",
+	}
+	s.MockCluster().EXPECT().Enumerate().Return(cluster, nil).Times(1)
+
+	// No token
+	res, err := http.Post(
+		s.GatewayURL()+"/v1/cluster/enumerate",
+		"application/json",
+		strings.NewReader("{}"))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	// The Authorization header is forwarded to the server
+	req, err := http.NewRequest(
+		"POST",
+		s.GatewayURL()+"/v1/cluster/enumerate",
+		strings.NewReader("{}"))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+newTestToken(t, "viewer1", auth.RoleViewer))
+	res, err = http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
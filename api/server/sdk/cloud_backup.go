@@ -26,7 +26,10 @@ import (
 	"github.com/libopenstorage/openstorage/volume"
 )
 
-// CloudBackupServer is an implementation of the gRPC OpenStorageCloudBackup interface
+// CloudBackupServer is an implementation of the gRPC OpenStorageCloudBackup interface.
+// The calls for a volume check the access of the caller to the volume. The
+// calls for a backup id, whose volume is not known, are left to administrators
+// by the default roles.
 type CloudBackupServer struct {
 	driver volume.VolumeDriver
 }
@@ -42,6 +45,9 @@ func (s *CloudBackupServer) Create(
 	} else if len(req.GetCredentialUuid()) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "Must supply credential uuid")
 	}
+	if _, err := checkAccessById(ctx, s.driver, req.GetVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); err != nil {
+		return nil, err
+	}
 
 	// Create the backup
 	if err := s.driver.CloudBackupCreate(&api.CloudBackupCreateRequest{
@@ -122,6 +128,9 @@ func (s *CloudBackupServer) DeleteAll(
 	} else if len(req.GetCredentialUuid()) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "Must provide credential uuid")
 	}
+	if _, err := checkAccessById(ctx, s.driver, req.GetSrcVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); err != nil {
+		return nil, err
+	}
 
 	if err := s.driver.CloudBackupDeleteAll(&api.CloudBackupDeleteAllRequest{
 		CloudBackupGenericRequest: api.CloudBackupGenericRequest{
@@ -144,6 +153,11 @@ func (s *CloudBackupServer) Enumerate(
 	if len(req.GetCredentialUuid()) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "Must provide credential uuid")
 	}
+	if len(req.GetSrcVolumeId()) != 0 {
+		if _, err := checkAccessById(ctx, s.driver, req.GetSrcVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ); err != nil {
+			return nil, err
+		}
+	}
 
 	r, err := s.driver.CloudBackupEnumerate(&api.CloudBackupEnumerateRequest{
 		CloudBackupGenericRequest: api.CloudBackupGenericRequest{
@@ -157,6 +171,22 @@ func (s *CloudBackupServer) Enumerate(
 		return nil, status.Errorf(codes.Internal, "Failed to enumerate backups: %v", err)
 	}
 
+	// Only the backups of the volumes the caller can read are returned
+	if _, ok := accessClaims(ctx); ok {
+		readable := make(map[string]bool)
+		backups := make([]api.CloudBackupInfo, 0, len(r.Backups))
+		for _, backup := range r.Backups {
+			id := backup.SrcVolumeID
+			if _, ok := readable[id]; !ok {
+				readable[id] = s.isReadable(ctx, id)
+			}
+			if readable[id] {
+				backups = append(backups, backup)
+			}
+		}
+		r.Backups = backups
+	}
+
 	return r.ToSdkCloudBackupEnumerateResponse(), nil
 }
 
@@ -166,6 +196,12 @@ func (s *CloudBackupServer) Status(
 	req *api.SdkCloudBackupStatusRequest,
 ) (*api.SdkCloudBackupStatusResponse, error) {
 
+	if len(req.GetSrcVolumeId()) != 0 {
+		if _, err := checkAccessById(ctx, s.driver, req.GetSrcVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ); err != nil {
+			return nil, err
+		}
+	}
+
 	r, err := s.driver.CloudBackupStatus(&api.CloudBackupStatusRequest{
 		SrcVolumeID: req.GetSrcVolumeId(),
 		Local:       req.GetLocal(),
@@ -174,6 +210,16 @@ func (s *CloudBackupServer) Status(
 		return nil, status.Errorf(codes.Internal, "Failed to get status of backup: %v", err)
 	}
 
+	// The statuses are keyed by volume id. Only those of the volumes the
+	// caller can read are returned.
+	if _, ok := accessClaims(ctx); ok {
+		for id := range r.Statuses {
+			if !s.isReadable(ctx, id) {
+				delete(r.Statuses, id)
+			}
+		}
+	}
+
 	return r.ToSdkCloudBackupStatusResponse(), nil
 }
 
@@ -211,6 +257,10 @@ func (s *CloudBackupServer) History(
 	if len(req.GetSrcVolumeId()) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "Must provide volume id")
 	}
+	if _, err := checkAccessById(ctx, s.driver, req.GetSrcVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ); err != nil {
+		return nil, err
+	}
+
 	r, err := s.driver.CloudBackupHistory(&api.CloudBackupHistoryRequest{
 		SrcVolumeID: req.GetSrcVolumeId(),
 	})
@@ -244,6 +294,9 @@ func (s *CloudBackupServer) StateChange(
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid requested state: %v", req.GetRequestedState())
 	}
+	if _, err := checkAccessById(ctx, s.driver, req.GetSrcVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); err != nil {
+		return nil, err
+	}
 
 	err := s.driver.CloudBackupStateChange(&api.CloudBackupStateChangeRequest{
 		SrcVolumeID:    req.GetSrcVolumeId(),
@@ -255,3 +308,10 @@ func (s *CloudBackupServer) StateChange(
 
 	return &api.SdkCloudBackupStateChangeResponse{}, nil
 }
+
+// isReadable returns true if the caller can read the volume. The backups of
+// volumes which no longer exist are only visible to administrators.
+func (s *CloudBackupServer) isReadable(ctx context.Context, volumeID string) bool {
+	_, err := checkAccessById(ctx, s.driver, volumeID, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ)
+	return err == nil
+}
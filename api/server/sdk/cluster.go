@@ -18,19 +18,28 @@ package sdk
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/golang/protobuf/ptypes"
 
+	"github.com/libopenstorage/openstorage/alert"
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/cluster"
 )
 
+const (
+	// alertWatchQueueSize is the number of alert events which can be
+	// queued for a client before its watch is ended.
+	alertWatchQueueSize = 64
+)
+
 // ClusterServer is an implementation of the gRPC OpenStorageCluster interface
 type ClusterServer struct {
 	cluster cluster.Cluster
+	alert   alert.Alert
 }
 
 // Enumerate returns information about the cluster
@@ -134,3 +143,125 @@ func (s *ClusterServer) AlertErase(
 
 	return &api.SdkClusterAlertEraseResponse{}, nil
 }
+
+// AlertWatch sends alert events from the storage cluster to the client
+// until the client disconnects.
+func (s *ClusterServer) AlertWatch(
+	req *api.SdkClusterAlertWatchRequest,
+	stream api.OpenStorageCluster_AlertWatchServer,
+) error {
+
+	if s.alert == nil {
+		return status.Error(
+			codes.Unimplemented,
+			"Watching alerts is not supported by this server")
+	}
+
+	c, err := s.cluster.Enumerate()
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	// The watch callback is called by kvdb, under the lock of the alerts,
+	// until it returns an error or the client disconnects. It must not
+	// block, so the stream is ended if the client does not keep up with
+	// the events.
+	ctx := stream.Context()
+	events := make(chan *api.SdkClusterAlertWatchResponse, alertWatchQueueSize)
+	overflow := make(chan struct{})
+	sent := make(map[int64]bool)
+	err = s.alert.WatchUntil(c.Id, func(
+		a *api.Alert,
+		action api.AlertActionType,
+		prefix string,
+		key string,
+	) error {
+		var event *api.SdkClusterAlertWatchResponse
+		if action != api.AlertActionType_ALERT_ACTION_TYPE_NONE {
+			if !alertWatchMatch(req, a, action, sent) {
+				return ctx.Err()
+			}
+			event = &api.SdkClusterAlertWatchResponse{
+				Action: action,
+				Alert:  a,
+			}
+		}
+
+		// A nil event notifies the stream that the watch has ended
+		select {
+		case events <- event:
+			return nil
+		default:
+			close(overflow)
+			return fmt.Errorf("Too many alert events pending")
+		}
+	}, ctx.Done())
+	if err != nil {
+		return status.Errorf(
+			codes.Internal,
+			"Failed to watch alerts: %v",
+			err.Error())
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-overflow:
+			return status.Errorf(
+				codes.ResourceExhausted,
+				"Alert watch ended as more than %d events were pending",
+				alertWatchQueueSize)
+		case event := <-events:
+			if event == nil {
+				return status.Error(
+					codes.Unavailable,
+					"Alert watch ended due to too many errors")
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// alertWatchMatch returns true if the alert event passes the filters of
+// the request. Deleted alerts only carry their resource type and id, so
+// when filtering by resource id or severity they are only sent if the
+// alert had been sent previously on the stream.
+func alertWatchMatch(
+	req *api.SdkClusterAlertWatchRequest,
+	a *api.Alert,
+	action api.AlertActionType,
+	sent map[int64]bool,
+) bool {
+	if a == nil {
+		return false
+	}
+	if req.GetResource() != api.ResourceType_RESOURCE_TYPE_NONE &&
+		req.GetResource() != a.GetResource() {
+		return false
+	}
+
+	if action == api.AlertActionType_ALERT_ACTION_TYPE_DELETE {
+		if len(req.GetResourceId()) == 0 &&
+			req.GetSeverity() == api.SeverityType_SEVERITY_TYPE_NONE {
+			return true
+		}
+		match := sent[a.GetId()]
+		delete(sent, a.GetId())
+		return match
+	}
+
+	if len(req.GetResourceId()) != 0 &&
+		req.GetResourceId() != a.GetResourceId() {
+		return false
+	}
+	if req.GetSeverity() != api.SeverityType_SEVERITY_TYPE_NONE &&
+		req.GetSeverity() != a.GetSeverity() {
+		return false
+	}
+
+	sent[a.GetId()] = true
+	return true
+}
@@ -395,3 +395,82 @@ func TestSdkAlertErase(t *testing.T) {
 	_, err := c.AlertErase(context.Background(), req)
 	assert.NoError(t, err)
 }
+
+func TestSdkAlertWatch(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	clusterID := s.AlertClusterId()
+	s.MockCluster().
+		EXPECT().
+		Enumerate().
+		Return(api.Cluster{Id: clusterID}, nil).
+		Times(1)
+
+	// Setup client
+	c := api.NewOpenStorageClusterClient(s.Conn())
+
+	// Watch only alarms for volumes
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := c.AlertWatch(ctx, &api.SdkClusterAlertWatchRequest{
+		Resource: api.ResourceType_RESOURCE_TYPE_VOLUME,
+		Severity: api.SeverityType_SEVERITY_TYPE_ALARM,
+	})
+	assert.NoError(t, err)
+
+	// Wait until the server has setup the watch
+	time.Sleep(100 * time.Millisecond)
+
+	// These alerts are filtered out
+	err = s.Alert().Raise(&api.Alert{
+		Resource:   api.ResourceType_RESOURCE_TYPE_NODE,
+		ResourceId: "node1",
+		Severity:   api.SeverityType_SEVERITY_TYPE_ALARM,
+	})
+	assert.NoError(t, err)
+	warning := &api.Alert{
+		Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "vol1",
+		Severity:   api.SeverityType_SEVERITY_TYPE_WARNING,
+	}
+	err = s.Alert().Raise(warning)
+	assert.NoError(t, err)
+
+	// This alert is sent
+	alarm := &api.Alert{
+		Resource:   api.ResourceType_RESOURCE_TYPE_VOLUME,
+		ResourceId: "vol1",
+		Severity:   api.SeverityType_SEVERITY_TYPE_ALARM,
+	}
+	err = s.Alert().Raise(alarm)
+	assert.NoError(t, err)
+
+	r, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, r.GetAction(), api.AlertActionType_ALERT_ACTION_TYPE_CREATE)
+	assert.Equal(t, r.GetAlert().GetId(), alarm.GetId())
+	assert.Equal(t, r.GetAlert().GetResourceId(), "vol1")
+
+	// Deleting the filtered out alert is not sent, but the alarm is
+	err = s.Alert().Erase(api.ResourceType_RESOURCE_TYPE_VOLUME, warning.GetId())
+	assert.NoError(t, err)
+	err = s.Alert().Erase(api.ResourceType_RESOURCE_TYPE_VOLUME, alarm.GetId())
+	assert.NoError(t, err)
+
+	r, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, r.GetAction(), api.AlertActionType_ALERT_ACTION_TYPE_DELETE)
+	assert.Equal(t, r.GetAlert().GetId(), alarm.GetId())
+	assert.Equal(t, r.GetAlert().GetResource(), api.ResourceType_RESOURCE_TYPE_VOLUME)
+
+	// Disconnect
+	cancel()
+	_, err = stream.Recv()
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Canceled)
+}
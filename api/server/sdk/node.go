@@ -0,0 +1,159 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/cluster"
+)
+
+// NodeServer is an implementation of the gRPC OpenStorageNode interface
+type NodeServer struct {
+	cluster cluster.Cluster
+}
+
+// Enumerate returns the ids of all the nodes in the cluster
+func (s *NodeServer) Enumerate(
+	ctx context.Context,
+	req *api.SdkNodeEnumerateRequest,
+) (*api.SdkNodeEnumerateResponse, error) {
+
+	c, err := s.cluster.Enumerate()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nodeIds := make([]string, len(c.Nodes))
+	for i, node := range c.Nodes {
+		nodeIds[i] = node.Id
+	}
+
+	return &api.SdkNodeEnumerateResponse{
+		NodeIds: nodeIds,
+	}, nil
+}
+
+// Inspect returns information about the specified node
+func (s *NodeServer) Inspect(
+	ctx context.Context,
+	req *api.SdkNodeInspectRequest,
+) (*api.SdkNodeInspectResponse, error) {
+
+	if len(req.GetNodeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Node id must be provided")
+	}
+
+	node, err := s.cluster.Inspect(req.GetNodeId())
+	if err == cluster.ErrNodeNotFound {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"Node %s not found",
+			req.GetNodeId())
+	} else if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to inspect node %s: %v",
+			req.GetNodeId(),
+			err.Error())
+	}
+
+	return &api.SdkNodeInspectResponse{
+		Node: node.ToStorageNode(),
+	}, nil
+}
+
+// InspectCurrent returns information about the node servicing the request
+func (s *NodeServer) InspectCurrent(
+	ctx context.Context,
+	req *api.SdkNodeInspectCurrentRequest,
+) (*api.SdkNodeInspectCurrentResponse, error) {
+
+	node, err := s.currentNode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.SdkNodeInspectCurrentResponse{
+		Node: node.ToStorageNode(),
+	}, nil
+}
+
+// UpdateLabels adds or changes the labels of the node servicing the request
+func (s *NodeServer) UpdateLabels(
+	ctx context.Context,
+	req *api.SdkNodeUpdateLabelsRequest,
+) (*api.SdkNodeUpdateLabelsResponse, error) {
+
+	if len(req.GetLabels()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Must supply labels")
+	}
+
+	node, err := s.currentNode()
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetNodeId()) != 0 && req.GetNodeId() != node.Id {
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			"Labels of node %s must be updated through that node, "+
+				"this request was received by node %s",
+			req.GetNodeId(),
+			node.Id)
+	}
+
+	if err := s.cluster.UpdateLabels(req.GetLabels()); err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to update labels of node %s: %v",
+			node.Id,
+			err.Error())
+	}
+
+	// Return the updated node information
+	node, err = s.currentNode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.SdkNodeUpdateLabelsResponse{
+		Node: node.ToStorageNode(),
+	}, nil
+}
+
+// currentNode returns the node servicing the request
+func (s *NodeServer) currentNode() (*api.Node, error) {
+	c, err := s.cluster.Enumerate()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	node, err := s.cluster.Inspect(c.NodeId)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to inspect node %s: %v",
+			c.NodeId,
+			err.Error())
+	}
+
+	return &node, nil
+}
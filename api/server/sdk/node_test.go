@@ -0,0 +1,250 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/cluster"
+)
+
+func TestSdkNodeEnumerate(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	cluster := api.Cluster{
+		Id:     "someclusterid",
+		NodeId: "node1",
+		Nodes: []api.Node{
+			api.Node{Id: "node1"},
+			api.Node{Id: "node2"},
+		},
+	}
+	s.MockCluster().EXPECT().Enumerate().Return(cluster, nil).Times(1)
+
+	// Setup client
+	c := api.NewOpenStorageNodeClient(s.Conn())
+
+	// Get info
+	r, err := c.Enumerate(context.Background(), &api.SdkNodeEnumerateRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, r.GetNodeIds(), []string{"node1", "node2"})
+}
+
+func TestSdkNodeEnumerateFail(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	s.MockCluster().
+		EXPECT().
+		Enumerate().
+		Return(api.Cluster{}, fmt.Errorf("MOCK ERROR")).
+		Times(1)
+
+	// Setup client
+	c := api.NewOpenStorageNodeClient(s.Conn())
+
+	// Get info
+	_, err := c.Enumerate(context.Background(), &api.SdkNodeEnumerateRequest{})
+	assert.Error(t, err)
+
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Internal)
+	assert.Contains(t, serverError.Message(), "MOCK ERROR")
+}
+
+func TestSdkNodeInspect(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	node := api.Node{
+		Id:       "nodeid",
+		Hostname: "myhost",
+		Status:   api.Status_STATUS_OK,
+		NodeLabels: map[string]string{
+			"zone": "a",
+		},
+		Pools: []api.StoragePool{
+			api.StoragePool{ID: 1},
+		},
+	}
+	s.MockCluster().EXPECT().Inspect(node.Id).Return(node, nil).Times(1)
+
+	// Setup client
+	c := api.NewOpenStorageNodeClient(s.Conn())
+
+	// Missing node id
+	_, err := c.Inspect(context.Background(), &api.SdkNodeInspectRequest{})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.InvalidArgument)
+
+	// Get info
+	r, err := c.Inspect(context.Background(), &api.SdkNodeInspectRequest{
+		NodeId: node.Id,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, r.GetNode().GetId(), node.Id)
+	assert.Equal(t, r.GetNode().GetHostname(), node.Hostname)
+	assert.Equal(t, r.GetNode().GetStatus(), node.Status)
+	assert.Equal(t, r.GetNode().GetNodeLabels()["zone"], "a")
+	assert.Len(t, r.GetNode().GetPools(), 1)
+
+	// Unknown node
+	s.MockCluster().
+		EXPECT().
+		Inspect("unknown").
+		Return(api.Node{}, cluster.ErrNodeNotFound).
+		Times(1)
+	_, err = c.Inspect(context.Background(), &api.SdkNodeInspectRequest{
+		NodeId: "unknown",
+	})
+	assert.Error(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.NotFound)
+}
+
+func TestSdkNodeInspectCurrent(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	cluster := api.Cluster{
+		Id:     "someclusterid",
+		NodeId: "node2",
+	}
+	gomock.InOrder(
+		s.MockCluster().EXPECT().Enumerate().Return(cluster, nil).Times(1),
+		s.MockCluster().
+			EXPECT().
+			Inspect(cluster.NodeId).
+			Return(api.Node{Id: cluster.NodeId}, nil).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageNodeClient(s.Conn())
+
+	// Get info
+	r, err := c.InspectCurrent(context.Background(), &api.SdkNodeInspectCurrentRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, r.GetNode().GetId(), cluster.NodeId)
+}
+
+func TestSdkNodeUpdateLabels(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	cluster := api.Cluster{
+		Id:     "someclusterid",
+		NodeId: "node1",
+	}
+	labels := map[string]string{
+		"rack": "r1",
+	}
+	gomock.InOrder(
+		s.MockCluster().EXPECT().Enumerate().Return(cluster, nil).Times(1),
+		s.MockCluster().
+			EXPECT().
+			Inspect(cluster.NodeId).
+			Return(api.Node{Id: cluster.NodeId}, nil).
+			Times(1),
+		s.MockCluster().EXPECT().UpdateLabels(labels).Return(nil).Times(1),
+		s.MockCluster().EXPECT().Enumerate().Return(cluster, nil).Times(1),
+		s.MockCluster().
+			EXPECT().
+			Inspect(cluster.NodeId).
+			Return(api.Node{
+				Id:         cluster.NodeId,
+				NodeLabels: labels,
+			}, nil).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageNodeClient(s.Conn())
+
+	// Update
+	r, err := c.UpdateLabels(context.Background(), &api.SdkNodeUpdateLabelsRequest{
+		NodeId: cluster.NodeId,
+		Labels: labels,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, r.GetNode().GetNodeLabels()["rack"], "r1")
+}
+
+func TestSdkNodeUpdateLabelsBadArguments(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	cluster := api.Cluster{
+		Id:     "someclusterid",
+		NodeId: "node1",
+	}
+	gomock.InOrder(
+		s.MockCluster().EXPECT().Enumerate().Return(cluster, nil).Times(1),
+		s.MockCluster().
+			EXPECT().
+			Inspect(cluster.NodeId).
+			Return(api.Node{Id: cluster.NodeId}, nil).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageNodeClient(s.Conn())
+
+	// No labels
+	_, err := c.UpdateLabels(context.Background(), &api.SdkNodeUpdateLabelsRequest{})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.InvalidArgument)
+
+	// Another node
+	_, err = c.UpdateLabels(context.Background(), &api.SdkNodeUpdateLabelsRequest{
+		NodeId: "node2",
+		Labels: map[string]string{
+			"rack": "r1",
+		},
+	})
+	assert.Error(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.FailedPrecondition)
+}
@@ -27,7 +27,12 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/kubernetes-csi/csi-test/utils"
+	"github.com/pborman/uuid"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/sirupsen/logrus"
 
+	"github.com/libopenstorage/openstorage/alert"
 	"github.com/libopenstorage/openstorage/api"
 	mockcluster "github.com/libopenstorage/openstorage/cluster/mock"
 	"github.com/libopenstorage/openstorage/pkg/grpcserver"
@@ -47,8 +52,11 @@ type testServer struct {
 	server *Server
 	m      *mockdriver.MockVolumeDriver
 	c      *mockcluster.MockCluster
+	a      alert.Alert
 	mc     *gomock.Controller
 	gw     *httptest.Server
+
+	alertClusterID string
 }
 
 func setupMockDriver(tester *testServer, t *testing.T) {
@@ -64,6 +72,17 @@ func setupMockDriver(tester *testServer, t *testing.T) {
 }
 
 func newTestServer(t *testing.T) *testServer {
+	return newTestServerWithConfig(t, &ServerConfig{}, grpc.WithInsecure())
+}
+
+// newTestServerWithConfig creates a test server with the configuration
+// provided. The driver, cluster and alerts of the configuration are set
+// to the ones of the test server. The client connects using dialOpt.
+func newTestServerWithConfig(
+	t *testing.T,
+	config *ServerConfig,
+	dialOpt grpc.DialOption,
+) *testServer {
 	tester := &testServer{}
 
 	// Add driver to registry
@@ -73,20 +92,26 @@ func newTestServer(t *testing.T) *testServer {
 
 	setupMockDriver(tester, t)
 
-	var err error
+	// Setup alerts in a kvdb for this test
+	kv, err := kvdb.New(mem.Name, "test", []string{}, nil, logrus.Panicf)
+	assert.NoError(t, err)
+	tester.alertClusterID = uuid.New()
+	tester.a, err = alert.New(alert.NameTest, tester.alertClusterID, kv)
+	assert.NoError(t, err)
+
 	// Setup simple driver
-	tester.server, err = New(&ServerConfig{
-		DriverName: mockDriverName,
-		Net:        "tcp",
-		Address:    "127.0.0.1:0",
-		Cluster:    tester.c,
-	})
+	config.DriverName = mockDriverName
+	config.Net = "tcp"
+	config.Address = "127.0.0.1:0"
+	config.Cluster = tester.c
+	config.Alert = tester.a
+	tester.server, err = New(config)
 	assert.Nil(t, err)
 	err = tester.server.Start()
 	assert.Nil(t, err)
 
 	// Setup a connection to the driver
-	tester.conn, err = grpc.Dial(tester.server.Address(), grpc.WithInsecure())
+	tester.conn, err = grpc.Dial(tester.server.Address(), dialOpt)
 	assert.Nil(t, err)
 
 	// Setup REST gateway
@@ -106,6 +131,15 @@ func (s *testServer) MockCluster() *mockcluster.MockCluster {
 	return s.c
 }
 
+func (s *testServer) Alert() alert.Alert {
+	return s.a
+}
+
+// AlertClusterId returns the cluster id used to store alerts
+func (s *testServer) AlertClusterId() string {
+	return s.alertClusterID
+}
+
 func (s *testServer) Stop() {
 	// Remove from registry
 	volumedrivers.Remove("mock")
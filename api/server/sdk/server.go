@@ -18,6 +18,7 @@ package sdk
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"mime"
 	"net/http"
@@ -27,11 +28,16 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
+	"github.com/libopenstorage/openstorage/alert"
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/api/spec"
 	"github.com/libopenstorage/openstorage/cluster"
+	"github.com/libopenstorage/openstorage/pkg/audit"
+	"github.com/libopenstorage/openstorage/pkg/auth"
 	"github.com/libopenstorage/openstorage/pkg/grpcserver"
+	"github.com/libopenstorage/openstorage/pkg/tlsutil"
 	volumedrivers "github.com/libopenstorage/openstorage/volume/drivers"
 )
 
@@ -50,6 +56,22 @@ type ServerConfig struct {
 	DriverName string
 	// Cluster interface
 	Cluster cluster.Cluster
+	// Alert is the alert client used to watch alerts in the cluster.
+	// If not provided, watching alerts is not supported.
+	Alert alert.Alert
+	// Auth validates the bearer token sent by the callers of the SDK
+	// in the `authorization` metadata. If not provided, authentication
+	// and authorization are disabled.
+	Auth auth.Authenticator
+	// Roles authorize the authenticated callers to use the SDK methods.
+	// If not provided, DefaultRoles() are used.
+	Roles []auth.Role
+	// TLS, if provided, is used to serve TLS on the gRPC server
+	// and on the REST gateway.
+	TLS *tlsutil.Config
+	// Auditor, if provided, records the calls to the methods which
+	// change anything and enumerates the audit events.
+	Auditor *audit.Auditor
 }
 
 // Server is an implementation of the gRPC SDK interface
@@ -57,12 +79,15 @@ type Server struct {
 	*grpcserver.GrpcServer
 
 	restPort             string
+	tls                  *tlsutil.CertReloader
 	clusterServer        *ClusterServer
+	nodeServer           *NodeServer
 	volumeServer         *VolumeServer
 	objectstoreServer    *ObjectstoreServer
 	schedulePolicyServer *SchedulePolicyServer
 	cloudBackupServer    *CloudBackupServer
 	credentialServer     *CredentialServer
+	auditServer          *AuditServer
 }
 
 // Interface check
@@ -83,11 +108,56 @@ func New(config *ServerConfig) (*Server, error) {
 		return nil, fmt.Errorf("Unable to get driver %s info: %s", config.DriverName, err.Error())
 	}
 
+	// Setup authentication and authorization
+	var (
+		opts              []grpc.ServerOption
+		unaryInterceptors []grpc.UnaryServerInterceptor
+	)
+	var auditInterceptor *auditInterceptor
+	if config.Auditor != nil {
+		auditInterceptor = newAuditInterceptor(config.Auditor)
+	}
+	if config.Auth != nil {
+		authInterceptor, err := newAuthInterceptor(config.Auth, config.Roles)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to setup authorization: %v", err)
+		}
+		authInterceptor.audit = auditInterceptor
+		unaryInterceptors = append(unaryInterceptors, authInterceptor.unary)
+		opts = append(opts, grpc.StreamInterceptor(authInterceptor.stream))
+	}
+
+	// Setup auditing. It is done after the authentication so that the
+	// identity of the callers is known. The calls denied by the
+	// authentication are recorded by its interceptor.
+	if auditInterceptor != nil {
+		unaryInterceptors = append(unaryInterceptors, auditInterceptor.unary)
+	}
+	if len(unaryInterceptors) != 0 {
+		opts = append(opts, grpc.UnaryInterceptor(
+			chainUnaryInterceptors(unaryInterceptors...)))
+	}
+
+	// Setup TLS
+	var (
+		certs     *tlsutil.CertReloader
+		tlsConfig *tls.Config
+	)
+	if config.TLS != nil {
+		certs, err = tlsutil.NewCertReloader(config.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to setup TLS: %v", err)
+		}
+		tlsConfig = certs.ServerConfig()
+	}
+
 	// Create gRPC server
 	gServer, err := grpcserver.New(&grpcserver.GrpcServerConfig{
-		Name:    "SDK",
-		Net:     config.Net,
-		Address: config.Address,
+		Name:      "SDK",
+		Net:       config.Net,
+		Address:   config.Address,
+		Opts:      opts,
+		TLSConfig: tlsConfig,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("Unable to setup server: %v", err)
@@ -96,8 +166,13 @@ func New(config *ServerConfig) (*Server, error) {
 	return &Server{
 		GrpcServer: gServer,
 		restPort:   config.RestPort,
+		tls:        certs,
 		clusterServer: &ClusterServer{
-			config.Cluster,
+			cluster: config.Cluster,
+			alert:   config.Alert,
+		},
+		nodeServer: &NodeServer{
+			cluster: config.Cluster,
 		},
 		volumeServer: &VolumeServer{
 			driver:      d,
@@ -116,6 +191,9 @@ func New(config *ServerConfig) (*Server, error) {
 		credentialServer: &CredentialServer{
 			driver: d,
 		},
+		auditServer: &AuditServer{
+			auditor: config.Auditor,
+		},
 	}, nil
 }
 
@@ -126,11 +204,13 @@ func (s *Server) Start() error {
 	// Start the gRPC Server
 	err := s.GrpcServer.Start(func(grpcServer *grpc.Server) {
 		api.RegisterOpenStorageClusterServer(grpcServer, s.clusterServer)
+		api.RegisterOpenStorageNodeServer(grpcServer, s.nodeServer)
 		api.RegisterOpenStorageObjectstoreServer(grpcServer, s.objectstoreServer)
 		api.RegisterOpenStorageVolumeServer(grpcServer, s.volumeServer)
 		api.RegisterOpenStorageCredentialsServer(grpcServer, s.credentialServer)
 		api.RegisterOpenStorageSchedulePolicyServer(grpcServer, s.schedulePolicyServer)
 		api.RegisterOpenStorageCloudBackupServer(grpcServer, s.cloudBackupServer)
+		api.RegisterOpenStorageAuditServer(grpcServer, s.auditServer)
 	})
 	if err != nil {
 		return err
@@ -152,7 +232,18 @@ func (s *Server) startRestServer() error {
 	ready := make(chan bool)
 	go func() {
 		ready <- true
-		err := http.ListenAndServe(":"+s.restPort, mux)
+		var err error
+		if s.tls != nil {
+			server := &http.Server{
+				Addr:      ":" + s.restPort,
+				Handler:   mux,
+				TLSConfig: s.tls.ServerConfig(),
+			}
+			// Certificates are provided by the TLS configuration
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			err = http.ListenAndServe(":"+s.restPort, mux)
+		}
 		if err != nil {
 			logrus.Fatalf("Unable to start SDK REST gRPC Gateway: %s\n",
 				err.Error())
@@ -188,13 +279,29 @@ func (s *Server) restServerSetupHandlers() (*http.ServeMux, error) {
 	mux.Handle(prefix,
 		http.StripPrefix(prefix, http.FileServer(swaggerUIBox)))
 
-	// Create a router just for HTTP REST gRPC Server Gateway
+	// Create a router just for HTTP REST gRPC Server Gateway.
+	// The gateway forwards the HTTP Authorization header to the
+	// gRPC server as the `authorization` metadata.
 	gmux := runtime.NewServeMux()
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if s.tls != nil {
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(
+			credentials.NewTLS(s.tls.LoopbackClientConfig()))}
+	}
 	err := api.RegisterOpenStorageClusterHandlerFromEndpoint(
 		context.Background(),
 		gmux,
 		s.Address(),
-		[]grpc.DialOption{grpc.WithInsecure()})
+		opts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = api.RegisterOpenStorageNodeHandlerFromEndpoint(
+		context.Background(),
+		gmux,
+		s.Address(),
+		opts)
 	if err != nil {
 		return nil, err
 	}
@@ -203,7 +310,7 @@ func (s *Server) restServerSetupHandlers() (*http.ServeMux, error) {
 		context.Background(),
 		gmux,
 		s.Address(),
-		[]grpc.DialOption{grpc.WithInsecure()})
+		opts)
 	if err != nil {
 		return nil, err
 	}
@@ -212,7 +319,7 @@ func (s *Server) restServerSetupHandlers() (*http.ServeMux, error) {
 		context.Background(),
 		gmux,
 		s.Address(),
-		[]grpc.DialOption{grpc.WithInsecure()})
+		opts)
 	if err != nil {
 		return nil, err
 	}
@@ -221,7 +328,7 @@ func (s *Server) restServerSetupHandlers() (*http.ServeMux, error) {
 		context.Background(),
 		gmux,
 		s.Address(),
-		[]grpc.DialOption{grpc.WithInsecure()})
+		opts)
 	if err != nil {
 		return nil, err
 	}
@@ -230,7 +337,7 @@ func (s *Server) restServerSetupHandlers() (*http.ServeMux, error) {
 		context.Background(),
 		gmux,
 		s.Address(),
-		[]grpc.DialOption{grpc.WithInsecure()})
+		opts)
 	if err != nil {
 		return nil, err
 	}
@@ -239,7 +346,16 @@ func (s *Server) restServerSetupHandlers() (*http.ServeMux, error) {
 		context.Background(),
 		gmux,
 		s.Address(),
-		[]grpc.DialOption{grpc.WithInsecure()})
+		opts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = api.RegisterOpenStorageAuditHandlerFromEndpoint(
+		context.Background(),
+		gmux,
+		s.Address(),
+		opts)
 	if err != nil {
 		return nil, err
 	}
@@ -249,3 +365,25 @@ func (s *Server) restServerSetupHandlers() (*http.ServeMux, error) {
 
 	return mux, nil
 }
+
+// chainUnaryInterceptors returns an interceptor calling the interceptors
+// in order, the first one being the outermost
+func chainUnaryInterceptors(
+	interceptors ...grpc.UnaryServerInterceptor,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		chain := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chain
+			chain = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return chain(ctx, req)
+	}
+}
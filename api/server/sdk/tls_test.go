@@ -0,0 +1,178 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/tlsutil"
+)
+
+// writeTestCert creates a certificate signed by the parent, or a self signed
+// CA when parent is nil, and saves it in dir as <name>.pem and <name>-key.pem
+func writeTestCert(
+	t *testing.T,
+	dir, name string,
+	parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage: []x509.ExtKeyUsage{
+			x509.ExtKeyUsageServerAuth,
+			x509.ExtKeyUsageClientAuth,
+		},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	err = ioutil.WriteFile(filepath.Join(dir, name+".pem"),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	assert.NoError(t, err)
+	err = ioutil.WriteFile(filepath.Join(dir, name+"-key.pem"),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	assert.NoError(t, err)
+
+	return cert, key
+}
+
+func TestSdkTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sdktls")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca, caKey := writeTestCert(t, dir, "ca", nil, nil)
+	writeTestCert(t, dir, "server", ca, caKey)
+	writeTestCert(t, dir, "client", ca, caKey)
+	clientCert, err := tls.LoadX509KeyPair(
+		filepath.Join(dir, "client.pem"),
+		filepath.Join(dir, "client-key.pem"))
+	assert.NoError(t, err)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	s := newTestServerWithConfig(t,
+		&ServerConfig{
+			TLS: &tlsutil.Config{
+				CertFile:   filepath.Join(dir, "server.pem"),
+				KeyFile:    filepath.Join(dir, "server-key.pem"),
+				CAFile:     filepath.Join(dir, "ca.pem"),
+				ClientAuth: true,
+			},
+		},
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			RootCAs:      roots,
+			Certificates: []tls.Certificate{clientCert},
+		})))
+	defer s.Stop()
+
+	cluster := api.Cluster{
+		Id:     "someid",
+		NodeId: "somenodeid",
+	}
+	s.MockCluster().EXPECT().Enumerate().Return(cluster, nil).Times(2)
+
+	// Client with a certificate
+	c := api.NewOpenStorageClusterClient(s.Conn())
+	r, err := c.Enumerate(context.Background(), &api.SdkClusterEnumerateRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "someid", r.GetCluster().GetId())
+
+	// Client without a certificate
+	conn, err := grpc.Dial(s.Server().Address(),
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			RootCAs: roots,
+		})))
+	assert.NoError(t, err)
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = api.NewOpenStorageClusterClient(conn).
+		Enumerate(ctx, &api.SdkClusterEnumerateRequest{})
+	assert.Error(t, err)
+
+	// Insecure client
+	conn, err = grpc.Dial(s.Server().Address(), grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer conn.Close()
+	_, err = api.NewOpenStorageClusterClient(conn).
+		Enumerate(ctx, &api.SdkClusterEnumerateRequest{})
+	assert.Error(t, err)
+
+	// The REST gateway connects to the gRPC server using TLS
+	res, err := http.Post(
+		s.GatewayURL()+"/v1/cluster/enumerate",
+		"application/json",
+		strings.NewReader("{}"))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestSdkTLSBadConfig(t *testing.T) {
+	// Registers the mock driver
+	s := newTestServer(t)
+	defer s.Stop()
+
+	_, err := New(&ServerConfig{
+		DriverName: mockDriverName,
+		Net:        "tcp",
+		Address:    "127.0.0.1:0",
+		TLS: &tlsutil.Config{
+			CertFile: "/nonexistent/cert.pem",
+			KeyFile:  "/nonexistent/key.pem",
+		},
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "TLS")
+}
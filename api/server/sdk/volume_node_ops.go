@@ -34,6 +34,10 @@ func (s *VolumeServer) Attach(
 		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
 	}
 
+	if _, err := checkAccessById(ctx, s.driver, req.GetVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); err != nil {
+		return nil, err
+	}
+
 	devPath, err := s.driver.Attach(req.GetVolumeId(), req.GetOptions())
 	if err != nil {
 		return nil, status.Errorf(
@@ -55,6 +59,10 @@ func (s *VolumeServer) Detach(
 		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
 	}
 
+	if _, err := checkAccessById(ctx, s.driver, req.GetVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); err != nil {
+		return nil, err
+	}
+
 	err := s.driver.Detach(req.GetVolumeId(), nil)
 
 	return &api.SdkVolumeDetachResponse{}, err
@@ -74,6 +82,10 @@ func (s *VolumeServer) Mount(
 		return nil, status.Error(codes.InvalidArgument, "Invalid Mount Path")
 	}
 
+	if _, err := checkAccessById(ctx, s.driver, req.GetVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); err != nil {
+		return nil, err
+	}
+
 	err := s.driver.Mount(req.GetVolumeId(), req.GetMountPath(), req.GetOptions())
 
 	return &api.SdkVolumeMountResponse{}, err
@@ -93,6 +105,10 @@ func (s *VolumeServer) Unmount(
 		return nil, status.Error(codes.InvalidArgument, "Invalid Mount Path")
 	}
 
+	if _, err := checkAccessById(ctx, s.driver, req.GetVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); err != nil {
+		return nil, err
+	}
+
 	err := s.driver.Unmount(req.GetVolumeId(), req.GetMountPath(), req.GetOptions())
 	if err != nil {
 		return nil, status.Errorf(
@@ -19,6 +19,7 @@ package sdk
 import (
 	"context"
 
+	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -28,6 +29,7 @@ import (
 )
 
 func (s *VolumeServer) create(
+	ctx context.Context,
 	locator *api.VolumeLocator,
 	source *api.Source,
 	spec *api.VolumeSpec,
@@ -55,11 +57,20 @@ func (s *VolumeServer) create(
 		if v.GetSource().GetParent() != source.GetParent() {
 			return "", status.Error(codes.AlreadyExists, "Existing volume has conflicting parent value")
 		}
+		if err := checkAccess(ctx, v, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ); err != nil {
+			return "", err
+		}
 
 		// Return information on existing volume
 		return v.GetId(), nil
 	}
 
+	// Volumes created by an authenticated user are owned by that user
+	ownership, err := ownershipForNewVolume(ctx, spec.GetOwnership())
+	if err != nil {
+		return "", err
+	}
+
 	// Check if the caller is asking to create a snapshot or for a new volume
 	var id string
 	if len(source.GetParent()) != 0 {
@@ -71,6 +82,9 @@ func (s *VolumeServer) create(
 				"unable to get parent volume information: %s",
 				err.Error())
 		}
+		if err := checkAccess(ctx, parent, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ); err != nil {
+			return "", err
+		}
 
 		// Create a snapshot from the parent
 		id, err = s.driver.Snapshot(parent.GetId(), false, &api.VolumeLocator{
@@ -82,8 +96,19 @@ func (s *VolumeServer) create(
 				"unable to create snapshot: %s\n",
 				err.Error())
 		}
+
+		// The new volume does not inherit the ownership of its parent
+		if ownership != nil {
+			if err := s.setOwnership(id, ownership); err != nil {
+				return "", err
+			}
+		}
 	} else {
 		// Create the volume
+		if ownership != spec.GetOwnership() {
+			spec = spec.Copy()
+			spec.Ownership = ownership
+		}
 		id, err = s.driver.Create(locator, source, spec)
 		if err != nil {
 			return "", status.Errorf(
@@ -118,9 +143,9 @@ func (s *VolumeServer) Create(
 	}
 	source := &api.Source{}
 
-	id, err := s.create(locator, source, spec)
+	id, err := s.create(ctx, locator, source, spec)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &api.SdkVolumeCreateResponse{
@@ -156,9 +181,9 @@ func (s *VolumeServer) CreateFromVolumeId(
 		Parent: req.GetParentId(),
 	}
 
-	id, err := s.create(locator, source, spec)
+	id, err := s.create(ctx, locator, source, spec)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &api.SdkVolumeCreateFromVolumeIdResponse{
@@ -188,6 +213,9 @@ func (s *VolumeServer) Delete(
 			req.GetVolumeId(),
 			err.Error())
 	}
+	if err := checkAccess(ctx, volumes[0], api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_ADMIN); err != nil {
+		return nil, err
+	}
 
 	err = s.driver.Delete(req.GetVolumeId())
 	if err != nil {
@@ -219,6 +247,9 @@ func (s *VolumeServer) Inspect(
 			req.GetVolumeId(),
 			err.Error())
 	}
+	if err := checkAccess(ctx, vols[0], api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ); err != nil {
+		return nil, err
+	}
 
 	return &api.SdkVolumeInspectResponse{
 		Volume: vols[0],
@@ -231,15 +262,206 @@ func (s *VolumeServer) Enumerate(
 	req *api.SdkVolumeEnumerateRequest,
 ) (*api.SdkVolumeEnumerateResponse, error) {
 
-	vols, err := s.driver.Enumerate(req.GetLocator(), nil)
-	if err != nil {
+	var (
+		vols          []*api.Volume
+		nextPageToken string
+		err           error
+	)
+	if req.GetFilter() == nil && req.GetMaxResults() == 0 && len(req.GetPageToken()) == 0 {
+		vols, err = s.driver.Enumerate(req.GetLocator(), nil)
+	} else {
+		filter := &api.VolumeFilter{}
+		if req.GetFilter() != nil {
+			filter = proto.Clone(req.GetFilter()).(*api.VolumeFilter)
+		}
+		if filter.GetLocator() == nil {
+			filter.Locator = req.GetLocator()
+		}
+		vols, nextPageToken, err = s.driver.EnumerateWithFilter(
+			filter,
+			req.GetPageToken(),
+			int(req.GetMaxResults()))
+	}
+	if err == volume.ErrEinval {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Invalid page token %s",
+			req.GetPageToken())
+	} else if err != nil {
 		return nil, status.Errorf(
 			codes.Internal,
 			"Failed to enumerate volumes: %v",
 			err.Error())
 	}
 
+	// Only return the volumes the caller has access to. Pages may
+	// therefore have fewer volumes than requested.
+	if _, ok := accessClaims(ctx); ok {
+		permitted := make([]*api.Volume, 0, len(vols))
+		for _, v := range vols {
+			if isPermitted(ctx, v, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ) {
+				permitted = append(permitted, v)
+			}
+		}
+		vols = permitted
+	}
+
 	return &api.SdkVolumeEnumerateResponse{
-		Volumes: vols,
+		Volumes:       vols,
+		NextPageToken: nextPageToken,
 	}, nil
 }
+
+// Update changes the labels and specification of an existing volume
+func (s *VolumeServer) Update(
+	ctx context.Context,
+	req *api.SdkVolumeUpdateRequest,
+) (*api.SdkVolumeUpdateResponse, error) {
+
+	if len(req.GetVolumeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
+	}
+
+	// Get current state
+	vols, err := s.driver.Inspect([]string{req.GetVolumeId()})
+	if (err == nil && len(vols) == 0) ||
+		(err != nil && err == volume.ErrEnoEnt) {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"Volume id %s not found",
+			req.GetVolumeId())
+	} else if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to inspect volume %s: %v",
+			req.GetVolumeId(),
+			err.Error())
+	}
+	v := vols[0]
+	if err := checkAccess(ctx, v, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); err != nil {
+		return nil, err
+	}
+
+	// Only pass to the driver the objects which are being changed
+	var locator *api.VolumeLocator
+	if len(req.GetLabels()) != 0 {
+		locator = updatedLocator(v.GetLocator(), req.GetLabels())
+	}
+
+	var spec *api.VolumeSpec
+	if requested := req.GetSpec(); requested != nil {
+		// An unset ownership leaves the ownership of the volume unchanged
+		if requested.GetOwnership() == nil && v.GetSpec().GetOwnership() != nil {
+			requested = proto.Clone(requested).(*api.VolumeSpec)
+			requested.Ownership = v.GetSpec().GetOwnership()
+		}
+		if !proto.Equal(requested, v.GetSpec()) {
+			if err := checkSpecUpdate(v.GetSpec(), requested); err != nil {
+				return nil, err
+			}
+			if err := checkOwnershipUpdate(ctx, v, requested.GetOwnership()); err != nil {
+				return nil, err
+			}
+			spec = requested
+		}
+	}
+
+	if locator != nil || spec != nil {
+		err = s.driver.Set(req.GetVolumeId(), locator, spec)
+		if err == volume.ErrNotSupported {
+			return nil, status.Errorf(
+				codes.Unimplemented,
+				"Volume driver does not support updating volume %s: %v",
+				req.GetVolumeId(),
+				err.Error())
+		} else if err != nil {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Failed to update volume %s: %v",
+				req.GetVolumeId(),
+				err.Error())
+		}
+
+		// Get the updated information
+		vols, err = s.driver.Inspect([]string{req.GetVolumeId()})
+		if err != nil || len(vols) == 0 {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Failed to inspect volume %s after update: %v",
+				req.GetVolumeId(),
+				err)
+		}
+		v = vols[0]
+	}
+
+	return &api.SdkVolumeUpdateResponse{
+		Volume: v,
+	}, nil
+}
+
+// updatedLocator returns a copy of the locator with the labels applied.
+// Labels with an empty value are removed.
+func updatedLocator(
+	current *api.VolumeLocator,
+	labels map[string]string,
+) *api.VolumeLocator {
+	locator := &api.VolumeLocator{}
+	if current != nil {
+		locator = proto.Clone(current).(*api.VolumeLocator)
+	}
+	if locator.VolumeLabels == nil {
+		locator.VolumeLabels = make(map[string]string)
+	}
+	for k, v := range labels {
+		if len(v) == 0 {
+			delete(locator.VolumeLabels, k)
+		} else {
+			locator.VolumeLabels[k] = v
+		}
+	}
+	return locator
+}
+
+// checkSpecUpdate returns an InvalidArgument error if the requested spec
+// changes fields which cannot be updated on an existing volume.
+func checkSpecUpdate(current, requested *api.VolumeSpec) error {
+	if current == nil {
+		current = &api.VolumeSpec{}
+	}
+	if requested.GetSize() < current.GetSize() {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Volume size cannot be reduced from %v to %v",
+			current.GetSize(),
+			requested.GetSize())
+	}
+	if requested.GetHaLevel() < 0 {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Invalid HA level %v",
+			requested.GetHaLevel())
+	}
+
+	// Reset all the fields which can be updated to their current values
+	// and then check that nothing else has changed.
+	check := proto.Clone(requested).(*api.VolumeSpec)
+	check.Size = current.GetSize()
+	check.HaLevel = current.GetHaLevel()
+	check.Cos = current.GetCos()
+	check.IoProfile = current.GetIoProfile()
+	check.Sticky = current.GetSticky()
+	check.Shared = current.GetShared()
+	check.SnapshotInterval = current.GetSnapshotInterval()
+	check.SnapshotSchedule = current.GetSnapshotSchedule()
+	check.ReplicaSet = current.GetReplicaSet()
+	check.Ownership = current.GetOwnership()
+	if !proto.Equal(check, current) {
+		return status.Error(
+			codes.InvalidArgument,
+			"Only size, ha_level, cos, io_profile, sticky, shared, "+
+				"snapshot_interval, snapshot_schedule, replica_set "+
+				"and ownership can be updated")
+	}
+
+	return nil
+}
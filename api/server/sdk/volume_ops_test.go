@@ -320,3 +320,307 @@ func TestSdkVolumeEnumerate(t *testing.T) {
 	assert.Len(t, r.GetVolumes(), 1)
 	assert.Equal(t, r.GetVolumes()[0].GetId(), id)
 }
+
+func TestSdkVolumeEnumerateWithFilter(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	locator := &api.VolumeLocator{
+		Name: "myvol",
+	}
+	filter := &api.VolumeFilter{
+		Owner: "user",
+		State: api.VolumeState_VOLUME_STATE_ATTACHED,
+	}
+	id := "myid"
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			EnumerateWithFilter(&api.VolumeFilter{
+				Locator: &api.VolumeLocator{
+					Name: "myvol",
+				},
+				Owner: "user",
+				State: api.VolumeState_VOLUME_STATE_ATTACHED,
+			}, "", 1).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: id,
+				},
+			}, "mytoken", nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			EnumerateWithFilter(&api.VolumeFilter{}, "badtoken", 0).
+			Return(nil, "", volume.ErrEinval).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	// Get a page
+	r, err := c.Enumerate(context.Background(), &api.SdkVolumeEnumerateRequest{
+		Locator:    locator,
+		Filter:     filter,
+		MaxResults: 1,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, r.GetVolumes(), 1)
+	assert.Equal(t, id, r.GetVolumes()[0].GetId())
+	assert.Equal(t, "mytoken", r.GetNextPageToken())
+
+	// Invalid page token
+	_, err = c.Enumerate(context.Background(), &api.SdkVolumeEnumerateRequest{
+		PageToken: "badtoken",
+	})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, serverError.Code())
+}
+
+func TestSdkVolumeUpdate(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	id := "myid"
+	current := &api.Volume{
+		Id: id,
+		Locator: &api.VolumeLocator{
+			Name: "myvol",
+			VolumeLabels: map[string]string{
+				"app":  "db",
+				"tier": "gold",
+			},
+		},
+		Spec: &api.VolumeSpec{
+			Size:    uint64(1234),
+			HaLevel: 1,
+			Format:  api.FSType_FS_TYPE_EXT4,
+		},
+	}
+	spec := &api.VolumeSpec{
+		Size:    uint64(5678),
+		HaLevel: 2,
+		Format:  api.FSType_FS_TYPE_EXT4,
+		Sticky:  true,
+	}
+	req := &api.SdkVolumeUpdateRequest{
+		VolumeId: id,
+		Labels: map[string]string{
+			"tier":  "",
+			"owner": "me",
+		},
+		Spec: &api.VolumeSpec{
+			Size:    spec.GetSize(),
+			HaLevel: spec.GetHaLevel(),
+			Format:  spec.GetFormat(),
+			Sticky:  spec.GetSticky(),
+		},
+	}
+	updated := &api.Volume{
+		Id: id,
+		Locator: &api.VolumeLocator{
+			Name: "myvol",
+			VolumeLabels: map[string]string{
+				"app":   "db",
+				"owner": "me",
+			},
+		},
+		Spec: spec,
+	}
+
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{current}, nil).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Set(id, updated.GetLocator(), spec).
+			Return(nil).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{updated}, nil).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	// Update
+	r, err := c.Update(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, r.GetVolume().GetId(), id)
+	assert.Equal(t, r.GetVolume().GetSpec().GetSize(), uint64(5678))
+	assert.Equal(t, r.GetVolume().GetLocator().GetVolumeLabels()["owner"], "me")
+	assert.NotContains(t, r.GetVolume().GetLocator().GetVolumeLabels(), "tier")
+}
+
+func TestSdkVolumeUpdateNoChanges(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	id := "myid"
+	spec := &api.VolumeSpec{
+		Size: uint64(1234),
+	}
+
+	// Set must not be called when nothing changes
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{
+			&api.Volume{
+				Id:   id,
+				Spec: spec,
+			},
+		}, nil).
+		Times(1)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	r, err := c.Update(context.Background(), &api.SdkVolumeUpdateRequest{
+		VolumeId: id,
+		Spec:     spec,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, r.GetVolume().GetId(), id)
+}
+
+func TestSdkVolumeUpdateBadArguments(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	id := "myid"
+	current := &api.Volume{
+		Id: id,
+		Spec: &api.VolumeSpec{
+			Size:   uint64(1234),
+			Format: api.FSType_FS_TYPE_EXT4,
+		},
+	}
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{current}, nil).
+		Times(2)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	tests := []struct {
+		req     *api.SdkVolumeUpdateRequest
+		message string
+	}{
+		{
+			req:     &api.SdkVolumeUpdateRequest{},
+			message: "volume id",
+		},
+		{
+			req: &api.SdkVolumeUpdateRequest{
+				VolumeId: id,
+				Spec: &api.VolumeSpec{
+					Size:   uint64(1),
+					Format: api.FSType_FS_TYPE_EXT4,
+				},
+			},
+			message: "cannot be reduced",
+		},
+		{
+			req: &api.SdkVolumeUpdateRequest{
+				VolumeId: id,
+				Spec: &api.VolumeSpec{
+					Size:   uint64(1234),
+					Format: api.FSType_FS_TYPE_XFS,
+				},
+			},
+			message: "can be updated",
+		},
+	}
+
+	for _, test := range tests {
+		_, err := c.Update(context.Background(), test.req)
+		assert.Error(t, err)
+
+		serverError, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, serverError.Code(), codes.InvalidArgument)
+		assert.Contains(t, serverError.Message(), test.message)
+	}
+}
+
+func TestSdkVolumeUpdateErrors(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	id := "myid"
+	req := &api.SdkVolumeUpdateRequest{
+		VolumeId: id,
+		Labels: map[string]string{
+			"hello": "world",
+		},
+	}
+
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return(nil, volume.ErrEnoEnt).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: id,
+				},
+			}, nil).
+			Times(1),
+
+		s.MockDriver().
+			EXPECT().
+			Set(id, &api.VolumeLocator{
+				VolumeLabels: map[string]string{
+					"hello": "world",
+				},
+			}, nil).
+			Return(volume.ErrNotSupported).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	// Volume not found
+	_, err := c.Update(context.Background(), req)
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.NotFound)
+
+	// Driver does not support the update
+	_, err = c.Update(context.Background(), req)
+	assert.Error(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Unimplemented)
+}
@@ -0,0 +1,182 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/libopenstorage/openstorage/volume"
+)
+
+// accessClaims returns the claims of the caller when its access to volumes
+// must be checked. Ownership is only enforced when authentication is
+// enabled and the caller is not an administrator.
+func accessClaims(ctx context.Context) (*auth.Claims, bool) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok || claims == nil || claims.IsAdmin() {
+		return nil, false
+	}
+	return claims, true
+}
+
+// isPermitted returns true if the caller has the access requested to the volume
+func isPermitted(ctx context.Context, v *api.Volume, access api.OwnershipAccessType) bool {
+	claims, ok := accessClaims(ctx)
+	if !ok {
+		return true
+	}
+	return v.GetSpec().GetOwnership().IsPermitted(claims.Subject, claims.Groups, access)
+}
+
+// checkAccess returns a PermissionDenied error if the caller does not have
+// the access requested to the volume
+func checkAccess(ctx context.Context, v *api.Volume, access api.OwnershipAccessType) error {
+	if !isPermitted(ctx, v, access) {
+		return status.Errorf(
+			codes.PermissionDenied,
+			"Access denied to volume %s",
+			v.GetId())
+	}
+	return nil
+}
+
+// checkAccessById inspects the volume and checks that the caller has the
+// access requested to it. The volume is only inspected when ownership must
+// be enforced, in which case it is also returned.
+func checkAccessById(
+	ctx context.Context,
+	d volume.VolumeDriver,
+	id string,
+	access api.OwnershipAccessType,
+) (*api.Volume, error) {
+	if _, ok := accessClaims(ctx); !ok {
+		return nil, nil
+	}
+
+	v, err := inspectVolume(d, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAccess(ctx, v, access); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// inspectVolume returns the volume, or a NotFound error if it does not exist
+func inspectVolume(d volume.VolumeDriver, id string) (*api.Volume, error) {
+	vols, err := d.Inspect([]string{id})
+	if (err == nil && len(vols) == 0) ||
+		(err != nil && err == volume.ErrEnoEnt) {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"Volume id %s not found",
+			id)
+	} else if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to inspect volume %s: %v",
+			id,
+			err.Error())
+	}
+	return vols[0], nil
+}
+
+// ownershipForNewVolume returns the ownership of a volume created by the
+// caller. Volumes without an owner are owned by the caller, and only
+// administrators can create volumes on behalf of other users.
+func ownershipForNewVolume(
+	ctx context.Context,
+	requested *api.Ownership,
+) (*api.Ownership, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok || claims == nil {
+		return requested, nil
+	}
+
+	ownership := requested.Copy()
+	if ownership == nil {
+		ownership = &api.Ownership{}
+	}
+	if len(ownership.GetOwner()) == 0 {
+		ownership.Owner = claims.Subject
+	} else if ownership.GetOwner() != claims.Subject && !claims.IsAdmin() {
+		return nil, status.Errorf(
+			codes.PermissionDenied,
+			"User %s cannot create volumes owned by %s",
+			claims.Subject,
+			ownership.GetOwner())
+	}
+	return ownership, nil
+}
+
+// setOwnership sets the ownership of a volume created from another volume,
+// unless the driver has already set it.
+func (s *VolumeServer) setOwnership(id string, ownership *api.Ownership) error {
+	vols, err := s.driver.Inspect([]string{id})
+	if err != nil || len(vols) == 0 {
+		return status.Errorf(
+			codes.Internal,
+			"Failed to inspect volume %s: %v",
+			id,
+			err)
+	}
+	if proto.Equal(vols[0].GetSpec().GetOwnership(), ownership) {
+		return nil
+	}
+
+	spec := &api.VolumeSpec{}
+	if vols[0].GetSpec() != nil {
+		spec = vols[0].GetSpec().Copy()
+	}
+	spec.Ownership = ownership.Copy()
+	if err := s.driver.Set(id, nil, spec); err != nil {
+		return status.Errorf(
+			codes.Internal,
+			"Failed to set the ownership of volume %s: %v",
+			id,
+			err.Error())
+	}
+	return nil
+}
+
+// checkOwnershipUpdate returns a PermissionDenied error if the caller is
+// not allowed to change the ownership of the volume to the one requested
+func checkOwnershipUpdate(ctx context.Context, v *api.Volume, requested *api.Ownership) error {
+	current := v.GetSpec().GetOwnership()
+	if proto.Equal(current, requested) {
+		return nil
+	}
+	claims, ok := accessClaims(ctx)
+	if !ok {
+		return nil
+	}
+	if !current.IsPermittedToUpdate(claims.Subject, claims.Groups, requested) {
+		return status.Errorf(
+			codes.PermissionDenied,
+			"User %s is not allowed to change the ownership of volume %s",
+			claims.Subject,
+			v.GetId())
+	}
+	return nil
+}
@@ -0,0 +1,409 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+)
+
+func assertPermissionDenied(t *testing.T, err error) {
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.PermissionDenied)
+}
+
+func newTestOwnedVolume(id, owner string) *api.Volume {
+	return &api.Volume{
+		Id: id,
+		Spec: &api.VolumeSpec{
+			Size: 1234,
+			Ownership: &api.Ownership{
+				Owner: owner,
+				Acls: &api.OwnershipAcls{
+					Collaborators: map[string]api.OwnershipAccessType{
+						"reader": api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ,
+					},
+					Groups: map[string]api.OwnershipAccessType{
+						"writers": api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSdkVolumeOwnershipCreate(t *testing.T) {
+	s := newTestAuthServer(t)
+	defer s.Stop()
+
+	name := "myvol"
+	size := uint64(1234)
+	id := "myid"
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{name}).
+		Return(nil, nil).
+		Times(3)
+	s.MockDriver().
+		EXPECT().
+		Enumerate(&api.VolumeLocator{Name: name}, nil).
+		Return(nil, nil).
+		Times(3)
+
+	// The caller owns the volumes it creates
+	s.MockDriver().
+		EXPECT().
+		Create(&api.VolumeLocator{Name: name}, &api.Source{}, &api.VolumeSpec{
+			Size:      size,
+			Ownership: &api.Ownership{Owner: "user1"},
+		}).
+		Return(id, nil).
+		Times(1)
+
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+	user := contextWithToken(newTestToken(t, "user1", auth.RoleVolumeUser))
+	r, err := c.Create(user, &api.SdkVolumeCreateRequest{
+		Name: name,
+		Spec: &api.VolumeSpec{Size: size},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, id, r.GetVolumeId())
+
+	// Users cannot create volumes for other users
+	_, err = c.Create(user, &api.SdkVolumeCreateRequest{
+		Name: name,
+		Spec: &api.VolumeSpec{
+			Size:      size,
+			Ownership: &api.Ownership{Owner: "user2"},
+		},
+	})
+	assertPermissionDenied(t, err)
+
+	// Administrators can
+	s.MockDriver().
+		EXPECT().
+		Create(&api.VolumeLocator{Name: name}, &api.Source{}, &api.VolumeSpec{
+			Size:      size,
+			Ownership: &api.Ownership{Owner: "user2"},
+		}).
+		Return(id, nil).
+		Times(1)
+	admin := contextWithToken(newTestToken(t, "admin1", auth.RoleAdmin))
+	_, err = c.Create(admin, &api.SdkVolumeCreateRequest{
+		Name: name,
+		Spec: &api.VolumeSpec{
+			Size:      size,
+			Ownership: &api.Ownership{Owner: "user2"},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestSdkVolumeOwnershipAccess(t *testing.T) {
+	s := newTestAuthServer(t)
+	defer s.Stop()
+
+	id := "myvol"
+	path := "/mnt/myvol"
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{newTestOwnedVolume(id, "owner")}, nil).
+		AnyTimes()
+
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+	owner := contextWithToken(newTestToken(t, "owner", auth.RoleVolumeUser))
+	reader := contextWithToken(newTestToken(t, "reader", auth.RoleVolumeUser))
+	writer := contextWithToken(
+		newTestTokenWithGroups(t, "writer", []string{"writers"}, auth.RoleVolumeUser))
+	stranger := contextWithToken(newTestToken(t, "stranger", auth.RoleVolumeUser))
+
+	// Read access
+	_, err := c.Inspect(reader, &api.SdkVolumeInspectRequest{VolumeId: id})
+	assert.NoError(t, err)
+	_, err = c.Inspect(stranger, &api.SdkVolumeInspectRequest{VolumeId: id})
+	assertPermissionDenied(t, err)
+
+	// Write access through a group
+	s.MockDriver().
+		EXPECT().
+		Mount(id, path, nil).
+		Return(nil).
+		Times(1)
+	_, err = c.Mount(writer, &api.SdkVolumeMountRequest{VolumeId: id, MountPath: path})
+	assert.NoError(t, err)
+	_, err = c.Mount(reader, &api.SdkVolumeMountRequest{VolumeId: id, MountPath: path})
+	assertPermissionDenied(t, err)
+
+	// Only the owner can delete
+	_, err = c.Delete(writer, &api.SdkVolumeDeleteRequest{VolumeId: id})
+	assertPermissionDenied(t, err)
+	s.MockDriver().
+		EXPECT().
+		Delete(id).
+		Return(nil).
+		Times(1)
+	_, err = c.Delete(owner, &api.SdkVolumeDeleteRequest{VolumeId: id})
+	assert.NoError(t, err)
+}
+
+func TestSdkVolumeOwnershipEnumerate(t *testing.T) {
+	s := newTestAuthServer(t)
+	defer s.Stop()
+
+	s.MockDriver().
+		EXPECT().
+		Enumerate(nil, nil).
+		Return([]*api.Volume{
+			&api.Volume{Id: "public"},
+			newTestOwnedVolume("mine", "user1"),
+			newTestOwnedVolume("other", "user2"),
+		}, nil).
+		Times(2)
+
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+	user := contextWithToken(newTestToken(t, "user1", auth.RoleVolumeUser))
+	r, err := c.Enumerate(user, &api.SdkVolumeEnumerateRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, r.GetVolumes(), 2)
+	assert.Equal(t, "public", r.GetVolumes()[0].GetId())
+	assert.Equal(t, "mine", r.GetVolumes()[1].GetId())
+
+	admin := contextWithToken(newTestToken(t, "admin1", auth.RoleAdmin))
+	r, err = c.Enumerate(admin, &api.SdkVolumeEnumerateRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, r.GetVolumes(), 3)
+}
+
+func TestSdkVolumeOwnershipUpdate(t *testing.T) {
+	s := newTestAuthServer(t)
+	defer s.Stop()
+
+	id := "myvol"
+	v := newTestOwnedVolume(id, "owner")
+	v.Spec.Ownership.Acls.Collaborators["manager"] =
+		api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_ADMIN
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{v}, nil).
+		AnyTimes()
+
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+	manager := contextWithToken(newTestToken(t, "manager", auth.RoleVolumeUser))
+	writer := contextWithToken(
+		newTestTokenWithGroups(t, "writer", []string{"writers"}, auth.RoleVolumeUser))
+
+	// Writers cannot change the acls
+	spec := v.GetSpec().Copy()
+	spec.Ownership = v.GetSpec().GetOwnership().Copy()
+	spec.Ownership.Acls.Collaborators["writer"] =
+		api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_ADMIN
+	_, err := c.Update(writer, &api.SdkVolumeUpdateRequest{VolumeId: id, Spec: spec})
+	assertPermissionDenied(t, err)
+
+	// Collaborators with admin access can
+	s.MockDriver().
+		EXPECT().
+		Set(id, nil, gomock.Any()).
+		Return(nil).
+		Times(1)
+	_, err = c.Update(manager, &api.SdkVolumeUpdateRequest{VolumeId: id, Spec: spec})
+	assert.NoError(t, err)
+
+	// But only the owner can transfer the volume
+	spec = v.GetSpec().Copy()
+	spec.Ownership = v.GetSpec().GetOwnership().Copy()
+	spec.Ownership.Owner = "manager"
+	_, err = c.Update(manager, &api.SdkVolumeUpdateRequest{VolumeId: id, Spec: spec})
+	assertPermissionDenied(t, err)
+}
+
+func TestSdkVolumeOwnershipSnapshotCreate(t *testing.T) {
+	s := newTestAuthServer(t)
+	defer s.Stop()
+
+	id := "myvol"
+	snapID := "mysnap"
+	v := newTestOwnedVolume(id, "owner")
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{v}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Snapshot(id, true, gomock.Any()).
+			Return(snapID, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{snapID}).
+			Return([]*api.Volume{&api.Volume{
+				Id:   snapID,
+				Spec: &api.VolumeSpec{Size: 1234},
+			}}, nil).
+			Times(1),
+
+		// The snapshot inherits the ownership of the volume
+		s.MockDriver().
+			EXPECT().
+			Set(snapID, nil, &api.VolumeSpec{
+				Size:      1234,
+				Ownership: v.GetSpec().GetOwnership(),
+			}).
+			Return(nil).
+			Times(1),
+	)
+
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+	writer := contextWithToken(
+		newTestTokenWithGroups(t, "writer", []string{"writers"}, auth.RoleVolumeUser))
+	r, err := c.SnapshotCreate(writer, &api.SdkVolumeSnapshotCreateRequest{VolumeId: id})
+	assert.NoError(t, err)
+	assert.Equal(t, snapID, r.GetSnapshotId())
+
+	// Readers cannot create snapshots
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{v}, nil).
+		Times(1)
+	reader := contextWithToken(newTestToken(t, "reader", auth.RoleVolumeUser))
+	_, err = c.SnapshotCreate(reader, &api.SdkVolumeSnapshotCreateRequest{VolumeId: id})
+	assertPermissionDenied(t, err)
+
+	// The snapshots of administrators inherit the ownership of the volume
+	// too, and are deleted if it cannot be set
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{v}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Snapshot(id, true, gomock.Any()).
+			Return(snapID, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{snapID}).
+			Return([]*api.Volume{&api.Volume{Id: snapID}}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Set(snapID, nil, &api.VolumeSpec{Ownership: v.GetSpec().GetOwnership()}).
+			Return(fmt.Errorf("MOCK ERROR")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Delete(snapID).
+			Return(nil).
+			Times(1),
+	)
+	admin := contextWithToken(newTestToken(t, "admin1", auth.RoleAdmin))
+	_, err = c.SnapshotCreate(admin, &api.SdkVolumeSnapshotCreateRequest{VolumeId: id})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Internal, serverError.Code())
+}
+
+func TestSdkVolumeOwnershipCloudBackup(t *testing.T) {
+	s := newTestAuthServer(t)
+	defer s.Stop()
+
+	id := "myvol"
+	cred := "mycred"
+	v := newTestOwnedVolume(id, "owner")
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{v}, nil).
+		AnyTimes()
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{"othervol"}).
+		Return([]*api.Volume{&api.Volume{
+			Id:   "othervol",
+			Spec: &api.VolumeSpec{Ownership: &api.Ownership{Owner: "other"}},
+		}}, nil).
+		AnyTimes()
+
+	c := api.NewOpenStorageCloudBackupClient(s.Conn())
+	owner := contextWithToken(newTestToken(t, "owner", auth.RoleVolumeUser))
+	reader := contextWithToken(newTestToken(t, "reader", auth.RoleVolumeUser))
+
+	// Only writers can delete the backups of a volume
+	_, err := c.DeleteAll(reader, &api.SdkCloudBackupDeleteAllRequest{
+		SrcVolumeId:    id,
+		CredentialUuid: cred,
+	})
+	assertPermissionDenied(t, err)
+	s.MockDriver().
+		EXPECT().
+		CloudBackupDeleteAll(gomock.Any()).
+		Return(nil).
+		Times(1)
+	_, err = c.DeleteAll(owner, &api.SdkCloudBackupDeleteAllRequest{
+		SrcVolumeId:    id,
+		CredentialUuid: cred,
+	})
+	assert.NoError(t, err)
+
+	// Only the backups of the volumes the caller can read are enumerated
+	s.MockDriver().
+		EXPECT().
+		CloudBackupEnumerate(gomock.Any()).
+		Return(&api.CloudBackupEnumerateResponse{
+			Backups: []api.CloudBackupInfo{
+				{ID: "backup1", SrcVolumeID: id},
+				{ID: "backup2", SrcVolumeID: "othervol"},
+			},
+		}, nil).
+		Times(1)
+	r, err := c.Enumerate(reader, &api.SdkCloudBackupEnumerateRequest{
+		CredentialUuid: cred,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, r.GetBackups(), 1)
+	assert.Equal(t, "backup1", r.GetBackups()[0].GetId())
+
+	// The calls for a backup id are left to administrators
+	_, err = c.Delete(owner, &api.SdkCloudBackupDeleteRequest{
+		BackupId:       "backup1",
+		CredentialUuid: cred,
+	})
+	assertPermissionDenied(t, err)
+	_, err = c.Restore(owner, &api.SdkCloudBackupRestoreRequest{
+		BackupId:       "backup1",
+		CredentialUuid: cred,
+	})
+	assertPermissionDenied(t, err)
+}
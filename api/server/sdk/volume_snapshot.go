@@ -18,11 +18,16 @@ package sdk
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
 )
 
 // SnapshotCreate creates a read-only snapshot of a volume
@@ -35,6 +40,16 @@ func (s *VolumeServer) SnapshotCreate(
 		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
 	}
 
+	// The volume is always inspected since its snapshot inherits its
+	// ownership, whoever the caller is
+	parent, err := inspectVolume(s.driver, req.GetVolumeId())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAccess(ctx, parent, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); err != nil {
+		return nil, err
+	}
+
 	readonly := true
 	snapshotID, err := s.driver.Snapshot(req.GetVolumeId(), readonly, &api.VolumeLocator{
 		VolumeLabels: req.GetLabels(),
@@ -43,6 +58,16 @@ func (s *VolumeServer) SnapshotCreate(
 		return nil, status.Errorf(codes.Internal, "Failed to create snapshot: %v", err.Error())
 	}
 
+	// Snapshots inherit the ownership of their volume. A snapshot whose
+	// ownership cannot be set is deleted rather than left public.
+	if err := s.setOwnership(snapshotID, parent.GetSpec().GetOwnership()); err != nil {
+		if derr := s.driver.Delete(snapshotID); derr != nil {
+			logrus.Warnf("Failed to delete snapshot %s of volume %s: %v",
+				snapshotID, req.GetVolumeId(), derr)
+		}
+		return nil, err
+	}
+
 	return &api.SdkVolumeSnapshotCreateResponse{
 		SnapshotId: snapshotID,
 	}, nil
@@ -60,6 +85,13 @@ func (s *VolumeServer) SnapshotRestore(
 		return nil, status.Error(codes.InvalidArgument, "Must supply snapshot id")
 	}
 
+	if _, err := checkAccessById(ctx, s.driver, req.GetVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); err != nil {
+		return nil, err
+	}
+	if _, err := checkAccessById(ctx, s.driver, req.GetSnapshotId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ); err != nil {
+		return nil, err
+	}
+
 	err := s.driver.Restore(req.GetVolumeId(), req.GetSnapshotId())
 	if err != nil {
 		return nil, status.Errorf(
@@ -83,6 +115,10 @@ func (s *VolumeServer) SnapshotEnumerate(
 		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
 	}
 
+	if _, err := checkAccessById(ctx, s.driver, req.GetVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ); err != nil {
+		return nil, err
+	}
+
 	snapshots, err := s.driver.SnapEnumerate([]string{req.GetVolumeId()}, req.GetLabels())
 	if err != nil {
 		return nil, status.Errorf(
@@ -92,7 +128,240 @@ func (s *VolumeServer) SnapshotEnumerate(
 			err.Error())
 	}
 
+	// Only return the snapshots the caller has access to
+	if _, ok := accessClaims(ctx); ok {
+		permitted := make([]*api.Volume, 0, len(snapshots))
+		for _, snap := range snapshots {
+			if isPermitted(ctx, snap, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ) {
+				permitted = append(permitted, snap)
+			}
+		}
+		snapshots = permitted
+	}
+
 	return &api.SdkVolumeSnapshotEnumerateResponse{
 		Snapshots: snapshots,
 	}, nil
 }
+
+// SnapshotGroupCreate creates a consistent snapshot of all the volumes in a group
+func (s *VolumeServer) SnapshotGroupCreate(
+	ctx context.Context,
+	req *api.SdkVolumeSnapshotGroupCreateRequest,
+) (*api.SdkVolumeSnapshotGroupCreateResponse, error) {
+
+	if len(req.GetGroupId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Must supply group id")
+	}
+
+	// The volumes of the group are needed to check the access of the
+	// caller, to quiesce them and to set the ownership of their snapshots
+	groupVolumes, err := s.groupVolumes(ctx, req.GetGroupId())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetQuiesce() {
+
+		// Unquiesce all the volumes which were quiesced, whatever the result
+		var quiesced []string
+		defer func() {
+			for _, id := range quiesced {
+				if err := s.driver.Unquiesce(id); err != nil {
+					logrus.Warnf("Failed to unquiesce volume %s of group %s: %v",
+						id, req.GetGroupId(), err)
+				}
+			}
+		}()
+
+		quiesceID := "group-" + req.GetGroupId()
+		for _, v := range groupVolumes {
+			id := v.GetId()
+			err := s.driver.Quiesce(id, req.GetQuiesceTimeoutSeconds(), quiesceID)
+			if err == volume.ErrNotSupported {
+				return nil, status.Errorf(
+					codes.Unimplemented,
+					"Volume driver does not support quiesce: %v",
+					err.Error())
+			} else if err != nil {
+				return nil, status.Errorf(
+					codes.Internal,
+					"Failed to quiesce volume %s of group %s: %v",
+					id,
+					req.GetGroupId(),
+					err.Error())
+			}
+			quiesced = append(quiesced, id)
+		}
+	}
+
+	resp, err := s.driver.SnapshotGroup(req.GetGroupId(), req.GetLabels())
+	if err == volume.ErrNotSupported {
+		return nil, status.Errorf(
+			codes.Unimplemented,
+			"Volume driver does not support group snapshots: %v",
+			err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to create snapshot of group %s: %v",
+			req.GetGroupId(),
+			err.Error())
+	} else if len(resp.GetError()) != 0 {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to create snapshot of group %s: %s",
+			req.GetGroupId(),
+			resp.GetError())
+	}
+
+	// The group snapshot is not consistent if the snapshot of any volume
+	// failed, so the snapshots which were created are deleted
+	snapshots := make(map[string]string, len(resp.GetSnapshots()))
+	var failures []string
+	for volumeID, snap := range resp.GetSnapshots() {
+		snapshotID := snap.GetVolumeCreateResponse().GetId()
+		snapErr := snap.GetVolumeCreateResponse().GetVolumeResponse().GetError()
+		if len(snapErr) == 0 && len(snapshotID) == 0 {
+			snapErr = "no snapshot was created"
+		}
+		if len(snapErr) != 0 {
+			failures = append(failures, fmt.Sprintf("%s (%s)", volumeID, snapErr))
+			continue
+		}
+		snapshots[volumeID] = snapshotID
+	}
+	if len(failures) != 0 {
+		s.deleteGroupSnapshots(req.GetGroupId(), snapshots)
+		sort.Strings(failures)
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to create snapshot of group %s for volumes %s",
+			req.GetGroupId(),
+			strings.Join(failures, ", "))
+	}
+
+	// Snapshots inherit the ownership of their volume. The snapshots are
+	// deleted if the ownership of any of them cannot be set rather than
+	// left public.
+	for _, v := range groupVolumes {
+		if snapshotID, ok := snapshots[v.GetId()]; ok {
+			if err := s.setOwnership(snapshotID, v.GetSpec().GetOwnership()); err != nil {
+				s.deleteGroupSnapshots(req.GetGroupId(), snapshots)
+				return nil, err
+			}
+		}
+	}
+
+	return &api.SdkVolumeSnapshotGroupCreateResponse{
+		Snapshots: snapshots,
+	}, nil
+}
+
+// deleteGroupSnapshots deletes the snapshots, by volume id, of a group
+// snapshot which failed
+func (s *VolumeServer) deleteGroupSnapshots(groupID string, snapshots map[string]string) {
+	for volumeID, snapshotID := range snapshots {
+		if err := s.driver.Delete(snapshotID); err != nil {
+			logrus.Warnf("Failed to delete snapshot %s of volume %s of group %s: %v",
+				snapshotID, volumeID, groupID, err)
+		}
+	}
+}
+
+// groupVolumes returns the volumes in a group. The caller must have write
+// access to all of them.
+func (s *VolumeServer) groupVolumes(
+	ctx context.Context,
+	groupID string,
+) ([]*api.Volume, error) {
+	vols, err := s.driver.Enumerate(nil, nil)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to enumerate volumes of group %s: %v",
+			groupID,
+			err.Error())
+	}
+
+	var group []*api.Volume
+	for _, v := range vols {
+		if v.GetGroup().GetId() == groupID ||
+			v.GetSpec().GetGroup().GetId() == groupID {
+			if err := checkAccess(ctx, v, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); err != nil {
+				return nil, err
+			}
+			group = append(group, v)
+		}
+	}
+	if len(group) == 0 {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"No volumes found in group %s",
+			groupID)
+	}
+
+	return group, nil
+}
+
+// Quiesce freezes the filesystem of a mounted volume
+func (s *VolumeServer) Quiesce(
+	ctx context.Context,
+	req *api.SdkVolumeQuiesceRequest,
+) (*api.SdkVolumeQuiesceResponse, error) {
+
+	if len(req.GetVolumeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
+	}
+
+	if _, err := checkAccessById(ctx, s.driver, req.GetVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); err != nil {
+		return nil, err
+	}
+
+	err := s.driver.Quiesce(req.GetVolumeId(), req.GetTimeoutSeconds(), req.GetQuiesceId())
+	if err == volume.ErrNotSupported {
+		return nil, status.Errorf(
+			codes.Unimplemented,
+			"Volume driver does not support quiesce: %v",
+			err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to quiesce volume %s: %v",
+			req.GetVolumeId(),
+			err.Error())
+	}
+
+	return &api.SdkVolumeQuiesceResponse{}, nil
+}
+
+// Unquiesce unfreezes the filesystem of a quiesced volume
+func (s *VolumeServer) Unquiesce(
+	ctx context.Context,
+	req *api.SdkVolumeUnquiesceRequest,
+) (*api.SdkVolumeUnquiesceResponse, error) {
+
+	if len(req.GetVolumeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
+	}
+
+	if _, err := checkAccessById(ctx, s.driver, req.GetVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); err != nil {
+		return nil, err
+	}
+
+	err := s.driver.Unquiesce(req.GetVolumeId())
+	if err == volume.ErrNotSupported {
+		return nil, status.Errorf(
+			codes.Unimplemented,
+			"Volume driver does not support quiesce: %v",
+			err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to unquiesce volume %s: %v",
+			req.GetVolumeId(),
+			err.Error())
+	}
+
+	return &api.SdkVolumeUnquiesceResponse{}, nil
+}
@@ -18,13 +18,16 @@ package sdk
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
 )
 
 func TestSdkVolumeSnapshotCreateBadArguments(t *testing.T) {
@@ -61,12 +64,33 @@ func TestSdkVolumeSnapshotCreate(t *testing.T) {
 		VolumeId: volid,
 	}
 
-	// Create response
-	s.MockDriver().
-		EXPECT().
-		Snapshot(req.GetVolumeId(), true, &api.VolumeLocator{}).
-		Return(snapid, nil).
-		Times(1)
+	// Create response. The snapshot inherits the ownership of the volume.
+	ownership := &api.Ownership{Owner: "owner"}
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{volid}).
+			Return([]*api.Volume{&api.Volume{
+				Id:   volid,
+				Spec: &api.VolumeSpec{Ownership: ownership},
+			}}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Snapshot(req.GetVolumeId(), true, &api.VolumeLocator{}).
+			Return(snapid, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{snapid}).
+			Return([]*api.Volume{&api.Volume{Id: snapid}}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Set(snapid, nil, &api.VolumeSpec{Ownership: ownership}).
+			Return(nil).
+			Times(1),
+	)
 
 	// Setup client
 	c := api.NewOpenStorageVolumeClient(s.Conn())
@@ -200,3 +224,390 @@ func TestSdkVolumeSnapshotEnumerate(t *testing.T) {
 	assert.Len(t, r.GetSnapshots(), 1)
 	assert.Equal(t, r.GetSnapshots()[0].Id, snapid)
 }
+
+func TestSdkVolumeSnapshotGroupCreate(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	groupID := "mygroup"
+	labels := map[string]string{
+		"backup": "daily",
+	}
+
+	ownership := &api.Ownership{Owner: "owner"}
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Enumerate(nil, nil).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:    "vol1",
+					Group: &api.Group{Id: groupID},
+					Spec:  &api.VolumeSpec{Ownership: ownership},
+				},
+				&api.Volume{
+					Id:    "vol2",
+					Group: &api.Group{Id: groupID},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			SnapshotGroup(groupID, labels).
+			Return(&api.GroupSnapCreateResponse{
+				Snapshots: map[string]*api.SnapCreateResponse{
+					"vol1": &api.SnapCreateResponse{
+						VolumeCreateResponse: &api.VolumeCreateResponse{
+							Id: "snap1",
+						},
+					},
+					"vol2": &api.SnapCreateResponse{
+						VolumeCreateResponse: &api.VolumeCreateResponse{
+							Id: "snap2",
+						},
+					},
+				},
+			}, nil).
+			Times(1),
+
+		// The snapshots inherit the ownership of their volume
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{"snap1"}).
+			Return([]*api.Volume{&api.Volume{Id: "snap1"}}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Set("snap1", nil, &api.VolumeSpec{Ownership: ownership}).
+			Return(nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{"snap2"}).
+			Return([]*api.Volume{&api.Volume{Id: "snap2"}}, nil).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	// Create the snapshots
+	r, err := c.SnapshotGroupCreate(context.Background(), &api.SdkVolumeSnapshotGroupCreateRequest{
+		GroupId: groupID,
+		Labels:  labels,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, r.GetSnapshots(), map[string]string{
+		"vol1": "snap1",
+		"vol2": "snap2",
+	})
+}
+
+func TestSdkVolumeSnapshotGroupCreateWithQuiesce(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	groupID := "mygroup"
+	timeout := uint64(30)
+	quiesceID := "group-" + groupID
+
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Enumerate(nil, nil).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:    "vol1",
+					Group: &api.Group{Id: groupID},
+				},
+				&api.Volume{
+					Id: "vol2",
+					Spec: &api.VolumeSpec{
+						Group: &api.Group{Id: groupID},
+					},
+				},
+				&api.Volume{
+					Id:    "other",
+					Group: &api.Group{Id: "othergroup"},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Quiesce("vol1", timeout, quiesceID).
+			Return(nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Quiesce("vol2", timeout, quiesceID).
+			Return(nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			SnapshotGroup(groupID, nil).
+			Return(&api.GroupSnapCreateResponse{
+				Snapshots: map[string]*api.SnapCreateResponse{
+					"vol1": &api.SnapCreateResponse{
+						VolumeCreateResponse: &api.VolumeCreateResponse{
+							Id: "snap1",
+						},
+					},
+					"vol2": &api.SnapCreateResponse{
+						VolumeCreateResponse: &api.VolumeCreateResponse{
+							Id: "snap2",
+						},
+					},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{"snap1"}).
+			Return([]*api.Volume{&api.Volume{Id: "snap1"}}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{"snap2"}).
+			Return([]*api.Volume{&api.Volume{Id: "snap2"}}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Unquiesce("vol1").
+			Return(nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Unquiesce("vol2").
+			Return(nil).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	// Create the snapshots
+	r, err := c.SnapshotGroupCreate(context.Background(), &api.SdkVolumeSnapshotGroupCreateRequest{
+		GroupId:               groupID,
+		Quiesce:               true,
+		QuiesceTimeoutSeconds: timeout,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, r.GetSnapshots(), 2)
+}
+
+func TestSdkVolumeSnapshotGroupCreateUnquiesceOnFailure(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	groupID := "mygroup"
+	quiesceID := "group-" + groupID
+
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Enumerate(nil, nil).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:    "vol1",
+					Group: &api.Group{Id: groupID},
+				},
+				&api.Volume{
+					Id:    "vol2",
+					Group: &api.Group{Id: groupID},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Quiesce("vol1", uint64(0), quiesceID).
+			Return(nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Quiesce("vol2", uint64(0), quiesceID).
+			Return(fmt.Errorf("MOCK ERROR")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Unquiesce("vol1").
+			Return(nil).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	// Create the snapshots
+	_, err := c.SnapshotGroupCreate(context.Background(), &api.SdkVolumeSnapshotGroupCreateRequest{
+		GroupId: groupID,
+		Quiesce: true,
+	})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Internal)
+	assert.Contains(t, serverError.Message(), "MOCK ERROR")
+}
+
+func TestSdkVolumeSnapshotGroupCreateBadArguments(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	_, err := c.SnapshotGroupCreate(context.Background(), &api.SdkVolumeSnapshotGroupCreateRequest{})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.InvalidArgument)
+	assert.Contains(t, serverError.Message(), "group id")
+}
+
+func TestSdkVolumeQuiesce(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	id := "myvol"
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Quiesce(id, uint64(10), "myquiesce").
+			Return(nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Unquiesce(id).
+			Return(volume.ErrNotSupported).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	_, err := c.Quiesce(context.Background(), &api.SdkVolumeQuiesceRequest{
+		VolumeId:       id,
+		TimeoutSeconds: 10,
+		QuiesceId:      "myquiesce",
+	})
+	assert.NoError(t, err)
+
+	_, err = c.Unquiesce(context.Background(), &api.SdkVolumeUnquiesceRequest{
+		VolumeId: id,
+	})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Unimplemented)
+}
+
+func TestSdkVolumeSnapshotGroupCreateQuiesceNotSupported(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	groupID := "mygroup"
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Enumerate(nil, nil).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:    "vol1",
+					Group: &api.Group{Id: groupID},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Quiesce("vol1", uint64(0), "group-"+groupID).
+			Return(volume.ErrNotSupported).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	_, err := c.SnapshotGroupCreate(context.Background(), &api.SdkVolumeSnapshotGroupCreateRequest{
+		GroupId: groupID,
+		Quiesce: true,
+	})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Unimplemented)
+}
+
+func TestSdkVolumeSnapshotGroupCreatePartialFailure(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	groupID := "mygroup"
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Enumerate(nil, nil).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:    "vol1",
+					Group: &api.Group{Id: groupID},
+				},
+				&api.Volume{
+					Id:    "vol2",
+					Group: &api.Group{Id: groupID},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			SnapshotGroup(groupID, nil).
+			Return(&api.GroupSnapCreateResponse{
+				Snapshots: map[string]*api.SnapCreateResponse{
+					"vol1": &api.SnapCreateResponse{
+						VolumeCreateResponse: &api.VolumeCreateResponse{
+							Id: "snap1",
+						},
+					},
+					"vol2": &api.SnapCreateResponse{
+						VolumeCreateResponse: &api.VolumeCreateResponse{
+							VolumeResponse: &api.VolumeResponse{
+								Error: "MOCK ERROR",
+							},
+						},
+					},
+				},
+			}, nil).
+			Times(1),
+		// The snapshot which was created is deleted
+		s.MockDriver().
+			EXPECT().
+			Delete("snap1").
+			Return(nil).
+			Times(1),
+	)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	_, err := c.SnapshotGroupCreate(context.Background(), &api.SdkVolumeSnapshotGroupCreateRequest{
+		GroupId: groupID,
+	})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Internal)
+	assert.Contains(t, serverError.Message(), "vol2 (MOCK ERROR)")
+}
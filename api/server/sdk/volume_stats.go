@@ -0,0 +1,150 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+)
+
+// Stats returns the IO statistics of a volume
+func (s *VolumeServer) Stats(
+	ctx context.Context,
+	req *api.SdkVolumeStatsRequest,
+) (*api.SdkVolumeStatsResponse, error) {
+
+	if len(req.GetVolumeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
+	}
+
+	if _, err := checkAccessById(ctx, s.driver, req.GetVolumeId(), api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.driver.Stats(req.GetVolumeId(), !req.GetNotCumulative())
+	if err == volume.ErrEnoEnt {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"Volume id %s not found",
+			req.GetVolumeId())
+	} else if err == volume.ErrNotSupported {
+		return nil, status.Errorf(
+			codes.Unimplemented,
+			"Volume driver does not support statistics: %v",
+			err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to obtain stats for volume %s: %v",
+			req.GetVolumeId(),
+			err.Error())
+	} else if stats == nil {
+		stats = &api.Stats{}
+	}
+
+	return &api.SdkVolumeStatsResponse{
+		Stats:           stats,
+		WriteThroughput: stats.WriteThroughput(),
+		ReadThroughput:  stats.ReadThroughput(),
+		Latency:         stats.Latency(),
+		ReadLatency:     stats.ReadLatency(),
+		WriteLatency:    stats.WriteLatency(),
+		Iops:            stats.Iops(),
+	}, nil
+}
+
+// CapacityUsage returns the used and provisioned capacity of a volume
+func (s *VolumeServer) CapacityUsage(
+	ctx context.Context,
+	req *api.SdkVolumeCapacityUsageRequest,
+) (*api.SdkVolumeCapacityUsageResponse, error) {
+
+	if len(req.GetVolumeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Must supply volume id")
+	}
+
+	vols, err := s.driver.Inspect([]string{req.GetVolumeId()})
+	if (err == nil && len(vols) == 0) ||
+		(err != nil && err == volume.ErrEnoEnt) {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"Volume id %s not found",
+			req.GetVolumeId())
+	} else if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to inspect volume %s: %v",
+			req.GetVolumeId(),
+			err.Error())
+	}
+	if err := checkAccess(ctx, vols[0], api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ); err != nil {
+		return nil, err
+	}
+
+	used, err := s.driver.UsedSize(req.GetVolumeId())
+	if err == volume.ErrEnoEnt {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"Volume id %s not found",
+			req.GetVolumeId())
+	} else if err == volume.ErrNotSupported {
+		return nil, status.Errorf(
+			codes.Unimplemented,
+			"Volume driver does not support capacity usage: %v",
+			err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to obtain used size of volume %s: %v",
+			req.GetVolumeId(),
+			err.Error())
+	}
+
+	return &api.SdkVolumeCapacityUsageResponse{
+		UsedBytes:  used,
+		TotalBytes: vols[0].GetSpec().GetSize(),
+	}, nil
+}
+
+// ActiveRequests returns the IO requests in progress in the volume driver
+func (s *VolumeServer) ActiveRequests(
+	ctx context.Context,
+	req *api.SdkVolumeActiveRequestsRequest,
+) (*api.SdkVolumeActiveRequestsResponse, error) {
+
+	requests, err := s.driver.GetActiveRequests()
+	if err == volume.ErrNotSupported {
+		return nil, status.Errorf(
+			codes.Unimplemented,
+			"Volume driver does not support active requests: %v",
+			err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Failed to obtain active requests: %v",
+			err.Error())
+	}
+
+	return &api.SdkVolumeActiveRequestsResponse{
+		ActiveRequests: requests,
+	}, nil
+}
@@ -0,0 +1,211 @@
+/*
+Package sdk is the gRPC implementation of the SDK gRPC server
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+)
+
+func TestSdkVolumeStats(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	id := "myid"
+	stats := &api.Stats{
+		Reads:      10,
+		ReadMs:     20,
+		ReadBytes:  4096,
+		Writes:     30,
+		WriteMs:    40,
+		WriteBytes: 8192,
+		IoMs:       60,
+		BytesUsed:  1234,
+		IntervalMs: 2000,
+	}
+	s.MockDriver().
+		EXPECT().
+		Stats(id, false).
+		Return(stats, nil).
+		Times(1)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	// Get info
+	r, err := c.Stats(context.Background(), &api.SdkVolumeStatsRequest{
+		VolumeId:      id,
+		NotCumulative: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, r.GetStats().GetBytesUsed(), stats.GetBytesUsed())
+	assert.Equal(t, r.GetWriteThroughput(), stats.WriteThroughput())
+	assert.Equal(t, r.GetReadThroughput(), stats.ReadThroughput())
+	assert.Equal(t, r.GetLatency(), stats.Latency())
+	assert.Equal(t, r.GetReadLatency(), stats.ReadLatency())
+	assert.Equal(t, r.GetWriteLatency(), stats.WriteLatency())
+	assert.Equal(t, r.GetIops(), uint64(20))
+}
+
+func TestSdkVolumeStatsErrors(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	id := "myid"
+	s.MockDriver().
+		EXPECT().
+		Stats(id, true).
+		Return(nil, volume.ErrEnoEnt).
+		Times(1)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	// Missing volume id
+	_, err := c.Stats(context.Background(), &api.SdkVolumeStatsRequest{})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.InvalidArgument)
+
+	// Volume not found
+	_, err = c.Stats(context.Background(), &api.SdkVolumeStatsRequest{
+		VolumeId: id,
+	})
+	assert.Error(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.NotFound)
+}
+
+func TestSdkVolumeCapacityUsage(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	id := "myid"
+	size := uint64(4096)
+	used := uint64(1024)
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{
+			&api.Volume{
+				Id: id,
+				Spec: &api.VolumeSpec{
+					Size: size,
+				},
+			},
+		}, nil).
+		Times(1)
+	s.MockDriver().
+		EXPECT().
+		UsedSize(id).
+		Return(used, nil).
+		Times(1)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	// Get info
+	r, err := c.CapacityUsage(context.Background(), &api.SdkVolumeCapacityUsageRequest{
+		VolumeId: id,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, r.GetUsedBytes(), used)
+	assert.Equal(t, r.GetTotalBytes(), size)
+}
+
+func TestSdkVolumeCapacityUsageNotSupported(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	id := "myid"
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{
+			&api.Volume{
+				Id: id,
+			},
+		}, nil).
+		Times(1)
+	s.MockDriver().
+		EXPECT().
+		UsedSize(id).
+		Return(uint64(0), volume.ErrNotSupported).
+		Times(1)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	// Get info
+	_, err := c.CapacityUsage(context.Background(), &api.SdkVolumeCapacityUsageRequest{
+		VolumeId: id,
+	})
+	assert.Error(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Unimplemented)
+}
+
+func TestSdkVolumeActiveRequests(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	requests := &api.ActiveRequests{
+		RequestCount: 1,
+		ActiveRequest: []*api.ActiveRequest{
+			&api.ActiveRequest{
+				ReqestKV: map[int64]string{
+					1: "read",
+				},
+			},
+		},
+	}
+	s.MockDriver().
+		EXPECT().
+		GetActiveRequests().
+		Return(requests, nil).
+		Times(1)
+
+	// Setup client
+	c := api.NewOpenStorageVolumeClient(s.Conn())
+
+	// Get info
+	r, err := c.ActiveRequests(context.Background(), &api.SdkVolumeActiveRequestsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, r.GetActiveRequests().GetRequestCount(), int64(1))
+	assert.Len(t, r.GetActiveRequests().GetActiveRequest(), 1)
+	assert.Equal(t, r.GetActiveRequests().GetActiveRequest()[0].GetReqestKV()[1], "read")
+}
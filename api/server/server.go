@@ -1,6 +1,7 @@
 package server
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
@@ -11,6 +12,8 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/libopenstorage/openstorage/objectstore"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/libopenstorage/openstorage/pkg/tlsutil"
 	sched "github.com/libopenstorage/openstorage/schedpolicy"
 	"github.com/libopenstorage/openstorage/secrets"
 )
@@ -38,7 +41,7 @@ func (r *Route) GetFn() func(http.ResponseWriter, *http.Request) {
 // the Linux container engine.
 func StartGraphAPI(name string, restBase string) error {
 	graphPlugin := newGraphPlugin(name)
-	if err := startServer(name, restBase, 0, graphPlugin.Routes()); err != nil {
+	if err := startServer(name, restBase, 0, graphPlugin.Routes(), nil); err != nil {
 		return err
 	}
 
@@ -47,17 +50,25 @@ func StartGraphAPI(name string, restBase string) error {
 
 // StartPluginAPI starts a REST server to receive volume API commands from the
 // Linux container engine and volume management commands from the CLI/UX.
+// When an authenticator is provided, volume management requests must be
+// authenticated, the roles of the callers are authorized and the ownership
+// of the volumes is enforced. When a TLS configuration is provided, the
+// volume management API is served over TLS on its port.
 func StartPluginAPI(
 	name string,
 	mgmtBase string,
 	pluginBase string,
 	mgmtPort uint16,
 	pluginPort uint16,
+	authenticator auth.Authenticator,
+	tlsConfig *tlsutil.Config,
 ) error {
 	if err := StartVolumeMgmtAPI(
 		name,
 		mgmtBase,
 		mgmtPort,
+		authenticator,
+		tlsConfig,
 	); err != nil {
 		return err
 	}
@@ -71,18 +82,31 @@ func StartPluginAPI(
 	return nil
 }
 
-// StartVolumeMgmtAPI starts a REST server to receive volume management API commands
+// StartVolumeMgmtAPI starts a REST server to receive volume management API commands.
+// The port, if any, is served over TLS when a TLS configuration is provided.
 func StartVolumeMgmtAPI(
 	name string,
 	mgmtBase string,
 	mgmtPort uint16,
+	authenticator auth.Authenticator,
+	tlsConfig *tlsutil.Config,
 ) error {
-	volMgmtApi := newVolumeAPI(name)
+	var serverTLS *tls.Config
+	if tlsConfig != nil {
+		certs, err := tlsutil.NewCertReloader(tlsConfig)
+		if err != nil {
+			return fmt.Errorf("Failed to setup TLS for REST server: %v", err)
+		}
+		serverTLS = certs.ServerConfig()
+	}
+
+	volMgmtApi := newVolumeAPI(name, authenticator)
 	if err := startServer(
 		name,
 		mgmtBase,
 		mgmtPort,
 		volMgmtApi.Routes(),
+		serverTLS,
 	); err != nil {
 		return err
 	}
@@ -90,7 +114,7 @@ func StartVolumeMgmtAPI(
 }
 
 func GetVolumeAPIRoutes(name string) []*Route {
-	volMgmtApi := newVolumeAPI(name)
+	volMgmtApi := newVolumeAPI(name, nil)
 	return volMgmtApi.Routes()
 }
 
@@ -108,6 +132,7 @@ func StartVolumePluginAPI(
 		pluginBase,
 		pluginPort,
 		volPluginApi.Routes(),
+		nil,
 	); err != nil {
 		return err
 	}
@@ -146,7 +171,7 @@ func StartClusterApiWithConfiguration(
 	clusterApi := newClusterAPI(config)
 
 	// start server as before
-	if err := startServer("osd", clusterApiBase, clusterPort, clusterApi.Routes()); err != nil {
+	if err := startServer("osd", clusterApiBase, clusterPort, clusterApi.Routes(), nil); err != nil {
 		return err
 	}
 
@@ -176,7 +201,15 @@ func GetClusterAPIRoutesWithConfiguration(config ClusterServerConfiguration) []*
 	return clusterApi.Routes()
 }
 
-func startServer(name string, sockBase string, port uint16, routes []*Route) error {
+// startServer serves the routes on the unix socket of the server and, if
+// provided, on the port. The port is served over TLS if tlsConfig is provided.
+func startServer(
+	name string,
+	sockBase string,
+	port uint16,
+	routes []*Route,
+	tlsConfig *tls.Config,
+) error {
 	var (
 		listener net.Listener
 		err      error
@@ -184,7 +217,11 @@ func startServer(name string, sockBase string, port uint16, routes []*Route) err
 	router := mux.NewRouter()
 	router.NotFoundHandler = http.HandlerFunc(notFound)
 	for _, v := range routes {
-		router.Methods(v.verb).Path(v.path).HandlerFunc(v.fn)
+		fn := v.fn
+		if auditor != nil && isAudited(v) {
+			fn = audited(auditor, v)
+		}
+		router.Methods(v.verb).Path(v.path).HandlerFunc(fn)
 	}
 	socket := path.Join(sockBase, name+".sock")
 	os.Remove(socket)
@@ -197,7 +234,16 @@ func startServer(name string, sockBase string, port uint16, routes []*Route) err
 		return err
 	}
 	go http.Serve(listener, router)
-	if port != 0 {
+	if port != 0 && tlsConfig != nil {
+		logrus.Printf("Starting REST service with TLS on port : %v", port)
+		server := &http.Server{
+			Addr:      fmt.Sprintf(":%d", port),
+			Handler:   router,
+			TLSConfig: tlsConfig,
+		}
+		// The certificate is provided by the TLS configuration
+		go server.ListenAndServeTLS("", "")
+	} else if port != 0 {
 		logrus.Printf("Starting REST service on port : %v", port)
 		go http.ListenAndServe(fmt.Sprintf(":%d", port), router)
 	}
@@ -13,6 +13,7 @@ import (
 
 	mockcluster "github.com/libopenstorage/openstorage/cluster/mock"
 	mockobject "github.com/libopenstorage/openstorage/objectstore/mock"
+	"github.com/libopenstorage/openstorage/pkg/auth"
 	mocksched "github.com/libopenstorage/openstorage/schedpolicy/mock"
 	mocksecrets "github.com/libopenstorage/openstorage/secrets/mock"
 	"github.com/libopenstorage/openstorage/volume"
@@ -104,7 +105,14 @@ func setupMockDriver(tester *testServer, t *testing.T) {
 }
 
 func testRestServer(t *testing.T) (*httptest.Server, *testServer) {
-	vapi := &volAPI{}
+	return testRestServerWithAuth(t, nil)
+}
+
+func testRestServerWithAuth(
+	t *testing.T,
+	authenticator auth.Authenticator,
+) (*httptest.Server, *testServer) {
+	vapi := &volAPI{auth: authenticator}
 	router := mux.NewRouter()
 	// Register all routes from the App
 	for _, route := range vapi.Routes() {
@@ -4,13 +4,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/api/errors"
 	"github.com/libopenstorage/openstorage/cluster"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/libopenstorage/openstorage/pkg/proto/time"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/libopenstorage/openstorage/volume/drivers"
 )
@@ -19,6 +23,10 @@ const schedDriverPostFix = "-sched"
 
 type volAPI struct {
 	restBase
+
+	// auth, when set, authenticates the requests so that
+	// the ownership of the volumes can be enforced
+	auth auth.Authenticator
 }
 
 func responseStatus(err error) string {
@@ -28,8 +36,11 @@ func responseStatus(err error) string {
 	return err.Error()
 }
 
-func newVolumeAPI(name string) restServer {
-	return &volAPI{restBase{version: volume.APIVersion, name: name}}
+func newVolumeAPI(name string, authenticator auth.Authenticator) restServer {
+	return &volAPI{
+		restBase: restBase{version: volume.APIVersion, name: name},
+		auth:     authenticator,
+	}
 }
 
 func (vd *volAPI) String() string {
@@ -157,6 +168,24 @@ func (vd *volAPI) create(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if parent := dcReq.Source.GetParent(); len(parent) != 0 {
+		if _, ok := vd.checkVolumeAccess(method, w, r, d, parent, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ); !ok {
+			return
+		}
+	}
+
+	ownership, err := ownershipForNewVolume(r, dcReq.Spec.GetOwnership())
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if ownership != dcReq.Spec.GetOwnership() {
+		if dcReq.Spec == nil {
+			dcReq.Spec = &api.VolumeSpec{}
+		}
+		dcReq.Spec.Ownership = ownership
+	}
+
 	id, err := d.Create(dcReq.Locator, dcReq.Source, dcReq.Spec)
 	dcRes.VolumeResponse = &api.VolumeResponse{Error: responseStatus(err)}
 	dcRes.Id = id
@@ -250,6 +279,25 @@ func (vd *volAPI) volumeSet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	v, ok := vd.checkVolumeAccess(method, w, r, d, volumeID, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE)
+	if !ok {
+		return
+	}
+	if v != nil {
+		if err = checkOwnershipUpdate(r, v, req.Spec.GetOwnership()); err != nil {
+			vd.sendError(vd.name, method, w, err.Error(), http.StatusForbidden)
+			return
+		}
+	}
+	// The drivers replace the spec of the volume with the one provided,
+	// so keep the current ownership unless a new one is requested
+	if req.Spec != nil && req.Spec.GetOwnership() == nil {
+		if req.Spec.Ownership, err = currentOwnership(d, v, volumeID); err != nil {
+			vd.sendError(vd.name, method, w, err.Error(), http.StatusNotFound)
+			return
+		}
+	}
+
 	if req.Locator != nil || req.Spec != nil {
 		if req.Spec != nil {
 			if err = vd.updateReplicaSpecNodeIPstoIds(req.Spec.ReplicaSet); err != nil {
@@ -347,6 +395,13 @@ func (vd *volAPI) inspect(w http.ResponseWriter, r *http.Request) {
 		vd.sendError(vd.name, method, w, err.Error(), http.StatusNotFound)
 		return
 	}
+	for _, v := range dk {
+		if !isPermitted(r, v, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ) {
+			vd.sendError(vd.name, method, w,
+				fmt.Sprintf("Access denied to volume %s", volumeID), http.StatusForbidden)
+			return
+		}
+	}
 
 	json.NewEncoder(w).Encode(dk)
 }
@@ -392,6 +447,10 @@ func (vd *volAPI) delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, ok := vd.checkVolumeAccess(method, w, r, d, volumeID, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_ADMIN); !ok {
+		return
+	}
+
 	volumeResponse := &api.VolumeResponse{}
 
 	if err := d.Delete(volumeID); err != nil {
@@ -435,6 +494,52 @@ func (vd *volAPI) delete(w http.ResponseWriter, r *http.Request) {
 //   required: false
 //   type: string
 //   format: uuid
+// - name: LabelSelector
+//   in: query
+//   description: |
+//    Comma separated label requirements
+//    example: env in (prod,dev),tier notin (cache),app,!deprecated
+//   required: false
+//   type: string
+// - name: State
+//   in: query
+//   description: Volume state, for example attached
+//   required: false
+//   type: string
+// - name: Status
+//   in: query
+//   description: Volume status, for example up
+//   required: false
+//   type: string
+// - name: Owner
+//   in: query
+//   description: Owner of the volumes
+//   required: false
+//   type: string
+// - name: CreatedAfter
+//   in: query
+//   description: Volumes created after this time
+//   required: false
+//   type: string
+//   format: date-time
+// - name: CreatedBefore
+//   in: query
+//   description: Volumes created before this time
+//   required: false
+//   type: string
+//   format: date-time
+// - name: MaxResults
+//   in: query
+//   description: |
+//    Maximum number of volumes returned. The token of the next
+//    page is returned in the X-Next-Page-Token header
+//   required: false
+//   type: integer
+// - name: PageToken
+//   in: query
+//   description: Token of the page returned by a previous request
+//   required: false
+//   type: string
 // responses:
 //   '200':
 //      description: an array of volumes
@@ -486,6 +591,30 @@ func (vd *volAPI) enumerate(w http.ResponseWriter, r *http.Request) {
 			vd.sendError(vd.name, method, w, e.Error(), http.StatusBadRequest)
 			return
 		}
+	} else if isFilteredEnumerate(params) {
+		if configLabels != nil {
+			e := fmt.Errorf("%s cannot be used with filters and pagination", api.OptConfigLabel)
+			vd.sendError(vd.name, method, w, e.Error(), http.StatusBadRequest)
+			return
+		}
+		filter, pageToken, maxResults, err := volumeFilterFromParams(params, &locator)
+		if err != nil {
+			vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var nextPageToken string
+		vols, nextPageToken, err = d.EnumerateWithFilter(filter, pageToken, maxResults)
+		if err == volume.ErrEinval {
+			e := fmt.Errorf("Invalid page token %s", pageToken)
+			vd.sendError(vd.name, method, w, e.Error(), http.StatusBadRequest)
+			return
+		} else if err != nil {
+			vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if len(nextPageToken) != 0 {
+			w.Header().Set(api.NextPageTokenHeader, nextPageToken)
+		}
 	} else {
 		vols, err = d.Enumerate(&locator, configLabels)
 		if err != nil {
@@ -493,9 +622,83 @@ func (vd *volAPI) enumerate(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
+	vols = permittedVolumes(r, vols, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ)
 	json.NewEncoder(w).Encode(vols)
 }
 
+// isFilteredEnumerate returns true if the enumeration of the volumes
+// is filtered or paginated
+func isFilteredEnumerate(params url.Values) bool {
+	for _, param := range []string{
+		api.OptLabelSelector,
+		api.OptState,
+		api.OptStatus,
+		api.OptOwner,
+		api.OptCreatedAfter,
+		api.OptCreatedBefore,
+		api.OptPageToken,
+		api.OptMaxResults,
+	} {
+		if len(params.Get(param)) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// volumeFilterFromParams returns the filter, the page token and the maximum
+// number of results of a filtered enumeration
+func volumeFilterFromParams(
+	params url.Values,
+	locator *api.VolumeLocator,
+) (*api.VolumeFilter, string, int, error) {
+	var (
+		filter = &api.VolumeFilter{
+			Locator: locator,
+			Owner:   params.Get(api.OptOwner),
+		}
+		maxResults int
+		err        error
+	)
+
+	if v := params.Get(api.OptLabelSelector); len(v) != 0 {
+		if filter.LabelSelector, err = api.ParseLabelSelector(v); err != nil {
+			return nil, "", 0, err
+		}
+	}
+	if v := params.Get(api.OptState); len(v) != 0 {
+		if filter.State, err = api.VolumeStateSimpleValueOf(v); err != nil {
+			return nil, "", 0, err
+		}
+	}
+	if v := params.Get(api.OptStatus); len(v) != 0 {
+		if filter.Status, err = api.VolumeStatusSimpleValueOf(v); err != nil {
+			return nil, "", 0, err
+		}
+	}
+	if v := params.Get(api.OptCreatedAfter); len(v) != 0 {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return nil, "", 0, fmt.Errorf("Failed to parse %s: %v", api.OptCreatedAfter, err)
+		}
+		filter.CreatedAfter = prototime.TimeToTimestamp(t)
+	}
+	if v := params.Get(api.OptCreatedBefore); len(v) != 0 {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return nil, "", 0, fmt.Errorf("Failed to parse %s: %v", api.OptCreatedBefore, err)
+		}
+		filter.CreatedBefore = prototime.TimeToTimestamp(t)
+	}
+	if v := params.Get(api.OptMaxResults); len(v) != 0 {
+		if maxResults, err = strconv.Atoi(v); err != nil || maxResults < 0 {
+			return nil, "", 0, fmt.Errorf("Invalid %s %s", api.OptMaxResults, v)
+		}
+	}
+
+	return filter, params.Get(api.OptPageToken), maxResults, nil
+}
+
 // swagger:operation POST /osd-snapshots snapshot createSnap
 //
 // Take a snapshot of volume in SnapCreateRequest
@@ -541,7 +744,27 @@ func (vd *volAPI) snap(w http.ResponseWriter, r *http.Request) {
 
 	vd.logRequest(method, string(snapReq.Id)).Infoln("")
 
-	id, err := d.Snapshot(snapReq.Id, snapReq.Readonly, snapReq.Locator)
+	parent, ok := vd.checkVolumeAccess(method, w, r, d, snapReq.Id, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE)
+	if !ok {
+		return
+	}
+
+	// Snapshots inherit the ownership of their volume. A snapshot whose
+	// ownership cannot be set is deleted rather than left public.
+	var id string
+	ownership, err := currentOwnership(d, parent, snapReq.Id)
+	if err == nil {
+		id, err = d.Snapshot(snapReq.Id, snapReq.Readonly, snapReq.Locator)
+	}
+	if err == nil {
+		if err = setOwnership(d, id, ownership); err != nil {
+			if derr := d.Delete(id); derr != nil {
+				vd.logRequest(method, id).Warnf("Failed to delete snapshot: %v", derr)
+			}
+			id = ""
+		}
+	}
+
 	snapRes.VolumeCreateResponse = &api.VolumeCreateResponse{
 		Id: id,
 		VolumeResponse: &api.VolumeResponse{
@@ -600,6 +823,13 @@ func (vd *volAPI) restore(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, ok := vd.checkVolumeAccess(method, w, r, d, volumeID, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); !ok {
+		return
+	}
+	if _, ok := vd.checkVolumeAccess(method, w, r, d, snapID, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ); !ok {
+		return
+	}
+
 	volumeResponse := &api.VolumeResponse{}
 	if err := d.Restore(volumeID, snapID); err != nil {
 		volumeResponse.Error = responseStatus(err)
@@ -683,6 +913,7 @@ func (vd *volAPI) snapEnumerate(w http.ResponseWriter, r *http.Request) {
 		vd.sendError(vd.name, method, w, e.Error(), http.StatusBadRequest)
 		return
 	}
+	snaps = permittedVolumes(r, snaps, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ)
 
 	json.NewEncoder(w).Encode(snaps)
 }
@@ -735,6 +966,10 @@ func (vd *volAPI) stats(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, ok := vd.checkVolumeAccess("stats", w, r, d, volumeID, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ); !ok {
+		return
+	}
+
 	stats, err := d.Stats(volumeID, cumulative)
 	if err != nil {
 		e := fmt.Errorf("Failed to get stats: %s", err.Error())
@@ -778,6 +1013,10 @@ func (vd *volAPI) usedsize(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, ok := vd.checkVolumeAccess("usedsize", w, r, d, volumeID, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ); !ok {
+		return
+	}
+
 	used, err := d.UsedSize(volumeID)
 	if err != nil {
 		e := fmt.Errorf("Failed to get used size: %s", err.Error())
@@ -883,6 +1122,10 @@ func (vd *volAPI) quiesce(w http.ResponseWriter, r *http.Request) {
 		quiesceId = quiesceIdParam[0]
 	}
 
+	if _, ok := vd.checkVolumeAccess(method, w, r, d, volumeID, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); !ok {
+		return
+	}
+
 	volumeResponse := &api.VolumeResponse{}
 	if err := d.Quiesce(volumeID, timeoutSec, quiesceId); err != nil {
 		volumeResponse.Error = responseStatus(err)
@@ -929,6 +1172,10 @@ func (vd *volAPI) unquiesce(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, ok := vd.checkVolumeAccess(method, w, r, d, volumeID, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE); !ok {
+		return
+	}
+
 	volumeResponse := &api.VolumeResponse{}
 	if err := d.Unquiesce(volumeID); err != nil {
 		volumeResponse.Error = responseStatus(err)
@@ -973,12 +1220,58 @@ func (vd *volAPI) snapGroup(w http.ResponseWriter, r *http.Request) {
 		notFound(w, r)
 		return
 	}
+
+	// The caller must have write access to all the volumes of the group,
+	// whose ownership is inherited by their snapshots
+	vols, err := d.Enumerate(nil, nil)
+	if err != nil {
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ownerships := make(map[string]*api.Ownership)
+	for _, v := range vols {
+		if v.GetGroup().GetId() != snapReq.Id && v.GetSpec().GetGroup().GetId() != snapReq.Id {
+			continue
+		}
+		if !isPermitted(r, v, api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE) {
+			vd.sendError(vd.name, method, w,
+				fmt.Sprintf("Access denied to volume %s", v.GetId()), http.StatusForbidden)
+			return
+		}
+		ownerships[v.GetId()] = v.GetSpec().GetOwnership()
+	}
+
 	snapRes, err = d.SnapshotGroup(snapReq.Id, snapReq.Labels)
 	if err != nil {
 		vd.sendError(vd.name, method, w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
+	// The snapshots are deleted if the ownership of any of them cannot be
+	// set rather than left public
+	for volumeID, snap := range snapRes.GetSnapshots() {
+		id := snap.GetVolumeCreateResponse().GetId()
+		if len(id) == 0 {
+			continue
+		}
+		if err = setOwnership(d, id, ownerships[volumeID]); err != nil {
+			break
+		}
+	}
+	if err != nil {
+		for _, snap := range snapRes.GetSnapshots() {
+			id := snap.GetVolumeCreateResponse().GetId()
+			if len(id) == 0 {
+				continue
+			}
+			if derr := d.Delete(id); derr != nil {
+				vd.logRequest(method, id).Warnf("Failed to delete snapshot: %v", derr)
+			}
+		}
+		vd.sendError(vd.name, method, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	json.NewEncoder(w).Encode(&snapRes)
 }
 
@@ -1029,7 +1322,7 @@ func backupPath(route, version string) string {
 }
 
 func (vd *volAPI) Routes() []*Route {
-	return []*Route{
+	routes := []*Route{
 		{verb: "GET", path: "/" + api.OsdVolumePath + "/versions", fn: vd.versions},
 		{verb: "POST", path: volPath("", volume.APIVersion), fn: vd.create},
 		{verb: "PUT", path: volPath("/{id}", volume.APIVersion), fn: vd.volumeSet},
@@ -1065,4 +1358,13 @@ func (vd *volAPI) Routes() []*Route {
 		{verb: "DELETE", path: backupPath("/sched", volume.APIVersion), fn: vd.cloudBackupSchedDelete},
 		{verb: "GET", path: backupPath("/sched", volume.APIVersion), fn: vd.cloudBackupSchedEnumerate},
 	}
+
+	// All the requests but versions must be authenticated and authorized
+	// when an authenticator has been configured
+	if vd.auth != nil {
+		for _, route := range routes[1:] {
+			route.fn = vd.authenticated(route.fn, routeRoles(route))
+		}
+	}
+	return routes
 }
@@ -0,0 +1,228 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/context"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/libopenstorage/openstorage/volume"
+)
+
+// claimsKey is the key of the claims of the caller in the request context.
+// The gorilla context is used, like mux does for the route variables, as
+// those would be lost in a copy of the request made by WithContext().
+type claimsKey struct{}
+
+// routeRoles returns the roles allowed to call the route, as the default
+// roles of the SDK do: viewers can only read, volume users can manage
+// volumes and snapshots, and credentials are left to administrators.
+// Backups are left to administrators as well, as the backup handlers do
+// not check the access to the volumes.
+func routeRoles(route *Route) []string {
+	switch {
+	case strings.Contains(route.path, "/"+api.OsdCredsPath),
+		strings.Contains(route.path, "/"+api.OsdBackupPath):
+		return []string{auth.RoleAdmin}
+	case route.verb == "GET":
+		return []string{auth.RoleAdmin, auth.RoleVolumeUser, auth.RoleViewer}
+	default:
+		return []string{auth.RoleAdmin, auth.RoleVolumeUser}
+	}
+}
+
+// hasRole returns true if the caller has any of the roles
+func hasRole(claims *auth.Claims, roles []string) bool {
+	for _, role := range claims.Roles {
+		for _, allowed := range roles {
+			if role == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// authenticated wraps a handler so that requests must carry a valid bearer
+// token in the Authorization header, from a caller having one of the roles
+// provided. The claims of the caller are saved in the context of the request.
+func (vd *volAPI) authenticated(
+	fn func(http.ResponseWriter, *http.Request),
+	roles []string,
+) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		method := "authenticate"
+		parts := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+		if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+			vd.sendError(vd.name, method, w,
+				"Access denied: missing bearer token", http.StatusUnauthorized)
+			return
+		}
+
+		claims, err := vd.auth.AuthenticateToken(parts[1])
+		if err != nil {
+			e := fmt.Errorf("Access denied: %v", err)
+			vd.sendError(vd.name, method, w, e.Error(), http.StatusUnauthorized)
+			return
+		}
+		if !hasRole(claims, roles) {
+			e := fmt.Errorf("Access denied: user %s is not authorized to %s %s",
+				claims.Subject, r.Method, r.URL.Path)
+			vd.sendError(vd.name, method, w, e.Error(), http.StatusForbidden)
+			return
+		}
+
+		context.Set(r, claimsKey{}, claims)
+		fn(w, r)
+	}
+}
+
+// accessClaims returns the claims of the caller when its access to volumes
+// must be checked. Ownership is only enforced when authentication is
+// enabled and the caller is not an administrator.
+func accessClaims(r *http.Request) (*auth.Claims, bool) {
+	claims, ok := requestClaims(r)
+	if !ok || claims.IsAdmin() {
+		return nil, false
+	}
+	return claims, true
+}
+
+// requestClaims returns the claims of the authenticated caller
+func requestClaims(r *http.Request) (*auth.Claims, bool) {
+	claims, ok := context.Get(r, claimsKey{}).(*auth.Claims)
+	return claims, ok && claims != nil
+}
+
+// isPermitted returns true if the caller has the access requested to the volume
+func isPermitted(r *http.Request, v *api.Volume, access api.OwnershipAccessType) bool {
+	claims, ok := accessClaims(r)
+	if !ok {
+		return true
+	}
+	return v.GetSpec().GetOwnership().IsPermitted(claims.Subject, claims.Groups, access)
+}
+
+// permittedVolumes returns the volumes the caller has the access requested to
+func permittedVolumes(
+	r *http.Request,
+	vols []*api.Volume,
+	access api.OwnershipAccessType,
+) []*api.Volume {
+	if _, ok := accessClaims(r); !ok {
+		return vols
+	}
+	permitted := make([]*api.Volume, 0, len(vols))
+	for _, v := range vols {
+		if isPermitted(r, v, access) {
+			permitted = append(permitted, v)
+		}
+	}
+	return permitted
+}
+
+// checkVolumeAccess sends an error and returns false if the caller does not
+// have the access requested to the volume. The volume is only inspected
+// when ownership must be enforced, in which case it is also returned.
+func (vd *volAPI) checkVolumeAccess(
+	method string,
+	w http.ResponseWriter,
+	r *http.Request,
+	d volume.VolumeDriver,
+	volumeID string,
+	access api.OwnershipAccessType,
+) (*api.Volume, bool) {
+	if _, ok := accessClaims(r); !ok {
+		return nil, true
+	}
+
+	vols, err := d.Inspect([]string{volumeID})
+	if err != nil || len(vols) == 0 {
+		vd.sendError(vd.name, method, w,
+			fmt.Sprintf("Volume %s not found", volumeID), http.StatusNotFound)
+		return nil, false
+	}
+	if !isPermitted(r, vols[0], access) {
+		vd.sendError(vd.name, method, w,
+			fmt.Sprintf("Access denied to volume %s", volumeID), http.StatusForbidden)
+		return nil, false
+	}
+	return vols[0], true
+}
+
+// ownershipForNewVolume returns the ownership of a volume created by the
+// caller. Volumes without an owner are owned by the caller, and only
+// administrators can create volumes on behalf of other users.
+func ownershipForNewVolume(r *http.Request, requested *api.Ownership) (*api.Ownership, error) {
+	claims, ok := requestClaims(r)
+	if !ok {
+		return requested, nil
+	}
+
+	ownership := requested.Copy()
+	if ownership == nil {
+		ownership = &api.Ownership{}
+	}
+	if len(ownership.GetOwner()) == 0 {
+		ownership.Owner = claims.Subject
+	} else if ownership.GetOwner() != claims.Subject && !claims.IsAdmin() {
+		return nil, fmt.Errorf("User %s cannot create volumes owned by %s",
+			claims.Subject, ownership.GetOwner())
+	}
+	return ownership, nil
+}
+
+// checkOwnershipUpdate returns an error if the caller is not allowed to
+// change the ownership of the volume to the one requested
+func checkOwnershipUpdate(r *http.Request, v *api.Volume, requested *api.Ownership) error {
+	current := v.GetSpec().GetOwnership()
+	if requested == nil || proto.Equal(current, requested) {
+		return nil
+	}
+	claims, ok := accessClaims(r)
+	if !ok {
+		return nil
+	}
+	if !current.IsPermittedToUpdate(claims.Subject, claims.Groups, requested) {
+		return fmt.Errorf("User %s is not allowed to change the ownership of volume %s",
+			claims.Subject, v.GetId())
+	}
+	return nil
+}
+
+// currentOwnership returns a copy of the ownership of the volume, which is
+// inspected unless it is provided
+func currentOwnership(d volume.VolumeDriver, v *api.Volume, volumeID string) (*api.Ownership, error) {
+	if v == nil {
+		vols, err := d.Inspect([]string{volumeID})
+		if err != nil || len(vols) == 0 {
+			return nil, fmt.Errorf("Volume %s not found", volumeID)
+		}
+		v = vols[0]
+	}
+	return v.GetSpec().GetOwnership().Copy(), nil
+}
+
+// setOwnership sets the ownership of a volume created from another volume,
+// unless the driver has already set it.
+func setOwnership(d volume.VolumeDriver, id string, ownership *api.Ownership) error {
+	vols, err := d.Inspect([]string{id})
+	if err != nil {
+		return err
+	} else if len(vols) == 0 {
+		return fmt.Errorf("Volume %s not found", id)
+	}
+	if proto.Equal(vols[0].GetSpec().GetOwnership(), ownership) {
+		return nil
+	}
+
+	spec := &api.VolumeSpec{}
+	if vols[0].GetSpec() != nil {
+		spec = vols[0].GetSpec().Copy()
+	}
+	spec.Ownership = ownership.Copy()
+	return d.Set(id, nil, spec)
+}
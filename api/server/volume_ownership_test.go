@@ -0,0 +1,192 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libopenstorage/openstorage/api"
+	volumeclient "github.com/libopenstorage/openstorage/api/client/volume"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+)
+
+var testAuthSecret = []byte("server-test-secret")
+
+func newTestToken(t *testing.T, subject string, roles ...string) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":   subject,
+		"roles": roles,
+		"exp":   time.Now().Add(time.Minute).Unix(),
+	}).SignedString(testAuthSecret)
+	require.NoError(t, err)
+	return token
+}
+
+func doTestRequest(t *testing.T, verb, url, token string) *http.Response {
+	return doTestRequestWithBody(t, verb, url, token, nil)
+}
+
+func doTestRequestWithBody(t *testing.T, verb, url, token string, body interface{}) *http.Response {
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&buf).Encode(body))
+	}
+	req, err := http.NewRequest(verb, url, &buf)
+	require.NoError(t, err)
+	req.Header.Set("User-Agent", mockDriverName+"/"+version)
+	if len(token) != 0 {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	return res
+}
+
+func TestVolumeOwnership(t *testing.T) {
+	a, err := auth.NewJwtAuth(&auth.JwtAuthConfig{
+		SharedSecret: testAuthSecret,
+	})
+	require.NoError(t, err)
+
+	ts, testVolDriver := testRestServerWithAuth(t, a)
+	defer ts.Close()
+	defer testVolDriver.Stop()
+
+	id := "myvol"
+	vols := []*api.Volume{
+		&api.Volume{
+			Id: id,
+			Spec: &api.VolumeSpec{
+				Ownership: &api.Ownership{
+					Owner: "owner",
+					Acls: &api.OwnershipAcls{
+						Collaborators: map[string]api.OwnershipAccessType{
+							"reader": api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_READ,
+							"writer": api.OwnershipAccessType_OWNERSHIP_ACCESS_TYPE_WRITE,
+						},
+					},
+				},
+			},
+		},
+		&api.Volume{Id: "public"},
+	}
+	testVolDriver.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return(vols[:1], nil).
+		AnyTimes()
+	testVolDriver.MockDriver().
+		EXPECT().
+		Enumerate(&api.VolumeLocator{}, nil).
+		Return(vols, nil).
+		AnyTimes()
+
+	volumeURL := ts.URL + volPath("/"+id, version)
+	owner := newTestToken(t, "owner", auth.RoleVolumeUser)
+	reader := newTestToken(t, "reader", auth.RoleVolumeUser)
+	writer := newTestToken(t, "writer", auth.RoleVolumeUser)
+	stranger := newTestToken(t, "stranger", auth.RoleVolumeUser)
+	viewer := newTestToken(t, "reader", auth.RoleViewer)
+
+	// Requests must be authenticated
+	res := doTestRequest(t, "GET", volumeURL, "")
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	res = doTestRequest(t, "GET", ts.URL+"/"+api.OsdVolumePath+"/versions", "")
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	// Inspect
+	res = doTestRequest(t, "GET", volumeURL, reader)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	res = doTestRequest(t, "GET", volumeURL, stranger)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	// Enumerate
+	var enumerated []*api.Volume
+	res = doTestRequest(t, "GET", ts.URL+volPath("", version), stranger)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.NoError(t, json.NewDecoder(res.Body).Decode(&enumerated))
+	assert.Len(t, enumerated, 1)
+	assert.Equal(t, "public", enumerated[0].GetId())
+
+	// Viewers can only read
+	res = doTestRequest(t, "GET", volumeURL, viewer)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	res = doTestRequest(t, "DELETE", volumeURL, viewer)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	res = doTestRequest(t, "GET", ts.URL+credsPath("", version), owner)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	// Backups are left to administrators
+	res = doTestRequest(t, "GET", ts.URL+backupPath("", version), owner)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	res = doTestRequest(t, "DELETE", ts.URL+backupPath("/all", version), owner)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	// Update keeps the ownership when none is requested
+	setReq := &api.VolumeSetRequest{
+		Spec: &api.VolumeSpec{Size: 10},
+	}
+	res = doTestRequestWithBody(t, "PUT", volumeURL, reader, setReq)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	testVolDriver.MockDriver().
+		EXPECT().
+		Set(id, nil, &api.VolumeSpec{
+			Size:      10,
+			Ownership: vols[0].GetSpec().GetOwnership(),
+		}).
+		Return(nil).
+		Times(1)
+	res = doTestRequestWithBody(t, "PUT", volumeURL, writer, setReq)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	// Delete
+	res = doTestRequest(t, "DELETE", volumeURL, reader)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	testVolDriver.MockDriver().
+		EXPECT().
+		Delete(id).
+		Return(nil).
+		Times(1)
+	res = doTestRequest(t, "DELETE", volumeURL, owner)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	// Administrators have access to all volumes
+	res = doTestRequest(t, "GET", volumeURL, newTestToken(t, "admin1", auth.RoleAdmin))
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestVolumeClientBearerToken(t *testing.T) {
+	a, err := auth.NewJwtAuth(&auth.JwtAuthConfig{
+		SharedSecret: testAuthSecret,
+	})
+	require.NoError(t, err)
+
+	ts, testVolDriver := testRestServerWithAuth(t, a)
+	defer ts.Close()
+	defer testVolDriver.Stop()
+
+	id := "myvol"
+	testVolDriver.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{&api.Volume{Id: id}}, nil).
+		Times(1)
+
+	cl, err := volumeclient.NewDriverClient(ts.URL, mockDriverName, version, mockDriverName)
+	require.NoError(t, err)
+	driverclient := volumeclient.VolumeDriver(cl)
+
+	_, err = driverclient.Inspect([]string{id})
+	assert.Error(t, err)
+
+	cl.SetBearerToken(newTestToken(t, "admin1", auth.RoleAdmin))
+	vols, err := driverclient.Inspect([]string{id})
+	assert.NoError(t, err)
+	assert.Len(t, vols, 1)
+}
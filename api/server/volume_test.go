@@ -3,9 +3,12 @@ package server
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/libopenstorage/openstorage/api"
 	volumeclient "github.com/libopenstorage/openstorage/api/client/volume"
+	"github.com/libopenstorage/openstorage/pkg/proto/time"
+	"github.com/libopenstorage/openstorage/volume"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -220,11 +223,34 @@ func TestVolumeSnapshotCreateSuccess(t *testing.T) {
 		Readonly: true,
 	}
 
-	//mock Snapshot call
-	testVolDriver.MockDriver().
-		EXPECT().
-		Snapshot(req.GetId(), req.GetReadonly(), req.GetLocator()).
-		Return(id, nil)
+	snapID := "snapid"
+	ownership := &api.Ownership{Owner: "owner"}
+
+	//mock Snapshot call, the snapshot inherits the ownership of the volume
+	gomock.InOrder(
+		testVolDriver.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{&api.Volume{
+				Id:   id,
+				Spec: &api.VolumeSpec{Ownership: ownership},
+			}}, nil),
+		testVolDriver.MockDriver().
+			EXPECT().
+			Snapshot(req.GetId(), req.GetReadonly(), req.GetLocator()).
+			Return(snapID, nil),
+		testVolDriver.MockDriver().
+			EXPECT().
+			Inspect([]string{snapID}).
+			Return([]*api.Volume{&api.Volume{
+				Id:   snapID,
+				Spec: &api.VolumeSpec{},
+			}}, nil),
+		testVolDriver.MockDriver().
+			EXPECT().
+			Set(snapID, nil, &api.VolumeSpec{Ownership: ownership}).
+			Return(nil),
+	)
 
 	// create client
 	driverclient := volumeclient.VolumeDriver(client)
@@ -232,7 +258,68 @@ func TestVolumeSnapshotCreateSuccess(t *testing.T) {
 	res, err := driverclient.Snapshot(req.GetId(), req.GetReadonly(), req.GetLocator())
 
 	assert.Nil(t, err)
-	assert.EqualValues(t, id, res)
+	assert.EqualValues(t, snapID, res)
+}
+
+func TestVolumeSnapshotCreateOwnershipFailed(t *testing.T) {
+
+	var err error
+	ts, testVolDriver := testRestServer(t)
+
+	defer ts.Close()
+	defer testVolDriver.Stop()
+
+	id := "myid"
+	snapID := "snapid"
+	ownership := &api.Ownership{Owner: "owner"}
+
+	client, err := volumeclient.NewDriverClient(ts.URL, mockDriverName, version, mockDriverName)
+
+	assert.Nil(t, err)
+
+	req := &api.SnapCreateRequest{Id: id,
+		Locator:  &api.VolumeLocator{Name: "snapName"},
+		Readonly: true,
+	}
+
+	// The snapshot is deleted when its ownership cannot be set
+	gomock.InOrder(
+		testVolDriver.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{&api.Volume{
+				Id:   id,
+				Spec: &api.VolumeSpec{Ownership: ownership},
+			}}, nil),
+		testVolDriver.MockDriver().
+			EXPECT().
+			Snapshot(req.GetId(), req.GetReadonly(), req.GetLocator()).
+			Return(snapID, nil),
+		testVolDriver.MockDriver().
+			EXPECT().
+			Inspect([]string{snapID}).
+			Return([]*api.Volume{&api.Volume{
+				Id:   snapID,
+				Spec: &api.VolumeSpec{},
+			}}, nil),
+		testVolDriver.MockDriver().
+			EXPECT().
+			Set(snapID, nil, &api.VolumeSpec{Ownership: ownership}).
+			Return(fmt.Errorf("error in set")),
+		testVolDriver.MockDriver().
+			EXPECT().
+			Delete(snapID).
+			Return(nil),
+	)
+
+	// create client
+	driverclient := volumeclient.VolumeDriver(client)
+
+	res, err := driverclient.Snapshot(req.GetId(), req.GetReadonly(), req.GetLocator())
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "error in set")
+	assert.EqualValues(t, "", res)
 }
 
 func TestVolumeSnapshotCreateFailed(t *testing.T) {
@@ -256,10 +343,16 @@ func TestVolumeSnapshotCreateFailed(t *testing.T) {
 	}
 
 	//mock Snapshot call
-	testVolDriver.MockDriver().
-		EXPECT().
-		Snapshot(req.GetId(), req.GetReadonly(), req.GetLocator()).
-		Return("", fmt.Errorf("error in snapshot create"))
+	gomock.InOrder(
+		testVolDriver.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{&api.Volume{Id: id}}, nil),
+		testVolDriver.MockDriver().
+			EXPECT().
+			Snapshot(req.GetId(), req.GetReadonly(), req.GetLocator()).
+			Return("", fmt.Errorf("error in snapshot create")),
+	)
 
 	// create client
 	driverclient := volumeclient.VolumeDriver(client)
@@ -375,6 +468,11 @@ func TestVolumeSetSuccess(t *testing.T) {
 		Spec:    &api.VolumeSpec{Size: size},
 	}
 	gomock.InOrder(
+		testVolDriver.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{&api.Volume{Id: id}}, nil),
+
 		testVolDriver.MockDriver().
 			EXPECT().
 			Set(id, req.GetLocator(), req.GetSpec()).
@@ -430,6 +528,10 @@ func TestVolumeSetFailed(t *testing.T) {
 		Spec:    &api.VolumeSpec{Size: size},
 	}
 
+	testVolDriver.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{&api.Volume{Id: id}}, nil)
 	testVolDriver.MockDriver().
 		EXPECT().
 		Set(id, req.GetLocator(), req.GetSpec()).
@@ -1184,6 +1286,79 @@ func TestVolumeEnumerateFailed(t *testing.T) {
 	assert.Empty(t, res)
 }
 
+func TestVolumeEnumerateWithFilterSuccess(t *testing.T) {
+
+	var err error
+	ts, testVolDriver := testRestServer(t)
+
+	defer ts.Close()
+	defer testVolDriver.Stop()
+
+	client, err := volumeclient.NewDriverClient(ts.URL, mockDriverName, version, mockDriverName)
+	assert.Nil(t, err)
+
+	selector, err := api.ParseLabelSelector("env in (prod,dev),!deprecated")
+	assert.NoError(t, err)
+	filter := &api.VolumeFilter{
+		Locator: &api.VolumeLocator{
+			Name: "myvol",
+			VolumeLabels: map[string]string{
+				"dept": "auto",
+			},
+		},
+		LabelSelector: selector,
+		State:         api.VolumeState_VOLUME_STATE_ATTACHED,
+		Status:        api.VolumeStatus_VOLUME_STATUS_UP,
+		Owner:         "user",
+		CreatedAfter:  prototime.TimeToTimestamp(time.Unix(1000, 500)),
+		CreatedBefore: prototime.TimeToTimestamp(time.Unix(2000, 0)),
+	}
+	id := "myid"
+
+	testVolDriver.MockDriver().
+		EXPECT().
+		EnumerateWithFilter(filter, "token", 10).
+		Return([]*api.Volume{
+			&api.Volume{
+				Id: id,
+			},
+		}, "nexttoken", nil)
+
+	// create client
+	driverclient := volumeclient.VolumeDriver(client)
+	res, next, err := driverclient.EnumerateWithFilter(filter, "token", 10)
+
+	assert.Nil(t, err)
+	assert.Len(t, res, 1)
+	assert.EqualValues(t, id, res[0].GetId())
+	assert.Equal(t, "nexttoken", next)
+}
+
+func TestVolumeEnumerateWithFilterFailed(t *testing.T) {
+
+	var err error
+	ts, testVolDriver := testRestServer(t)
+
+	defer ts.Close()
+	defer testVolDriver.Stop()
+
+	client, err := volumeclient.NewDriverClient(ts.URL, mockDriverName, version, mockDriverName)
+	assert.Nil(t, err)
+
+	testVolDriver.MockDriver().
+		EXPECT().
+		EnumerateWithFilter(&api.VolumeFilter{Locator: &api.VolumeLocator{}}, "badtoken", 0).
+		Return(nil, "", volume.ErrEinval)
+
+	// create client
+	driverclient := volumeclient.VolumeDriver(client)
+	res, _, err := driverclient.EnumerateWithFilter(nil, "badtoken", 0)
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Invalid page token")
+	assert.Empty(t, res)
+}
+
 func TestVolumeSnapshotEnumerateSuccess(t *testing.T) {
 
 	var err error
@@ -1561,10 +1736,24 @@ func TestGroupSnapshotCreateSuccess(t *testing.T) {
 	}
 
 	//mock Snapshot call
+	gomock.InOrder(
+		testVolDriver.MockDriver().
+			EXPECT().
+			Enumerate(nil, nil).
+			Return([]*api.Volume{
+				&api.Volume{Id: "vol1", Group: &api.Group{Id: id}},
+				&api.Volume{Id: "vol2", Group: &api.Group{Id: id}},
+			}, nil),
+		testVolDriver.MockDriver().
+			EXPECT().
+			SnapshotGroup(req.GetId(), req.GetLabels()).
+			Return(response, nil),
+	)
 	testVolDriver.MockDriver().
 		EXPECT().
-		SnapshotGroup(req.GetId(), req.GetLabels()).
-		Return(response, nil)
+		Inspect([]string{id}).
+		Return([]*api.Volume{&api.Volume{Id: id}}, nil).
+		Times(2)
 
 	// create client
 	driverclient := volumeclient.VolumeDriver(client)
@@ -56,7 +56,10 @@ func TestAll(t *testing.T) {
 		t.Fatalf("Failed to create test path: %v", err)
 	}
 
-	err = volumedrivers.Register(nfs.Name, map[string]string{"path": testPath})
+	err = volumedrivers.Register(nfs.Name, map[string]string{
+		"path":               testPath,
+		nfs.EnforceSizeParam: "false",
+	})
 	if err != nil {
 		t.Fatalf("Failed to initialize Driver: %v", err)
 	}
@@ -67,6 +70,8 @@ func TestAll(t *testing.T) {
 		volume.PluginAPIBase,
 		0,
 		0,
+		nil,
+		nil,
 	)
 	time.Sleep(time.Second * 2)
 	versions, err := volumeclient.GetSupportedDriverVersions(nfs.Name, "")
@@ -0,0 +1,215 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/libopenstorage/openstorage/pkg/proto/time"
+)
+
+// setRequirementRegexp matches the in and notin requirements of a selector
+var setRequirementRegexp = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+
+// Match returns true if the labels satisfy the requirement
+func (r *LabelSelectorRequirement) Match(labels map[string]string) bool {
+	value, ok := labels[r.GetKey()]
+	switch r.GetOperator() {
+	case LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN:
+		return ok && containsString(r.GetValues(), value)
+	case LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_NOT_IN:
+		return !ok || !containsString(r.GetValues(), value)
+	case LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_EXISTS:
+		return ok
+	case LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST:
+		return !ok
+	}
+	return false
+}
+
+// formatLabelSelectorRequirement returns the requirement in the syntax
+// of ParseLabelSelector
+func formatLabelSelectorRequirement(r *LabelSelectorRequirement) string {
+	switch r.GetOperator() {
+	case LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN:
+		return fmt.Sprintf("%s in (%s)", r.GetKey(), strings.Join(r.GetValues(), ","))
+	case LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_NOT_IN:
+		return fmt.Sprintf("%s notin (%s)", r.GetKey(), strings.Join(r.GetValues(), ","))
+	case LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST:
+		return "!" + r.GetKey()
+	}
+	return r.GetKey()
+}
+
+// ParseLabelSelector parses a comma separated list of requirements like
+// "env in (prod,dev),tier notin (cache),app,!deprecated,zone=east,rack!=r1".
+// A key alone requires the label to exist and a key prefixed with ! requires
+// the label not to exist.
+func ParseLabelSelector(selector string) ([]*LabelSelectorRequirement, error) {
+	var requirements []*LabelSelectorRequirement
+	for _, part := range splitLabelSelector(selector) {
+		part = strings.TrimSpace(part)
+		if len(part) == 0 {
+			continue
+		}
+		r, err := parseLabelSelectorRequirement(part)
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, r)
+	}
+	return requirements, nil
+}
+
+// FormatLabelSelector returns the requirements in the syntax of ParseLabelSelector
+func FormatLabelSelector(requirements []*LabelSelectorRequirement) string {
+	parts := make([]string, len(requirements))
+	for i, r := range requirements {
+		parts[i] = formatLabelSelectorRequirement(r)
+	}
+	return strings.Join(parts, ",")
+}
+
+// splitLabelSelector splits the selector on the commas which are not
+// in the set of values of a requirement
+func splitLabelSelector(selector string) []string {
+	var (
+		parts []string
+		depth int
+		start int
+	)
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, selector[start:])
+}
+
+func parseLabelSelectorRequirement(s string) (*LabelSelectorRequirement, error) {
+	if m := setRequirementRegexp.FindStringSubmatch(s); m != nil {
+		r := &LabelSelectorRequirement{
+			Key:      m[1],
+			Operator: LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN,
+		}
+		if m[2] == "notin" {
+			r.Operator = LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_NOT_IN
+		}
+		for _, value := range strings.Split(m[3], ",") {
+			if value = strings.TrimSpace(value); len(value) != 0 {
+				r.Values = append(r.Values, value)
+			}
+		}
+		if len(r.Values) == 0 {
+			return nil, fmt.Errorf("Label selector %q has no values", s)
+		}
+		return r, nil
+	}
+
+	var (
+		r  *LabelSelectorRequirement
+		kv []string
+	)
+	switch {
+	case strings.HasPrefix(s, "!") && !strings.Contains(s, "="):
+		r = &LabelSelectorRequirement{
+			Key:      strings.TrimSpace(s[1:]),
+			Operator: LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST,
+		}
+	case strings.Contains(s, "!="):
+		kv = strings.SplitN(s, "!=", 2)
+		r = &LabelSelectorRequirement{
+			Operator: LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_NOT_IN,
+		}
+	case strings.Contains(s, "="):
+		kv = strings.SplitN(strings.Replace(s, "==", "=", 1), "=", 2)
+		r = &LabelSelectorRequirement{
+			Operator: LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN,
+		}
+	default:
+		r = &LabelSelectorRequirement{
+			Key:      s,
+			Operator: LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_EXISTS,
+		}
+	}
+	if kv != nil {
+		r.Key = strings.TrimSpace(kv[0])
+		r.Values = []string{strings.TrimSpace(kv[1])}
+	}
+	if len(r.Key) == 0 || strings.ContainsAny(r.Key, " \t()") {
+		return nil, fmt.Errorf("Invalid label selector %q", s)
+	}
+	return r, nil
+}
+
+// IsEmpty returns true if the filter selects all the volumes
+func (f *VolumeFilter) IsEmpty() bool {
+	return f == nil ||
+		(len(f.GetLocator().GetName()) == 0 &&
+			len(f.GetLocator().GetVolumeLabels()) == 0 &&
+			len(f.GetLabelSelector()) == 0 &&
+			f.GetState() == VolumeState_VOLUME_STATE_NONE &&
+			f.GetStatus() == VolumeStatus_VOLUME_STATUS_NONE &&
+			len(f.GetOwner()) == 0 &&
+			f.GetCreatedAfter() == nil &&
+			f.GetCreatedBefore() == nil)
+}
+
+// Match returns true if the volume is selected by the filter
+func (f *VolumeFilter) Match(v *Volume) bool {
+	if f == nil {
+		return true
+	}
+
+	labels := v.GetLocator().GetVolumeLabels()
+	if name := f.GetLocator().GetName(); len(name) != 0 && name != v.GetLocator().GetName() {
+		return false
+	}
+	for key, value := range f.GetLocator().GetVolumeLabels() {
+		if current, ok := labels[key]; !ok || current != value {
+			return false
+		}
+	}
+	for _, r := range f.GetLabelSelector() {
+		if !r.Match(labels) {
+			return false
+		}
+	}
+
+	if f.GetState() != VolumeState_VOLUME_STATE_NONE && f.GetState() != v.GetState() {
+		return false
+	}
+	if f.GetStatus() != VolumeStatus_VOLUME_STATUS_NONE && f.GetStatus() != v.GetStatus() {
+		return false
+	}
+	if len(f.GetOwner()) != 0 && f.GetOwner() != v.GetSpec().GetOwnership().GetOwner() {
+		return false
+	}
+
+	if f.GetCreatedAfter() != nil &&
+		!prototime.TimestampLess(f.GetCreatedAfter(), v.GetCtime()) {
+		return false
+	}
+	if f.GetCreatedBefore() != nil &&
+		(v.GetCtime() == nil || !prototime.TimestampLess(v.GetCtime(), f.GetCreatedBefore())) {
+		return false
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
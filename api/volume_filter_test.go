@@ -0,0 +1,138 @@
+/*
+Package api contains the external OpenStorage apis
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/libopenstorage/openstorage/pkg/proto/time"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	selector := "env in (prod, dev),tier notin (cache),app,!deprecated,zone=east,rack!=r1,os==linux"
+	requirements, err := ParseLabelSelector(selector)
+	assert.NoError(t, err)
+	assert.Equal(t, []*LabelSelectorRequirement{
+		{
+			Key:      "env",
+			Operator: LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN,
+			Values:   []string{"prod", "dev"},
+		},
+		{
+			Key:      "tier",
+			Operator: LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_NOT_IN,
+			Values:   []string{"cache"},
+		},
+		{
+			Key:      "app",
+			Operator: LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_EXISTS,
+		},
+		{
+			Key:      "deprecated",
+			Operator: LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST,
+		},
+		{
+			Key:      "zone",
+			Operator: LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN,
+			Values:   []string{"east"},
+		},
+		{
+			Key:      "rack",
+			Operator: LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_NOT_IN,
+			Values:   []string{"r1"},
+		},
+		{
+			Key:      "os",
+			Operator: LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN,
+			Values:   []string{"linux"},
+		},
+	}, requirements)
+
+	// Formatting and parsing again gives the same requirements
+	formatted, err := ParseLabelSelector(FormatLabelSelector(requirements))
+	assert.NoError(t, err)
+	assert.Equal(t, requirements, formatted)
+
+	requirements, err = ParseLabelSelector("")
+	assert.NoError(t, err)
+	assert.Empty(t, requirements)
+
+	for _, invalid := range []string{"env in ()", "=prod", "env in (prod", "my env"} {
+		_, err = ParseLabelSelector(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestVolumeFilterMatch(t *testing.T) {
+	v := &Volume{
+		Id: "myid",
+		Locator: &VolumeLocator{
+			Name:         "myvol",
+			VolumeLabels: map[string]string{"env": "prod", "tier": "db"},
+		},
+		Ctime:  prototime.TimeToTimestamp(time.Unix(1000, 0)),
+		State:  VolumeState_VOLUME_STATE_ATTACHED,
+		Status: VolumeStatus_VOLUME_STATUS_UP,
+		Spec: &VolumeSpec{
+			Ownership: &Ownership{Owner: "owner"},
+		},
+	}
+	selector := func(s string) []*LabelSelectorRequirement {
+		requirements, err := ParseLabelSelector(s)
+		assert.NoError(t, err)
+		return requirements
+	}
+
+	var nilFilter *VolumeFilter
+	assert.True(t, nilFilter.IsEmpty())
+	assert.True(t, nilFilter.Match(v))
+	assert.True(t, (&VolumeFilter{}).IsEmpty())
+	assert.False(t, (&VolumeFilter{Owner: "owner"}).IsEmpty())
+
+	for _, f := range []*VolumeFilter{
+		{},
+		{Locator: &VolumeLocator{Name: "myvol"}},
+		{Locator: &VolumeLocator{VolumeLabels: map[string]string{"env": "prod"}}},
+		{LabelSelector: selector("env in (dev,prod),tier,!deprecated,zone notin (east)")},
+		{State: VolumeState_VOLUME_STATE_ATTACHED, Status: VolumeStatus_VOLUME_STATUS_UP},
+		{Owner: "owner"},
+		{
+			CreatedAfter:  prototime.TimeToTimestamp(time.Unix(999, 0)),
+			CreatedBefore: prototime.TimeToTimestamp(time.Unix(1001, 0)),
+		},
+	} {
+		assert.True(t, f.Match(v), f.String())
+	}
+
+	for _, f := range []*VolumeFilter{
+		{Locator: &VolumeLocator{Name: "other"}},
+		{Locator: &VolumeLocator{VolumeLabels: map[string]string{"env": "dev"}}},
+		{LabelSelector: selector("env notin (prod)")},
+		{LabelSelector: selector("zone")},
+		{LabelSelector: selector("!tier")},
+		{State: VolumeState_VOLUME_STATE_DETACHED},
+		{Status: VolumeStatus_VOLUME_STATUS_DOWN},
+		{Owner: "other"},
+		{CreatedAfter: prototime.TimeToTimestamp(time.Unix(1000, 0))},
+		{CreatedBefore: prototime.TimeToTimestamp(time.Unix(1000, 0))},
+	} {
+		assert.False(t, f.Match(v), f.String())
+	}
+}
@@ -45,6 +45,8 @@ var (
 	ErrNodeDecommissioned   = errors.New("Node is decomissioned.")
 	stopHeartbeat           = make(chan bool)
 	ErrRemoveCausesDataLoss = errors.New("Cannot remove node without data loss")
+	// ErrNodeNotFound is returned when the node is not part of the cluster
+	ErrNodeNotFound = errors.New("Unable to locate node with provided UUID.")
 )
 
 // ClusterManager implements the cluster interface
@@ -202,7 +204,7 @@ func (c *ClusterManager) getNodeEntry(nodeID string, clustDBRef *ClusterInfo) (a
 	if nodeID == c.selfNode.Id {
 		n = *c.getCurrentState()
 	} else if n, ok = c.nodeCache[nodeID]; !ok {
-		return api.Node{}, errors.New("Unable to locate node with provided UUID.")
+		return api.Node{}, ErrNodeNotFound
 	} else if n.Status == api.Status_STATUS_OFFLINE &&
 		(n.DataIp == "" || n.MgmtIp == "") {
 		// cached info unstable, read from DB
@@ -25,6 +25,7 @@ package main
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/url"
 	"os"
 	"runtime"
@@ -34,6 +35,7 @@ import (
 
 	"github.com/codegangsta/cli"
 	"github.com/docker/docker/pkg/reexec"
+	"github.com/libopenstorage/openstorage/alert"
 	"github.com/libopenstorage/openstorage/api"
 	"github.com/libopenstorage/openstorage/api/flexvolume"
 	"github.com/libopenstorage/openstorage/api/server"
@@ -43,6 +45,9 @@ import (
 	"github.com/libopenstorage/openstorage/config"
 	"github.com/libopenstorage/openstorage/csi"
 	"github.com/libopenstorage/openstorage/graph/drivers"
+	"github.com/libopenstorage/openstorage/pkg/audit"
+	"github.com/libopenstorage/openstorage/pkg/auth"
+	"github.com/libopenstorage/openstorage/pkg/tlsutil"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/libopenstorage/openstorage/volume/drivers"
 	"github.com/portworx/kvdb"
@@ -97,6 +102,74 @@ func main() {
 			Usage: "gRPC REST Gateway port for SDK. Example: 9110",
 			Value: "9110",
 		},
+		cli.StringFlag{
+			Name:   "jwt-shared-secret",
+			Usage:  "Shared secret used to validate SDK tokens signed with HMAC. Enables SDK authentication",
+			EnvVar: "OSD_JWT_SHARED_SECRET",
+		},
+		cli.StringFlag{
+			Name:  "jwt-rsa-pubkey-file",
+			Usage: "RSA public key file used to validate SDK tokens signed with RSA. Enables SDK authentication",
+		},
+		cli.StringFlag{
+			Name:  "jwt-issuer",
+			Usage: "Issuer of the SDK tokens. If set, tokens from other issuers are rejected",
+		},
+		cli.BoolFlag{
+			Name:  "rest-auth",
+			Usage: "Require SDK tokens on the REST management API of the drivers as well. Viewers can only read and credentials are left to administrators. Requires SDK authentication",
+		},
+		cli.StringFlag{
+			Name:   "csi-mgmt-token",
+			Usage:  "Token with the admin role sent by CSI to the REST management API of the other nodes when it requires authentication. Requires --rest-tls",
+			EnvVar: "OSD_CSI_MGMT_TOKEN",
+		},
+		cli.StringFlag{
+			Name:  "tls-cert-file",
+			Usage: "TLS certificate file. Enables TLS on the SDK and its REST gateway",
+		},
+		cli.StringFlag{
+			Name:  "tls-key-file",
+			Usage: "TLS private key file of the certificate",
+		},
+		cli.StringFlag{
+			Name:  "tls-ca-file",
+			Usage: "CA file used to verify client certificates",
+		},
+		cli.BoolFlag{
+			Name:  "tls-client-auth",
+			Usage: "Require clients to present a certificate signed by the CA file",
+		},
+		cli.BoolFlag{
+			Name:  "csi-tls",
+			Usage: "Serve TLS on the CSI endpoints as well",
+		},
+		cli.BoolFlag{
+			Name:  "rest-tls",
+			Usage: "Serve TLS on the port of the REST management API of the drivers as well. CSI then reaches the other nodes over TLS, verifying them with the CA file. Required to send the CSI management token and attach secrets to other nodes",
+		},
+		cli.StringFlag{
+			Name:  "audit-log-file",
+			Usage: "File where the mutating API calls are recorded. Enables auditing. Example: /var/log/osd/audit.log",
+		},
+		cli.IntFlag{
+			Name:  "audit-log-max-size",
+			Usage: "Size in MB of the audit log file before it is rotated",
+			Value: audit.DefaultMaxSize / (1024 * 1024),
+		},
+		cli.IntFlag{
+			Name:  "audit-log-max-backups",
+			Usage: "Number of rotated audit log files kept",
+			Value: audit.DefaultMaxBackups,
+		},
+		cli.BoolFlag{
+			Name:  "audit-kvdb",
+			Usage: "Also record the mutating API calls in the kvdb so that they can be enumerated from the SDK. Enables auditing",
+		},
+		cli.DurationFlag{
+			Name:  "audit-kvdb-ttl",
+			Usage: "Time the audit events are kept in the kvdb. Example: 720h. Events are kept forever if not set",
+		},
 	}
 	app.Action = wrapAction(start)
 	app.Commands = []cli.Command{
@@ -192,6 +265,13 @@ func start(c *cli.Context) error {
 		return fmt.Errorf("Failed to initialize KVDB: %v", err)
 	}
 
+	// Setup auditing, if enabled, before any server is started
+	auditor, err := newAuditor(c, cfg.Osd.ClusterConfig.NodeId, kv)
+	if err != nil {
+		return err
+	}
+	server.SetAuditor(auditor)
+
 	// Start the cluster state machine, if enabled.
 	clusterInit := false
 	if cfg.Osd.ClusterConfig.NodeId != "" && cfg.Osd.ClusterConfig.ClusterId != "" {
@@ -205,6 +285,36 @@ func start(c *cli.Context) error {
 		clusterInit = true
 	}
 
+	// Alerts of the cluster are stored in the kvdb
+	alerts, err := alert.New(alert.Name, cfg.Osd.ClusterConfig.ClusterId, kv)
+	if err != nil {
+		return fmt.Errorf("Unable to setup alerts: %v", err)
+	}
+
+	// Setup SDK authentication, if enabled
+	sdkAuth, err := sdkAuthenticator(c)
+	if err != nil {
+		return err
+	}
+	var restAuth auth.Authenticator
+	if c.Bool("rest-auth") {
+		if sdkAuth == nil {
+			return fmt.Errorf("REST authentication requires SDK authentication to be enabled")
+		}
+		restAuth = sdkAuth
+	}
+
+	// Setup TLS, if enabled
+	sdkTLS := tlsConfig(c, &cfg.Osd.TLS)
+	var csiTLS *tlsutil.Config
+	if cfg.Osd.TLS.EnableCSI {
+		csiTLS = sdkTLS
+	}
+	var restTLS *tlsutil.Config
+	if cfg.Osd.TLS.EnableREST {
+		restTLS = sdkTLS
+	}
+
 	isDefaultSet := false
 	// Start the volume drivers.
 	for d, v := range cfg.Osd.Drivers {
@@ -238,6 +348,8 @@ func start(c *cli.Context) error {
 			volume.PluginAPIBase,
 			uint16(mgmtPort),
 			uint16(pluginPort),
+			restAuth,
+			restTLS,
 		); err != nil {
 			return fmt.Errorf("Unable to start volume plugin: %v", err)
 		}
@@ -257,6 +369,10 @@ func start(c *cli.Context) error {
 			Address:    csisock,
 			DriverName: d,
 			Cluster:    cm,
+			TLS:        csiTLS,
+			MgmtPort:   uint16(mgmtPort),
+			MgmtToken:  c.String("csi-mgmt-token"),
+			MgmtTLS:    restTLS,
 		})
 		if err != nil {
 			return fmt.Errorf("Failed to start CSI server for driver %s: %v", d, err)
@@ -270,6 +386,10 @@ func start(c *cli.Context) error {
 			RestPort:   c.String("sdkrestport"),
 			DriverName: d,
 			Cluster:    cm,
+			Alert:      alerts,
+			Auth:       sdkAuth,
+			TLS:        sdkTLS,
+			Auditor:    auditor,
 		})
 		if err != nil {
 			return fmt.Errorf("Failed to start SDK server for driver %s: %v", d, err)
@@ -307,6 +427,98 @@ func start(c *cli.Context) error {
 	select {}
 }
 
+// newAuditor returns the auditor of the API calls
+// or nil if auditing has not been enabled.
+func newAuditor(c *cli.Context, nodeID string, kv kvdb.Kvdb) (*audit.Auditor, error) {
+	logFile := c.String("audit-log-file")
+	if logFile == "" && !c.Bool("audit-kvdb") {
+		return nil, nil
+	}
+
+	var loggers []audit.Logger
+	if logFile != "" {
+		l, err := audit.NewFileLogger(&audit.FileConfig{
+			Path:       logFile,
+			MaxSize:    int64(c.Int("audit-log-max-size")) * 1024 * 1024,
+			MaxBackups: c.Int("audit-log-max-backups"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Unable to setup audit log: %v", err)
+		}
+		loggers = append(loggers, l)
+	}
+
+	var store audit.Store
+	if c.Bool("audit-kvdb") {
+		store = audit.NewKvdbStore(kv, uint64(c.Duration("audit-kvdb-ttl").Seconds()))
+	}
+
+	return audit.New(nodeID, store, loggers...), nil
+}
+
+// sdkAuthenticator returns the authenticator of the SDK server
+// or nil if authentication has not been enabled.
+func sdkAuthenticator(c *cli.Context) (auth.Authenticator, error) {
+	secret := c.String("jwt-shared-secret")
+	pubkeyFile := c.String("jwt-rsa-pubkey-file")
+	if secret == "" && pubkeyFile == "" {
+		return nil, nil
+	}
+
+	config := &auth.JwtAuthConfig{
+		SharedSecret: []byte(secret),
+		Issuer:       c.String("jwt-issuer"),
+	}
+	if pubkeyFile != "" {
+		pem, err := ioutil.ReadFile(pubkeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to read RSA public key file %s: %v", pubkeyFile, err)
+		}
+		config.RsaPublicPem = pem
+	}
+
+	a, err := auth.NewJwtAuth(config)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to setup SDK authentication: %v", err)
+	}
+	logrus.Infof("SDK authentication enabled")
+	return a, nil
+}
+
+// tlsConfig returns the TLS configuration of the servers or nil if TLS
+// has not been enabled. The flags override the configuration file.
+func tlsConfig(c *cli.Context, cfg *config.TLSConfig) *tlsutil.Config {
+	if certFile := c.String("tls-cert-file"); certFile != "" {
+		cfg.CertFile = certFile
+	}
+	if keyFile := c.String("tls-key-file"); keyFile != "" {
+		cfg.KeyFile = keyFile
+	}
+	if caFile := c.String("tls-ca-file"); caFile != "" {
+		cfg.CAFile = caFile
+	}
+	if c.Bool("tls-client-auth") {
+		cfg.ClientAuth = true
+	}
+	if c.Bool("csi-tls") {
+		cfg.EnableCSI = true
+	}
+	if c.Bool("rest-tls") {
+		cfg.EnableREST = true
+	}
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	logrus.Infof("TLS enabled using certificate %s", cfg.CertFile)
+	return &tlsutil.Config{
+		CertFile:   cfg.CertFile,
+		KeyFile:    cfg.KeyFile,
+		CAFile:     cfg.CAFile,
+		ClientAuth: cfg.ClientAuth,
+	}
+}
+
 func showVersion(c *cli.Context) error {
 	fmt.Println("OSD Version:", config.Version)
 	fmt.Println("Go Version:", runtime.Version())
@@ -40,10 +40,32 @@ type ClusterConfig struct {
 	FluentDHost   string
 }
 
+// TLSConfig provides the certificates used to serve TLS on the SDK
+// and its REST gateway and, if EnableCSI is set, on the CSI endpoints.
+// If EnableREST is set, the REST management API of the drivers is served
+// over TLS as well, and CSI uses TLS to reach it on the other nodes.
+// swagger:model
+type TLSConfig struct {
+	CertFile string
+	KeyFile  string
+	// CAFile is used to verify the certificates of the clients
+	CAFile string
+	// ClientAuth requires clients to present a certificate signed by CAFile
+	ClientAuth bool
+	EnableCSI  bool
+	EnableREST bool
+}
+
+// Enabled returns true if TLS has been configured
+func (c *TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != ""
+}
+
 // swagger:model
 type Config struct {
 	Osd struct {
 		ClusterConfig ClusterConfig `yaml:"cluster"`
+		TLS           TLSConfig     `yaml:"tls"`
 		// map[string]string is volume.VolumeParams equivalent
 		Drivers map[string]map[string]string
 		// map[string]string is volume.VolumeParams equivalent
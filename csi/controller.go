@@ -18,13 +18,17 @@ package csi
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/portworx/kvdb"
 
 	"github.com/libopenstorage/openstorage/api"
+	volumeclient "github.com/libopenstorage/openstorage/api/client/volume"
 	"github.com/libopenstorage/openstorage/pkg/util"
+	"github.com/libopenstorage/openstorage/volume"
 
-	csi "github.com/container-storage-interface/spec/lib/go/csi/v0"
+	csi "github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/golang/protobuf/proto"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
@@ -36,7 +40,12 @@ const (
 	volumeCapabilityMessageNotMultinodeVolume = "Volume is not a multinode volume"
 	volumeCapabilityMessageReadOnlyVolume     = "Volume is read only"
 	volumeCapabilityMessageNotReadOnlyVolume  = "Volume is not read only"
+	volumeCapabilityMessageBlockNotSupported  = "Driver does not support raw block volumes"
 	defaultCSIVolumeSize                      = uint64(1024 * 1024 * 1024)
+
+	// publishContextDevicePath is the key of the device path of an attached
+	// volume in the publish context returned by ControllerPublishVolume
+	publishContextDevicePath = "devicePath"
 )
 
 // ControllerGetCapabilities is a CSI API functions which returns to the caller
@@ -64,31 +73,287 @@ func (s *OsdCsiServer) ControllerGetCapabilities(
 		},
 	}
 
+	// Creating and deleting snapshots supported
+	capCreateDeleteSnapshot := &csi.ControllerServiceCapability{
+		Type: &csi.ControllerServiceCapability_Rpc{
+			Rpc: &csi.ControllerServiceCapability_RPC{
+				Type: csi.ControllerServiceCapability_RPC_CREATE_DELETE_SNAPSHOT,
+			},
+		},
+	}
+
+	// Attaching volumes to nodes supported
+	capPublishUnpublishVolume := &csi.ControllerServiceCapability{
+		Type: &csi.ControllerServiceCapability_Rpc{
+			Rpc: &csi.ControllerServiceCapability_RPC{
+				Type: csi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME,
+			},
+		},
+	}
+
+	// Expanding volumes supported
+	capExpandVolume := &csi.ControllerServiceCapability{
+		Type: &csi.ControllerServiceCapability_Rpc{
+			Rpc: &csi.ControllerServiceCapability_RPC{
+				Type: csi.ControllerServiceCapability_RPC_EXPAND_VOLUME,
+			},
+		},
+	}
+
+	// ListSnapshots supported
+	capListSnapshots := &csi.ControllerServiceCapability{
+		Type: &csi.ControllerServiceCapability_Rpc{
+			Rpc: &csi.ControllerServiceCapability_RPC{
+				Type: csi.ControllerServiceCapability_RPC_LIST_SNAPSHOTS,
+			},
+		},
+	}
+
+	// Cloning volumes supported
+	capCloneVolume := &csi.ControllerServiceCapability{
+		Type: &csi.ControllerServiceCapability_Rpc{
+			Rpc: &csi.ControllerServiceCapability_RPC{
+				Type: csi.ControllerServiceCapability_RPC_CLONE_VOLUME,
+			},
+		},
+	}
+
 	return &csi.ControllerGetCapabilitiesResponse{
 		Capabilities: []*csi.ControllerServiceCapability{
 			capCreateDeleteVolume,
 			capListVolumes,
+			capPublishUnpublishVolume,
+			capCreateDeleteSnapshot,
+			capListSnapshots,
+			capExpandVolume,
+			capCloneVolume,
 		},
 	}, nil
 
 }
 
-// ControllerPublishVolume is a CSI API implements the attachment of a volume
-// on to a node.
+// ControllerPublishVolume is a CSI API which attaches a volume to a node.
+// Volumes of block drivers are attached by the driver of the node, and the
+// device path is returned in the publish context for the node plugin.
+// Volumes of other drivers need no attachment.
 func (s *OsdCsiServer) ControllerPublishVolume(
-	context.Context,
-	*csi.ControllerPublishVolumeRequest,
+	ctx context.Context,
+	req *csi.ControllerPublishVolumeRequest,
 ) (*csi.ControllerPublishVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "This request is not supported")
+
+	// Log request. The secrets are not logged.
+	logrus.Debugf("ControllerPublishVolume of id %s on node %s",
+		req.GetVolumeId(),
+		req.GetNodeId())
+
+	// Check arguments
+	if len(req.GetVolumeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Volume id must be provided")
+	}
+	if len(req.GetNodeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Node id must be provided")
+	}
+	if req.GetVolumeCapability() == nil || req.GetVolumeCapability().GetAccessMode() == nil {
+		return nil, status.Error(codes.InvalidArgument, "Volume access mode must be provided")
+	}
+
+	// Get volume information
+	volumes, err := s.driver.Inspect([]string{req.GetVolumeId()})
+	if err != nil || len(volumes) == 0 {
+		return nil, status.Errorf(codes.NotFound, "Volume id %s not found", req.GetVolumeId())
+	}
+	v := volumes[0]
+
+	node, err := s.inspectNode(req.GetNodeId())
+	if err != nil {
+		return nil, err
+	}
+	if s.driver.Type() != api.DriverType_DRIVER_TYPE_BLOCK {
+		return &csi.ControllerPublishVolumeResponse{}, nil
+	}
+
+	// Check if the volume is already attached
+	if v.GetState() == api.VolumeState_VOLUME_STATE_ATTACHED {
+		if !isAttachedOn(v, node) {
+			return nil, status.Errorf(
+				codes.FailedPrecondition,
+				"Volume %s is attached on %s",
+				req.GetVolumeId(),
+				v.GetAttachedOn())
+		}
+		return &csi.ControllerPublishVolumeResponse{
+			PublishContext: map[string]string{
+				publishContextDevicePath: v.GetDevicePath(),
+			},
+		}, nil
+	}
+
+	// Gather volume attributes
+	spec, _, _, err := s.specHandler.SpecFromOpts(req.GetVolumeContext())
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Invalid volume attributes: %#v",
+			req.GetVolumeContext())
+	}
+	opts, err := s.attachOptions(v, spec, req.GetSecrets())
+	if err != nil {
+		return nil, err
+	}
+
+	// The attach options only carry secrets
+	driver, err := s.nodeDriver(node, len(opts) != 0)
+	if err != nil {
+		return nil, err
+	}
+	devicePath, err := driver.Attach(req.GetVolumeId(), opts)
+	if err != nil {
+		e := fmt.Sprintf("Unable to attach volume %s on node %s: %s",
+			req.GetVolumeId(),
+			req.GetNodeId(),
+			err.Error())
+		logrus.Errorln(e)
+		return nil, status.Error(codes.Internal, e)
+	}
+
+	logrus.Infof("Volume %s attached on node %s at %s",
+		req.GetVolumeId(),
+		req.GetNodeId(),
+		devicePath)
+
+	return &csi.ControllerPublishVolumeResponse{
+		PublishContext: map[string]string{
+			publishContextDevicePath: devicePath,
+		},
+	}, nil
 }
 
-// ControllerUnpublishVolume is a CSI API which implements the detaching of a volume
-// onto a node.
+// ControllerUnpublishVolume is a CSI API which detaches a volume from a node
 func (s *OsdCsiServer) ControllerUnpublishVolume(
-	context.Context,
-	*csi.ControllerUnpublishVolumeRequest,
+	ctx context.Context,
+	req *csi.ControllerUnpublishVolumeRequest,
 ) (*csi.ControllerUnpublishVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "This request is not supported")
+
+	// Log request. The secrets are not logged.
+	logrus.Debugf("ControllerUnpublishVolume of id %s from node %s",
+		req.GetVolumeId(),
+		req.GetNodeId())
+
+	// Check arguments
+	if len(req.GetVolumeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Volume id must be provided")
+	}
+	if len(req.GetNodeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Node id must be provided")
+	}
+
+	// Get volume information
+	volumes, err := s.driver.Inspect([]string{req.GetVolumeId()})
+	if err != nil || len(volumes) == 0 {
+		return nil, status.Errorf(codes.NotFound, "Volume id %s not found", req.GetVolumeId())
+	}
+	v := volumes[0]
+
+	node, err := s.inspectNode(req.GetNodeId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Nothing to do if the volume is not attached on the node
+	if s.driver.Type() != api.DriverType_DRIVER_TYPE_BLOCK ||
+		v.GetState() != api.VolumeState_VOLUME_STATE_ATTACHED ||
+		!isAttachedOn(v, node) {
+		return &csi.ControllerUnpublishVolumeResponse{}, nil
+	}
+
+	driver, err := s.nodeDriver(node, false)
+	if err != nil {
+		return nil, err
+	}
+	err = driver.Detach(req.GetVolumeId(), nil)
+	if err != nil && err != volume.ErrVolDetached {
+		e := fmt.Sprintf("Unable to detach volume %s from node %s: %s",
+			req.GetVolumeId(),
+			req.GetNodeId(),
+			err.Error())
+		logrus.Errorln(e)
+		return nil, status.Error(codes.Internal, e)
+	}
+
+	logrus.Infof("Volume %s detached from node %s",
+		req.GetVolumeId(),
+		req.GetNodeId())
+
+	return &csi.ControllerUnpublishVolumeResponse{}, nil
+}
+
+// inspectNode returns the information of the node
+func (s *OsdCsiServer) inspectNode(nodeID string) (*api.Node, error) {
+	node, err := s.cluster.Inspect(nodeID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Node id %s not found: %s", nodeID, err)
+	}
+	return &node, nil
+}
+
+// nodeDriver returns the driver which attaches the volumes on the node.
+// The requests for other nodes are sent to the REST management server of
+// the driver on the node, with the management token if one is configured.
+// Requests carrying secrets are refused unless they are sent over TLS.
+func (s *OsdCsiServer) nodeDriver(node *api.Node, secrets bool) (volume.BlockDriver, error) {
+	clus, err := s.cluster.Enumerate()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to Enumerate cluster: %s", err)
+	}
+	if clus.NodeId == node.Id {
+		return s.driver, nil
+	}
+
+	if s.mgmtPort == 0 {
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			"Unable to reach node %s: the management port of driver %s is not configured",
+			node.Id,
+			s.driver.Name())
+	}
+	scheme := "https"
+	if s.mgmtCerts == nil {
+		if secrets {
+			return nil, status.Errorf(
+				codes.FailedPrecondition,
+				"Unable to send the secrets to node %s: TLS is not configured on the management API of driver %s",
+				node.Id,
+				s.driver.Name())
+		}
+		scheme = "http"
+	}
+
+	c, err := volumeclient.NewDriverClient(
+		fmt.Sprintf("%s://%s:%d", scheme, node.MgmtIp, s.mgmtPort),
+		s.driver.Name(),
+		volume.APIVersion,
+		s.driver.Name())
+	if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Unable to create a client for node %s: %s",
+			node.Id,
+			err)
+	}
+	if s.mgmtCerts != nil {
+		c.SetTLS(s.mgmtCerts.ClientConfig())
+	}
+	c.SetBearerToken(s.mgmtToken)
+	return volumeclient.VolumeDriver(c), nil
+}
+
+// isAttachedOn returns true if the volume is attached on the node
+func isAttachedOn(v *api.Volume, node *api.Node) bool {
+	switch v.GetAttachedOn() {
+	case node.Id, node.MgmtIp, node.DataIp, node.Hostname:
+		return len(v.GetAttachedOn()) != 0
+	}
+	return false
 }
 
 // ValidateVolumeCapabilities is a CSI API used by container orchestration systems
@@ -110,15 +375,15 @@ func (s *OsdCsiServer) ValidateVolumeCapabilities(
 	if len(id) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "volume_id must be specified")
 	}
-	attributes := req.GetVolumeAttributes()
+	volumeContext := req.GetVolumeContext()
 
 	// Log request
 	logrus.Debugf("ValidateVolumeCapabilities of id %s "+
 		"capabilities %#v "+
-		"attributes %#v ",
+		"volume context %#v ",
 		id,
 		capabilities,
-		attributes)
+		volumeContext)
 
 	// Check ID is valid with the specified volume capabilities
 	volumes, err := s.driver.Inspect([]string{id})
@@ -142,67 +407,70 @@ func (s *OsdCsiServer) ValidateVolumeCapabilities(
 		return nil, status.Error(codes.Internal, errs)
 	}
 
-	// Setup uninitialized response object
-	result := &csi.ValidateVolumeCapabilitiesResponse{
-		Supported: true,
-	}
+	// Setup uninitialized response object. The capabilities are
+	// confirmed only if all of them are supported.
+	result := &csi.ValidateVolumeCapabilitiesResponse{}
+	supported := true
 
 	// Check capability
 	for _, capability := range capabilities {
-		// Currently the CSI spec defines all storage as "file systems."
-		// So we do not need to check this with the volume. All we will check
-		// here is the validity of the capability access type.
+		// Every volume can be mounted as a filesystem while raw block
+		// access is only available from block drivers.
 		if capability.GetMount() == nil && capability.GetBlock() == nil {
 			return nil, status.Error(
 				codes.InvalidArgument,
 				"Cannot have both mount and block be undefined")
 		}
+		if capability.GetBlock() != nil && !s.supportsBlock() {
+			result.Message = volumeCapabilityMessageBlockNotSupported
+			return result, nil
+		}
 
 		// Check access mode is setup correctly
 		mode := capability.GetAccessMode()
 		switch {
 		case mode.Mode == csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER:
 			if v.Spec.Shared {
-				result.Supported = false
+				supported = false
 				result.Message = volumeCapabilityMessageMultinodeVolume
 				break
 			}
 			if v.Readonly {
-				result.Supported = false
+				supported = false
 				result.Message = volumeCapabilityMessageReadOnlyVolume
 				break
 			}
 		case mode.Mode == csi.VolumeCapability_AccessMode_SINGLE_NODE_READER_ONLY:
 			if v.Spec.Shared {
-				result.Supported = false
+				supported = false
 				result.Message = volumeCapabilityMessageMultinodeVolume
 				break
 			}
 			if !v.Readonly {
-				result.Supported = false
+				supported = false
 				result.Message = volumeCapabilityMessageNotReadOnlyVolume
 				break
 			}
 		case mode.Mode == csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY:
 			if !v.Spec.Shared {
-				result.Supported = false
+				supported = false
 				result.Message = volumeCapabilityMessageNotMultinodeVolume
 				break
 			}
 			if !v.Readonly {
-				result.Supported = false
+				supported = false
 				result.Message = volumeCapabilityMessageNotReadOnlyVolume
 				break
 			}
 		case mode.Mode == csi.VolumeCapability_AccessMode_MULTI_NODE_SINGLE_WRITER ||
 			mode.Mode == csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER:
 			if !v.Spec.Shared {
-				result.Supported = false
+				supported = false
 				result.Message = volumeCapabilityMessageNotMultinodeVolume
 				break
 			}
 			if v.Readonly {
-				result.Supported = false
+				supported = false
 				result.Message = volumeCapabilityMessageReadOnlyVolume
 				break
 			}
@@ -213,12 +481,17 @@ func (s *OsdCsiServer) ValidateVolumeCapabilities(
 				mode.Mode.String())
 		}
 
-		if !result.Supported {
+		if !supported {
 			return result, nil
 		}
 	}
 
 	// If we passed all the checks, then it is valid
+	result.Confirmed = &csi.ValidateVolumeCapabilitiesResponse_Confirmed{
+		VolumeContext:      req.GetVolumeContext(),
+		VolumeCapabilities: capabilities,
+		Parameters:         req.GetParameters(),
+	}
 	result.Message = "Volume is supported"
 	return result, nil
 }
@@ -260,16 +533,16 @@ func (s *OsdCsiServer) ListVolumes(
 		}
 
 		// Required
-		entries[i].Volume.Id = v.Id
+		entries[i].Volume.VolumeId = v.Id
 
 		// This entry is optional in the API, but OSD has
 		// the information available to provide it
 		entries[i].Volume.CapacityBytes = int64(v.Spec.Size)
 
-		// Attributes. We can add or remove as needed since they
+		// Volume context. We can add or remove as needed since they
 		// are optional and opaque to the Container Orchestrator(CO)
 		// but could be used for debugging using a csi complient client.
-		entries[i].Volume.Attributes = osdVolumeAttributes(v)
+		entries[i].Volume.VolumeContext = osdVolumeAttributes(v)
 	}
 
 	return &csi.ListVolumesResponse{
@@ -293,14 +566,17 @@ func osdVolumeAttributes(v *api.Volume) map[string]string {
 
 // CreateVolume is a CSI API which creates a volume on OSD
 // This function supports snapshots if the parent volume id is supplied
-// in the parameters.
+// in the parameters, and restores a snapshot or clones a volume if the
+// snapshot or volume id is supplied as the volume content source. The replicas of a new volume
+// are placed on the nodes satisfying the accessibility requirements.
 func (s *OsdCsiServer) CreateVolume(
 	ctx context.Context,
 	req *csi.CreateVolumeRequest,
 ) (*csi.CreateVolumeResponse, error) {
 
-	// Log request
-	logrus.Debugf("CreateVolume req[%#v]", *req)
+	// Log request. The parameters and secrets are not logged since
+	// they may contain passphrases.
+	logrus.Debugf("CreateVolume of name %s", req.GetName())
 
 	if len(req.GetName()) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "Name must be provided")
@@ -308,6 +584,12 @@ func (s *OsdCsiServer) CreateVolume(
 	if req.GetVolumeCapabilities() == nil || len(req.GetVolumeCapabilities()) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "Volume capabilities must be provided")
 	}
+	if csiRequestsBlockVolume(req) && !s.supportsBlock() {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Driver %s does not support raw block volumes",
+			s.driver.Name())
+	}
 
 	// Get parameters
 	spec, locator, source, err := s.specHandler.SpecFromOpts(req.GetParameters())
@@ -317,6 +599,22 @@ func (s *OsdCsiServer) CreateVolume(
 		return nil, status.Error(codes.InvalidArgument, e)
 	}
 
+	// Restoring a snapshot creates a writable snapshot of the snapshot
+	// and cloning a volume a writable snapshot of the volume
+	contentSource := req.GetVolumeContentSource()
+	if contentSnapshot := contentSource.GetSnapshot(); contentSnapshot != nil {
+		if len(contentSnapshot.GetSnapshotId()) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "Snapshot id must be provided")
+		}
+		source.Parent = contentSnapshot.GetSnapshotId()
+	}
+	if contentVolume := contentSource.GetVolume(); contentVolume != nil {
+		if len(contentVolume.GetVolumeId()) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "Source volume id must be provided")
+		}
+		source.Parent = contentVolume.GetVolumeId()
+	}
+
 	// Get Size
 	if req.GetCapacityRange() != nil && req.GetCapacityRange().GetRequiredBytes() != 0 {
 		spec.Size = uint64(req.GetCapacityRange().GetRequiredBytes())
@@ -333,8 +631,11 @@ func (s *OsdCsiServer) CreateVolume(
 	// Check if the volume has already been created or is in process of creation
 	v, err := util.VolumeFromName(s.driver, req.GetName())
 	if err == nil {
-		// Check the requested arguments match that of the existing volume
-		if spec.Size != v.GetSpec().GetSize() {
+		// Check the requested arguments match that of the existing volume.
+		// Volumes created from a source have the size of the source
+		// unless a size is requested.
+		sized := len(source.GetParent()) == 0 || req.GetCapacityRange().GetRequiredBytes() != 0
+		if sized && spec.Size != v.GetSpec().GetSize() {
 			return nil, status.Errorf(
 				codes.AlreadyExists,
 				"Existing volume has a size of %v which differs from requested size of %v",
@@ -354,6 +655,10 @@ func (s *OsdCsiServer) CreateVolume(
 
 		// Return information on existing volume
 		osdToCsiVolumeInfo(volume, v)
+		volume.ContentSource = req.GetVolumeContentSource()
+		if volume.AccessibleTopology, err = s.volumeTopology(v); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 		return resp, nil
 	}
 
@@ -365,22 +670,66 @@ func (s *OsdCsiServer) CreateVolume(
 		if err != nil {
 			e := fmt.Sprintf("unable to get parent volume information: %s\n", err.Error())
 			logrus.Errorln(e)
+			if contentSource != nil {
+				return nil, status.Error(codes.NotFound, e)
+			}
 			return nil, status.Error(codes.InvalidArgument, e)
 		}
 
-		// Create a snapshot from the parent
-		id, err = s.driver.Snapshot(parent.GetId(), false, &api.VolumeLocator{
+		// The volume has the size of its parent unless a larger size is requested
+		sized := req.GetCapacityRange().GetRequiredBytes() != 0
+		if sized && spec.Size < parent.GetSpec().GetSize() {
+			return nil, status.Errorf(
+				codes.OutOfRange,
+				"Requested size of %v is smaller than the size of %v of the source %s",
+				spec.Size,
+				parent.GetSpec().GetSize(),
+				source.GetParent())
+		}
+
+		// Create a snapshot from the parent, with the labels requested
+		snapLocator := &api.VolumeLocator{
 			Name: req.GetName(),
-		})
+		}
+		if len(locator.GetVolumeLabels()) != 0 {
+			snapLocator.VolumeLabels = locator.GetVolumeLabels()
+		}
+		id, err = s.driver.Snapshot(parent.GetId(), false, snapLocator)
 		if err != nil {
 			e := fmt.Sprintf("unable to create snapshot: %s\n", err.Error())
 			logrus.Errorln(e)
 			return nil, status.Error(codes.Internal, e)
 		}
+		if sized && spec.Size > parent.GetSpec().GetSize() {
+			if err := s.resizeVolume(id, spec.Size); err != nil {
+				return nil, err
+			}
+		}
 	} else {
 		// Get Capabilities and Size
 		spec.Shared = csiRequestsSharedVolume(req)
 
+		// Volumes created with a passphrase are encrypted. Only the name
+		// of the secret store key of the passphrase is saved in the volume.
+		secret, err := s.secretFromRequest(req.GetSecrets())
+		if err != nil {
+			return nil, err
+		}
+		if secret != nil {
+			spec.Encrypted = true
+			if len(secret.key) != 0 {
+				spec.Passphrase = secret.key
+			}
+		}
+
+		// Place the replicas according to the topology requirements
+		if req.GetAccessibilityRequirements() != nil {
+			err = s.applyTopologyRequirement(req.GetAccessibilityRequirements(), locator, spec)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		// Create the volume
 		locator.Name = req.GetName()
 		id, err = s.driver.Create(locator, source, spec)
@@ -397,17 +746,49 @@ func (s *OsdCsiServer) CreateVolume(
 		return nil, status.Error(codes.Internal, e)
 	}
 	osdToCsiVolumeInfo(volume, v)
+	volume.ContentSource = req.GetVolumeContentSource()
+	if volume.AccessibleTopology, err = s.volumeTopology(v); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 	return resp, nil
 }
 
+// resizeVolume grows the volume created from a source to the size requested.
+// The volume is deleted if it cannot be grown so that the creation can be
+// retried.
+func (s *OsdCsiServer) resizeVolume(id string, size uint64) error {
+	v, err := util.VolumeFromName(s.driver, id)
+	if err == nil {
+		// Only the size of the spec is changed
+		spec := &api.VolumeSpec{}
+		if v.GetSpec() != nil {
+			spec = proto.Clone(v.GetSpec()).(*api.VolumeSpec)
+		}
+		spec.Size = size
+		err = s.driver.Set(id, nil, spec)
+	}
+	if err != nil {
+		if derr := s.driver.Delete(id); derr != nil {
+			logrus.Warnf("Unable to delete volume %s which could not be resized: %v", id, derr)
+		}
+		return status.Errorf(
+			codes.Internal,
+			"Unable to resize volume %s to %v: %v",
+			id,
+			size,
+			err)
+	}
+	return nil
+}
+
 // DeleteVolume is a CSI API which deletes a volume
 func (s *OsdCsiServer) DeleteVolume(
 	ctx context.Context,
 	req *csi.DeleteVolumeRequest,
 ) (*csi.DeleteVolumeResponse, error) {
 
-	// Log request
-	logrus.Debugf("DeleteVolume req[%#v]", *req)
+	// Log request. The secrets are not logged.
+	logrus.Debugf("DeleteVolume of id %s", req.GetVolumeId())
 
 	// Check arguments
 	if len(req.GetVolumeId()) == 0 {
@@ -436,10 +817,288 @@ func (s *OsdCsiServer) DeleteVolume(
 	return &csi.DeleteVolumeResponse{}, nil
 }
 
+// ControllerExpandVolume is a CSI API which expands a volume to the
+// requested size. Volumes are never shrunk. The filesystems of the
+// volumes of block drivers are then grown by NodeExpandVolume.
+func (s *OsdCsiServer) ControllerExpandVolume(
+	ctx context.Context,
+	req *csi.ControllerExpandVolumeRequest,
+) (*csi.ControllerExpandVolumeResponse, error) {
+
+	// Log request. The secrets are not logged.
+	logrus.Debugf("ControllerExpandVolume of id %s to %d bytes",
+		req.GetVolumeId(),
+		req.GetCapacityRange().GetRequiredBytes())
+
+	// Check arguments
+	if len(req.GetVolumeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Volume id must be provided")
+	}
+	size := req.GetCapacityRange().GetRequiredBytes()
+	if size <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Required bytes must be provided")
+	}
+	if limit := req.GetCapacityRange().GetLimitBytes(); limit != 0 && size > limit {
+		return nil, status.Errorf(
+			codes.OutOfRange,
+			"Required bytes %d exceed the limit of %d bytes",
+			size,
+			limit)
+	}
+
+	// Get volume information
+	volumes, err := s.driver.Inspect([]string{req.GetVolumeId()})
+	if err != nil || len(volumes) == 0 {
+		return nil, status.Errorf(codes.NotFound, "Volume id %s not found", req.GetVolumeId())
+	}
+	v := volumes[0]
+
+	resp := &csi.ControllerExpandVolumeResponse{
+		CapacityBytes:         int64(v.GetSpec().GetSize()),
+		NodeExpansionRequired: s.driver.Type() == api.DriverType_DRIVER_TYPE_BLOCK,
+	}
+	if uint64(size) <= v.GetSpec().GetSize() {
+		return resp, nil
+	}
+
+	// Update the size of the volume
+	spec := &api.VolumeSpec{}
+	if v.GetSpec() != nil {
+		spec = proto.Clone(v.GetSpec()).(*api.VolumeSpec)
+	}
+	spec.Size = uint64(size)
+	if err := s.driver.Set(req.GetVolumeId(), nil, spec); err != nil {
+		e := fmt.Sprintf("Unable to expand volume %s to %d bytes: %s",
+			req.GetVolumeId(),
+			size,
+			err.Error())
+		logrus.Errorln(e)
+		return nil, status.Error(codes.Internal, e)
+	}
+
+	logrus.Infof("Volume %s expanded to %d bytes", req.GetVolumeId(), size)
+
+	resp.CapacityBytes = size
+	return resp, nil
+}
+
+// CreateSnapshot is a CSI API which creates a read only snapshot of a volume.
+// The name of the snapshot is used to find a snapshot which has already
+// been created by a previous request.
+func (s *OsdCsiServer) CreateSnapshot(
+	ctx context.Context,
+	req *csi.CreateSnapshotRequest,
+) (*csi.CreateSnapshotResponse, error) {
+
+	// Log request. The secrets are not logged.
+	logrus.Debugf("CreateSnapshot of volume %s name %s",
+		req.GetSourceVolumeId(),
+		req.GetName())
+
+	// Check arguments
+	if len(req.GetSourceVolumeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Source volume id must be provided")
+	}
+	if len(req.GetName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Name must be provided")
+	}
+
+	// Check if the snapshot has already been created
+	v, err := util.VolumeFromName(s.driver, req.GetName())
+	if err == nil {
+		if !v.IsSnapshot() || v.GetSource().GetParent() != req.GetSourceVolumeId() {
+			return nil, status.Errorf(
+				codes.AlreadyExists,
+				"A volume named %s already exists which is not a snapshot of volume %s",
+				req.GetName(),
+				req.GetSourceVolumeId())
+		}
+		return &csi.CreateSnapshotResponse{
+			Snapshot: osdToCsiSnapshot(v),
+		}, nil
+	}
+
+	// Check the source volume exists
+	volumes, err := s.driver.Inspect([]string{req.GetSourceVolumeId()})
+	if err != nil || len(volumes) == 0 {
+		return nil, status.Errorf(codes.NotFound, "Volume id %s not found", req.GetSourceVolumeId())
+	}
+
+	// Create the snapshot
+	id, err := s.driver.Snapshot(req.GetSourceVolumeId(), true, &api.VolumeLocator{
+		Name: req.GetName(),
+	})
+	if err != nil {
+		e := fmt.Sprintf("Unable to create snapshot of volume %s: %s",
+			req.GetSourceVolumeId(),
+			err.Error())
+		logrus.Errorln(e)
+		return nil, status.Error(codes.Internal, e)
+	}
+
+	snapshots, err := s.driver.Inspect([]string{id})
+	if err != nil || len(snapshots) == 0 {
+		e := fmt.Sprintf("Unable to find newly created snapshot %s", id)
+		logrus.Errorln(e)
+		return nil, status.Error(codes.Internal, e)
+	}
+	return &csi.CreateSnapshotResponse{
+		Snapshot: osdToCsiSnapshot(snapshots[0]),
+	}, nil
+}
+
+// DeleteSnapshot is a CSI API which deletes a snapshot
+func (s *OsdCsiServer) DeleteSnapshot(
+	ctx context.Context,
+	req *csi.DeleteSnapshotRequest,
+) (*csi.DeleteSnapshotResponse, error) {
+
+	// Log request. The secrets are not logged.
+	logrus.Debugf("DeleteSnapshot of id %s", req.GetSnapshotId())
+
+	// Check arguments
+	if len(req.GetSnapshotId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Snapshot id must be provided")
+	}
+
+	// If the snapshot is not found, then we can return OK
+	volumes, err := s.driver.Inspect([]string{req.GetSnapshotId()})
+	if (err == nil && len(volumes) == 0) ||
+		(err != nil && err == kvdb.ErrNotFound) {
+		return &csi.DeleteSnapshotResponse{}, nil
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !volumes[0].IsSnapshot() {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Volume %s is not a snapshot",
+			req.GetSnapshotId())
+	}
+
+	// Delete snapshot
+	err = s.driver.Delete(req.GetSnapshotId())
+	if err != nil {
+		e := fmt.Sprintf("Unable to delete snapshot with id %s: %s",
+			req.GetSnapshotId(),
+			err.Error())
+		logrus.Errorln(e)
+		return nil, status.Error(codes.Internal, e)
+	}
+
+	return &csi.DeleteSnapshotResponse{}, nil
+}
+
+// ListSnapshots is a CSI API which returns the snapshots on this cluster,
+// optionally only the snapshot with the requested id or the snapshots of
+// the requested volume. The snapshots are returned in id order and the
+// tokens are the id of the first snapshot of the next page.
+func (s *OsdCsiServer) ListSnapshots(
+	ctx context.Context,
+	req *csi.ListSnapshotsRequest,
+) (*csi.ListSnapshotsResponse, error) {
+
+	logrus.Debugf("ListSnapshots req[%#v]", req)
+
+	if req.GetMaxEntries() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Max entries must not be negative")
+	}
+
+	var (
+		volumes []*api.Volume
+		err     error
+	)
+	if len(req.GetSnapshotId()) != 0 {
+		volumes, err = s.driver.Inspect([]string{req.GetSnapshotId()})
+		if err == kvdb.ErrNotFound {
+			volumes, err = nil, nil
+		}
+	} else {
+		var ids []string
+		if len(req.GetSourceVolumeId()) != 0 {
+			ids = []string{req.GetSourceVolumeId()}
+		}
+		volumes, err = s.driver.SnapEnumerate(ids, nil)
+	}
+	if err != nil {
+		errs := fmt.Sprintf("Unable to get list of snapshots: %s", err.Error())
+		logrus.Errorln(errs)
+		return nil, status.Error(codes.Internal, errs)
+	}
+
+	snapshots := make([]*api.Volume, 0, len(volumes))
+	for _, v := range volumes {
+		if !v.IsSnapshot() {
+			continue
+		}
+		if len(req.GetSourceVolumeId()) != 0 &&
+			v.GetSource().GetParent() != req.GetSourceVolumeId() {
+			continue
+		}
+		snapshots = append(snapshots, v)
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].GetId() < snapshots[j].GetId()
+	})
+
+	// Return the page starting at the token
+	start := 0
+	if token := req.GetStartingToken(); len(token) != 0 {
+		start = sort.Search(len(snapshots), func(i int) bool {
+			return snapshots[i].GetId() >= token
+		})
+	}
+	end := len(snapshots)
+	if max := int(req.GetMaxEntries()); max != 0 && start+max < end {
+		end = start + max
+	}
+
+	resp := &csi.ListSnapshotsResponse{
+		Entries: make([]*csi.ListSnapshotsResponse_Entry, 0, end-start),
+	}
+	for _, v := range snapshots[start:end] {
+		resp.Entries = append(resp.Entries, &csi.ListSnapshotsResponse_Entry{
+			Snapshot: osdToCsiSnapshot(v),
+		})
+	}
+	if end < len(snapshots) {
+		resp.NextToken = snapshots[end].GetId()
+	}
+	return resp, nil
+}
+
+// osdToCsiSnapshot returns the CSI information of an OSD snapshot.
+// OSD snapshots can be used as soon as they are created.
+func osdToCsiSnapshot(v *api.Volume) *csi.Snapshot {
+	return &csi.Snapshot{
+		SnapshotId:     v.GetId(),
+		SourceVolumeId: v.GetSource().GetParent(),
+		SizeBytes:      int64(v.GetSpec().GetSize()),
+		CreationTime:   v.GetCtime(),
+		ReadyToUse:     true,
+	}
+}
+
 func osdToCsiVolumeInfo(dest *csi.Volume, src *api.Volume) {
-	dest.Id = src.GetId()
+	dest.VolumeId = src.GetId()
 	dest.CapacityBytes = int64(src.Spec.GetSize())
-	dest.Attributes = osdVolumeAttributes(src)
+	dest.VolumeContext = osdVolumeAttributes(src)
+}
+
+func csiRequestsBlockVolume(req *csi.CreateVolumeRequest) bool {
+	for _, cap := range req.GetVolumeCapabilities() {
+		if cap.GetBlock() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// supportsBlock returns true if the volumes of the driver can be
+// accessed as raw block devices. File drivers like nfs and vfs
+// only provide filesystems.
+func (s *OsdCsiServer) supportsBlock() bool {
+	return s.driver.Type() == api.DriverType_DRIVER_TYPE_BLOCK
 }
 
 func csiRequestsSharedVolume(req *csi.CreateVolumeRequest) bool {
@@ -453,10 +1112,3 @@ func csiRequestsSharedVolume(req *csi.CreateVolumeRequest) bool {
 	}
 	return false
 }
-
-/*
-For next patches what still needs to be worked on in the Conroller server:
-
-	GetCapacity(context.Context, *GetCapacityRequest) (*GetCapacityResponse, error)
-	ControllerGetCapabilities(context.Context, *ControllerGetCapabilitiesRequest) (*ControllerGetCapabilitiesResponse, error)
-*/
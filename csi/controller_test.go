@@ -19,14 +19,20 @@ package csi
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/osdconfig"
+	"github.com/libopenstorage/openstorage/pkg/proto/time"
+	"github.com/libopenstorage/openstorage/volume/drivers/fake"
 	"github.com/portworx/kvdb"
 
-	csi "github.com/container-storage-interface/spec/lib/go/csi/v0"
+	csi "github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -48,6 +54,11 @@ func TestControllerGetCapabilities(t *testing.T) {
 	expectedValues := []csi.ControllerServiceCapability_RPC_Type{
 		csi.ControllerServiceCapability_RPC_LIST_VOLUMES,
 		csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME,
+		csi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME,
+		csi.ControllerServiceCapability_RPC_CREATE_DELETE_SNAPSHOT,
+		csi.ControllerServiceCapability_RPC_LIST_SNAPSHOTS,
+		csi.ControllerServiceCapability_RPC_EXPAND_VOLUME,
+		csi.ControllerServiceCapability_RPC_CLONE_VOLUME,
 	}
 	caps := r.GetCapabilities()
 	assert.Len(t, caps, len(expectedValues))
@@ -63,38 +74,374 @@ func TestControllerGetCapabilities(t *testing.T) {
 	assert.Equal(t, found, len(expectedValues))
 }
 
-func TestControllerPublishVolume(t *testing.T) {
+func TestControllerPublishVolumeBadArguments(t *testing.T) {
 	// Create server and client connection
 	s := newTestServer(t)
 	defer s.Stop()
+	c := csi.NewControllerClient(s.Conn())
 
-	// Make a call
+	testargs := []struct {
+		expectedErrorContains string
+		req                   *csi.ControllerPublishVolumeRequest
+	}{
+		{
+			expectedErrorContains: "Volume id",
+			req:                   &csi.ControllerPublishVolumeRequest{},
+		},
+		{
+			expectedErrorContains: "Node id",
+			req: &csi.ControllerPublishVolumeRequest{
+				VolumeId: "myid",
+			},
+		},
+		{
+			expectedErrorContains: "Volume access mode",
+			req: &csi.ControllerPublishVolumeRequest{
+				VolumeId: "myid",
+				NodeId:   "mynode",
+			},
+		},
+	}
+
+	for _, testarg := range testargs {
+		_, err := c.ControllerPublishVolume(context.Background(), testarg.req)
+		assert.NotNil(t, err)
+
+		serverError, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, serverError.Code())
+		assert.Contains(t, serverError.Message(), testarg.expectedErrorContains)
+	}
+}
+
+func TestControllerPublishVolume(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
 	c := csi.NewControllerClient(s.Conn())
-	_, err := c.ControllerPublishVolume(context.Background(), &csi.ControllerPublishVolumeRequest{})
-	assert.NotNil(t, err)
 
+	id := "myid"
+	nodeID := "mynode"
+	devicePath := "/dev/mydev"
+	req := &csi.ControllerPublishVolumeRequest{
+		VolumeId: id,
+		NodeId:   nodeID,
+		VolumeCapability: &csi.VolumeCapability{
+			AccessMode: &csi.VolumeCapability_AccessMode{},
+		},
+	}
+
+	// Volume not found
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{}, nil).
+		Times(1)
+	_, err := c.ControllerPublishVolume(context.Background(), req)
+	assert.NotNil(t, err)
 	serverError, ok := status.FromError(err)
 	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, serverError.Code())
 
-	assert.Equal(t, serverError.Code(), codes.Unimplemented)
-	assert.Contains(t, serverError.Message(), "not supported")
+	// Node not found
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{&api.Volume{Id: id}}, nil).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Inspect(nodeID).
+			Return(api.Node{}, fmt.Errorf("not found")).
+			Times(1),
+	)
+	_, err = c.ControllerPublishVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, serverError.Code())
+
+	// Attach on the local node
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{&api.Volume{Id: id}}, nil).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Inspect(nodeID).
+			Return(api.Node{Id: nodeID}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Enumerate().
+			Return(api.Cluster{NodeId: nodeID}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Attach(id, gomock.Any()).
+			Return(devicePath, nil).
+			Times(1),
+	)
+	r, err := c.ControllerPublishVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, devicePath, r.GetPublishContext()[publishContextDevicePath])
+
+	// Already attached on the node
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:         id,
+					State:      api.VolumeState_VOLUME_STATE_ATTACHED,
+					AttachedOn: nodeID,
+					DevicePath: devicePath,
+				},
+			}, nil).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Inspect(nodeID).
+			Return(api.Node{Id: nodeID}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+	)
+	r, err = c.ControllerPublishVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, devicePath, r.GetPublishContext()[publishContextDevicePath])
+
+	// Attached on another node
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:         id,
+					State:      api.VolumeState_VOLUME_STATE_ATTACHED,
+					AttachedOn: "othernode",
+				},
+			}, nil).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Inspect(nodeID).
+			Return(api.Node{Id: nodeID}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+	)
+	_, err = c.ControllerPublishVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, serverError.Code())
+
+	// Remote node without a management port
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{&api.Volume{Id: id}}, nil).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Inspect(nodeID).
+			Return(api.Node{Id: nodeID}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Enumerate().
+			Return(api.Cluster{NodeId: "othernode"}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Name().
+			Return(mockDriverName).
+			Times(1),
+	)
+	_, err = c.ControllerPublishVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, serverError.Code())
+	assert.Contains(t, serverError.Message(), "management port")
+
+	// Secrets are not sent to remote nodes without TLS
+	s.Server().(*OsdCsiServer).mgmtPort = 9001
+	defer func() { s.Server().(*OsdCsiServer).mgmtPort = 0 }()
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:   id,
+					Spec: &api.VolumeSpec{Passphrase: "secret"},
+				},
+			}, nil).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Inspect(nodeID).
+			Return(api.Node{Id: nodeID}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Enumerate().
+			Return(api.Cluster{NodeId: "othernode"}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Name().
+			Return(mockDriverName).
+			Times(1),
+	)
+	_, err = c.ControllerPublishVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, serverError.Code())
+	assert.Contains(t, serverError.Message(), "TLS")
+
+	// Volumes of file drivers are not attached
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{&api.Volume{Id: id}}, nil).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Inspect(nodeID).
+			Return(api.Node{Id: nodeID}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_FILE).
+			Times(1),
+	)
+	r, err = c.ControllerPublishVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Empty(t, r.GetPublishContext())
 }
 
-func TestControllerUnPublishVolume(t *testing.T) {
+func TestControllerUnpublishVolume(t *testing.T) {
 	// Create server and client connection
 	s := newTestServer(t)
 	defer s.Stop()
-
-	// Make a call
 	c := csi.NewControllerClient(s.Conn())
-	_, err := c.ControllerUnpublishVolume(context.Background(), &csi.ControllerUnpublishVolumeRequest{})
-	assert.NotNil(t, err)
 
+	id := "myid"
+	nodeID := "mynode"
+	req := &csi.ControllerUnpublishVolumeRequest{
+		VolumeId: id,
+		NodeId:   nodeID,
+	}
+
+	// Bad arguments
+	_, err := c.ControllerUnpublishVolume(
+		context.Background(),
+		&csi.ControllerUnpublishVolumeRequest{VolumeId: id})
+	assert.NotNil(t, err)
 	serverError, ok := status.FromError(err)
 	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, serverError.Code())
+	assert.Contains(t, serverError.Message(), "Node id")
 
-	assert.Equal(t, serverError.Code(), codes.Unimplemented)
-	assert.Contains(t, serverError.Message(), "not supported")
+	// Not attached on the node
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:    id,
+					State: api.VolumeState_VOLUME_STATE_DETACHED,
+				},
+			}, nil).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Inspect(nodeID).
+			Return(api.Node{Id: nodeID}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+	)
+	_, err = c.ControllerUnpublishVolume(context.Background(), req)
+	assert.Nil(t, err)
+
+	// Detach from the local node
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:         id,
+					State:      api.VolumeState_VOLUME_STATE_ATTACHED,
+					AttachedOn: "10.0.0.1",
+				},
+			}, nil).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Inspect(nodeID).
+			Return(api.Node{Id: nodeID, MgmtIp: "10.0.0.1"}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Enumerate().
+			Return(api.Cluster{NodeId: nodeID}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Detach(id, nil).
+			Return(fmt.Errorf("DETACH ERROR")).
+			Times(1),
+	)
+	_, err = c.ControllerUnpublishVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Internal, serverError.Code())
+	assert.Contains(t, serverError.Message(), "DETACH ERROR")
 }
 
 func TestControllerValidateVolumeCapabilitiesBadArguments(t *testing.T) {
@@ -276,6 +623,67 @@ func TestControllerValidateVolumeInvalidCapabilities(t *testing.T) {
 	assert.Contains(t, serverError.Message(), "Cannot have both")
 }
 
+func TestControllerValidateVolumeBlock(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Setup mock. Raw block access is only supported by block drivers.
+	id := "testvolumeid"
+	vol := &api.Volume{
+		Id:   id,
+		Spec: &api.VolumeSpec{},
+	}
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{vol}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_FILE).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{vol}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+	)
+
+	// Setup request
+	req := &csi.ValidateVolumeCapabilitiesRequest{
+		VolumeCapabilities: []*csi.VolumeCapability{
+			&csi.VolumeCapability{
+				AccessMode: &csi.VolumeCapability_AccessMode{
+					Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+				},
+				AccessType: &csi.VolumeCapability_Block{
+					Block: &csi.VolumeCapability_BlockVolume{},
+				},
+			},
+		},
+		VolumeId: id,
+	}
+
+	// Make request
+	c := csi.NewControllerClient(s.Conn())
+	r, err := c.ValidateVolumeCapabilities(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Nil(t, r.GetConfirmed())
+	assert.Equal(t, volumeCapabilityMessageBlockNotSupported, r.GetMessage())
+
+	r, err = c.ValidateVolumeCapabilities(context.Background(), req)
+	assert.Nil(t, err)
+	assert.NotNil(t, r.GetConfirmed())
+}
+
 func TestControllerValidateVolumeAccessModeSNWR(t *testing.T) {
 	// Create server and client connection
 	s := newTestServer(t)
@@ -366,22 +774,22 @@ func TestControllerValidateVolumeAccessModeSNWR(t *testing.T) {
 	c := csi.NewControllerClient(s.Conn())
 	r, err := c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.True(t, r.Supported)
+	assert.NotNil(t, r.GetConfirmed())
 
 	// Expect RO and non-SH
 	r, err = c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.False(t, r.Supported)
+	assert.Nil(t, r.GetConfirmed())
 
 	// Expect non-RO and SH
 	r, err = c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.False(t, r.Supported)
+	assert.Nil(t, r.GetConfirmed())
 
 	// Expect RO and SH
 	r, err = c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.False(t, r.Supported)
+	assert.Nil(t, r.GetConfirmed())
 }
 
 func TestControllerValidateVolumeAccessModeSNRO(t *testing.T) {
@@ -474,22 +882,22 @@ func TestControllerValidateVolumeAccessModeSNRO(t *testing.T) {
 	c := csi.NewControllerClient(s.Conn())
 	r, err := c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.False(t, r.Supported)
+	assert.Nil(t, r.GetConfirmed())
 
 	// Expect RO and non-SH
 	r, err = c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.True(t, r.Supported)
+	assert.NotNil(t, r.GetConfirmed())
 
 	// Expect non-RO and SH
 	r, err = c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.False(t, r.Supported)
+	assert.Nil(t, r.GetConfirmed())
 
 	// Expect RO and SH
 	r, err = c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.False(t, r.Supported)
+	assert.Nil(t, r.GetConfirmed())
 }
 
 func TestControllerValidateVolumeAccessModeMNRO(t *testing.T) {
@@ -582,22 +990,22 @@ func TestControllerValidateVolumeAccessModeMNRO(t *testing.T) {
 	c := csi.NewControllerClient(s.Conn())
 	r, err := c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.False(t, r.Supported)
+	assert.Nil(t, r.GetConfirmed())
 
 	// Expect RO and non-SH
 	r, err = c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.False(t, r.Supported)
+	assert.Nil(t, r.GetConfirmed())
 
 	// Expect non-RO and SH
 	r, err = c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.False(t, r.Supported)
+	assert.Nil(t, r.GetConfirmed())
 
 	// Expect RO and SH
 	r, err = c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.True(t, r.Supported)
+	assert.NotNil(t, r.GetConfirmed())
 }
 
 func TestControllerValidateVolumeAccessModeMNWR(t *testing.T) {
@@ -690,22 +1098,22 @@ func TestControllerValidateVolumeAccessModeMNWR(t *testing.T) {
 	c := csi.NewControllerClient(s.Conn())
 	r, err := c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.False(t, r.Supported)
+	assert.Nil(t, r.GetConfirmed())
 
 	// Expect RO and non-SH
 	r, err = c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.False(t, r.Supported)
+	assert.Nil(t, r.GetConfirmed())
 
 	// Expect non-RO and SH
 	r, err = c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.True(t, r.Supported)
+	assert.NotNil(t, r.GetConfirmed())
 
 	// Expect RO and SH
 	r, err = c.ValidateVolumeCapabilities(context.Background(), req)
 	assert.Nil(t, err)
-	assert.False(t, r.Supported)
+	assert.Nil(t, r.GetConfirmed())
 }
 
 func TestControllerValidateVolumeAccessModeUnknown(t *testing.T) {
@@ -861,11 +1269,11 @@ func TestControllerListVolumes(t *testing.T) {
 			info := v.GetVolume()
 			assert.NotNil(t, info)
 
-			if mv.GetId() == info.GetId() {
+			if mv.GetId() == info.GetVolumeId() {
 				found++
 				assert.Equal(t, info.GetCapacityBytes(), int64(mv.GetSpec().GetSize()))
 
-				attributes := info.GetAttributes()
+				attributes := info.GetVolumeContext()
 				assert.Equal(t, attributes["readonly"], fmt.Sprintf("%v", mv.GetReadonly()))
 				assert.Equal(t, attributes[api.SpecShared], fmt.Sprintf("%v", mv.GetSpec().GetShared()))
 				assert.Equal(t, attributes["state"], mv.GetState().String())
@@ -918,13 +1326,49 @@ func TestControllerCreateVolumeInvalidArguments(t *testing.T) {
 
 }
 
-func TestControllerCreateVolumeFoundByVolumeFromNameConflict(t *testing.T) {
+func TestControllerCreateVolumeBlockNotSupported(t *testing.T) {
 	// Create server and client connection
 	s := newTestServer(t)
 	defer s.Stop()
 	c := csi.NewControllerClient(s.Conn())
 
-	// Setup request
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_FILE).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Name().
+			Return("vfs").
+			Times(1),
+	)
+
+	_, err := c.CreateVolume(context.Background(), &csi.CreateVolumeRequest{
+		Name: "myvol",
+		VolumeCapabilities: []*csi.VolumeCapability{
+			&csi.VolumeCapability{
+				AccessType: &csi.VolumeCapability_Block{
+					Block: &csi.VolumeCapability_BlockVolume{},
+				},
+			},
+		},
+	})
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, serverError.Code())
+	assert.Contains(t, serverError.Message(), "vfs does not support raw block")
+}
+
+func TestControllerCreateVolumeFoundByVolumeFromNameConflict(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewControllerClient(s.Conn())
+
+	// Setup request
 	tests := []struct {
 		name string
 		req  *csi.CreateVolumeRequest
@@ -1102,7 +1546,7 @@ func TestControllerCreateVolumeNoCapacity(t *testing.T) {
 	assert.NotNil(t, r)
 	volumeInfo := r.GetVolume()
 
-	assert.Equal(t, id, volumeInfo.GetId())
+	assert.Equal(t, id, volumeInfo.GetVolumeId())
 	assert.Equal(t, int64(defaultCSIVolumeSize), volumeInfo.GetCapacityBytes())
 }
 
@@ -1155,7 +1599,7 @@ func TestControllerCreateVolumeFoundByVolumeFromName(t *testing.T) {
 	assert.NotNil(t, r)
 	volumeInfo := r.GetVolume()
 
-	assert.Equal(t, name, volumeInfo.GetId())
+	assert.Equal(t, name, volumeInfo.GetVolumeId())
 	assert.Equal(t, size, volumeInfo.GetCapacityBytes())
 }
 
@@ -1399,9 +1843,9 @@ func TestControllerCreateVolumeWithSharedVolume(t *testing.T) {
 		assert.NotNil(t, r)
 		volumeInfo := r.GetVolume()
 
-		assert.Equal(t, id, volumeInfo.GetId())
+		assert.Equal(t, id, volumeInfo.GetVolumeId())
 		assert.Equal(t, size, volumeInfo.GetCapacityBytes())
-		assert.Equal(t, "true", volumeInfo.GetAttributes()[api.SpecShared])
+		assert.Equal(t, "true", volumeInfo.GetVolumeContext()[api.SpecShared])
 	}
 }
 
@@ -1576,9 +2020,207 @@ func TestControllerCreateVolume(t *testing.T) {
 	assert.NotNil(t, r)
 	volumeInfo := r.GetVolume()
 
-	assert.Equal(t, id, volumeInfo.GetId())
+	assert.Equal(t, id, volumeInfo.GetVolumeId())
 	assert.Equal(t, size, volumeInfo.GetCapacityBytes())
-	assert.NotEqual(t, "true", volumeInfo.GetAttributes()[api.SpecShared])
+	assert.NotEqual(t, "true", volumeInfo.GetVolumeContext()[api.SpecShared])
+}
+
+func TestControllerCreateVolumeTopology(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewControllerClient(s.Conn())
+
+	// Setup request. The volume must be in region west and
+	// zone z2 is preferred.
+	name := "myvol"
+	req := &csi.CreateVolumeRequest{
+		Name: name,
+		VolumeCapabilities: []*csi.VolumeCapability{
+			&csi.VolumeCapability{},
+		},
+		Parameters: map[string]string{
+			api.SpecHaLevel: "2",
+		},
+		AccessibilityRequirements: &csi.TopologyRequirement{
+			Requisite: []*csi.Topology{
+				&csi.Topology{
+					Segments: map[string]string{TopologyKeyRegion: "west"},
+				},
+			},
+			Preferred: []*csi.Topology{
+				&csi.Topology{
+					Segments: map[string]string{TopologyKeyZone: "z2"},
+				},
+			},
+		},
+	}
+	clus := api.Cluster{
+		NodeId: "node1",
+		Nodes: []api.Node{
+			{Id: "node1"},
+			{Id: "node2"},
+			{Id: "node3", NodeLabels: map[string]string{"region": "east", "zone": "z2"}},
+			{Id: "node4"},
+		},
+	}
+	nodesConf := osdconfig.NodesConfig{
+		&osdconfig.NodeConfig{
+			NodeId: "node1",
+			Geo:    &osdconfig.GeoConfig{Region: "west", Zone: "z1"},
+		},
+		&osdconfig.NodeConfig{
+			NodeId: "node2",
+			Geo:    &osdconfig.GeoConfig{Region: "east", Zone: "z2"},
+		},
+		&osdconfig.NodeConfig{
+			NodeId: "node4",
+			Geo:    &osdconfig.GeoConfig{Region: "west", Zone: "z2"},
+		},
+	}
+
+	// Setup mock functions
+	id := "myid"
+	var (
+		createdLocator *api.VolumeLocator
+		createdSpec    *api.VolumeSpec
+	)
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: name}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Enumerate().
+			Return(clus, nil).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			EnumerateNodeConf().
+			Return(&nodesConf, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Create(gomock.Any(), gomock.Any(), gomock.Any()).
+			Do(func(locator *api.VolumeLocator, source *api.Source, spec *api.VolumeSpec) {
+				createdLocator = locator
+				createdSpec = spec
+			}).
+			Return(id, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: id,
+					Locator: &api.VolumeLocator{
+						Name: name,
+					},
+					Spec: &api.VolumeSpec{
+						Size:       defaultCSIVolumeSize,
+						HaLevel:    2,
+						ReplicaSet: &api.ReplicaSet{Nodes: []string{"node4", "node1"}},
+					},
+				},
+			}, nil).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Enumerate().
+			Return(clus, nil).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			EnumerateNodeConf().
+			Return(&nodesConf, nil).
+			Times(1),
+	)
+
+	r, err := c.CreateVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.NotNil(t, r)
+
+	// Only the nodes in region west are candidates and
+	// the node in the preferred zone goes first
+	assert.Equal(t, []string{"node4", "node1"}, createdSpec.GetReplicaSet().GetNodes())
+	assert.Equal(t, "z2,z1", createdLocator.GetVolumeLabels()[api.SpecZones])
+
+	topology := r.GetVolume().GetAccessibleTopology()
+	assert.Len(t, topology, 2)
+	assert.Equal(t, map[string]string{
+		TopologyKeyRegion: "west",
+		TopologyKeyZone:   "z2",
+	}, topology[0].GetSegments())
+	assert.Equal(t, map[string]string{
+		TopologyKeyRegion: "west",
+		TopologyKeyZone:   "z1",
+	}, topology[1].GetSegments())
+}
+
+func TestControllerCreateVolumeTopologyNotSatisfied(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewControllerClient(s.Conn())
+
+	// Setup request
+	name := "myvol"
+	req := &csi.CreateVolumeRequest{
+		Name: name,
+		VolumeCapabilities: []*csi.VolumeCapability{
+			&csi.VolumeCapability{},
+		},
+		AccessibilityRequirements: &csi.TopologyRequirement{
+			Requisite: []*csi.Topology{
+				&csi.Topology{
+					Segments: map[string]string{TopologyKeyZone: "z9"},
+				},
+			},
+		},
+	}
+
+	// Setup mock functions
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: name}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			Enumerate().
+			Return(api.Cluster{
+				Nodes: []api.Node{
+					{Id: "node1", NodeLabels: map[string]string{"zone": "z1"}},
+				},
+			}, nil).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			EnumerateNodeConf().
+			Return(nil, fmt.Errorf("no configuration")).
+			Times(1),
+	)
+
+	_, err := c.CreateVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, serverError.Code())
+	assert.Contains(t, serverError.Message(), "topology")
 }
 
 func TestControllerCreateVolumeSnapshot(t *testing.T) {
@@ -1624,7 +2266,8 @@ func TestControllerCreateVolumeSnapshot(t *testing.T) {
 			Inspect([]string{mockParentID}).
 			Return([]*api.Volume{
 				&api.Volume{
-					Id: mockParentID,
+					Id:   mockParentID,
+					Spec: &api.VolumeSpec{Size: uint64(size)},
 				},
 			}, nil).
 			Times(1),
@@ -1662,10 +2305,10 @@ func TestControllerCreateVolumeSnapshot(t *testing.T) {
 	assert.NotNil(t, r)
 	volumeInfo := r.GetVolume()
 
-	assert.Equal(t, id, volumeInfo.GetId())
+	assert.Equal(t, id, volumeInfo.GetVolumeId())
 	assert.Equal(t, size, volumeInfo.GetCapacityBytes())
-	assert.NotEqual(t, "true", volumeInfo.GetAttributes()[api.SpecShared])
-	assert.Equal(t, mockParentID, volumeInfo.GetAttributes()[api.SpecParent])
+	assert.NotEqual(t, "true", volumeInfo.GetVolumeContext()[api.SpecShared])
+	assert.Equal(t, mockParentID, volumeInfo.GetVolumeContext()[api.SpecParent])
 }
 
 func TestControllerDeleteVolumeInvalidArguments(t *testing.T) {
@@ -1778,3 +2421,960 @@ func TestControllerDeleteVolume(t *testing.T) {
 	_, err = c.DeleteVolume(context.Background(), req)
 	assert.Nil(t, err)
 }
+
+func TestControllerCreateVolumeFromSnapshot(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewControllerClient(s.Conn())
+
+	// Setup request
+	snapID := "snapid"
+	name := "myvol"
+	size := int64(1234)
+	req := &csi.CreateVolumeRequest{
+		Name: name,
+		VolumeCapabilities: []*csi.VolumeCapability{
+			&csi.VolumeCapability{},
+		},
+		CapacityRange: &csi.CapacityRange{
+			RequiredBytes: size,
+		},
+		VolumeContentSource: &csi.VolumeContentSource{
+			Type: &csi.VolumeContentSource_Snapshot{
+				Snapshot: &csi.VolumeContentSource_SnapshotSource{
+					SnapshotId: snapID,
+				},
+			},
+		},
+	}
+
+	// Snapshot not found
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: name}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{snapID}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: snapID}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+	)
+	_, err := c.CreateVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, serverError.Code())
+
+	// Restore the snapshot
+	id := "myid"
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: name}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{snapID}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:       snapID,
+					Readonly: true,
+					Spec:     &api.VolumeSpec{Size: uint64(size)},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Snapshot(snapID, false, &api.VolumeLocator{
+				Name: name,
+			}).
+			Return(id, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: id,
+					Locator: &api.VolumeLocator{
+						Name: name,
+					},
+					Spec: &api.VolumeSpec{
+						Size: uint64(size),
+					},
+					Source: &api.Source{
+						Parent: snapID,
+					},
+				},
+			}, nil).
+			Times(1),
+	)
+
+	r, err := c.CreateVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.NotNil(t, r)
+	volumeInfo := r.GetVolume()
+	assert.Equal(t, id, volumeInfo.GetVolumeId())
+	assert.Equal(t, size, volumeInfo.GetCapacityBytes())
+	assert.Equal(t, snapID, volumeInfo.GetVolumeContext()[api.SpecParent])
+	assert.Equal(t, snapID, volumeInfo.GetContentSource().GetSnapshot().GetSnapshotId())
+}
+
+func TestControllerCreateVolumeClone(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewControllerClient(s.Conn())
+
+	// Setup request. No size is requested so that the clone
+	// has the size of its source.
+	sourceID := "sourceid"
+	name := "myvol"
+	size := uint64(1234)
+	req := &csi.CreateVolumeRequest{
+		Name: name,
+		VolumeCapabilities: []*csi.VolumeCapability{
+			&csi.VolumeCapability{},
+		},
+		VolumeContentSource: &csi.VolumeContentSource{
+			Type: &csi.VolumeContentSource_Volume{
+				Volume: &csi.VolumeContentSource_VolumeSource{
+					VolumeId: sourceID,
+				},
+			},
+		},
+	}
+
+	// Source volume not found
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: name}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{sourceID}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: sourceID}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+	)
+	_, err := c.CreateVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, serverError.Code())
+
+	// Clone the volume
+	id := "myid"
+	clone := &api.Volume{
+		Id: id,
+		Locator: &api.VolumeLocator{
+			Name: name,
+		},
+		Spec: &api.VolumeSpec{
+			Size: size,
+		},
+		Source: &api.Source{
+			Parent: sourceID,
+		},
+	}
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: name}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{sourceID}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:   sourceID,
+					Spec: &api.VolumeSpec{Size: size},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Snapshot(sourceID, false, &api.VolumeLocator{
+				Name: name,
+			}).
+			Return(id, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{clone}, nil).
+			Times(1),
+	)
+
+	r, err := c.CreateVolume(context.Background(), req)
+	assert.Nil(t, err)
+	volumeInfo := r.GetVolume()
+	assert.Equal(t, id, volumeInfo.GetVolumeId())
+	assert.Equal(t, int64(size), volumeInfo.GetCapacityBytes())
+	assert.Equal(t, sourceID, volumeInfo.GetVolumeContext()[api.SpecParent])
+	assert.Equal(t, sourceID, volumeInfo.GetContentSource().GetVolume().GetVolumeId())
+
+	// Creating it again returns the clone
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{name}).
+		Return([]*api.Volume{clone}, nil).
+		Times(1)
+
+	r, err = c.CreateVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, id, r.GetVolume().GetVolumeId())
+
+	// The source volume id is required
+	req.VolumeContentSource = &csi.VolumeContentSource{
+		Type: &csi.VolumeContentSource_Volume{
+			Volume: &csi.VolumeContentSource_VolumeSource{},
+		},
+	}
+	_, err = c.CreateVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, serverError.Code())
+}
+
+func TestControllerCreateVolumeCloneResize(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewControllerClient(s.Conn())
+
+	// Setup request for a clone larger than its source
+	sourceID := "sourceid"
+	name := "myvol"
+	sourceSize := uint64(1024)
+	size := uint64(2048)
+	labels := map[string]string{"app": "db"}
+	req := &csi.CreateVolumeRequest{
+		Name: name,
+		VolumeCapabilities: []*csi.VolumeCapability{
+			&csi.VolumeCapability{},
+		},
+		CapacityRange: &csi.CapacityRange{
+			RequiredBytes: int64(size),
+		},
+		Parameters: map[string]string{
+			api.SpecLabels: "app=db",
+		},
+		VolumeContentSource: &csi.VolumeContentSource{
+			Type: &csi.VolumeContentSource_Volume{
+				Volume: &csi.VolumeContentSource_VolumeSource{
+					VolumeId: sourceID,
+				},
+			},
+		},
+	}
+	source := &api.Volume{
+		Id:   sourceID,
+		Spec: &api.VolumeSpec{Size: sourceSize, HaLevel: 2},
+	}
+
+	// Clone the volume and grow it
+	id := "myid"
+	clone := &api.Volume{
+		Id: id,
+		Locator: &api.VolumeLocator{
+			Name:         name,
+			VolumeLabels: labels,
+		},
+		Spec: &api.VolumeSpec{
+			Size:    sourceSize,
+			HaLevel: 2,
+		},
+		Source: &api.Source{
+			Parent: sourceID,
+		},
+	}
+	resized := &api.Volume{
+		Id:      id,
+		Locator: clone.GetLocator(),
+		Spec: &api.VolumeSpec{
+			Size:    size,
+			HaLevel: 2,
+		},
+		Source: clone.GetSource(),
+	}
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: name}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{sourceID}).
+			Return([]*api.Volume{source}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Snapshot(sourceID, false, &api.VolumeLocator{
+				Name:         name,
+				VolumeLabels: labels,
+			}).
+			Return(id, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{clone}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Set(id, nil, resized.GetSpec()).
+			Return(nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{resized}, nil).
+			Times(1),
+	)
+
+	r, err := c.CreateVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, id, r.GetVolume().GetVolumeId())
+	assert.Equal(t, int64(size), r.GetVolume().GetCapacityBytes())
+
+	// Creating it again returns the clone
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{name}).
+		Return([]*api.Volume{resized}, nil).
+		Times(1)
+	r, err = c.CreateVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, id, r.GetVolume().GetVolumeId())
+
+	// A clone cannot be smaller than its source
+	req.Name = "othervol"
+	req.CapacityRange.RequiredBytes = int64(sourceSize / 2)
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{req.Name}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: req.Name}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{sourceID}).
+			Return([]*api.Volume{source}, nil).
+			Times(1),
+	)
+	_, err = c.CreateVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.OutOfRange, serverError.Code())
+}
+
+func TestControllerCreateSnapshotInvalidArguments(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewControllerClient(s.Conn())
+
+	// No source volume
+	_, err := c.CreateSnapshot(context.Background(), &csi.CreateSnapshotRequest{
+		Name: "mysnap",
+	})
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, serverError.Code())
+	assert.Contains(t, serverError.Message(), "Source volume id")
+
+	// No name
+	_, err = c.CreateSnapshot(context.Background(), &csi.CreateSnapshotRequest{
+		SourceVolumeId: "myid",
+	})
+	assert.NotNil(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, serverError.Code())
+	assert.Contains(t, serverError.Message(), "Name")
+}
+
+func TestControllerCreateSnapshotNameConflict(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewControllerClient(s.Conn())
+
+	name := "mysnap"
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{name}).
+		Return([]*api.Volume{
+			&api.Volume{
+				Id:       "snapid",
+				Readonly: true,
+				Source: &api.Source{
+					Parent: "othervolume",
+				},
+			},
+		}, nil).
+		Times(1)
+
+	_, err := c.CreateSnapshot(context.Background(), &csi.CreateSnapshotRequest{
+		SourceVolumeId: "myid",
+		Name:           name,
+	})
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.AlreadyExists, serverError.Code())
+}
+
+func TestControllerCreateSnapshot(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewControllerClient(s.Conn())
+
+	volID := "myid"
+	snapID := "snapid"
+	name := "mysnap"
+	size := uint64(1234)
+	ctime := time.Unix(1500000000, 0)
+	snap := &api.Volume{
+		Id:       snapID,
+		Readonly: true,
+		Ctime:    prototime.TimeToTimestamp(ctime),
+		Locator: &api.VolumeLocator{
+			Name: name,
+		},
+		Spec: &api.VolumeSpec{
+			Size: size,
+		},
+		Source: &api.Source{
+			Parent: volID,
+		},
+	}
+	req := &csi.CreateSnapshotRequest{
+		SourceVolumeId: volID,
+		Name:           name,
+	}
+
+	// Source volume not found
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: name}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{volID}).
+			Return([]*api.Volume{}, nil).
+			Times(1),
+	)
+	_, err := c.CreateSnapshot(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, serverError.Code())
+
+	// Snapshot created
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Enumerate(&api.VolumeLocator{Name: name}, nil).
+			Return(nil, fmt.Errorf("not found")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{volID}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: volID,
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Snapshot(volID, true, &api.VolumeLocator{
+				Name: name,
+			}).
+			Return(snapID, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{snapID}).
+			Return([]*api.Volume{snap}, nil).
+			Times(1),
+	)
+	r, err := c.CreateSnapshot(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, snapID, r.GetSnapshot().GetSnapshotId())
+	assert.Equal(t, volID, r.GetSnapshot().GetSourceVolumeId())
+	assert.Equal(t, int64(size), r.GetSnapshot().GetSizeBytes())
+	assert.Equal(t, ctime.Unix(), r.GetSnapshot().GetCreationTime().GetSeconds())
+	assert.True(t, r.GetSnapshot().GetReadyToUse())
+
+	// The snapshot already exists
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{name}).
+		Return([]*api.Volume{snap}, nil).
+		Times(1)
+	r, err = c.CreateSnapshot(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, snapID, r.GetSnapshot().GetSnapshotId())
+}
+
+func TestControllerDeleteSnapshot(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewControllerClient(s.Conn())
+
+	// No id
+	_, err := c.DeleteSnapshot(context.Background(), &csi.DeleteSnapshotRequest{})
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, serverError.Code())
+
+	// According to CSI spec, if the ID is not found, it must return OK
+	snapID := "snapid"
+	req := &csi.DeleteSnapshotRequest{
+		SnapshotId: snapID,
+	}
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{snapID}).
+		Return(nil, kvdb.ErrNotFound).
+		Times(1)
+	_, err = c.DeleteSnapshot(context.Background(), req)
+	assert.Nil(t, err)
+
+	// Volumes which are not snapshots are not deleted
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{snapID}).
+		Return([]*api.Volume{
+			&api.Volume{
+				Id: snapID,
+			},
+		}, nil).
+		Times(1)
+	_, err = c.DeleteSnapshot(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, serverError.Code())
+
+	// Delete the snapshot
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{snapID}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:       snapID,
+					Readonly: true,
+					Source: &api.Source{
+						Parent: "myid",
+					},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Delete(snapID).
+			Return(nil).
+			Times(1),
+	)
+	_, err = c.DeleteSnapshot(context.Background(), req)
+	assert.Nil(t, err)
+}
+
+func TestControllerListSnapshots(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewControllerClient(s.Conn())
+
+	volID := "myid"
+	snapshots := []*api.Volume{
+		&api.Volume{
+			Id:       "snap3",
+			Readonly: true,
+			Source:   &api.Source{Parent: volID},
+		},
+		&api.Volume{
+			Id:       "snap1",
+			Readonly: true,
+			Source:   &api.Source{Parent: volID},
+		},
+		&api.Volume{
+			// Clones are not snapshots
+			Id:     "clone",
+			Source: &api.Source{Parent: volID},
+		},
+		&api.Volume{
+			Id:       "snap2",
+			Readonly: true,
+			Source:   &api.Source{Parent: volID},
+		},
+	}
+
+	// All the snapshots of the volume in pages
+	s.MockDriver().
+		EXPECT().
+		SnapEnumerate([]string{volID}, nil).
+		Return(snapshots, nil).
+		Times(2)
+	r, err := c.ListSnapshots(context.Background(), &csi.ListSnapshotsRequest{
+		SourceVolumeId: volID,
+		MaxEntries:     2,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, r.GetEntries(), 2)
+	assert.Equal(t, "snap1", r.GetEntries()[0].GetSnapshot().GetSnapshotId())
+	assert.Equal(t, "snap2", r.GetEntries()[1].GetSnapshot().GetSnapshotId())
+	assert.Equal(t, volID, r.GetEntries()[1].GetSnapshot().GetSourceVolumeId())
+	assert.Equal(t, "snap3", r.GetNextToken())
+
+	r, err = c.ListSnapshots(context.Background(), &csi.ListSnapshotsRequest{
+		SourceVolumeId: volID,
+		MaxEntries:     2,
+		StartingToken:  r.GetNextToken(),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, r.GetEntries(), 1)
+	assert.Equal(t, "snap3", r.GetEntries()[0].GetSnapshot().GetSnapshotId())
+	assert.Empty(t, r.GetNextToken())
+
+	// Snapshot by id
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{"snap2"}).
+		Return([]*api.Volume{snapshots[3]}, nil).
+		Times(1)
+	r, err = c.ListSnapshots(context.Background(), &csi.ListSnapshotsRequest{
+		SnapshotId: "snap2",
+	})
+	assert.Nil(t, err)
+	assert.Len(t, r.GetEntries(), 1)
+	assert.Equal(t, "snap2", r.GetEntries()[0].GetSnapshot().GetSnapshotId())
+
+	// Snapshot by id not found
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{"snap4"}).
+		Return(nil, kvdb.ErrNotFound).
+		Times(1)
+	r, err = c.ListSnapshots(context.Background(), &csi.ListSnapshotsRequest{
+		SnapshotId: "snap4",
+	})
+	assert.Nil(t, err)
+	assert.Len(t, r.GetEntries(), 0)
+
+	// Enumerate error
+	s.MockDriver().
+		EXPECT().
+		SnapEnumerate(nil, nil).
+		Return(nil, fmt.Errorf("TEST")).
+		Times(1)
+	_, err = c.ListSnapshots(context.Background(), &csi.ListSnapshotsRequest{})
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Internal, serverError.Code())
+	assert.Contains(t, serverError.Message(), "TEST")
+}
+
+func TestControllerExpandVolumeBadArguments(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewControllerClient(s.Conn())
+
+	testargs := []struct {
+		expectedCode          codes.Code
+		expectedErrorContains string
+		req                   *csi.ControllerExpandVolumeRequest
+	}{
+		{
+			expectedCode:          codes.InvalidArgument,
+			expectedErrorContains: "Volume id",
+			req:                   &csi.ControllerExpandVolumeRequest{},
+		},
+		{
+			expectedCode:          codes.InvalidArgument,
+			expectedErrorContains: "Required bytes",
+			req: &csi.ControllerExpandVolumeRequest{
+				VolumeId: "myid",
+			},
+		},
+		{
+			expectedCode:          codes.OutOfRange,
+			expectedErrorContains: "limit",
+			req: &csi.ControllerExpandVolumeRequest{
+				VolumeId: "myid",
+				CapacityRange: &csi.CapacityRange{
+					RequiredBytes: 2048,
+					LimitBytes:    1024,
+				},
+			},
+		},
+	}
+
+	for _, testarg := range testargs {
+		_, err := c.ControllerExpandVolume(context.Background(), testarg.req)
+		assert.NotNil(t, err)
+
+		serverError, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, testarg.expectedCode, serverError.Code())
+		assert.Contains(t, serverError.Message(), testarg.expectedErrorContains)
+	}
+}
+
+func TestControllerExpandVolume(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewControllerClient(s.Conn())
+
+	id := "myid"
+	size := uint64(1024)
+	req := &csi.ControllerExpandVolumeRequest{
+		VolumeId: id,
+		CapacityRange: &csi.CapacityRange{
+			RequiredBytes: int64(2 * size),
+		},
+	}
+
+	// Volume not found
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{id}).
+		Return([]*api.Volume{}, nil).
+		Times(1)
+	_, err := c.ControllerExpandVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, serverError.Code())
+
+	// Expand the volume
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: id,
+					Spec: &api.VolumeSpec{
+						Size:   size,
+						Shared: true,
+					},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Set(id, nil, &api.VolumeSpec{
+				Size:   2 * size,
+				Shared: true,
+			}).
+			Return(nil).
+			Times(1),
+	)
+	r, err := c.ControllerExpandVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2*size), r.GetCapacityBytes())
+	assert.True(t, r.GetNodeExpansionRequired())
+
+	// Volumes are not shrunk
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: id,
+					Spec: &api.VolumeSpec{
+						Size: 4 * size,
+					},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_FILE).
+			Times(1),
+	)
+	r, err = c.ControllerExpandVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4*size), r.GetCapacityBytes())
+	assert.False(t, r.GetNodeExpansionRequired())
+
+	// Driver failure
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{id}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: id,
+					Spec: &api.VolumeSpec{
+						Size: size,
+					},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Set(id, nil, &api.VolumeSpec{
+				Size: 2 * size,
+			}).
+			Return(fmt.Errorf("SET ERROR")).
+			Times(1),
+	)
+	_, err = c.ControllerExpandVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Internal, serverError.Code())
+	assert.Contains(t, serverError.Message(), "SET ERROR")
+}
+
+func TestControllerExpandVolumeFakeDriver(t *testing.T) {
+	setupTestFakeDriver(t)
+
+	// Start a server with the fake driver
+	server, err := NewOsdCsiServer(&OsdCsiServerConfig{
+		DriverName: fake.Name,
+		Net:        "tcp",
+		Address:    "127.0.0.1:0",
+	})
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer server.Stop()
+	conn, err := grpc.Dial(server.Address(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	c := csi.NewControllerClient(conn)
+
+	// Create a volume
+	size := int64(defaultCSIVolumeSize)
+	created, err := c.CreateVolume(context.Background(), &csi.CreateVolumeRequest{
+		Name: "expanded",
+		VolumeCapabilities: []*csi.VolumeCapability{
+			&csi.VolumeCapability{
+				AccessMode: &csi.VolumeCapability_AccessMode{
+					Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	id := created.GetVolume().GetVolumeId()
+	assert.Equal(t, size, created.GetVolume().GetCapacityBytes())
+
+	// Expand it
+	r, err := c.ControllerExpandVolume(context.Background(), &csi.ControllerExpandVolumeRequest{
+		VolumeId: id,
+		CapacityRange: &csi.CapacityRange{
+			RequiredBytes: 2 * size,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2*size, r.GetCapacityBytes())
+	assert.True(t, r.GetNodeExpansionRequired())
+
+	volumes, err := c.ListVolumes(context.Background(), &csi.ListVolumesRequest{})
+	require.NoError(t, err)
+	require.Len(t, volumes.GetEntries(), 1)
+	assert.Equal(t, 2*size, volumes.GetEntries()[0].GetVolume().GetCapacityBytes())
+
+	// Shrinking it does nothing
+	r, err = c.ControllerExpandVolume(context.Background(), &csi.ControllerExpandVolumeRequest{
+		VolumeId: id,
+		CapacityRange: &csi.CapacityRange{
+			RequiredBytes: size,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2*size, r.GetCapacityBytes())
+
+	_, err = c.DeleteVolume(context.Background(), &csi.DeleteVolumeRequest{
+		VolumeId: id,
+	})
+	assert.NoError(t, err)
+}
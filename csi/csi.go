@@ -17,14 +17,17 @@ limitations under the License.
 package csi
 
 import (
+	"crypto/tls"
 	"fmt"
 
-	csi "github.com/container-storage-interface/spec/lib/go/csi/v0"
+	csi "github.com/container-storage-interface/spec/lib/go/csi"
 	"google.golang.org/grpc"
 
 	"github.com/libopenstorage/openstorage/api/spec"
 	"github.com/libopenstorage/openstorage/cluster"
 	"github.com/libopenstorage/openstorage/pkg/grpcserver"
+	"github.com/libopenstorage/openstorage/pkg/mount"
+	"github.com/libopenstorage/openstorage/pkg/tlsutil"
 	"github.com/libopenstorage/openstorage/volume"
 	volumedrivers "github.com/libopenstorage/openstorage/volume/drivers"
 )
@@ -36,6 +39,21 @@ type OsdCsiServerConfig struct {
 	Address    string
 	DriverName string
 	Cluster    cluster.Cluster
+	// TLS, if provided, is used to serve TLS
+	TLS *tlsutil.Config
+	// Mounter, if provided, is used to bind mount the staged volumes
+	// onto the target paths. A bind mounter is created otherwise.
+	Mounter mount.Manager
+	// MgmtPort is the port of the REST management server of the driver
+	// on each node. It is used to attach volumes on other nodes.
+	MgmtPort uint16
+	// MgmtToken, if provided, is sent as a bearer token to the REST
+	// management servers of the other nodes. It requires MgmtTLS.
+	MgmtToken string
+	// MgmtTLS, if provided, is used to reach the REST management servers
+	// of the other nodes over TLS. The management token and the attach
+	// secrets are only sent to the other nodes over TLS.
+	MgmtTLS *tlsutil.Config
 }
 
 // OsdCsiServer is a OSD CSI compliant server which
@@ -49,6 +67,10 @@ type OsdCsiServer struct {
 	specHandler spec.SpecHandler
 	driver      volume.VolumeDriver
 	cluster     cluster.Cluster
+	mounter     mount.Manager
+	mgmtPort    uint16
+	mgmtToken   string
+	mgmtCerts   *tlsutil.CertReloader
 }
 
 // NewOsdCsiServer creates a gRPC CSI complient server on the
@@ -66,10 +88,38 @@ func NewOsdCsiServer(config *OsdCsiServerConfig) (grpcserver.Server, error) {
 		return nil, fmt.Errorf("Unable to get driver %s info: %s", config.DriverName, err.Error())
 	}
 
+	var tlsConfig *tls.Config
+	if config.TLS != nil {
+		certs, err := tlsutil.NewCertReloader(config.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to setup TLS for CSI server: %v", err)
+		}
+		tlsConfig = certs.ServerConfig()
+	}
+
+	var mgmtCerts *tlsutil.CertReloader
+	if config.MgmtTLS != nil {
+		mgmtCerts, err = tlsutil.NewCertReloader(config.MgmtTLS)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to setup TLS for the management API: %v", err)
+		}
+	} else if len(config.MgmtToken) != 0 {
+		return nil, fmt.Errorf("The management token is only sent over TLS, which must be configured")
+	}
+
+	mounter := config.Mounter
+	if mounter == nil {
+		mounter, err = mount.New(mount.BindMount, nil, nil, nil, nil, "")
+		if err != nil {
+			return nil, fmt.Errorf("Unable to create the bind mounter: %v", err)
+		}
+	}
+
 	gServer, err := grpcserver.New(&grpcserver.GrpcServerConfig{
-		Name:    "CSI",
-		Net:     config.Net,
-		Address: config.Address,
+		Name:      "CSI",
+		Net:       config.Net,
+		Address:   config.Address,
+		TLSConfig: tlsConfig,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("Failed to create CSI server: %v", err)
@@ -80,12 +130,21 @@ func NewOsdCsiServer(config *OsdCsiServerConfig) (grpcserver.Server, error) {
 		GrpcServer:  gServer,
 		driver:      d,
 		cluster:     config.Cluster,
+		mounter:     mounter,
+		mgmtPort:    config.MgmtPort,
+		mgmtToken:   config.MgmtToken,
+		mgmtCerts:   mgmtCerts,
 	}, nil
 }
 
 // Start is used to start the server.
 // It will return an error if the server is already running.
+// Ephemeral inline volumes leaked while the server was not running are
+// deleted first.
 func (s *OsdCsiServer) Start() error {
+	if !s.IsRunning() {
+		s.cleanupEphemeralVolumes()
+	}
 	return s.GrpcServer.Start(func(grpcServer *grpc.Server) {
 		csi.RegisterIdentityServer(grpcServer, s)
 		csi.RegisterControllerServer(grpcServer, s)
@@ -19,18 +19,26 @@ package csi
 import (
 	"testing"
 
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 
-	csi "github.com/container-storage-interface/spec/lib/go/csi/v0"
+	csi "github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/golang/mock/gomock"
 	"github.com/kubernetes-csi/csi-test/utils"
 	"golang.org/x/net/context"
 
+	"github.com/libopenstorage/openstorage/api"
 	mockcluster "github.com/libopenstorage/openstorage/cluster/mock"
 	"github.com/libopenstorage/openstorage/pkg/grpcserver"
+	mockmount "github.com/libopenstorage/openstorage/pkg/mount/mock"
+	"github.com/libopenstorage/openstorage/pkg/tlsutil"
 	"github.com/libopenstorage/openstorage/volume"
 	volumedrivers "github.com/libopenstorage/openstorage/volume/drivers"
+	"github.com/libopenstorage/openstorage/volume/drivers/fake"
 	mockdriver "github.com/libopenstorage/openstorage/volume/drivers/mock"
 )
 
@@ -45,6 +53,7 @@ type testServer struct {
 	server grpcserver.Server
 	m      *mockdriver.MockVolumeDriver
 	c      *mockcluster.MockCluster
+	mm     *mockmount.MockManager
 	mc     *gomock.Controller
 }
 
@@ -60,6 +69,20 @@ func setupMockDriver(tester *testServer, t *testing.T) {
 	assert.Nil(t, err)
 }
 
+// setupTestFakeDriver registers the fake driver with its kvdb instance.
+// They can only be set up once for all the tests of the package.
+func setupTestFakeDriver(t *testing.T) {
+	if _, err := volumedrivers.Get(fake.Name); err == nil {
+		return
+	}
+	if kvdb.Instance() == nil {
+		kv, err := kvdb.New(mem.Name, "csi_test", []string{}, nil, logrus.Panicf)
+		require.NoError(t, err)
+		require.NoError(t, kvdb.SetInstance(kv))
+	}
+	require.NoError(t, volumedrivers.Register(fake.Name, nil))
+}
+
 func newTestServer(t *testing.T) *testServer {
 	tester := &testServer{}
 
@@ -67,9 +90,20 @@ func newTestServer(t *testing.T) *testServer {
 	tester.mc = gomock.NewController(&utils.SafeGoroutineTester{})
 	tester.m = mockdriver.NewMockVolumeDriver(tester.mc)
 	tester.c = mockcluster.NewMockCluster(tester.mc)
+	tester.mm = mockmount.NewMockManager(tester.mc)
 
 	setupMockDriver(tester, t)
 
+	// Ephemeral volumes are cleaned up when the server starts
+	tester.m.EXPECT().
+		Enumerate(&api.VolumeLocator{
+			VolumeLabels: map[string]string{
+				ephemeralLabel: "true",
+			},
+		}, nil).
+		Return(nil, nil).
+		Times(1)
+
 	var err error
 	// Setup simple driver
 	tester.server, err = NewOsdCsiServer(&OsdCsiServerConfig{
@@ -77,6 +111,7 @@ func newTestServer(t *testing.T) *testServer {
 		Net:        "tcp",
 		Address:    "127.0.0.1:0",
 		Cluster:    tester.c,
+		Mounter:    tester.mm,
 	})
 	assert.Nil(t, err)
 	err = tester.server.Start()
@@ -97,6 +132,10 @@ func (s *testServer) MockCluster() *mockcluster.MockCluster {
 	return s.c
 }
 
+func (s *testServer) MockMounter() *mockmount.MockManager {
+	return s.mm
+}
+
 func (s *testServer) Stop() {
 	// Remove from registry
 	volumedrivers.Remove("mock")
@@ -214,4 +253,40 @@ func TestNewCSIServerBadParameters(t *testing.T) {
 	assert.Nil(t, s)
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "Unable to setup server")
+	s, err = NewOsdCsiServer(&OsdCsiServerConfig{
+		Net:        "tcp",
+		Address:    "127.0.0.1:0",
+		DriverName: "mock",
+		TLS: &tlsutil.Config{
+			CertFile: "/nonexistent/cert.pem",
+			KeyFile:  "/nonexistent/key.pem",
+		},
+	})
+	assert.Nil(t, s)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "TLS")
+
+	// The management token is only sent over TLS
+	s, err = NewOsdCsiServer(&OsdCsiServerConfig{
+		Net:        "tcp",
+		Address:    "127.0.0.1:0",
+		DriverName: "mock",
+		MgmtToken:  "token",
+	})
+	assert.Nil(t, s)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "management token")
+	s, err = NewOsdCsiServer(&OsdCsiServerConfig{
+		Net:        "tcp",
+		Address:    "127.0.0.1:0",
+		DriverName: "mock",
+		MgmtToken:  "token",
+		MgmtTLS: &tlsutil.Config{
+			CertFile: "/nonexistent/cert.pem",
+			KeyFile:  "/nonexistent/key.pem",
+		},
+	})
+	assert.Nil(t, s)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "management API")
 }
@@ -20,18 +20,21 @@ package csi
 
 import (
 	"os"
+	"sync"
 	"testing"
 
 	"github.com/libopenstorage/openstorage/cluster"
 	"github.com/libopenstorage/openstorage/config"
+	"github.com/libopenstorage/openstorage/pkg/mount"
 	"github.com/libopenstorage/openstorage/volume"
-	"github.com/libopenstorage/openstorage/volume/drivers"
+	"github.com/libopenstorage/openstorage/volume/drivers/fake"
 
+	csi "github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/kubernetes-csi/csi-test/pkg/sanity"
-	"github.com/sirupsen/logrus"
-
-	"github.com/portworx/kvdb"
-	"github.com/portworx/kvdb/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -45,28 +48,18 @@ var (
 
 func TestCSISanity(t *testing.T) {
 
-	kv, err := kvdb.New(mem.Name, "driver_test", []string{}, nil, logrus.Panicf)
-	if err != nil {
-		t.Fatalf("Failed to initialize KVDB")
-	}
-	if err := kvdb.SetInstance(kv); err != nil {
-		t.Fatalf("Failed to set KVDB instance")
-	}
+	setupTestFakeDriver(t)
 
-	err = os.MkdirAll(testPath, 0744)
+	err := os.MkdirAll(testPath, 0744)
 	if err != nil {
 		t.Fatalf("Failed to create test path: %v", err)
 	}
 
-	if err := volumedrivers.Register("nfs", map[string]string{"path": testPath}); err != nil {
-		t.Fatalf("Unable to start volume driver nfs")
-	}
-
 	// Initialize the cluster
 	if err := cluster.Init(config.ClusterConfig{
 		ClusterId:     "cluster",
 		NodeId:        "node1",
-		DefaultDriver: "nfs",
+		DefaultDriver: fake.Name,
 	}); err != nil {
 		t.Fatalf("Unable to init cluster server: %v", err)
 	}
@@ -81,7 +74,7 @@ func TestCSISanity(t *testing.T) {
 
 	// Start CSI Server
 	server, err := NewOsdCsiServer(&OsdCsiServerConfig{
-		DriverName: "nfs",
+		DriverName: fake.Name,
 		Net:        "tcp",
 		Address:    "127.0.0.1:0",
 		Cluster:    cm,
@@ -94,7 +87,164 @@ func TestCSISanity(t *testing.T) {
 
 	// Start CSI Sanity test
 	sanity.Test(t, &sanity.Config{
-		Address:    server.Address(),
-		TargetPath: "/mnt/openstorage/mount/nfs",
+		Address:     server.Address(),
+		TargetPath:  testPath + "/target",
+		StagingPath: testPath + "/staging",
+	})
+}
+
+// blockTestMounter records the bind mounts of raw block devices since
+// the devices returned by the fake driver do not exist
+type blockTestMounter struct {
+	mount.Manager
+
+	lock    sync.Mutex
+	targets map[string]string
+}
+
+func (m *blockTestMounter) Mount(
+	minor int,
+	device, path, fs string,
+	flags uintptr,
+	data string,
+	timeout int,
+	opts map[string]string,
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, err := os.Stat(path); err != nil {
+		return err
+	}
+	m.targets[path] = device
+	return nil
+}
+
+func (m *blockTestMounter) Unmount(source, path string, flags int, timeout int, opts map[string]string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.targets[path] != source {
+		return mount.ErrEnoent
+	}
+	delete(m.targets, path)
+	return nil
+}
+
+func (m *blockTestMounter) GetSourcePath(path string) (string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if source, ok := m.targets[path]; ok {
+		return source, nil
+	}
+	return "", mount.ErrEnoent
+}
+
+func (m *blockTestMounter) HasMounts(source string) int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	count := 0
+	for _, device := range m.targets {
+		if device == source {
+			count++
+		}
+	}
+	return count
+}
+
+func TestCSISanityRawBlock(t *testing.T) {
+	setupTestFakeDriver(t)
+	require.NoError(t, os.MkdirAll(testPath+"/block", 0744))
+
+	// Start CSI Server
+	mounter := &blockTestMounter{targets: make(map[string]string)}
+	server, err := NewOsdCsiServer(&OsdCsiServerConfig{
+		DriverName: fake.Name,
+		Net:        "tcp",
+		Address:    "127.0.0.1:0",
+		Mounter:    mounter,
+	})
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer server.Stop()
+	conn, err := grpc.Dial(server.Address(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	controller := csi.NewControllerClient(conn)
+	node := csi.NewNodeClient(conn)
+
+	capability := &csi.VolumeCapability{
+		AccessMode: &csi.VolumeCapability_AccessMode{
+			Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+		},
+		AccessType: &csi.VolumeCapability_Block{
+			Block: &csi.VolumeCapability_BlockVolume{},
+		},
+	}
+
+	// Create a raw block volume
+	created, err := controller.CreateVolume(context.Background(), &csi.CreateVolumeRequest{
+		Name:               "rawblock",
+		VolumeCapabilities: []*csi.VolumeCapability{capability},
+	})
+	require.NoError(t, err)
+	id := created.GetVolume().GetVolumeId()
+
+	validated, err := controller.ValidateVolumeCapabilities(context.Background(),
+		&csi.ValidateVolumeCapabilitiesRequest{
+			VolumeId:           id,
+			VolumeCapabilities: []*csi.VolumeCapability{capability},
+		})
+	require.NoError(t, err)
+	assert.NotNil(t, validated.GetConfirmed())
+
+	// Stage and publish it. The device is bind mounted onto a file.
+	stagingPath := testPath + "/block/staging"
+	targetPath := testPath + "/block/target"
+	require.NoError(t, os.MkdirAll(stagingPath, 0744))
+	_, err = node.NodeStageVolume(context.Background(), &csi.NodeStageVolumeRequest{
+		VolumeId:          id,
+		StagingTargetPath: stagingPath,
+		VolumeCapability:  capability,
+	})
+	require.NoError(t, err)
+	_, err = node.NodePublishVolume(context.Background(), &csi.NodePublishVolumeRequest{
+		VolumeId:          id,
+		StagingTargetPath: stagingPath,
+		TargetPath:        targetPath,
+		VolumeCapability:  capability,
+	})
+	require.NoError(t, err)
+	fi, err := os.Stat(targetPath)
+	require.NoError(t, err)
+	assert.False(t, fi.IsDir())
+	devicePath, err := mounter.GetSourcePath(targetPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "/dev/fake/"+id, devicePath)
+
+	stats, err := node.NodeGetVolumeStats(context.Background(), &csi.NodeGetVolumeStatsRequest{
+		VolumeId:   id,
+		VolumePath: targetPath,
+	})
+	require.NoError(t, err)
+	require.Len(t, stats.GetUsage(), 1)
+	assert.Equal(t, created.GetVolume().GetCapacityBytes(), stats.GetUsage()[0].GetTotal())
+
+	// Unpublish, unstage and delete it
+	_, err = node.NodeUnpublishVolume(context.Background(), &csi.NodeUnpublishVolumeRequest{
+		VolumeId:   id,
+		TargetPath: targetPath,
+	})
+	require.NoError(t, err)
+	_, err = os.Stat(targetPath)
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, 0, mounter.HasMounts(devicePath))
+
+	_, err = node.NodeUnstageVolume(context.Background(), &csi.NodeUnstageVolumeRequest{
+		VolumeId:          id,
+		StagingTargetPath: stagingPath,
+	})
+	require.NoError(t, err)
+	_, err = controller.DeleteVolume(context.Background(), &csi.DeleteVolumeRequest{
+		VolumeId: id,
 	})
+	require.NoError(t, err)
 }
@@ -0,0 +1,231 @@
+/*
+Package csi is CSI driver interface for OSD
+Copyright 2019 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package csi
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/util"
+
+	csi "github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// volumeContextEphemeral is set by Kubernetes in the volume context
+	// of the ephemeral inline volumes of a pod
+	volumeContextEphemeral = "csi.storage.k8s.io/ephemeral"
+	// volumeContextKubernetesPrefix is the prefix of the keys added by
+	// Kubernetes to the volume context
+	volumeContextKubernetesPrefix = "csi.storage.k8s.io/"
+
+	// ephemeralLabel marks the ephemeral volumes created by NodePublishVolume
+	ephemeralLabel = "csi.openstorage.org/ephemeral"
+	// ephemeralNodeLabel is the id of the node of an ephemeral volume
+	ephemeralNodeLabel = "csi.openstorage.org/ephemeral-node"
+	// ephemeralTargetLabel is the target path of an ephemeral volume
+	ephemeralTargetLabel = "csi.openstorage.org/ephemeral-target"
+)
+
+// ephemeralRejectedParams are the volume attributes which pods cannot set
+// on their ephemeral inline volumes: a pod cannot clone another volume, and
+// passphrases must never be saved in a volume. Any attribute naming a
+// secret is rejected as well.
+var ephemeralRejectedParams = []string{
+	api.SpecParent,
+	SecretPassphrase,
+}
+
+// checkEphemeralParam returns an error if the volume attribute cannot be
+// set on an ephemeral inline volume
+func checkEphemeralParam(key string) error {
+	for _, rejected := range ephemeralRejectedParams {
+		if key == rejected {
+			return fmt.Errorf("Attribute %s is not allowed for ephemeral volumes", key)
+		}
+	}
+	if strings.Contains(strings.ToLower(key), "secret") {
+		return fmt.Errorf("Secret attribute %s is not allowed for ephemeral volumes", key)
+	}
+	return nil
+}
+
+// isEphemeralRequest returns true if the request publishes an ephemeral
+// inline volume
+func isEphemeralRequest(req *csi.NodePublishVolumeRequest) bool {
+	ephemeral, _ := strconv.ParseBool(req.GetVolumeContext()[volumeContextEphemeral])
+	return ephemeral
+}
+
+// isEphemeralVolume returns true if the volume has been created by
+// NodePublishVolume for an ephemeral inline volume
+func isEphemeralVolume(v *api.Volume) bool {
+	return v.GetLocator().GetVolumeLabels()[ephemeralLabel] == "true"
+}
+
+// localNodeID returns the id of the node of the server, which is empty
+// if the server has no cluster
+func (s *OsdCsiServer) localNodeID() (string, error) {
+	if s.cluster == nil {
+		return "", nil
+	}
+	clus, err := s.cluster.Enumerate()
+	if err != nil {
+		return "", err
+	}
+	return clus.NodeId, nil
+}
+
+// createEphemeralVolume creates the ephemeral inline volume of a publish
+// request from the parameters of its volume context, unless it has already
+// been created. The volume is named after the id of the request. The
+// errors returned are gRPC status errors.
+func (s *OsdCsiServer) createEphemeralVolume(
+	req *csi.NodePublishVolumeRequest,
+) (*api.Volume, error) {
+	if v, err := util.VolumeFromName(s.driver, req.GetVolumeId()); err == nil {
+		return v, nil
+	}
+
+	params := make(map[string]string)
+	for k, v := range req.GetVolumeContext() {
+		if strings.HasPrefix(k, volumeContextKubernetesPrefix) {
+			continue
+		}
+		if err := checkEphemeralParam(k); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		params[k] = v
+	}
+	spec, locator, source, err := s.specHandler.SpecFromOpts(params)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Invalid ephemeral volume attributes: %s",
+			err.Error())
+	}
+	spec.Ephemeral = true
+	if spec.GetSize() == 0 {
+		spec.Size = defaultCSIVolumeSize
+	}
+
+	nodeID, err := s.localNodeID()
+	if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Unable to get the id of the node: %s",
+			err.Error())
+	}
+	locator.Name = req.GetVolumeId()
+	locator.VolumeLabels[ephemeralLabel] = "true"
+	locator.VolumeLabels[ephemeralNodeLabel] = nodeID
+	locator.VolumeLabels[ephemeralTargetLabel] = req.GetTargetPath()
+
+	id, err := s.driver.Create(locator, source, spec)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Unable to create ephemeral volume %s: %s",
+			req.GetVolumeId(),
+			err.Error())
+	}
+	v, err := util.VolumeFromName(s.driver, id)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Unable to find newly created volume: %s",
+			err.Error())
+	}
+
+	logrus.Infof("Ephemeral volume %s created with id %s",
+		req.GetVolumeId(),
+		id)
+	return v, nil
+}
+
+// deleteEphemeralVolume deletes an ephemeral inline volume once it has
+// been unpublished. The errors returned are gRPC status errors.
+func (s *OsdCsiServer) deleteEphemeralVolume(v *api.Volume) error {
+	if err := s.driver.Delete(v.GetId()); err != nil {
+		return status.Errorf(
+			codes.Internal,
+			"Unable to delete ephemeral volume %s: %s",
+			v.GetLocator().GetName(),
+			err.Error())
+	}
+
+	logrus.Infof("Ephemeral volume %s deleted", v.GetLocator().GetName())
+	return nil
+}
+
+// cleanupEphemeralVolumes deletes the ephemeral inline volumes of the node
+// whose target path no longer exists. They are leaked when their pod is
+// deleted while the server is not running.
+func (s *OsdCsiServer) cleanupEphemeralVolumes() {
+	vols, err := s.driver.Enumerate(&api.VolumeLocator{
+		VolumeLabels: map[string]string{
+			ephemeralLabel: "true",
+		},
+	}, nil)
+	if err != nil {
+		logrus.Warnf("Unable to enumerate the ephemeral volumes: %v", err)
+		return
+	}
+	if len(vols) == 0 {
+		return
+	}
+	nodeID, err := s.localNodeID()
+	if err != nil {
+		logrus.Warnf("Unable to get the id of the node: %v", err)
+		return
+	}
+
+	for _, v := range vols {
+		labels := v.GetLocator().GetVolumeLabels()
+		if labels[ephemeralNodeLabel] != nodeID {
+			continue
+		}
+		if _, err := os.Stat(labels[ephemeralTargetLabel]); err == nil {
+			continue
+		}
+
+		logrus.Infof("Removing leaked ephemeral volume %s", v.GetLocator().GetName())
+		for _, path := range v.GetAttachPath() {
+			if err := s.driver.Unmount(v.GetId(), path, nil); err != nil {
+				logrus.Warnf("Unable to unmount volume %s from %s: %v",
+					v.GetId(),
+					path,
+					err)
+			}
+		}
+		if s.driver.Type() == api.DriverType_DRIVER_TYPE_BLOCK &&
+			v.GetState() == api.VolumeState_VOLUME_STATE_ATTACHED {
+			if err := s.driver.Detach(v.GetId(), nil); err != nil {
+				logrus.Warnf("Unable to detach volume %s: %v", v.GetId(), err)
+			}
+		}
+		if err := s.deleteEphemeralVolume(v); err != nil {
+			logrus.Warnln(err)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Package csi is CSI driver interface for OSD
+Copyright 2019 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package csi
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	csi "github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/golang/mock/gomock"
+	"github.com/kubernetes-csi/csi-test/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/libopenstorage/openstorage/api"
+	mockcluster "github.com/libopenstorage/openstorage/cluster/mock"
+	"github.com/libopenstorage/openstorage/pkg/util"
+	volumedrivers "github.com/libopenstorage/openstorage/volume/drivers"
+	"github.com/libopenstorage/openstorage/volume/drivers/fake"
+)
+
+// startEphemeralTestServer starts a server with the fake driver on node1.
+// It returns a function stopping the server and a node client.
+func startEphemeralTestServer(t *testing.T) (func(), csi.NodeClient) {
+	mc := gomock.NewController(&utils.SafeGoroutineTester{})
+	cl := mockcluster.NewMockCluster(mc)
+	cl.EXPECT().
+		Enumerate().
+		Return(api.Cluster{NodeId: "node1"}, nil).
+		AnyTimes()
+	server, err := NewOsdCsiServer(&OsdCsiServerConfig{
+		DriverName: fake.Name,
+		Net:        "tcp",
+		Address:    "127.0.0.1:0",
+		Cluster:    cl,
+	})
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	conn, err := grpc.Dial(server.Address(), grpc.WithInsecure())
+	require.NoError(t, err)
+	return func() {
+		conn.Close()
+		server.Stop()
+		mc.Finish()
+	}, csi.NewNodeClient(conn)
+}
+
+func ephemeralPublishRequest(name, target string) *csi.NodePublishVolumeRequest {
+	return &csi.NodePublishVolumeRequest{
+		VolumeId:   name,
+		TargetPath: target,
+		VolumeCapability: &csi.VolumeCapability{
+			AccessType: &csi.VolumeCapability_Mount{
+				Mount: &csi.VolumeCapability_MountVolume{},
+			},
+			AccessMode: &csi.VolumeCapability_AccessMode{
+				Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+			},
+		},
+		VolumeContext: map[string]string{
+			volumeContextEphemeral:                     "true",
+			volumeContextKubernetesPrefix + "pod.name": "mypod",
+			api.SpecSize:                               "2G",
+		},
+	}
+}
+
+func TestNodePublishUnpublishEphemeralVolume(t *testing.T) {
+	setupTestFakeDriver(t)
+	d, err := volumedrivers.Get(fake.Name)
+	require.NoError(t, err)
+	stop, c := startEphemeralTestServer(t)
+	defer stop()
+
+	dir, err := ioutil.TempDir("", "csi-ephemeral")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	target := filepath.Join(dir, "target")
+
+	// Publishing creates the volume from the volume context
+	name := "csi-ephemeral-published"
+	req := ephemeralPublishRequest(name, target)
+	_, err = c.NodePublishVolume(context.Background(), req)
+	require.NoError(t, err)
+	v, err := util.VolumeFromName(d, name)
+	require.NoError(t, err)
+	assert.True(t, v.GetSpec().GetEphemeral())
+	assert.Equal(t, uint64(2*1024*1024*1024), v.GetSpec().GetSize())
+	assert.Equal(t, "true", v.GetLocator().GetVolumeLabels()[ephemeralLabel])
+	assert.Equal(t, "node1", v.GetLocator().GetVolumeLabels()[ephemeralNodeLabel])
+	assert.Equal(t, target, v.GetLocator().GetVolumeLabels()[ephemeralTargetLabel])
+	assert.NotContains(t, v.GetSpec().GetVolumeLabels(), volumeContextKubernetesPrefix+"pod.name")
+	assert.Contains(t, v.GetAttachPath(), target)
+	info, err := os.Stat(target)
+	require.NoError(t, err)
+	assert.True(t, info.IsDir())
+
+	// Publishing again uses the same volume
+	_, err = c.NodePublishVolume(context.Background(), req)
+	require.NoError(t, err)
+	vols, err := d.Enumerate(&api.VolumeLocator{Name: name}, nil)
+	require.NoError(t, err)
+	assert.Len(t, vols, 1)
+
+	// Raw block ephemeral volumes are not supported
+	blockReq := ephemeralPublishRequest("csi-ephemeral-block", filepath.Join(dir, "block"))
+	blockReq.VolumeCapability.AccessType = &csi.VolumeCapability_Block{
+		Block: &csi.VolumeCapability_BlockVolume{},
+	}
+	_, err = c.NodePublishVolume(context.Background(), blockReq)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// Pods cannot clone other volumes or save secrets in their volumes
+	for _, key := range []string{api.SpecParent, SecretPassphrase, api.SpecPassphrase} {
+		rejectedName := "csi-ephemeral-rejected"
+		rejectedReq := ephemeralPublishRequest(rejectedName, filepath.Join(dir, "rejected"))
+		rejectedReq.VolumeContext[key] = "value"
+		_, err = c.NodePublishVolume(context.Background(), rejectedReq)
+		assert.Equal(t, codes.InvalidArgument, grpc.Code(err), key)
+		_, err = util.VolumeFromName(d, rejectedName)
+		assert.Error(t, err, key)
+	}
+
+	// Unpublishing deletes the volume
+	_, err = c.NodeUnpublishVolume(context.Background(), &csi.NodeUnpublishVolumeRequest{
+		VolumeId:   name,
+		TargetPath: target,
+	})
+	require.NoError(t, err)
+	_, err = util.VolumeFromName(d, name)
+	assert.Error(t, err)
+	_, err = os.Stat(target)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCleanupEphemeralVolumes(t *testing.T) {
+	setupTestFakeDriver(t)
+	d, err := volumedrivers.Get(fake.Name)
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "csi-ephemeral")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Publish two ephemeral volumes and create one of another node
+	stop, c := startEphemeralTestServer(t)
+	leaked := filepath.Join(dir, "leaked")
+	_, err = c.NodePublishVolume(context.Background(),
+		ephemeralPublishRequest("csi-ephemeral-leaked", leaked))
+	require.NoError(t, err)
+	_, err = c.NodePublishVolume(context.Background(),
+		ephemeralPublishRequest("csi-ephemeral-inuse", filepath.Join(dir, "inuse")))
+	require.NoError(t, err)
+	_, err = d.Create(&api.VolumeLocator{
+		Name: "csi-ephemeral-remote",
+		VolumeLabels: map[string]string{
+			ephemeralLabel:       "true",
+			ephemeralNodeLabel:   "node2",
+			ephemeralTargetLabel: filepath.Join(dir, "remote"),
+		},
+	}, &api.Source{}, &api.VolumeSpec{Size: 1024, Ephemeral: true})
+	require.NoError(t, err)
+	stop()
+
+	// The volume whose target has been removed while the server was
+	// stopped is deleted when the server restarts
+	require.NoError(t, os.Remove(leaked))
+	stop, _ = startEphemeralTestServer(t)
+	defer stop()
+
+	_, err = util.VolumeFromName(d, "csi-ephemeral-leaked")
+	assert.Error(t, err)
+	for _, name := range []string{"csi-ephemeral-inuse", "csi-ephemeral-remote"} {
+		v, err := util.VolumeFromName(d, name)
+		assert.NoError(t, err, name)
+		assert.NoError(t, d.Delete(v.GetId()))
+	}
+}
@@ -19,7 +19,7 @@ package csi
 import (
 	"testing"
 
-	csi "github.com/container-storage-interface/spec/lib/go/csi/v0"
+	csi "github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
 )
@@ -50,3 +50,32 @@ func TestNewCSIServerGetPluginInfo(t *testing.T) {
 	assert.Len(t, manifest, 1)
 	assert.Equal(t, manifest["driver"], "mock")
 }
+
+func TestNewCSIServerGetPluginCapabilities(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Setup client
+	c := csi.NewIdentityClient(s.Conn())
+
+	// Get capabilities
+	r, err := c.GetPluginCapabilities(
+		context.Background(),
+		&csi.GetPluginCapabilitiesRequest{})
+	assert.NoError(t, err)
+
+	// Verify
+	caps := r.GetCapabilities()
+	assert.Len(t, caps, 3)
+	assert.Equal(t,
+		csi.PluginCapability_Service_CONTROLLER_SERVICE,
+		caps[0].GetService().GetType())
+	assert.Equal(t,
+		csi.PluginCapability_Service_VOLUME_ACCESSIBILITY_CONSTRAINTS,
+		caps[1].GetService().GetType())
+	assert.Equal(t,
+		csi.PluginCapability_VolumeExpansion_ONLINE,
+		caps[2].GetVolumeExpansion().GetType())
+}
@@ -19,39 +19,263 @@ package csi
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"syscall"
 
 	"github.com/libopenstorage/openstorage/api"
-	"github.com/libopenstorage/openstorage/pkg/options"
+	"github.com/libopenstorage/openstorage/pkg/mount"
+	"github.com/libopenstorage/openstorage/pkg/resizefs"
 	"github.com/libopenstorage/openstorage/pkg/util"
+	"github.com/libopenstorage/openstorage/volume"
 
-	csi "github.com/container-storage-interface/spec/lib/go/csi/v0"
+	csi "github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-// NodeGetId is a CSI API which gets the PX NodeId for the local node
-func (s *OsdCsiServer) NodeGetId(
+// controllerPublishedMarker is the file created in the staging path of the
+// volumes attached by ControllerPublishVolume, under the mount of the volume.
+// NodeUnstageVolume leaves those volumes attached for ControllerUnpublishVolume.
+const controllerPublishedMarker = ".osd-controller-published"
+
+// resizeFilesystem grows the filesystem of an expanded device. It is
+// replaced by the tests.
+var resizeFilesystem = resizefs.Resize
+
+// NodeGetInfo is a CSI API which gets the PX NodeId for the local node
+// and its topology
+func (s *OsdCsiServer) NodeGetInfo(
 	ctx context.Context,
-	req *csi.NodeGetIdRequest,
-) (*csi.NodeGetIdResponse, error) {
+	req *csi.NodeGetInfoRequest,
+) (*csi.NodeGetInfoResponse, error) {
 	clus, err := s.cluster.Enumerate()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to Enumerate cluster: %s", err)
 	}
 
-	result := &csi.NodeGetIdResponse{
+	result := &csi.NodeGetInfoResponse{
 		NodeId: clus.NodeId,
 	}
 
+	// Report the topology of the node from its geo configuration. It has
+	// no segments when the location of the node is not known.
+	var node *api.Node
+	for i := range clus.Nodes {
+		if clus.Nodes[i].Id == clus.NodeId {
+			node = &clus.Nodes[i]
+			break
+		}
+	}
+	conf, err := s.cluster.GetNodeConf(clus.NodeId)
+	if err != nil {
+		logrus.Debugf("Unable to get the configuration of node %s: %v", clus.NodeId, err)
+	}
+	result.AccessibleTopology = &csi.Topology{
+		Segments: nodeTopology(node, conf),
+	}
+
 	logrus.Infof("NodeId is %s", result.NodeId)
 
 	return result, nil
 }
 
+// NodeStageVolume is a CSI API call which attaches the volume and mounts it
+// on the staging path of the node. NodePublishVolume then bind mounts the
+// staging path onto each target path, so that a volume used by several
+// containers on the node is only attached and mounted once.
+func (s *OsdCsiServer) NodeStageVolume(
+	ctx context.Context,
+	req *csi.NodeStageVolumeRequest,
+) (*csi.NodeStageVolumeResponse, error) {
+
+	// Log request. The secrets are not logged.
+	logrus.Debugf("NodeStageVolume of id %s on %s",
+		req.GetVolumeId(),
+		req.GetStagingTargetPath())
+
+	// Check arguments
+	if len(req.GetVolumeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Volume id must be provided")
+	}
+	if len(req.GetStagingTargetPath()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Staging target path must be provided")
+	}
+	if req.GetVolumeCapability() == nil || req.GetVolumeCapability().GetAccessMode() == nil {
+		return nil, status.Error(codes.InvalidArgument, "Volume access mode must be provided")
+	}
+	block := req.GetVolumeCapability().GetBlock() != nil
+	if block && !s.supportsBlock() {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Driver %s does not support raw block volumes",
+			s.driver.Name())
+	}
+
+	// Get volume information
+	v, err := util.VolumeFromName(s.driver, req.GetVolumeId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Volume id %s not found: %s",
+			req.GetVolumeId(),
+			err.Error())
+	}
+
+	// Nothing to do if the volume has already been staged
+	if isMountedAt(v, req.GetStagingTargetPath()) {
+		return &csi.NodeStageVolumeResponse{}, nil
+	}
+
+	// Gather volume attributes
+	spec, _, _, err := s.specHandler.SpecFromOpts(req.GetVolumeContext())
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Invalid volume attributes: %#v",
+			req.GetVolumeContext())
+	}
+	opts, err := s.attachOptions(v, spec, req.GetSecrets())
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify staging location is an existing directory
+	if err := verifyTargetLocation(req.GetStagingTargetPath()); err != nil {
+		return nil, status.Errorf(
+			codes.Aborted,
+			"Failed to use staging location %s: %s",
+			req.GetStagingTargetPath(),
+			err.Error())
+	}
+
+	// If this is for a block driver, first attach the volume unless
+	// it has been attached by ControllerPublishVolume, which is recorded
+	// in the staging path so that it is not detached when unstaged
+	blockDriver := s.driver.Type() == api.DriverType_DRIVER_TYPE_BLOCK
+	published := len(req.GetPublishContext()[publishContextDevicePath]) != 0
+	attach := blockDriver && !published
+	if attach {
+		if _, err := s.driver.Attach(req.GetVolumeId(), opts); err != nil {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Unable to attach volume: %s",
+				err.Error())
+		}
+	} else if blockDriver {
+		if err := setControllerPublished(req.GetStagingTargetPath()); err != nil {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Unable to record the attachment of volume %s on %s: %s",
+				req.GetVolumeId(),
+				req.GetStagingTargetPath(),
+				err.Error())
+		}
+	}
+
+	// Raw block volumes are only attached. Their device is bind
+	// mounted onto the target paths by NodePublishVolume.
+	if block {
+		logrus.Infof("Volume %s staged as a raw block device", req.GetVolumeId())
+		return &csi.NodeStageVolumeResponse{}, nil
+	}
+
+	// Mount volume onto the staging path
+	if err := s.driver.Mount(req.GetVolumeId(), req.GetStagingTargetPath(), nil); err != nil {
+		if attach {
+			if detachErr := s.driver.Detach(v.GetId(), opts); detachErr != nil {
+				logrus.Errorf("Unable to detach volume %s: %s",
+					v.GetId(),
+					detachErr.Error())
+			}
+		} else if blockDriver {
+			clearControllerPublished(req.GetStagingTargetPath())
+		}
+		return nil, status.Errorf(
+			codes.Internal,
+			"Unable to mount volume %s onto %s: %s",
+			req.GetVolumeId(),
+			req.GetStagingTargetPath(),
+			err.Error())
+	}
+
+	logrus.Infof("Volume %s staged on %s",
+		req.GetVolumeId(),
+		req.GetStagingTargetPath())
+
+	return &csi.NodeStageVolumeResponse{}, nil
+}
+
+// NodeUnstageVolume is a CSI API call which unmounts the volume from the
+// staging path. The volume is detached once it is no longer mounted on
+// the node, unless it was attached by ControllerPublishVolume.
+func (s *OsdCsiServer) NodeUnstageVolume(
+	ctx context.Context,
+	req *csi.NodeUnstageVolumeRequest,
+) (*csi.NodeUnstageVolumeResponse, error) {
+
+	logrus.Debugf("NodeUnstageVolume req[%#v]", req)
+
+	// Check arguments
+	if len(req.GetVolumeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Volume id must be provided")
+	}
+	if len(req.GetStagingTargetPath()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Staging target path must be provided")
+	}
+
+	// Get volume information
+	v, err := util.VolumeFromName(s.driver, req.GetVolumeId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Volume id %s not found: %s",
+			req.GetVolumeId(),
+			err.Error())
+	}
+
+	// Unmount the volume from the staging path
+	if isMountedAt(v, req.GetStagingTargetPath()) {
+		if err = s.driver.Unmount(req.GetVolumeId(), req.GetStagingTargetPath(), nil); err != nil {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Unable to unmount volume %s from %s: %s",
+				req.GetVolumeId(),
+				req.GetStagingTargetPath(),
+				err.Error())
+		}
+	}
+
+	// Detach the volume if it is not mounted anywhere else. Volumes
+	// attached by ControllerPublishVolume are detached by
+	// ControllerUnpublishVolume.
+	published := clearControllerPublished(req.GetStagingTargetPath())
+	mounted := false
+	for _, path := range v.GetAttachPath() {
+		if !samePath(path, req.GetStagingTargetPath()) {
+			mounted = true
+			break
+		}
+	}
+	if !mounted && !published &&
+		v.GetState() != api.VolumeState_VOLUME_STATE_DETACHED &&
+		s.driver.Type() == api.DriverType_DRIVER_TYPE_BLOCK {
+		if err = s.driver.Detach(req.GetVolumeId(), nil); err != nil {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Unable to detach volume: %s",
+				err.Error())
+		}
+	}
+
+	logrus.Infof("Volume %s unstaged from %s",
+		req.GetVolumeId(),
+		req.GetStagingTargetPath())
+
+	return &csi.NodeUnstageVolumeResponse{}, nil
+}
+
 // NodePublishVolume is a CSI API call which mounts the volume on the specified
-// target path on the node.
+// target path on the node, creating the target path. Volumes which have been
+// staged are bind mounted from the staging path. Ephemeral inline volumes
+// are created from their volume context.
 //
 // TODO: Support READ ONLY Mounts
 //
@@ -60,7 +284,10 @@ func (s *OsdCsiServer) NodePublishVolume(
 	req *csi.NodePublishVolumeRequest,
 ) (*csi.NodePublishVolumeResponse, error) {
 
-	logrus.Debugf("NodePublishVolume req[%#v]", req)
+	// Log request. The volume context and secrets are not logged.
+	logrus.Debugf("NodePublishVolume of id %s on %s",
+		req.GetVolumeId(),
+		req.GetTargetPath())
 
 	// Check arguments
 	if len(req.GetVolumeId()) == 0 {
@@ -72,32 +299,55 @@ func (s *OsdCsiServer) NodePublishVolume(
 	if req.GetVolumeCapability() == nil || req.GetVolumeCapability().GetAccessMode() == nil {
 		return nil, status.Error(codes.InvalidArgument, "Volume access mode must be provided")
 	}
+	block := req.GetVolumeCapability().GetBlock() != nil
+	if block && !s.supportsBlock() {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Driver %s does not support raw block volumes",
+			s.driver.Name())
+	}
+	ephemeral := isEphemeralRequest(req)
+	if block && ephemeral {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"Ephemeral volumes cannot be raw block volumes")
+	}
 
-	// Get volume information
-	v, err := util.VolumeFromName(s.driver, req.GetVolumeId())
-	if err != nil {
+	// Get volume information. Ephemeral inline volumes are created
+	// when they are first published.
+	var v *api.Volume
+	var err error
+	if ephemeral {
+		if v, err = s.createEphemeralVolume(req); err != nil {
+			return nil, err
+		}
+	} else if v, err = util.VolumeFromName(s.driver, req.GetVolumeId()); err != nil {
 		return nil, status.Errorf(codes.NotFound, "Volume id %s not found: %s",
 			req.GetVolumeId(),
 			err.Error())
 	}
 
 	// Gather volume attributes
-	spec, _, _, err := s.specHandler.SpecFromOpts(req.GetVolumeAttributes())
+	spec, _, _, err := s.specHandler.SpecFromOpts(req.GetVolumeContext())
 	if err != nil {
 		return nil, status.Errorf(
 			codes.InvalidArgument,
 			"Invalid volume attributes: %#v",
-			req.GetVolumeAttributes())
+			req.GetVolumeContext())
 	}
 
-	// This seems weird as a way to change opts to map[string]string
-	opts := make(map[string]string)
-	if len(spec.GetPassphrase()) != 0 {
-		opts[options.OptionsSecret] = spec.GetPassphrase()
+	opts, err := s.attachOptions(v, spec, req.GetSecrets())
+	if err != nil {
+		return nil, err
 	}
 
-	// Verify target location is an existing directory
-	if err := verifyTargetLocation(req.GetTargetPath()); err != nil {
+	if block {
+		return s.publishBlockVolume(req, opts)
+	}
+
+	// Create the target directory. Its parent is created by the
+	// container orchestrator.
+	if err := createTargetLocation(req.GetTargetPath()); err != nil {
 		return nil, status.Errorf(
 			codes.Aborted,
 			"Failed to use target location %s: %s",
@@ -105,9 +355,15 @@ func (s *OsdCsiServer) NodePublishVolume(
 			err.Error())
 	}
 
-	// If this is for a block driver, first attach the volume
-	if s.driver.Type() == api.DriverType_DRIVER_TYPE_BLOCK {
-		if _, err := s.driver.Attach(req.GetVolumeId(), opts); err != nil {
+	if len(req.GetStagingTargetPath()) != 0 {
+		return s.publishStagedVolume(v, req)
+	}
+
+	// If this is for a block driver, first attach the volume unless
+	// it has been attached by ControllerPublishVolume
+	if s.driver.Type() == api.DriverType_DRIVER_TYPE_BLOCK &&
+		len(req.GetPublishContext()[publishContextDevicePath]) == 0 {
+		if _, err := s.driver.Attach(v.GetId(), opts); err != nil {
 			return nil, status.Errorf(
 				codes.Internal,
 				"Unable to attach volume: %s",
@@ -116,7 +372,7 @@ func (s *OsdCsiServer) NodePublishVolume(
 	}
 
 	// Mount volume onto the path
-	if err := s.driver.Mount(req.GetVolumeId(), req.GetTargetPath(), nil); err != nil {
+	if err := s.driver.Mount(v.GetId(), req.GetTargetPath(), nil); err != nil {
 		// Detach on error
 		detachErr := s.driver.Detach(v.GetId(), opts)
 		if detachErr != nil {
@@ -139,7 +395,9 @@ func (s *OsdCsiServer) NodePublishVolume(
 	return &csi.NodePublishVolumeResponse{}, nil
 }
 
-// NodeUnpublishVolume is a CSI API call which unmounts the volume.
+// NodeUnpublishVolume is a CSI API call which unmounts the volume and
+// removes the target path created by NodePublishVolume. Ephemeral inline
+// volumes are deleted.
 func (s *OsdCsiServer) NodeUnpublishVolume(
 	ctx context.Context,
 	req *csi.NodeUnpublishVolumeRequest,
@@ -156,21 +414,46 @@ func (s *OsdCsiServer) NodeUnpublishVolume(
 	}
 
 	// Get volume information
-	_, err := util.VolumeFromName(s.driver, req.GetVolumeId())
+	v, err := util.VolumeFromName(s.driver, req.GetVolumeId())
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "Volume id %s not found: %s",
 			req.GetVolumeId(),
 			err.Error())
 	}
 
+	// Nothing to do if the target has already been removed, except
+	// deleting an ephemeral volume
+	if _, err := os.Stat(req.GetTargetPath()); os.IsNotExist(err) {
+		logrus.Infof("Volume %s is not published on %s",
+			req.GetVolumeId(),
+			req.GetTargetPath())
+		if isEphemeralVolume(v) && len(v.GetAttachPath()) == 0 {
+			if err := s.deleteEphemeralVolume(v); err != nil {
+				return nil, err
+			}
+		}
+		return &csi.NodeUnpublishVolumeResponse{}, nil
+	}
+
+	// Raw block volumes are bind mounted onto a file
+	if s.isBlockTarget(req.GetTargetPath()) {
+		return s.unpublishBlockVolume(v, req)
+	}
+
 	// Verify target location is an existing directory
 	// See: https://github.com/container-storage-interface/spec/issues/60
 	if err = verifyTargetLocation(req.GetTargetPath()); err != nil {
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
+	// Staged volumes are mounted by the driver on the staging path
+	// and bind mounted on the target path
+	if len(v.GetAttachPath()) != 0 && !isMountedAt(v, req.GetTargetPath()) {
+		return s.unpublishStagedVolume(v, req)
+	}
+
 	// Mount volume onto the path
-	if err = s.driver.Unmount(req.GetVolumeId(), req.GetTargetPath(), nil); err != nil {
+	if err = s.driver.Unmount(v.GetId(), req.GetTargetPath(), nil); err != nil {
 		return nil, status.Errorf(
 			codes.Internal,
 			"Unable to unmount volume %s onto %s: %s",
@@ -178,9 +461,10 @@ func (s *OsdCsiServer) NodeUnpublishVolume(
 			req.GetTargetPath(),
 			err.Error())
 	}
+	removeTargetLocation(req.GetTargetPath())
 
 	if s.driver.Type() == api.DriverType_DRIVER_TYPE_BLOCK {
-		if err = s.driver.Detach(req.GetVolumeId(), nil); err != nil {
+		if err = s.driver.Detach(v.GetId(), nil); err != nil {
 			return nil, status.Errorf(
 				codes.Internal,
 				"Unable to detach volume: %s",
@@ -188,13 +472,380 @@ func (s *OsdCsiServer) NodeUnpublishVolume(
 		}
 	}
 
+	// Ephemeral inline volumes only live while they are published
+	if isEphemeralVolume(v) {
+		if err = s.deleteEphemeralVolume(v); err != nil {
+			return nil, err
+		}
+	}
+
 	logrus.Infof("Volume %s unmounted", req.GetVolumeId())
 
 	return &csi.NodeUnpublishVolumeResponse{}, nil
 }
 
-// NodeGetCapabilities is a CSI API function which seems to be setup for
-// future patches
+// publishStagedVolume bind mounts the staging path of the volume onto
+// the target path
+func (s *OsdCsiServer) publishStagedVolume(
+	v *api.Volume,
+	req *csi.NodePublishVolumeRequest,
+) (*csi.NodePublishVolumeResponse, error) {
+	stagingPath := req.GetStagingTargetPath()
+	if !isMountedAt(v, stagingPath) {
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			"Volume %s is not staged on %s",
+			req.GetVolumeId(),
+			stagingPath)
+	}
+
+	// Load the bind mounts of the staging path made before a restart
+	if s.mounter.HasMounts(stagingPath) == 0 {
+		if err := s.mounter.Reload(stagingPath); err != nil {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Unable to load the mounts of %s: %s",
+				stagingPath,
+				err.Error())
+		}
+	}
+	if err := s.mounter.Mount(
+		0,
+		stagingPath,
+		req.GetTargetPath(),
+		"",
+		syscall.MS_BIND,
+		"",
+		0,
+		nil,
+	); err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Unable to bind mount volume %s from %s onto %s: %s",
+			req.GetVolumeId(),
+			stagingPath,
+			req.GetTargetPath(),
+			err.Error())
+	}
+
+	logrus.Infof("Volume %s bind mounted from %s on %s",
+		req.GetVolumeId(),
+		stagingPath,
+		req.GetTargetPath())
+
+	return &csi.NodePublishVolumeResponse{}, nil
+}
+
+// unpublishStagedVolume removes the bind mount of the staging path of
+// the volume from the target path
+func (s *OsdCsiServer) unpublishStagedVolume(
+	v *api.Volume,
+	req *csi.NodeUnpublishVolumeRequest,
+) (*csi.NodeUnpublishVolumeResponse, error) {
+	for _, stagingPath := range v.GetAttachPath() {
+		// Load the bind mounts of the staging path made before a restart
+		if exists, _ := s.mounter.Exists(stagingPath, req.GetTargetPath()); !exists {
+			if err := s.mounter.Reload(stagingPath); err != nil {
+				return nil, status.Errorf(
+					codes.Internal,
+					"Unable to load the mounts of %s: %s",
+					stagingPath,
+					err.Error())
+			}
+		}
+		err := s.mounter.Unmount(stagingPath, req.GetTargetPath(), 0, 0, nil)
+		if err != nil && err != mount.ErrEnoent {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Unable to unmount volume %s from %s: %s",
+				req.GetVolumeId(),
+				req.GetTargetPath(),
+				err.Error())
+		}
+	}
+
+	// The bind mounter makes the target immutable while it is unmounted
+	if err := s.mounter.RemoveMountPath(req.GetTargetPath(), nil); err != nil {
+		logrus.Warnf("Unable to remove target location %s: %v",
+			req.GetTargetPath(),
+			err)
+	}
+
+	logrus.Infof("Volume %s unmounted from %s",
+		req.GetVolumeId(),
+		req.GetTargetPath())
+
+	return &csi.NodeUnpublishVolumeResponse{}, nil
+}
+
+// publishBlockVolume bind mounts the device of a raw block volume onto
+// the target path, which is created as a file. The volume is attached
+// unless it has been attached by ControllerPublishVolume.
+func (s *OsdCsiServer) publishBlockVolume(
+	req *csi.NodePublishVolumeRequest,
+	opts map[string]string,
+) (*csi.NodePublishVolumeResponse, error) {
+	devicePath := req.GetPublishContext()[publishContextDevicePath]
+	attached := false
+	if len(devicePath) == 0 {
+		var err error
+		if devicePath, err = s.driver.Attach(req.GetVolumeId(), opts); err != nil {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Unable to attach volume: %s",
+				err.Error())
+		}
+		attached = len(req.GetStagingTargetPath()) == 0
+	}
+	if len(devicePath) == 0 {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Driver returned no device for volume %s",
+			req.GetVolumeId())
+	}
+
+	err := createBlockTarget(req.GetTargetPath())
+	if err == nil {
+		err = s.mounter.Mount(
+			0,
+			devicePath,
+			req.GetTargetPath(),
+			"",
+			syscall.MS_BIND,
+			"",
+			0,
+			nil,
+		)
+	}
+	if err != nil {
+		if attached {
+			if detachErr := s.driver.Detach(req.GetVolumeId(), opts); detachErr != nil {
+				logrus.Errorf("Unable to detach volume %s: %s",
+					req.GetVolumeId(),
+					detachErr.Error())
+			}
+		}
+		return nil, status.Errorf(
+			codes.Internal,
+			"Unable to bind mount device %s of volume %s onto %s: %s",
+			devicePath,
+			req.GetVolumeId(),
+			req.GetTargetPath(),
+			err.Error())
+	}
+
+	logrus.Infof("Volume %s published as raw block device %s on %s",
+		req.GetVolumeId(),
+		devicePath,
+		req.GetTargetPath())
+
+	return &csi.NodePublishVolumeResponse{}, nil
+}
+
+// unpublishBlockVolume removes the bind mount of the device of a raw
+// block volume from the target path and removes the target file. The
+// volume is detached once its device is no longer published.
+func (s *OsdCsiServer) unpublishBlockVolume(
+	v *api.Volume,
+	req *csi.NodeUnpublishVolumeRequest,
+) (*csi.NodeUnpublishVolumeResponse, error) {
+	devicePath, err := s.mounter.GetSourcePath(req.GetTargetPath())
+	if err != nil {
+		// Load the bind mounts of the device made before a restart
+		devicePath = v.GetDevicePath()
+		if len(devicePath) != 0 {
+			if err := s.mounter.Reload(devicePath); err != nil {
+				return nil, status.Errorf(
+					codes.Internal,
+					"Unable to load the mounts of %s: %s",
+					devicePath,
+					err.Error())
+			}
+		}
+	}
+	if len(devicePath) != 0 {
+		err = s.mounter.Unmount(devicePath, req.GetTargetPath(), 0, 0, nil)
+		if err != nil && err != mount.ErrEnoent {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Unable to unmount volume %s from %s: %s",
+				req.GetVolumeId(),
+				req.GetTargetPath(),
+				err.Error())
+		}
+	}
+	if err = os.Remove(req.GetTargetPath()); err != nil && !os.IsNotExist(err) {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Unable to remove target %s: %s",
+			req.GetTargetPath(),
+			err.Error())
+	}
+
+	if (len(devicePath) == 0 || s.mounter.HasMounts(devicePath) == 0) &&
+		v.GetState() != api.VolumeState_VOLUME_STATE_DETACHED {
+		if err = s.driver.Detach(req.GetVolumeId(), nil); err != nil {
+			return nil, status.Errorf(
+				codes.Internal,
+				"Unable to detach volume: %s",
+				err.Error())
+		}
+	}
+
+	logrus.Infof("Volume %s unpublished from %s",
+		req.GetVolumeId(),
+		req.GetTargetPath())
+
+	return &csi.NodeUnpublishVolumeResponse{}, nil
+}
+
+// NodeExpandVolume is a CSI API call which grows the filesystem of a
+// volume, mounted on the volume path, after ControllerExpandVolume has
+// expanded the volume.
+func (s *OsdCsiServer) NodeExpandVolume(
+	ctx context.Context,
+	req *csi.NodeExpandVolumeRequest,
+) (*csi.NodeExpandVolumeResponse, error) {
+
+	logrus.Debugf("NodeExpandVolume req[%#v]", req)
+
+	// Check arguments
+	if len(req.GetVolumeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Volume id must be provided")
+	}
+	if len(req.GetVolumePath()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Volume path must be provided")
+	}
+
+	// Get volume information
+	v, err := util.VolumeFromName(s.driver, req.GetVolumeId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Volume id %s not found: %s",
+			req.GetVolumeId(),
+			err.Error())
+	}
+
+	resp := &csi.NodeExpandVolumeResponse{
+		CapacityBytes: int64(v.GetSpec().GetSize()),
+	}
+
+	// Raw block volumes have no filesystem to grow
+	if s.isBlockTarget(req.GetVolumePath()) {
+		return resp, nil
+	}
+	if err := verifyTargetLocation(req.GetVolumePath()); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	// The filesystems of the volumes of other drivers are not on a device
+	if s.driver.Type() != api.DriverType_DRIVER_TYPE_BLOCK {
+		return resp, nil
+	}
+	if len(v.GetDevicePath()) == 0 {
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			"Volume %s is not attached",
+			req.GetVolumeId())
+	}
+
+	format := v.GetFormat()
+	if format == api.FSType_FS_TYPE_NONE {
+		format = v.GetSpec().GetFormat()
+	}
+	if err := resizeFilesystem(format, v.GetDevicePath(), req.GetVolumePath()); err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Unable to resize the filesystem of volume %s: %s",
+			req.GetVolumeId(),
+			err.Error())
+	}
+
+	logrus.Infof("Filesystem of volume %s resized on %s",
+		req.GetVolumeId(),
+		req.GetVolumePath())
+
+	return resp, nil
+}
+
+// NodeGetVolumeStats is a CSI API call which returns the usage of the
+// filesystem of a volume mounted on the volume path, in bytes and inodes.
+// The used bytes are reported by the driver when it supports it.
+func (s *OsdCsiServer) NodeGetVolumeStats(
+	ctx context.Context,
+	req *csi.NodeGetVolumeStatsRequest,
+) (*csi.NodeGetVolumeStatsResponse, error) {
+
+	logrus.Debugf("NodeGetVolumeStats req[%#v]", req)
+
+	// Check arguments
+	if len(req.GetVolumeId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Volume id must be provided")
+	}
+	if len(req.GetVolumePath()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Volume path must be provided")
+	}
+
+	// Get volume information
+	v, err := util.VolumeFromName(s.driver, req.GetVolumeId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Volume id %s not found: %s",
+			req.GetVolumeId(),
+			err.Error())
+	}
+
+	// Only the size of raw block volumes is known
+	if s.isBlockTarget(req.GetVolumePath()) {
+		return &csi.NodeGetVolumeStatsResponse{
+			Usage: []*csi.VolumeUsage{
+				&csi.VolumeUsage{
+					Unit:  csi.VolumeUsage_BYTES,
+					Total: int64(v.GetSpec().GetSize()),
+				},
+			},
+		}, nil
+	}
+	if err := verifyTargetLocation(req.GetVolumePath()); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	var fs syscall.Statfs_t
+	if err := syscall.Statfs(req.GetVolumePath(), &fs); err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Unable to get the filesystem statistics of %s: %s",
+			req.GetVolumePath(),
+			err.Error())
+	}
+
+	bytes := &csi.VolumeUsage{
+		Unit:      csi.VolumeUsage_BYTES,
+		Total:     int64(fs.Blocks) * int64(fs.Bsize),
+		Available: int64(fs.Bavail) * int64(fs.Bsize),
+		Used:      int64(fs.Blocks-fs.Bfree) * int64(fs.Bsize),
+	}
+	used, err := s.driver.UsedSize(v.GetId())
+	if err == nil {
+		bytes.Used = int64(used)
+	} else if err != volume.ErrNotSupported {
+		logrus.Warnf("Unable to get the used size of volume %s: %s",
+			v.GetId(),
+			err.Error())
+	}
+
+	inodes := &csi.VolumeUsage{
+		Unit:      csi.VolumeUsage_INODES,
+		Total:     int64(fs.Files),
+		Available: int64(fs.Ffree),
+		Used:      int64(fs.Files - fs.Ffree),
+	}
+
+	return &csi.NodeGetVolumeStatsResponse{
+		Usage: []*csi.VolumeUsage{bytes, inodes},
+	}, nil
+}
+
+// NodeGetCapabilities is a CSI API function which returns the capabilities
+// of the node service
 func (s *OsdCsiServer) NodeGetCapabilities(
 	ctx context.Context,
 	req *csi.NodeGetCapabilitiesRequest,
@@ -207,7 +858,21 @@ func (s *OsdCsiServer) NodeGetCapabilities(
 			{
 				Type: &csi.NodeServiceCapability_Rpc{
 					Rpc: &csi.NodeServiceCapability_RPC{
-						Type: csi.NodeServiceCapability_RPC_UNKNOWN,
+						Type: csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME,
+					},
+				},
+			},
+			{
+				Type: &csi.NodeServiceCapability_Rpc{
+					Rpc: &csi.NodeServiceCapability_RPC{
+						Type: csi.NodeServiceCapability_RPC_EXPAND_VOLUME,
+					},
+				},
+			},
+			{
+				Type: &csi.NodeServiceCapability_Rpc{
+					Rpc: &csi.NodeServiceCapability_RPC{
+						Type: csi.NodeServiceCapability_RPC_GET_VOLUME_STATS,
 					},
 				},
 			},
@@ -231,3 +896,91 @@ func verifyTargetLocation(targetPath string) error {
 
 	return nil
 }
+
+// setControllerPublished records in the staging path that the volume
+// was attached by ControllerPublishVolume
+func setControllerPublished(stagingPath string) error {
+	f, err := os.OpenFile(
+		filepath.Join(stagingPath, controllerPublishedMarker),
+		os.O_CREATE|os.O_WRONLY,
+		0640)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// clearControllerPublished returns true if the staging path records that
+// the volume was attached by ControllerPublishVolume, and removes the record
+func clearControllerPublished(stagingPath string) bool {
+	marker := filepath.Join(stagingPath, controllerPublishedMarker)
+	if _, err := os.Stat(marker); err != nil {
+		return false
+	}
+	if err := os.Remove(marker); err != nil {
+		logrus.Warnf("Unable to remove %s: %v", marker, err)
+	}
+	return true
+}
+
+// createTargetLocation creates the target directory of a volume. Its
+// parent directory must exist.
+func createTargetLocation(targetPath string) error {
+	if err := os.Mkdir(targetPath, 0750); err != nil && !os.IsExist(err) {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("Parent of target location %s does not exist", targetPath)
+		}
+		return err
+	}
+	return verifyTargetLocation(targetPath)
+}
+
+// removeTargetLocation removes the target directory of a volume once the
+// volume has been unmounted from it. The directory is kept if it is not
+// empty.
+func removeTargetLocation(targetPath string) {
+	if err := os.Remove(targetPath); err != nil && !os.IsNotExist(err) {
+		logrus.Warnf("Unable to remove target location %s: %v", targetPath, err)
+	}
+}
+
+// createBlockTarget creates the file onto which the device of a raw
+// block volume is bind mounted. The parent directory must exist.
+func createBlockTarget(targetPath string) error {
+	fileInfo, err := os.Stat(targetPath)
+	if err == nil {
+		if fileInfo.IsDir() {
+			return fmt.Errorf("Target location %s is a directory", targetPath)
+		}
+		return nil
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	f, err := os.OpenFile(targetPath, os.O_CREATE|os.O_RDWR, 0640)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// isBlockTarget returns true if the path is the file onto which the
+// device of a raw block volume is published. Only block drivers
+// publish raw block volumes.
+func (s *OsdCsiServer) isBlockTarget(path string) bool {
+	fileInfo, err := os.Stat(path)
+	return err == nil && !fileInfo.IsDir() && s.supportsBlock()
+}
+
+// isMountedAt returns true if the volume is mounted by the driver on the path
+func isMountedAt(v *api.Volume, path string) bool {
+	for _, attachPath := range v.GetAttachPath() {
+		if samePath(attachPath, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func samePath(a, b string) bool {
+	return filepath.Clean(a) == filepath.Clean(b)
+}
@@ -18,18 +18,24 @@ package csi
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
 	"testing"
 
-	csi "github.com/container-storage-interface/spec/lib/go/csi/v0"
+	csi "github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/golang/mock/gomock"
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/osdconfig"
+	"github.com/libopenstorage/openstorage/volume"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-func TestNewCSIServerGetNodeId(t *testing.T) {
+func TestNewCSIServerGetNodeInfo(t *testing.T) {
 
 	// Create server and client connection
 	s := newTestServer(t)
@@ -47,20 +53,70 @@ func TestNewCSIServerGetNodeId(t *testing.T) {
 			NodeId: "pwx-testnodeid",
 		}, nil).
 		Times(1)
+	s.MockCluster().
+		EXPECT().
+		GetNodeConf("pwx-testnodeid").
+		Return(nil, fmt.Errorf("not found")).
+		Times(1)
 
-	// Setup request
-	req := &csi.NodeGetIdRequest{}
-
-	r, err := c.NodeGetId(context.Background(), req)
+	r, err := c.NodeGetInfo(context.Background(), &csi.NodeGetInfoRequest{})
 	assert.Nil(t, err)
 	assert.NotNil(t, r)
 
 	// Verify
-	nodeid := r.GetNodeId()
-	assert.Equal(t, nodeid, "pwx-testnodeid")
+	assert.Equal(t, "pwx-testnodeid", r.GetNodeId())
+	assert.Empty(t, r.GetAccessibleTopology().GetSegments())
+}
+
+func TestNewCSIServerGetNodeInfoTopology(t *testing.T) {
+
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Make a call
+	c := csi.NewNodeClient(s.Conn())
+
+	gomock.InOrder(
+		s.MockCluster().
+			EXPECT().
+			Enumerate().
+			Return(api.Cluster{
+				NodeId: "node1",
+				Nodes: []api.Node{
+					{
+						Id:         "node1",
+						NodeLabels: map[string]string{"region": "west", "rack": "r1"},
+					},
+				},
+			}, nil).
+			Times(1),
+		s.MockCluster().
+			EXPECT().
+			GetNodeConf("node1").
+			Return(&osdconfig.NodeConfig{
+				NodeId: "node1",
+				Geo: &osdconfig.GeoConfig{
+					Zone: "z1",
+					Rack: "r2",
+				},
+			}, nil).
+			Times(1),
+	)
+
+	r, err := c.NodeGetInfo(context.Background(), &csi.NodeGetInfoRequest{})
+	assert.Nil(t, err)
+
+	// The geo configuration has precedence over the labels
+	assert.Equal(t, "node1", r.GetNodeId())
+	assert.Equal(t, map[string]string{
+		TopologyKeyRegion: "west",
+		TopologyKeyZone:   "z1",
+		TopologyKeyRack:   "r2",
+	}, r.GetAccessibleTopology().GetSegments())
 }
 
-func TestNewCSIServerGetNodeIdEnumerateError(t *testing.T) {
+func TestNewCSIServerGetNodeInfoEnumerateError(t *testing.T) {
 
 	// Create server and client connection
 	s := newTestServer(t)
@@ -76,10 +132,10 @@ func TestNewCSIServerGetNodeIdEnumerateError(t *testing.T) {
 		Times(1)
 
 	// Setup request
-	req := &csi.NodeGetIdRequest{}
+	req := &csi.NodeGetInfoRequest{}
 
 	// Expect error without version
-	_, err := c.NodeGetId(context.Background(), req)
+	_, err := c.NodeGetInfo(context.Background(), req)
 
 	assert.NotNil(t, err)
 	serverError, ok := status.FromError(err)
@@ -211,7 +267,7 @@ func TestNodePublishVolumeBadAttribute(t *testing.T) {
 		},
 
 		// This will cause an error
-		VolumeAttributes: map[string]string{
+		VolumeContext: map[string]string{
 			api.SpecFilesystem: "whatkindoffsisthis?",
 		},
 	}
@@ -233,10 +289,6 @@ func TestNodePublishVolumeInvalidTargetLocation(t *testing.T) {
 		expectedErrorContains string
 		targetPath            string
 	}{
-		{
-			expectedErrorContains: "does not exist",
-			targetPath:            "////a/sdf//fd/asdf/as/f/asdfasf/fds",
-		},
 		{
 			expectedErrorContains: "not a directory",
 			targetPath:            "/etc/hosts",
@@ -253,7 +305,14 @@ func TestNodePublishVolumeInvalidTargetLocation(t *testing.T) {
 				Id: name,
 			},
 		}, nil).
-		Times(len(testargs))
+		Times(len(testargs) + 1)
+
+	// A target which does not exist is not published
+	_, err := c.NodeUnpublishVolume(context.Background(), &csi.NodeUnpublishVolumeRequest{
+		VolumeId:   name,
+		TargetPath: "////a/sdf//fd/asdf/as/f/asdfasf/fds",
+	})
+	assert.Nil(t, err)
 
 	req := &csi.NodePublishVolumeRequest{
 		VolumeId: name,
@@ -404,7 +463,10 @@ func TestNodePublishVolumeMount(t *testing.T) {
 
 	name := "myvol"
 	size := uint64(10)
-	targetPath := "/mnt"
+	dir, err := ioutil.TempDir("", "csitarget")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	targetPath := filepath.Join(dir, "target")
 	gomock.InOrder(
 		s.MockDriver().
 			EXPECT().
@@ -446,6 +508,79 @@ func TestNodePublishVolumeMount(t *testing.T) {
 		},
 	}
 
+	// The target path is created
+	r, err := c.NodePublishVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.NotNil(t, r)
+	assert.NoError(t, verifyTargetLocation(targetPath))
+}
+
+func TestNodePublishVolumeStaged(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Make a call
+	c := csi.NewNodeClient(s.Conn())
+
+	name := "myvol"
+	stagingPath := "/tmp"
+	targetPath := "/mnt"
+	req := &csi.NodePublishVolumeRequest{
+		VolumeId:          name,
+		StagingTargetPath: stagingPath,
+		TargetPath:        targetPath,
+		VolumeCapability: &csi.VolumeCapability{
+			AccessMode: &csi.VolumeCapability_AccessMode{},
+		},
+	}
+
+	// Volume not staged
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{name}).
+		Return([]*api.Volume{
+			&api.Volume{
+				Id: name,
+			},
+		}, nil).
+		Times(1)
+
+	_, err := c.NodePublishVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, serverError.Code())
+
+	// Bind mount the staging path
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:         name,
+					AttachPath: []string{stagingPath},
+				},
+			}, nil).
+			Times(1),
+		s.MockMounter().
+			EXPECT().
+			HasMounts(stagingPath).
+			Return(0).
+			Times(1),
+		s.MockMounter().
+			EXPECT().
+			Reload(stagingPath).
+			Return(nil).
+			Times(1),
+		s.MockMounter().
+			EXPECT().
+			Mount(0, stagingPath, targetPath, "", uintptr(syscall.MS_BIND), "", 0, nil).
+			Return(nil).
+			Times(1),
+	)
+
 	r, err := c.NodePublishVolume(context.Background(), req)
 	assert.Nil(t, err)
 	assert.NotNil(t, r)
@@ -497,10 +632,6 @@ func TestNodeUnpublishVolumeInvalidTargetLocation(t *testing.T) {
 		expectedErrorContains string
 		targetPath            string
 	}{
-		{
-			expectedErrorContains: "does not exist",
-			targetPath:            "////a/sdf//fd/asdf/as/f/asdfasf/fds",
-		},
 		{
 			expectedErrorContains: "not a directory",
 			targetPath:            "/etc/hosts",
@@ -517,7 +648,21 @@ func TestNodeUnpublishVolumeInvalidTargetLocation(t *testing.T) {
 				Id: name,
 			},
 		}, nil).
-		Times(len(testargs))
+		Times(len(testargs) + 1)
+
+	// A target which does not exist is not published
+	_, err := c.NodeUnpublishVolume(context.Background(), &csi.NodeUnpublishVolumeRequest{
+		VolumeId:   name,
+		TargetPath: "////a/sdf//fd/asdf/as/f/asdfasf/fds",
+	})
+	assert.Nil(t, err)
+
+	// Files are only raw block targets for block drivers
+	s.MockDriver().
+		EXPECT().
+		Type().
+		Return(api.DriverType_DRIVER_TYPE_FILE).
+		AnyTimes()
 
 	req := &csi.NodeUnpublishVolumeRequest{
 		VolumeId: name,
@@ -592,7 +737,9 @@ func TestNodeUnpublishVolumeFailedDetach(t *testing.T) {
 
 	name := "myvol"
 	size := uint64(10)
-	targetPath := "/mnt"
+	targetPath, err := ioutil.TempDir("", "csitarget")
+	assert.NoError(t, err)
+	defer os.RemoveAll(targetPath)
 	gomock.InOrder(
 		s.MockDriver().
 			EXPECT().
@@ -631,7 +778,7 @@ func TestNodeUnpublishVolumeFailedDetach(t *testing.T) {
 		TargetPath: targetPath,
 	}
 
-	_, err := c.NodeUnpublishVolume(context.Background(), req)
+	_, err = c.NodeUnpublishVolume(context.Background(), req)
 	assert.NotNil(t, err)
 	serverError, ok := status.FromError(err)
 	assert.True(t, ok)
@@ -650,7 +797,9 @@ func TestNodeUnpublishVolumeUnmount(t *testing.T) {
 
 	name := "myvol"
 	size := uint64(10)
-	targetPath := "/mnt"
+	targetPath, err := ioutil.TempDir("", "csitarget")
+	assert.NoError(t, err)
+	defer os.RemoveAll(targetPath)
 	gomock.InOrder(
 		s.MockDriver().
 			EXPECT().
@@ -689,12 +838,15 @@ func TestNodeUnpublishVolumeUnmount(t *testing.T) {
 		TargetPath: targetPath,
 	}
 
+	// The target path is removed
 	r, err := c.NodeUnpublishVolume(context.Background(), req)
 	assert.Nil(t, err)
 	assert.NotNil(t, r)
+	_, err = os.Stat(targetPath)
+	assert.True(t, os.IsNotExist(err))
 }
 
-func TestNodeGetCapabilities(t *testing.T) {
+func TestNodeUnpublishVolumeStaged(t *testing.T) {
 	// Create server and client connection
 	s := newTestServer(t)
 	defer s.Stop()
@@ -702,14 +854,850 @@ func TestNodeGetCapabilities(t *testing.T) {
 	// Make a call
 	c := csi.NewNodeClient(s.Conn())
 
-	// Get Capabilities
-	r, err := c.NodeGetCapabilities(
-		context.Background(),
-		&csi.NodeGetCapabilitiesRequest{})
+	name := "myvol"
+	stagingPath := "/tmp"
+	targetPath, err := ioutil.TempDir("", "csitarget")
 	assert.NoError(t, err)
-	assert.Len(t, r.GetCapabilities(), 1)
-	assert.Equal(
-		t,
-		csi.NodeServiceCapability_RPC_UNKNOWN,
-		r.GetCapabilities()[0].GetRpc().GetType())
+	defer os.RemoveAll(targetPath)
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:         name,
+					AttachPath: []string{stagingPath},
+				},
+			}, nil).
+			Times(1),
+		s.MockMounter().
+			EXPECT().
+			Exists(stagingPath, targetPath).
+			Return(false, nil).
+			Times(1),
+		s.MockMounter().
+			EXPECT().
+			Reload(stagingPath).
+			Return(nil).
+			Times(1),
+		s.MockMounter().
+			EXPECT().
+			Unmount(stagingPath, targetPath, 0, 0, nil).
+			Return(nil).
+			Times(1),
+		s.MockMounter().
+			EXPECT().
+			RemoveMountPath(targetPath, nil).
+			Return(nil).
+			Times(1),
+	)
+
+	req := &csi.NodeUnpublishVolumeRequest{
+		VolumeId:   name,
+		TargetPath: targetPath,
+	}
+
+	r, err := c.NodeUnpublishVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.NotNil(t, r)
+}
+
+func TestNodePublishVolumeBlockNotSupported(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Make a call
+	c := csi.NewNodeClient(s.Conn())
+
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_FILE).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Name().
+			Return("nfs").
+			Times(1),
+	)
+
+	req := &csi.NodePublishVolumeRequest{
+		VolumeId:   "myvol",
+		TargetPath: "mypath",
+		VolumeCapability: &csi.VolumeCapability{
+			AccessMode: &csi.VolumeCapability_AccessMode{},
+			AccessType: &csi.VolumeCapability_Block{
+				Block: &csi.VolumeCapability_BlockVolume{},
+			},
+		},
+	}
+
+	_, err := c.NodePublishVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, serverError.Code())
+	assert.Contains(t, serverError.Message(), "raw block")
+}
+
+func TestNodePublishUnpublishVolumeBlock(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Make a call
+	c := csi.NewNodeClient(s.Conn())
+
+	dir, err := ioutil.TempDir("", "csiblock")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	name := "myvol"
+	devicePath := "/dev/myvol"
+	targetPath := filepath.Join(dir, "target")
+
+	// The device from Attach is bind mounted onto the target file
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: name,
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Attach(name, gomock.Any()).
+			Return(devicePath, nil).
+			Times(1),
+		s.MockMounter().
+			EXPECT().
+			Mount(0, devicePath, targetPath, "", uintptr(syscall.MS_BIND), "", 0, nil).
+			Return(nil).
+			Times(1),
+	)
+
+	r, err := c.NodePublishVolume(context.Background(), &csi.NodePublishVolumeRequest{
+		VolumeId:   name,
+		TargetPath: targetPath,
+		VolumeCapability: &csi.VolumeCapability{
+			AccessMode: &csi.VolumeCapability_AccessMode{},
+			AccessType: &csi.VolumeCapability_Block{
+				Block: &csi.VolumeCapability_BlockVolume{},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, r)
+	fi, err := os.Stat(targetPath)
+	assert.NoError(t, err)
+	assert.False(t, fi.IsDir())
+
+	// The bind mount and the target file are removed and
+	// the volume detached
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:    name,
+					State: api.VolumeState_VOLUME_STATE_ATTACHED,
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockMounter().
+			EXPECT().
+			GetSourcePath(targetPath).
+			Return(devicePath, nil).
+			Times(1),
+		s.MockMounter().
+			EXPECT().
+			Unmount(devicePath, targetPath, 0, 0, nil).
+			Return(nil).
+			Times(1),
+		s.MockMounter().
+			EXPECT().
+			HasMounts(devicePath).
+			Return(0).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Detach(name, nil).
+			Return(nil).
+			Times(1),
+	)
+
+	_, err = c.NodeUnpublishVolume(context.Background(), &csi.NodeUnpublishVolumeRequest{
+		VolumeId:   name,
+		TargetPath: targetPath,
+	})
+	assert.Nil(t, err)
+	_, err = os.Stat(targetPath)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestNodeStageVolumeBlock(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Make a call
+	c := csi.NewNodeClient(s.Conn())
+
+	// Raw block volumes are attached but not mounted
+	name := "myvol"
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: name,
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Attach(name, gomock.Any()).
+			Return("/dev/myvol", nil).
+			Times(1),
+	)
+
+	r, err := c.NodeStageVolume(context.Background(), &csi.NodeStageVolumeRequest{
+		VolumeId:          name,
+		StagingTargetPath: "/tmp",
+		VolumeCapability: &csi.VolumeCapability{
+			AccessMode: &csi.VolumeCapability_AccessMode{},
+			AccessType: &csi.VolumeCapability_Block{
+				Block: &csi.VolumeCapability_BlockVolume{},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, r)
+}
+
+func TestNodeStageVolumeBadArguments(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	c := csi.NewNodeClient(s.Conn())
+
+	testargs := []struct {
+		expectedErrorContains string
+		req                   *csi.NodeStageVolumeRequest
+	}{
+		{
+			expectedErrorContains: "Volume id",
+			req:                   &csi.NodeStageVolumeRequest{},
+		},
+		{
+			expectedErrorContains: "Staging target path",
+			req: &csi.NodeStageVolumeRequest{
+				VolumeId: "abc",
+			},
+		},
+		{
+			expectedErrorContains: "Volume access mode",
+			req: &csi.NodeStageVolumeRequest{
+				VolumeId:          "abc",
+				StagingTargetPath: "mypath",
+			},
+		},
+	}
+
+	for _, testarg := range testargs {
+		_, err := c.NodeStageVolume(context.Background(), testarg.req)
+		assert.NotNil(t, err)
+
+		serverError, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, serverError.Code(), codes.InvalidArgument)
+		assert.Contains(t, serverError.Message(), testarg.expectedErrorContains)
+	}
+}
+
+func TestNodeStageVolumeFailedMount(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Make a call
+	c := csi.NewNodeClient(s.Conn())
+
+	name := "myvol"
+	stagingPath := "/mnt"
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: name,
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Attach(name, gomock.Any()).
+			Return("", nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Mount(name, stagingPath, nil).
+			Return(fmt.Errorf("MOUNT ERROR")).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Detach(name, gomock.Any()).
+			Return(nil).
+			Times(1),
+	)
+
+	req := &csi.NodeStageVolumeRequest{
+		VolumeId:          name,
+		StagingTargetPath: stagingPath,
+		VolumeCapability: &csi.VolumeCapability{
+			AccessMode: &csi.VolumeCapability_AccessMode{},
+		},
+	}
+
+	_, err := c.NodeStageVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Internal)
+	assert.Contains(t, serverError.Message(), "Unable to mount volume")
+	assert.Contains(t, serverError.Message(), "MOUNT ERROR")
+}
+
+func TestNodeStageVolume(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Make a call
+	c := csi.NewNodeClient(s.Conn())
+
+	name := "myvol"
+	stagingPath := "/mnt"
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: name,
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Attach(name, gomock.Any()).
+			Return("", nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Mount(name, stagingPath, nil).
+			Return(nil).
+			Times(1),
+	)
+
+	req := &csi.NodeStageVolumeRequest{
+		VolumeId:          name,
+		StagingTargetPath: stagingPath,
+		VolumeCapability: &csi.VolumeCapability{
+			AccessMode: &csi.VolumeCapability_AccessMode{},
+		},
+	}
+
+	r, err := c.NodeStageVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.NotNil(t, r)
+
+	// Staging again does nothing
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{name}).
+		Return([]*api.Volume{
+			&api.Volume{
+				Id:         name,
+				AttachPath: []string{stagingPath + "/"},
+			},
+		}, nil).
+		Times(1)
+
+	r, err = c.NodeStageVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.NotNil(t, r)
+
+	// Volumes attached by the controller are not attached again,
+	// which is recorded in the staging path
+	publishedPath, err := ioutil.TempDir("", "csistaging")
+	assert.NoError(t, err)
+	defer os.RemoveAll(publishedPath)
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: name,
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Mount(name, publishedPath, nil).
+			Return(nil).
+			Times(1),
+	)
+
+	req.StagingTargetPath = publishedPath
+	req.PublishContext = map[string]string{
+		publishContextDevicePath: "/dev/mydev",
+	}
+	r, err = c.NodeStageVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.NotNil(t, r)
+	_, err = os.Stat(filepath.Join(publishedPath, controllerPublishedMarker))
+	assert.NoError(t, err)
+}
+
+func TestNodeUnstageVolume(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Make a call
+	c := csi.NewNodeClient(s.Conn())
+
+	name := "myvol"
+	stagingPath := "/mnt"
+	req := &csi.NodeUnstageVolumeRequest{
+		VolumeId:          name,
+		StagingTargetPath: stagingPath,
+	}
+
+	// The volume is still mounted elsewhere and is not detached
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:         name,
+					State:      api.VolumeState_VOLUME_STATE_ATTACHED,
+					AttachPath: []string{stagingPath, "/other"},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Unmount(name, stagingPath, nil).
+			Return(nil).
+			Times(1),
+	)
+
+	r, err := c.NodeUnstageVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.NotNil(t, r)
+
+	// Last unstage detaches the volume
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:         name,
+					State:      api.VolumeState_VOLUME_STATE_ATTACHED,
+					AttachPath: []string{stagingPath},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Unmount(name, stagingPath, nil).
+			Return(nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Detach(name, gomock.Any()).
+			Return(nil).
+			Times(1),
+	)
+
+	r, err = c.NodeUnstageVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.NotNil(t, r)
+
+	// Detach failure
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:    name,
+					State: api.VolumeState_VOLUME_STATE_ATTACHED,
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Detach(name, gomock.Any()).
+			Return(fmt.Errorf("DETACH ERROR")).
+			Times(1),
+	)
+
+	_, err = c.NodeUnstageVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, serverError.Code(), codes.Internal)
+	assert.Contains(t, serverError.Message(), "DETACH ERROR")
+
+	// Volumes attached by the controller are left attached
+	publishedPath, err := ioutil.TempDir("", "csistaging")
+	assert.NoError(t, err)
+	defer os.RemoveAll(publishedPath)
+	assert.NoError(t, setControllerPublished(publishedPath))
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id:         name,
+					State:      api.VolumeState_VOLUME_STATE_ATTACHED,
+					AttachPath: []string{publishedPath},
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Unmount(name, publishedPath, nil).
+			Return(nil).
+			Times(1),
+	)
+
+	r, err = c.NodeUnstageVolume(context.Background(), &csi.NodeUnstageVolumeRequest{
+		VolumeId:          name,
+		StagingTargetPath: publishedPath,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, r)
+	_, err = os.Stat(filepath.Join(publishedPath, controllerPublishedMarker))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestNodeGetCapabilities(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+
+	// Make a call
+	c := csi.NewNodeClient(s.Conn())
+
+	// Get Capabilities
+	r, err := c.NodeGetCapabilities(
+		context.Background(),
+		&csi.NodeGetCapabilitiesRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, r.GetCapabilities(), 3)
+	assert.Equal(
+		t,
+		csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME,
+		r.GetCapabilities()[0].GetRpc().GetType())
+	assert.Equal(
+		t,
+		csi.NodeServiceCapability_RPC_EXPAND_VOLUME,
+		r.GetCapabilities()[1].GetRpc().GetType())
+	assert.Equal(
+		t,
+		csi.NodeServiceCapability_RPC_GET_VOLUME_STATS,
+		r.GetCapabilities()[2].GetRpc().GetType())
+}
+
+func TestNodeExpandVolumeBadArguments(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewNodeClient(s.Conn())
+
+	_, err := c.NodeExpandVolume(context.Background(), &csi.NodeExpandVolumeRequest{})
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, serverError.Code())
+	assert.Contains(t, serverError.Message(), "Volume id")
+
+	_, err = c.NodeExpandVolume(context.Background(), &csi.NodeExpandVolumeRequest{
+		VolumeId: "myvol",
+	})
+	assert.NotNil(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, serverError.Code())
+	assert.Contains(t, serverError.Message(), "Volume path")
+}
+
+func TestNodeExpandVolume(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewNodeClient(s.Conn())
+
+	// Record the filesystems which are resized
+	var (
+		resized   []string
+		resizeErr error
+	)
+	defer func(resize func(api.FSType, string, string) error) {
+		resizeFilesystem = resize
+	}(resizeFilesystem)
+	resizeFilesystem = func(format api.FSType, devicePath, mountPath string) error {
+		resized = append(resized, format.SimpleString()+":"+devicePath+":"+mountPath)
+		return resizeErr
+	}
+
+	name := "myvol"
+	volumePath := "/mnt"
+	size := uint64(2048)
+	req := &csi.NodeExpandVolumeRequest{
+		VolumeId:   name,
+		VolumePath: volumePath,
+	}
+
+	// Not attached
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: name,
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+	)
+	_, err := c.NodeExpandVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, serverError.Code())
+
+	// Resize the filesystem of the device
+	attached := &api.Volume{
+		Id:         name,
+		DevicePath: "/dev/mydev",
+		Format:     api.FSType_FS_TYPE_EXT4,
+		Spec: &api.VolumeSpec{
+			Size: size,
+		},
+	}
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{attached}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+	)
+	r, err := c.NodeExpandVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(size), r.GetCapacityBytes())
+	assert.Equal(t, []string{"ext4:/dev/mydev:/mnt"}, resized)
+
+	// Resize failure
+	resizeErr = fmt.Errorf("RESIZE ERROR")
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{attached}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_BLOCK).
+			Times(1),
+	)
+	_, err = c.NodeExpandVolume(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Internal, serverError.Code())
+	assert.Contains(t, serverError.Message(), "RESIZE ERROR")
+
+	// The filesystems of file drivers are not resized
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{attached}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			Type().
+			Return(api.DriverType_DRIVER_TYPE_FILE).
+			Times(1),
+	)
+	r, err = c.NodeExpandVolume(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(size), r.GetCapacityBytes())
+	assert.Len(t, resized, 2)
+}
+
+func TestNodeGetVolumeStats(t *testing.T) {
+	// Create server and client connection
+	s := newTestServer(t)
+	defer s.Stop()
+	c := csi.NewNodeClient(s.Conn())
+
+	name := "myvol"
+	volumePath := "/tmp"
+
+	// Bad arguments
+	_, err := c.NodeGetVolumeStats(context.Background(), &csi.NodeGetVolumeStatsRequest{
+		VolumeId: name,
+	})
+	assert.NotNil(t, err)
+	serverError, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, serverError.Code())
+	assert.Contains(t, serverError.Message(), "Volume path")
+
+	req := &csi.NodeGetVolumeStatsRequest{
+		VolumeId:   name,
+		VolumePath: volumePath,
+	}
+
+	// Used size reported by the driver
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: name,
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			UsedSize(name).
+			Return(uint64(1234), nil).
+			Times(1),
+	)
+	r, err := c.NodeGetVolumeStats(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Len(t, r.GetUsage(), 2)
+	bytes := r.GetUsage()[0]
+	assert.Equal(t, csi.VolumeUsage_BYTES, bytes.GetUnit())
+	assert.Equal(t, int64(1234), bytes.GetUsed())
+	assert.True(t, bytes.GetTotal() > 0)
+	inodes := r.GetUsage()[1]
+	assert.Equal(t, csi.VolumeUsage_INODES, inodes.GetUnit())
+	assert.Equal(t, inodes.GetTotal(), inodes.GetUsed()+inodes.GetAvailable())
+
+	// Used size of the filesystem when the driver does not support it
+	gomock.InOrder(
+		s.MockDriver().
+			EXPECT().
+			Inspect([]string{name}).
+			Return([]*api.Volume{
+				&api.Volume{
+					Id: name,
+				},
+			}, nil).
+			Times(1),
+		s.MockDriver().
+			EXPECT().
+			UsedSize(name).
+			Return(uint64(0), volume.ErrNotSupported).
+			Times(1),
+	)
+	r, err = c.NodeGetVolumeStats(context.Background(), req)
+	assert.Nil(t, err)
+	bytes = r.GetUsage()[0]
+	assert.True(t, bytes.GetUsed() <= bytes.GetTotal())
+
+	// Missing volume path
+	s.MockDriver().
+		EXPECT().
+		Inspect([]string{name}).
+		Return([]*api.Volume{
+			&api.Volume{
+				Id: name,
+			},
+		}, nil).
+		Times(1)
+	req.VolumePath = "/nonexistent/path"
+	_, err = c.NodeGetVolumeStats(context.Background(), req)
+	assert.NotNil(t, err)
+	serverError, ok = status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, serverError.Code())
 }
@@ -0,0 +1,117 @@
+/*
+Package csi is CSI driver interface for OSD
+Copyright 2019 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package csi
+
+import (
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/options"
+	"github.com/libopenstorage/openstorage/secrets"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// SecretPassphrase is the key of the passphrase of an encrypted
+	// volume in the secrets of a CSI request
+	SecretPassphrase = "passphrase"
+	// SecretKey is the key of the name of the secret holding the
+	// passphrase of an encrypted volume in the secrets of a CSI request.
+	// The secret is read from the secret store of the cluster.
+	SecretKey = api.SpecPassphrase
+)
+
+// volumeSecret is the passphrase of an encrypted volume given in the
+// secrets of a CSI request
+type volumeSecret struct {
+	// key is the name of the secret in the secret store, if any
+	key string
+	// passphrase must never be saved in the volume
+	passphrase string
+}
+
+// secretFromRequest returns the passphrase given in the secrets of a
+// request, or nil if none is given. A passphrase given as a secret store
+// key is read from the secret store of the cluster. The errors returned
+// are gRPC status errors.
+func (s *OsdCsiServer) secretFromRequest(reqSecrets map[string]string) (*volumeSecret, error) {
+	if passphrase := reqSecrets[SecretPassphrase]; len(passphrase) != 0 {
+		return &volumeSecret{passphrase: passphrase}, nil
+	}
+	key := reqSecrets[SecretKey]
+	if len(key) == 0 {
+		return nil, nil
+	}
+
+	if s.cluster == nil {
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			"Unable to get secret %s: no secret store",
+			key)
+	}
+	value, err := s.cluster.SecretGet(key)
+	if err == secrets.ErrInvalidSecretId {
+		return nil, status.Errorf(codes.NotFound, "Secret %s not found", key)
+	} else if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Unable to get secret %s: %v",
+			key,
+			err)
+	}
+
+	var passphrase string
+	switch v := value.(type) {
+	case string:
+		passphrase = v
+	case []byte:
+		passphrase = string(v)
+	}
+	if len(passphrase) == 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Secret %s is not a passphrase",
+			key)
+	}
+	return &volumeSecret{key: key, passphrase: passphrase}, nil
+}
+
+// attachOptions returns the options to attach an encrypted volume. The
+// passphrase given in the secrets of the request is used first, then the
+// secret store key given in the volume context or saved in the volume.
+func (s *OsdCsiServer) attachOptions(
+	v *api.Volume,
+	spec *api.VolumeSpec,
+	reqSecrets map[string]string,
+) (map[string]string, error) {
+	opts := make(map[string]string)
+	secret, err := s.secretFromRequest(reqSecrets)
+	if err != nil {
+		return nil, err
+	}
+	if secret != nil {
+		opts[options.OptionsSecretKey] = secret.passphrase
+		if len(secret.key) != 0 {
+			opts[options.OptionsSecret] = secret.key
+		}
+	} else if len(spec.GetPassphrase()) != 0 {
+		opts[options.OptionsSecret] = spec.GetPassphrase()
+	} else if len(v.GetSpec().GetPassphrase()) != 0 {
+		opts[options.OptionsSecret] = v.GetSpec().GetPassphrase()
+	}
+	return opts, nil
+}
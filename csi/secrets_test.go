@@ -0,0 +1,202 @@
+/*
+Package csi is CSI driver interface for OSD
+Copyright 2019 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package csi
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	csi "github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/golang/mock/gomock"
+	"github.com/kubernetes-csi/csi-test/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/libopenstorage/openstorage/api"
+	mockcluster "github.com/libopenstorage/openstorage/cluster/mock"
+	"github.com/libopenstorage/openstorage/pkg/options"
+	"github.com/libopenstorage/openstorage/secrets"
+	volumedrivers "github.com/libopenstorage/openstorage/volume/drivers"
+	"github.com/libopenstorage/openstorage/volume/drivers/fake"
+)
+
+func TestAttachOptions(t *testing.T) {
+	mc := gomock.NewController(t)
+	defer mc.Finish()
+	cl := mockcluster.NewMockCluster(mc)
+	s := &OsdCsiServer{cluster: cl}
+
+	// No secret
+	opts, err := s.attachOptions(nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, opts)
+
+	// Secret store key of the volume context, then of the volume
+	v := &api.Volume{Spec: &api.VolumeSpec{Passphrase: "volumekey"}}
+	opts, err = s.attachOptions(v, &api.VolumeSpec{Passphrase: "contextkey"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{options.OptionsSecret: "contextkey"}, opts)
+	opts, err = s.attachOptions(v, &api.VolumeSpec{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{options.OptionsSecret: "volumekey"}, opts)
+
+	// Passphrase of the secrets
+	opts, err = s.attachOptions(v, &api.VolumeSpec{}, map[string]string{
+		SecretPassphrase: "mypassphrase",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{options.OptionsSecretKey: "mypassphrase"}, opts)
+
+	// Secret store key of the secrets
+	cl.EXPECT().SecretGet("mykey").Return([]byte("mypassphrase"), nil)
+	opts, err = s.attachOptions(v, &api.VolumeSpec{}, map[string]string{
+		SecretKey: "mykey",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		options.OptionsSecret:    "mykey",
+		options.OptionsSecretKey: "mypassphrase",
+	}, opts)
+
+	// Errors of the secret store
+	cl.EXPECT().SecretGet("nokey").Return(nil, secrets.ErrInvalidSecretId)
+	_, err = s.attachOptions(v, &api.VolumeSpec{}, map[string]string{SecretKey: "nokey"})
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
+	cl.EXPECT().SecretGet("mykey").Return(map[string]interface{}{"a": "b"}, nil)
+	_, err = s.attachOptions(v, &api.VolumeSpec{}, map[string]string{SecretKey: "mykey"})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	cl.EXPECT().SecretGet("mykey").Return(nil, secrets.ErrNotAuthenticated)
+	_, err = s.attachOptions(v, &api.VolumeSpec{}, map[string]string{SecretKey: "mykey"})
+	assert.Equal(t, codes.Internal, grpc.Code(err))
+
+	// No secret store
+	s = &OsdCsiServer{}
+	_, err = s.attachOptions(v, &api.VolumeSpec{}, map[string]string{SecretKey: "mykey"})
+	assert.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+}
+
+func TestEncryptedVolumeFakeDriver(t *testing.T) {
+	setupTestFakeDriver(t)
+	d, err := volumedrivers.Get(fake.Name)
+	require.NoError(t, err)
+
+	// Start a server with the fake driver and a secret store
+	mc := gomock.NewController(&utils.SafeGoroutineTester{})
+	defer mc.Finish()
+	cl := mockcluster.NewMockCluster(mc)
+	server, err := NewOsdCsiServer(&OsdCsiServerConfig{
+		DriverName: fake.Name,
+		Net:        "tcp",
+		Address:    "127.0.0.1:0",
+		Cluster:    cl,
+	})
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer server.Stop()
+	conn, err := grpc.Dial(server.Address(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	c := csi.NewControllerClient(conn)
+	n := csi.NewNodeClient(conn)
+
+	cl.EXPECT().SecretGet("mykey").Return("storedpassphrase", nil).AnyTimes()
+	caps := []*csi.VolumeCapability{
+		&csi.VolumeCapability{
+			AccessType: &csi.VolumeCapability_Mount{
+				Mount: &csi.VolumeCapability_MountVolume{},
+			},
+			AccessMode: &csi.VolumeCapability_AccessMode{
+				Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		secrets map[string]string
+		key     string
+	}{
+		{
+			name:    "encryptedwithpassphrase",
+			secrets: map[string]string{SecretPassphrase: "mypassphrase"},
+		},
+		{
+			name:    "encryptedwithkey",
+			secrets: map[string]string{SecretKey: "mykey"},
+			key:     "mykey",
+		},
+	}
+	for _, test := range tests {
+		// Create the volume with the controller secrets
+		created, err := c.CreateVolume(context.Background(), &csi.CreateVolumeRequest{
+			Name:               test.name,
+			VolumeCapabilities: caps,
+			Secrets:            test.secrets,
+		})
+		require.NoError(t, err, test.name)
+		id := created.GetVolume().GetVolumeId()
+		assert.Equal(t, "true", created.GetVolume().GetVolumeContext()[api.SpecSecure])
+
+		// The volume is encrypted and its record has no passphrase
+		vols, err := d.Inspect([]string{id})
+		require.NoError(t, err)
+		require.Len(t, vols, 1)
+		assert.True(t, vols[0].GetSpec().GetEncrypted())
+		assert.Equal(t, test.key, vols[0].GetSpec().GetPassphrase())
+		record, err := json.Marshal(vols[0])
+		require.NoError(t, err)
+		assert.NotContains(t, string(record), "mypassphrase")
+		assert.NotContains(t, string(record), "storedpassphrase")
+
+		// Stage the volume with the node secrets
+		dir, err := ioutil.TempDir("", "csi-secrets")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+		stage := &csi.NodeStageVolumeRequest{
+			VolumeId:          id,
+			StagingTargetPath: dir,
+			VolumeCapability:  caps[0],
+			VolumeContext:     created.GetVolume().GetVolumeContext(),
+		}
+		if len(test.key) == 0 {
+			_, err = n.NodeStageVolume(context.Background(), stage)
+			assert.Equal(t, codes.Internal, grpc.Code(err), test.name)
+		}
+		stage.Secrets = test.secrets
+		_, err = n.NodeStageVolume(context.Background(), stage)
+		assert.NoError(t, err, test.name)
+
+		_, err = c.DeleteVolume(context.Background(), &csi.DeleteVolumeRequest{
+			VolumeId: id,
+		})
+		assert.NoError(t, err)
+	}
+
+	// Unknown secret store key
+	cl.EXPECT().SecretGet("nokey").Return(nil, secrets.ErrInvalidSecretId)
+	_, err = c.CreateVolume(context.Background(), &csi.CreateVolumeRequest{
+		Name:               "encryptedwithnokey",
+		VolumeCapabilities: caps,
+		Secrets:            map[string]string{SecretKey: "nokey"},
+	})
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
+}
@@ -0,0 +1,250 @@
+/*
+Package csi is CSI driver interface for OSD
+Copyright 2019 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package csi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/osdconfig"
+
+	csi "github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// TopologyKeyRegion is the topology segment of the region of a node
+	TopologyKeyRegion = "topology.openstorage.org/region"
+	// TopologyKeyZone is the topology segment of the zone of a node
+	TopologyKeyZone = "topology.openstorage.org/zone"
+	// TopologyKeyRack is the topology segment of the rack of a node
+	TopologyKeyRack = "topology.openstorage.org/rack"
+)
+
+// nodeTopology returns the topology segments of a node. They are taken
+// from the geo configuration of the node and, when it is not set, from
+// the region, zone and rack labels of the node.
+func nodeTopology(node *api.Node, conf *osdconfig.NodeConfig) map[string]string {
+	var region, zone, rack string
+	if node != nil {
+		region = node.NodeLabels["region"]
+		zone = node.NodeLabels["zone"]
+		rack = node.NodeLabels["rack"]
+	}
+	if conf != nil && conf.Geo != nil {
+		if len(conf.Geo.Region) != 0 {
+			region = conf.Geo.Region
+		}
+		if len(conf.Geo.Zone) != 0 {
+			zone = conf.Geo.Zone
+		}
+		if len(conf.Geo.Rack) != 0 {
+			rack = conf.Geo.Rack
+		}
+	}
+
+	segments := make(map[string]string)
+	if len(region) != 0 {
+		segments[TopologyKeyRegion] = region
+	}
+	if len(zone) != 0 {
+		segments[TopologyKeyZone] = zone
+	}
+	if len(rack) != 0 {
+		segments[TopologyKeyRack] = rack
+	}
+	return segments
+}
+
+// topologyMatches returns true if the segments of a node satisfy the topology
+func topologyMatches(t *csi.Topology, segments map[string]string) bool {
+	for key, value := range t.GetSegments() {
+		if segments[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// clusterTopology returns the ids of the nodes of the cluster and
+// their topology segments
+func (s *OsdCsiServer) clusterTopology() ([]string, map[string]map[string]string, error) {
+	clus, err := s.cluster.Enumerate()
+	if err != nil {
+		return nil, nil, fmt.Errorf("Unable to enumerate cluster: %v", err)
+	}
+
+	// Nodes without a configuration only have their labels
+	confs := make(map[string]*osdconfig.NodeConfig)
+	nodesConf, err := s.cluster.EnumerateNodeConf()
+	if err != nil {
+		logrus.Warnf("Unable to get the configuration of the nodes: %v", err)
+	}
+	if nodesConf != nil {
+		for _, conf := range *nodesConf {
+			if conf != nil {
+				confs[conf.NodeId] = conf
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(clus.Nodes))
+	segments := make(map[string]map[string]string)
+	for i := range clus.Nodes {
+		node := &clus.Nodes[i]
+		ids = append(ids, node.Id)
+		segments[node.Id] = nodeTopology(node, confs[node.Id])
+	}
+	return ids, segments, nil
+}
+
+// topologyReplicaSet returns the nodes of the cluster which satisfy the
+// requisite topologies, ordered by the preferred topologies. It returns
+// an error if no node satisfies them.
+func topologyReplicaSet(
+	req *csi.TopologyRequirement,
+	ids []string,
+	segments map[string]map[string]string,
+) ([]string, error) {
+	var candidates []string
+	for _, id := range ids {
+		if len(req.GetRequisite()) == 0 {
+			candidates = append(candidates, id)
+			continue
+		}
+		for _, t := range req.GetRequisite() {
+			if topologyMatches(t, segments[id]) {
+				candidates = append(candidates, id)
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("No node satisfies the requisite topology")
+	}
+
+	// Nodes of the preferred topologies go first, in the order of
+	// the preferences, followed by the rest of the candidates
+	nodes := make([]string, 0, len(candidates))
+	chosen := make(map[string]bool)
+	for _, t := range req.GetPreferred() {
+		for _, id := range candidates {
+			if !chosen[id] && topologyMatches(t, segments[id]) {
+				nodes = append(nodes, id)
+				chosen[id] = true
+			}
+		}
+	}
+	for _, id := range candidates {
+		if !chosen[id] {
+			nodes = append(nodes, id)
+		}
+	}
+	return nodes, nil
+}
+
+// applyTopologyRequirement places the replicas of a new volume on the
+// nodes which satisfy the requirement and constrains the volume to the
+// zones and racks of those nodes. Placement given in the parameters of
+// the request is kept. The errors returned are gRPC status errors.
+func (s *OsdCsiServer) applyTopologyRequirement(
+	req *csi.TopologyRequirement,
+	locator *api.VolumeLocator,
+	spec *api.VolumeSpec,
+) error {
+	if len(spec.GetReplicaSet().GetNodes()) != 0 {
+		return nil
+	}
+
+	ids, segments, err := s.clusterTopology()
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	nodes, err := topologyReplicaSet(req, ids, segments)
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	replicas := int(spec.GetHaLevel())
+	if replicas < 1 {
+		replicas = 1
+	}
+	if len(nodes) > replicas {
+		nodes = nodes[:replicas]
+	}
+	spec.ReplicaSet = &api.ReplicaSet{Nodes: nodes}
+
+	var zones, racks []string
+	for _, id := range nodes {
+		zones = appendUnique(zones, segments[id][TopologyKeyZone])
+		racks = appendUnique(racks, segments[id][TopologyKeyRack])
+	}
+	if locator.VolumeLabels == nil {
+		locator.VolumeLabels = make(map[string]string)
+	}
+	if _, ok := locator.VolumeLabels[api.SpecZones]; !ok && len(zones) != 0 {
+		locator.VolumeLabels[api.SpecZones] = strings.Join(zones, ",")
+	}
+	if _, ok := locator.VolumeLabels[api.SpecRacks]; !ok && len(racks) != 0 {
+		locator.VolumeLabels[api.SpecRacks] = strings.Join(racks, ",")
+	}
+	return nil
+}
+
+// volumeTopology returns the topologies of the nodes holding the replicas
+// of the volume, or nil if the volume is not placed on specific nodes.
+func (s *OsdCsiServer) volumeTopology(v *api.Volume) ([]*csi.Topology, error) {
+	nodes := v.GetSpec().GetReplicaSet().GetNodes()
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+
+	_, segments, err := s.clusterTopology()
+	if err != nil {
+		return nil, err
+	}
+	var topologies []*csi.Topology
+	seen := make(map[string]bool)
+	for _, id := range nodes {
+		t := segments[id]
+		if len(t) == 0 {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s/%s",
+			t[TopologyKeyRegion], t[TopologyKeyZone], t[TopologyKeyRack])
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		topologies = append(topologies, &csi.Topology{Segments: t})
+	}
+	return topologies, nil
+}
+
+func appendUnique(values []string, value string) []string {
+	if len(value) == 0 {
+		return values
+	}
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,213 @@
+/*
+Package audit records the mutating operations made through the APIs
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/pborman/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/proto/time"
+)
+
+const (
+	// APISdk is the api of the events recorded by the SDK server
+	APISdk = "sdk"
+	// APIRest is the api of the events recorded by the REST servers
+	APIRest = "rest"
+
+	// redacted replaces the value of the secrets in the requests
+	redacted = "[REDACTED]"
+)
+
+var (
+	// ErrNotSupported is returned when the events cannot be enumerated
+	ErrNotSupported = errors.New("Audit events cannot be enumerated without a store")
+
+	// secretNames are the parts of the names of the request
+	// parameters which hold secrets
+	secretNames = []string{"secret", "password", "passphrase", "token"}
+
+	marshaler = &jsonpb.Marshaler{OrigName: true}
+)
+
+// Logger writes audit events
+type Logger interface {
+	// Log writes the event
+	Log(event *api.AuditEvent) error
+}
+
+// Store is a Logger which can also enumerate the events it has written
+type Store interface {
+	Logger
+	// Enumerate returns the events selected by the filter
+	Enumerate(filter *Filter) ([]*api.AuditEvent, error)
+}
+
+// Filter selects audit events. Empty fields select all the events.
+type Filter struct {
+	// TimeStart selects the events which occurred after this time
+	TimeStart time.Time
+	// TimeEnd selects the events which occurred before this time
+	TimeEnd time.Time
+	// Subject selects the events of this caller
+	Subject string
+	// Operation selects the events of this operation
+	Operation string
+}
+
+// Match returns true if the event is selected by the filter
+func (f *Filter) Match(event *api.AuditEvent) bool {
+	if f == nil {
+		return true
+	}
+	t := prototime.TimestampToTime(event.GetTimestamp())
+	if !f.TimeStart.IsZero() && t.Before(f.TimeStart) {
+		return false
+	}
+	if !f.TimeEnd.IsZero() && t.After(f.TimeEnd) {
+		return false
+	}
+	if len(f.Subject) != 0 && f.Subject != event.GetSubject() {
+		return false
+	}
+	if len(f.Operation) != 0 && f.Operation != event.GetOperation() {
+		return false
+	}
+	return true
+}
+
+// Auditor completes the audit events and writes them to the loggers and
+// to the store
+type Auditor struct {
+	nodeID  string
+	store   Store
+	loggers []Logger
+}
+
+// New returns an auditor of the operations serviced by the node. The store
+// is optional and, when provided, is used to enumerate the events.
+func New(nodeID string, store Store, loggers ...Logger) *Auditor {
+	if store != nil {
+		loggers = append(loggers, store)
+	}
+	return &Auditor{
+		nodeID:  nodeID,
+		store:   store,
+		loggers: loggers,
+	}
+}
+
+// Record sets the id, timestamp and node id of the event and writes it.
+// Failures are only logged so that they do not fail the operation audited.
+func (a *Auditor) Record(event *api.AuditEvent) {
+	event.Id = uuid.New()
+	event.NodeId = a.nodeID
+	if event.Timestamp == nil {
+		event.Timestamp = prototime.Now()
+	}
+	for _, l := range a.loggers {
+		if err := l.Log(event); err != nil {
+			logrus.Warnf("Failed to record audit event of %s by %s: %v",
+				event.GetOperation(), event.GetSubject(), err)
+		}
+	}
+}
+
+// Enumerate returns the events selected by the filter ordered by time
+func (a *Auditor) Enumerate(filter *Filter) ([]*api.AuditEvent, error) {
+	if a.store == nil {
+		return nil, ErrNotSupported
+	}
+	events, err := a.store.Enumerate(filter)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return prototime.TimestampLess(events[i].GetTimestamp(), events[j].GetTimestamp())
+	})
+	return events, nil
+}
+
+// Redact returns the JSON request with the values of the secrets redacted.
+// Requests which are not JSON are redacted as a whole.
+func Redact(request []byte) string {
+	if len(request) == 0 {
+		return ""
+	}
+	var v interface{}
+	if err := json.Unmarshal(request, &v); err != nil {
+		return redacted
+	}
+	b, err := json.Marshal(redact(v))
+	if err != nil {
+		return redacted
+	}
+	return string(b)
+}
+
+func redact(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, field := range value {
+			if isSecret(k) {
+				value[k] = redacted
+			} else {
+				value[k] = redact(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redact(item)
+		}
+	}
+	return v
+}
+
+// isSecret returns true if the parameter name is the name of a secret,
+// for example secret_key, AccessKey or passphrase.
+func isSecret(name string) bool {
+	name = strings.ToLower(name)
+	name = strings.NewReplacer("_", "", "-", "").Replace(name)
+	for _, secret := range secretNames {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return strings.HasSuffix(name, "key")
+}
+
+// marshal returns the event in JSON
+func marshal(event *api.AuditEvent) (string, error) {
+	return marshaler.MarshalToString(event)
+}
+
+// unmarshal returns the event from its JSON
+func unmarshal(data []byte) (*api.AuditEvent, error) {
+	event := &api.AuditEvent{}
+	if err := jsonpb.UnmarshalString(string(data), event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,231 @@
+/*
+Package audit records the mutating operations made through the APIs
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package audit
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/proto/time"
+)
+
+func readEvents(t *testing.T, path string) []*api.AuditEvent {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var events []*api.AuditEvent
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		event, err := unmarshal(scanner.Bytes())
+		require.NoError(t, err)
+		events = append(events, event)
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		request  string
+		expected string
+	}{
+		{"", ""},
+		{"not json", redacted},
+		{`{"name":"vol"}`, `{"name":"vol"}`},
+		{
+			`{"access_key":"a","secret_key":"s","credential_id":"c"}`,
+			`{"access_key":"[REDACTED]","credential_id":"c","secret_key":"[REDACTED]"}`,
+		},
+		{
+			`{"spec":{"passphrase":"p","size":1},"items":[{"Token":"t"}]}`,
+			`{"items":[{"Token":"[REDACTED]"}],"spec":{"passphrase":"[REDACTED]","size":1}}`,
+		},
+		{
+			`{"InputParams":{"azure_account_key":"k","azure_account_name":"n"}}`,
+			`{"InputParams":{"azure_account_key":"[REDACTED]","azure_account_name":"n"}}`,
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, Redact([]byte(test.request)), test.request)
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	now := time.Now()
+	event := &api.AuditEvent{
+		Timestamp: prototime.TimeToTimestamp(now),
+		Subject:   "user1",
+		Operation: "create",
+	}
+
+	var nilFilter *Filter
+	assert.True(t, nilFilter.Match(event))
+	assert.True(t, (&Filter{}).Match(event))
+	assert.True(t, (&Filter{Subject: "user1", Operation: "create"}).Match(event))
+	assert.False(t, (&Filter{Subject: "user2"}).Match(event))
+	assert.False(t, (&Filter{Operation: "delete"}).Match(event))
+	assert.True(t, (&Filter{
+		TimeStart: now.Add(-time.Minute),
+		TimeEnd:   now.Add(time.Minute),
+	}).Match(event))
+	assert.False(t, (&Filter{TimeStart: now.Add(time.Minute)}).Match(event))
+	assert.False(t, (&Filter{TimeEnd: now.Add(-time.Minute)}).Match(event))
+}
+
+func TestFileLogger(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "log", "audit.log")
+
+	_, err = NewFileLogger(&FileConfig{})
+	assert.Error(t, err)
+
+	l, err := NewFileLogger(&FileConfig{
+		Path:       path,
+		MaxSize:    1024,
+		MaxBackups: 2,
+	})
+	require.NoError(t, err)
+	defer l.Close()
+
+	a := New("node1", nil, l)
+	a.Record(&api.AuditEvent{
+		Api:       APISdk,
+		Operation: "create",
+		Subject:   "user1",
+		Request:   `{"name":"vol"}`,
+		Success:   true,
+	})
+	events := readEvents(t, path)
+	require.Len(t, events, 1)
+	assert.NotEmpty(t, events[0].GetId())
+	assert.NotNil(t, events[0].GetTimestamp())
+	assert.Equal(t, "node1", events[0].GetNodeId())
+	assert.Equal(t, "create", events[0].GetOperation())
+	assert.Equal(t, "user1", events[0].GetSubject())
+	assert.True(t, events[0].GetSuccess())
+
+	// Enumerating requires a store
+	_, err = a.Enumerate(nil)
+	assert.Equal(t, ErrNotSupported, err)
+
+	// The file is rotated when it reaches its maximum size
+	// and only the most recent backups are kept
+	for i := 0; i < 40; i++ {
+		a.Record(&api.AuditEvent{
+			Operation: "delete",
+			Request:   strings.Repeat("x", 100),
+		})
+	}
+	_, err = os.Stat(path + ".1")
+	assert.NoError(t, err)
+	_, err = os.Stat(path + ".2")
+	assert.NoError(t, err)
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+	for _, p := range []string{path, path + ".1", path + ".2"} {
+		info, err := os.Stat(p)
+		require.NoError(t, err)
+		assert.True(t, info.Size() <= 1024)
+		for _, event := range readEvents(t, p) {
+			assert.Equal(t, "delete", event.GetOperation())
+		}
+	}
+
+	// Logging to a closed file fails
+	assert.NoError(t, l.Close())
+	assert.Error(t, l.Log(&api.AuditEvent{}))
+}
+
+func TestFileLoggerRotateFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	l, err := NewFileLogger(&FileConfig{
+		Path:       path,
+		MaxSize:    200,
+		MaxBackups: 1,
+	})
+	require.NoError(t, err)
+	defer l.Close()
+
+	event := &api.AuditEvent{
+		Operation: "delete",
+		Request:   strings.Repeat("x", 100),
+	}
+	require.NoError(t, l.Log(event))
+
+	// The backup cannot be replaced by the file while it is a directory
+	require.NoError(t, os.MkdirAll(filepath.Join(path+".1", "dir"), 0700))
+	assert.Error(t, l.Log(event))
+
+	// The file is reopened by the next event
+	require.NoError(t, os.RemoveAll(path+".1"))
+	assert.NoError(t, l.Log(event))
+	assert.NoError(t, l.Log(event))
+	assert.Len(t, readEvents(t, path+".1"), 1)
+	assert.Len(t, readEvents(t, path), 1)
+}
+
+func TestKvdbStore(t *testing.T) {
+	kv, err := kvdb.New(mem.Name, "audit_test", []string{}, nil, logrus.Panicf)
+	require.NoError(t, err)
+
+	a := New("node1", NewKvdbStore(kv, 0))
+	now := time.Now()
+	a.Record(&api.AuditEvent{
+		Timestamp: prototime.TimeToTimestamp(now),
+		Operation: "delete",
+		Subject:   "user2",
+	})
+	a.Record(&api.AuditEvent{
+		Timestamp: prototime.TimeToTimestamp(now.Add(-time.Second)),
+		Operation: "create",
+		Subject:   "user1",
+		Request:   `{"name":"vol"}`,
+	})
+
+	// Events are ordered by time
+	events, err := a.Enumerate(nil)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "create", events[0].GetOperation())
+	assert.Equal(t, `{"name":"vol"}`, events[0].GetRequest())
+	assert.Equal(t, "node1", events[0].GetNodeId())
+	assert.Equal(t, "delete", events[1].GetOperation())
+
+	events, err = a.Enumerate(&Filter{Subject: "user2"})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "delete", events[0].GetOperation())
+}
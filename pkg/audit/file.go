@@ -0,0 +1,171 @@
+/*
+Package audit records the mutating operations made through the APIs
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package audit
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const (
+	// DefaultMaxSize is the default size in bytes of the audit log file
+	// before it is rotated
+	DefaultMaxSize = 100 * 1024 * 1024
+	// DefaultMaxBackups is the default number of rotated files kept
+	DefaultMaxBackups = 5
+)
+
+// FileConfig provides the configuration of the file logger
+type FileConfig struct {
+	// Path of the audit log file
+	Path string
+	// MaxSize is the size in bytes of the file before it is rotated.
+	// Defaults to DefaultMaxSize.
+	MaxSize int64
+	// MaxBackups is the number of rotated files kept, named <path>.1 for
+	// the most recent one to <path>.<MaxBackups> for the oldest one.
+	// Defaults to DefaultMaxBackups.
+	MaxBackups int
+}
+
+// FileLogger writes the audit events as JSON lines to a file which is
+// rotated when it reaches its maximum size
+type FileLogger struct {
+	lock   sync.Mutex
+	config FileConfig
+	file   *os.File
+	size   int64
+	// closed is set once the logger is closed. The file is nil as well
+	// when it could not be reopened, in which case it is opened again
+	// on the next event.
+	closed bool
+}
+
+// Interface check
+var _ Logger = &FileLogger{}
+
+// NewFileLogger returns a logger writing to the file in the configuration
+func NewFileLogger(config *FileConfig) (*FileLogger, error) {
+	if config == nil || len(config.Path) == 0 {
+		return nil, fmt.Errorf("Must provide the path of the audit log file")
+	}
+
+	l := &FileLogger{
+		config: *config,
+	}
+	if l.config.MaxSize <= 0 {
+		l.config.MaxSize = DefaultMaxSize
+	}
+	if l.config.MaxBackups <= 0 {
+		l.config.MaxBackups = DefaultMaxBackups
+	}
+	if err := os.MkdirAll(filepath.Dir(l.config.Path), 0700); err != nil {
+		return nil, fmt.Errorf("Unable to create the audit log directory: %v", err)
+	}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Log appends the event to the file
+func (l *FileLogger) Log(event *api.AuditEvent) error {
+	line, err := marshal(event)
+	if err != nil {
+		return err
+	}
+	line += "\n"
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.closed {
+		return fmt.Errorf("Audit log file %s is closed", l.config.Path)
+	}
+	if l.file == nil {
+		if err := l.open(); err != nil {
+			return err
+		}
+	}
+	if l.size > 0 && l.size+int64(len(line)) > l.config.MaxSize {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := l.file.WriteString(line)
+	l.size += int64(n)
+	return err
+}
+
+// Close closes the file
+func (l *FileLogger) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.closed = true
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// open opens the file for appending
+func (l *FileLogger) open() error {
+	file, err := os.OpenFile(l.config.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("Unable to open the audit log file: %v", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("Unable to stat the audit log file: %v", err)
+	}
+	l.file = file
+	l.size = info.Size()
+	return nil
+}
+
+// rotate shifts the backups, dropping the oldest one, moves the file
+// to the most recent backup and opens a new file
+func (l *FileLogger) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+	l.file = nil
+
+	for i := l.config.MaxBackups - 1; i > 0; i-- {
+		err := os.Rename(l.backup(i), l.backup(i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(l.config.Path, l.backup(1)); err != nil {
+		return err
+	}
+	return l.open()
+}
+
+// backup returns the path of the backup i
+func (l *FileLogger) backup(i int) string {
+	return fmt.Sprintf("%s.%d", l.config.Path, i)
+}
@@ -0,0 +1,86 @@
+/*
+Package audit records the mutating operations made through the APIs
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package audit
+
+import (
+	"fmt"
+
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/proto/time"
+)
+
+const (
+	// auditKey is the kvdb prefix of the audit events
+	auditKey = "audit/"
+)
+
+// KvdbStore saves the audit events in the kvdb so that the events
+// of all the nodes of the cluster can be enumerated
+type KvdbStore struct {
+	kv  kvdb.Kvdb
+	ttl uint64
+}
+
+// Interface check
+var _ Store = &KvdbStore{}
+
+// NewKvdbStore returns a store saving the events in the kvdb. The events
+// expire after ttl seconds, unless ttl is zero.
+func NewKvdbStore(kv kvdb.Kvdb, ttl uint64) *KvdbStore {
+	return &KvdbStore{
+		kv:  kv,
+		ttl: ttl,
+	}
+}
+
+// Log saves the event. The keys of the events are ordered by time.
+func (s *KvdbStore) Log(event *api.AuditEvent) error {
+	value, err := marshal(event)
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf("%s%020d-%s",
+		auditKey,
+		prototime.TimestampToTime(event.GetTimestamp()).UnixNano(),
+		event.GetId())
+	_, err = s.kv.Put(key, value, s.ttl)
+	return err
+}
+
+// Enumerate returns the events selected by the filter
+func (s *KvdbStore) Enumerate(filter *Filter) ([]*api.AuditEvent, error) {
+	kvps, err := s.kv.Enumerate(auditKey)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*api.AuditEvent, 0, len(kvps))
+	for _, kvp := range kvps {
+		event, err := unmarshal(kvp.Value)
+		if err != nil {
+			logrus.Warnf("Ignoring audit event %s which cannot be read: %v", kvp.Key, err)
+			continue
+		}
+		if filter.Match(event) {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
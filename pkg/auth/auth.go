@@ -0,0 +1,67 @@
+/*
+Package auth can be used for authentication and authorization
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package auth
+
+import (
+	"context"
+)
+
+// Claims provides information about the authenticated user
+type Claims struct {
+	// Subject uniquely identifies the user
+	Subject string
+	// Name of the user
+	Name string
+	// Email of the user
+	Email string
+	// Roles of the user
+	Roles []string
+	// Groups the user belongs to
+	Groups []string
+}
+
+// Authenticator validates raw tokens and returns the claims they carry
+type Authenticator interface {
+	// AuthenticateToken validates the token and returns its claims.
+	// It returns an error if the token is not valid.
+	AuthenticateToken(rawtoken string) (*Claims, error)
+}
+
+// IsAdmin returns true if the user has the admin role
+func (c *Claims) IsAdmin() bool {
+	for _, role := range c.Roles {
+		if role == RoleAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+type claimsKey struct{}
+
+// ContextSaveClaims returns a new context which holds the claims of
+// the authenticated user
+func ContextSaveClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims of the authenticated user saved in
+// the context. It returns false if the context has no claims.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
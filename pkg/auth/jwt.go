@@ -0,0 +1,121 @@
+/*
+Package auth can be used for authentication and authorization
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package auth
+
+import (
+	"crypto/rsa"
+	"fmt"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// JwtAuthConfig provides the configuration to the JWT authenticator
+// created by NewJwtAuth(). At least one key must be provided.
+type JwtAuthConfig struct {
+	// SharedSecret is the key used to validate tokens signed with HMAC
+	SharedSecret []byte
+	// RsaPublicPem is the PEM encoded public key used to validate tokens
+	// signed with RSA
+	RsaPublicPem []byte
+	// Issuer, if provided, must match the `iss` claim of the token
+	Issuer string
+}
+
+// JwtAuthenticator validates JWT bearer tokens
+type JwtAuthenticator struct {
+	sharedSecret []byte
+	rsaKey       *rsa.PublicKey
+	issuer       string
+}
+
+// jwtClaims are the claims in the token understood by the authenticator
+type jwtClaims struct {
+	jwt.StandardClaims
+	Name   string   `json:"name,omitempty"`
+	Email  string   `json:"email,omitempty"`
+	Roles  []string `json:"roles,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+// Interface check
+var _ Authenticator = &JwtAuthenticator{}
+
+// NewJwtAuth returns a JWT authenticator
+func NewJwtAuth(config *JwtAuthConfig) (*JwtAuthenticator, error) {
+	if config == nil {
+		return nil, fmt.Errorf("Must provide configuration")
+	}
+	if len(config.SharedSecret) == 0 && len(config.RsaPublicPem) == 0 {
+		return nil, fmt.Errorf("Must provide a shared secret or an RSA public key")
+	}
+
+	a := &JwtAuthenticator{
+		sharedSecret: config.SharedSecret,
+		issuer:       config.Issuer,
+	}
+	if len(config.RsaPublicPem) != 0 {
+		var err error
+		a.rsaKey, err = jwt.ParseRSAPublicKeyFromPEM(config.RsaPublicPem)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse RSA public key: %v", err)
+		}
+	}
+
+	return a, nil
+}
+
+// AuthenticateToken validates the signature and the standard claims of
+// the token and returns its claims.
+func (a *JwtAuthenticator) AuthenticateToken(rawtoken string) (*Claims, error) {
+	claims := &jwtClaims{}
+	token, err := jwt.ParseWithClaims(rawtoken, claims, a.key)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid token: %v", err)
+	}
+	if !token.Valid {
+		return nil, fmt.Errorf("Invalid token")
+	}
+	if len(a.issuer) != 0 && !claims.VerifyIssuer(a.issuer, true) {
+		return nil, fmt.Errorf("Token issuer %s is not trusted", claims.Issuer)
+	}
+	if len(claims.Subject) == 0 {
+		return nil, fmt.Errorf("Token is missing the subject claim")
+	}
+
+	return &Claims{
+		Subject: claims.Subject,
+		Name:    claims.Name,
+		Email:   claims.Email,
+		Roles:   claims.Roles,
+		Groups:  claims.Groups,
+	}, nil
+}
+
+// key returns the key used to validate the signature of the token
+func (a *JwtAuthenticator) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if len(a.sharedSecret) != 0 {
+			return a.sharedSecret, nil
+		}
+	case *jwt.SigningMethodRSA:
+		if a.rsaKey != nil {
+			return a.rsaKey, nil
+		}
+	}
+	return nil, fmt.Errorf("Unsupported signing method %v", token.Header["alg"])
+}
@@ -0,0 +1,147 @@
+/*
+Package auth can be used for authentication and authorization
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClaims(subject string, roles []string, issuer string, exp time.Duration) *jwtClaims {
+	return &jwtClaims{
+		StandardClaims: jwt.StandardClaims{
+			Subject:   subject,
+			Issuer:    issuer,
+			ExpiresAt: time.Now().Add(exp).Unix(),
+		},
+		Email: subject + "@example.com",
+		Roles: roles,
+	}
+}
+
+func TestJwtAuthConfig(t *testing.T) {
+	_, err := NewJwtAuth(nil)
+	assert.Error(t, err)
+
+	_, err = NewJwtAuth(&JwtAuthConfig{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "shared secret")
+
+	_, err = NewJwtAuth(&JwtAuthConfig{RsaPublicPem: []byte("bad")})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "RSA")
+}
+
+func TestJwtAuthSharedSecret(t *testing.T) {
+	secret := []byte("mysecret")
+	a, err := NewJwtAuth(&JwtAuthConfig{
+		SharedSecret: secret,
+		Issuer:       "openstorage.io",
+	})
+	assert.NoError(t, err)
+
+	// Valid token
+	rawtoken, err := jwt.NewWithClaims(jwt.SigningMethodHS256,
+		newTestClaims("user1", []string{RoleAdmin}, "openstorage.io", time.Minute)).
+		SignedString(secret)
+	assert.NoError(t, err)
+	claims, err := a.AuthenticateToken(rawtoken)
+	assert.NoError(t, err)
+	assert.Equal(t, "user1", claims.Subject)
+	assert.Equal(t, "user1@example.com", claims.Email)
+	assert.Equal(t, []string{RoleAdmin}, claims.Roles)
+
+	// Wrong secret
+	rawtoken, err = jwt.NewWithClaims(jwt.SigningMethodHS256,
+		newTestClaims("user1", nil, "openstorage.io", time.Minute)).
+		SignedString([]byte("other"))
+	assert.NoError(t, err)
+	_, err = a.AuthenticateToken(rawtoken)
+	assert.Error(t, err)
+
+	// Expired
+	rawtoken, err = jwt.NewWithClaims(jwt.SigningMethodHS256,
+		newTestClaims("user1", nil, "openstorage.io", -time.Minute)).
+		SignedString(secret)
+	assert.NoError(t, err)
+	_, err = a.AuthenticateToken(rawtoken)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "expired")
+
+	// Untrusted issuer
+	rawtoken, err = jwt.NewWithClaims(jwt.SigningMethodHS256,
+		newTestClaims("user1", nil, "someone", time.Minute)).
+		SignedString(secret)
+	assert.NoError(t, err)
+	_, err = a.AuthenticateToken(rawtoken)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "issuer")
+
+	// Missing subject
+	rawtoken, err = jwt.NewWithClaims(jwt.SigningMethodHS256,
+		newTestClaims("", nil, "openstorage.io", time.Minute)).
+		SignedString(secret)
+	assert.NoError(t, err)
+	_, err = a.AuthenticateToken(rawtoken)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "subject")
+
+	// Garbage
+	_, err = a.AuthenticateToken("garbage")
+	assert.Error(t, err)
+}
+
+func TestJwtAuthRsa(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.NoError(t, err)
+	pubPem := pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: der,
+	})
+
+	a, err := NewJwtAuth(&JwtAuthConfig{
+		RsaPublicPem: pubPem,
+	})
+	assert.NoError(t, err)
+
+	rawtoken, err := jwt.NewWithClaims(jwt.SigningMethodRS256,
+		newTestClaims("user2", []string{RoleViewer}, "", time.Minute)).
+		SignedString(key)
+	assert.NoError(t, err)
+	claims, err := a.AuthenticateToken(rawtoken)
+	assert.NoError(t, err)
+	assert.Equal(t, "user2", claims.Subject)
+	assert.Equal(t, []string{RoleViewer}, claims.Roles)
+
+	// HMAC tokens are not accepted without a shared secret
+	rawtoken, err = jwt.NewWithClaims(jwt.SigningMethodHS256,
+		newTestClaims("user2", nil, "", time.Minute)).
+		SignedString(pubPem)
+	assert.NoError(t, err)
+	_, err = a.AuthenticateToken(rawtoken)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "signing method")
+}
@@ -0,0 +1,85 @@
+/*
+Package auth can be used for authentication and authorization
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package auth
+
+import (
+	"fmt"
+	"path"
+)
+
+const (
+	// RoleAdmin is allowed to call any method
+	RoleAdmin = "admin"
+	// RoleVolumeUser is allowed to manage volumes
+	RoleVolumeUser = "volume-user"
+	// RoleViewer is only allowed to read information
+	RoleViewer = "viewer"
+)
+
+// Role describes the gRPC methods a role is allowed to call. Methods are
+// full gRPC method names which may contain patterns as supported by
+// path.Match(), for example "/openstorage.api.OpenStorageVolume/*".
+type Role struct {
+	Name    string
+	Methods []string
+}
+
+// RoleManager authorizes a set of roles to call gRPC methods
+type RoleManager interface {
+	// Verify returns nil if any of the roles is allowed to
+	// call the full gRPC method provided
+	Verify(roles []string, fullMethod string) error
+}
+
+// roleManager is a RoleManager backed by a map of roles to method patterns
+type roleManager struct {
+	roles map[string][]string
+}
+
+// NewRoleManager returns a RoleManager which authorizes the roles provided
+func NewRoleManager(roles []Role) (RoleManager, error) {
+	r := &roleManager{
+		roles: make(map[string][]string, len(roles)),
+	}
+	for _, role := range roles {
+		if len(role.Name) == 0 {
+			return nil, fmt.Errorf("Role name must be provided")
+		}
+		if _, ok := r.roles[role.Name]; ok {
+			return nil, fmt.Errorf("Role %s defined more than once", role.Name)
+		}
+		for _, method := range role.Methods {
+			if _, err := path.Match(method, ""); err != nil {
+				return nil, fmt.Errorf("Bad method pattern %s in role %s: %v",
+					method, role.Name, err)
+			}
+		}
+		r.roles[role.Name] = role.Methods
+	}
+	return r, nil
+}
+
+func (r *roleManager) Verify(roles []string, fullMethod string) error {
+	for _, role := range roles {
+		for _, method := range r.roles[role] {
+			if match, _ := path.Match(method, fullMethod); match {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("Access denied to %s", fullMethod)
+}
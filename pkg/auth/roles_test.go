@@ -0,0 +1,60 @@
+/*
+Package auth can be used for authentication and authorization
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRoleManagerBadRoles(t *testing.T) {
+	_, err := NewRoleManager([]Role{{Name: ""}})
+	assert.Error(t, err)
+
+	_, err = NewRoleManager([]Role{{Name: "a"}, {Name: "a"}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "more than once")
+
+	_, err = NewRoleManager([]Role{{Name: "a", Methods: []string{"/x/["}}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "pattern")
+}
+
+func TestRoleManagerVerify(t *testing.T) {
+	r, err := NewRoleManager([]Role{
+		{
+			Name:    RoleAdmin,
+			Methods: []string{"/*/*"},
+		},
+		{
+			Name:    RoleViewer,
+			Methods: []string{"/test.Service/Inspect*", "/test.Service/Enumerate"},
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, r.Verify([]string{RoleAdmin}, "/test.Service/Delete"))
+	assert.NoError(t, r.Verify([]string{RoleViewer}, "/test.Service/Enumerate"))
+	assert.NoError(t, r.Verify([]string{RoleViewer}, "/test.Service/InspectCurrent"))
+	assert.NoError(t, r.Verify([]string{"unknown", RoleViewer}, "/test.Service/Inspect"))
+
+	assert.Error(t, r.Verify([]string{RoleViewer}, "/test.Service/Delete"))
+	assert.Error(t, r.Verify([]string{RoleViewer}, "/other.Service/Inspect"))
+	assert.Error(t, r.Verify([]string{"unknown"}, "/test.Service/Inspect"))
+	assert.Error(t, r.Verify(nil, "/test.Service/Inspect"))
+}
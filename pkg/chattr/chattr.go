@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"strconv"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -76,6 +77,48 @@ func IsImmutable(path string) bool {
 	return false
 }
 
+// SetProjectID sets the project id of the tree of path. The directories of
+// the tree also inherit the project id to the files created within them.
+func SetProjectID(path string, projectID uint32) error {
+	chattrBin := which(chattrCmd)
+	cmd := exec.Command(chattrBin, "-R", "-p", strconv.FormatUint(uint64(projectID), 10), "+P", path)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s -p %d failed: %s. Err: %v", chattrBin, projectID, stderr.String(), err)
+	}
+	return nil
+}
+
+// GetProjectID returns the project id of path
+func GetProjectID(path string) (uint32, error) {
+	lsattrBin := which(lsattrCmd)
+	op, err := exec.Command(lsattrBin, "-d", "-p", path).CombinedOutput()
+	if err != nil {
+		return 0, fmt.Errorf("%s -p failed: %s. Err: %v", lsattrBin, string(op), err)
+	}
+	return parseProjectID(string(op))
+}
+
+// parseProjectID returns the project id of the output of 'lsattr -d -p'
+func parseProjectID(op string) (uint32, error) {
+	// 'lsattr -d -p' output is a single line whose 1st field is the project
+	// id, 2nd field the list of applicable attrs and then the path itself.
+	// Sample output below.
+	// lsattr -d -p /mnt/vol2
+	//     5 --------------e-------P-- /mnt/vol2
+	fields := strings.Fields(op)
+	if len(fields) < 3 {
+		return 0, fmt.Errorf("Invalid lsattr output %v", op)
+	}
+	projectID, err := strconv.ParseUint(fields[0], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid project id in lsattr output %v", op)
+	}
+	return uint32(projectID), nil
+}
+
 func which(bin string) string {
 	pathList := []string{"/usr/bin", "/sbin", "/usr/sbin", "/usr/local/bin"}
 	for _, p := range pathList {
@@ -50,3 +50,32 @@ func TestImmutable(t *testing.T) {
 	require.True(t, os.IsNotExist(err), "Unexpected error on remove")
 
 }
+
+func TestParseProjectID(t *testing.T) {
+	projectID, err := parseProjectID("    5 --------------e-------P-- /mnt/vol2\n")
+	require.NoError(t, err, "Unexpected error on parseProjectID")
+	require.Equal(t, uint32(5), projectID)
+
+	projectID, err = parseProjectID("4294967295 --------------e-- /mnt/vol 2\n")
+	require.NoError(t, err, "Unexpected error on parseProjectID")
+	require.Equal(t, uint32(4294967295), projectID)
+
+	_, err = parseProjectID("--------------e-- /mnt/vol2\n")
+	require.Error(t, err, "Expected an error for a missing project id")
+	_, err = parseProjectID("x --------------e-- /mnt/vol2\n")
+	require.Error(t, err, "Expected an error for an invalid project id")
+}
+
+func TestGetProjectID(t *testing.T) {
+	err := os.MkdirAll(testFile, 0755)
+	require.NoError(t, err, "Unexpected error on create test dir")
+	defer os.RemoveAll(testFile)
+
+	// The project id of a directory which has not been set is 0
+	projectID, err := GetProjectID(testFile)
+	require.NoError(t, err, "Unexpected error on GetProjectID")
+	require.Equal(t, uint32(0), projectID)
+
+	_, err = GetProjectID(testFile + "-missing")
+	require.Error(t, err, "Expected an error for a missing path")
+}
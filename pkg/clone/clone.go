@@ -0,0 +1,216 @@
+// Package clone copies files and directory trees, sharing the extents of
+// the files where the filesystem supports reflinks.
+package clone
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// ficlone is the FICLONE ioctl sharing the extents of a file with
+	// another file on the filesystems supporting reflinks
+	ficlone = 0x40049409
+	// renameExchange is the RENAME_EXCHANGE flag of renameat2
+	renameExchange = 1 << 1
+	// copyBlockSize is the size of the blocks copied by SparseCopy
+	copyBlockSize = 64 * 1024
+)
+
+// File creates dest with the content of source. The extents of source are
+// shared with dest where the filesystem supports reflinks, or else only the
+// blocks of source which are not zeros are copied so that dest is as sparse
+// as source. dest must not exist.
+func File(source, dest string) error {
+	src, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+	dst, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_EXCL, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+
+	if _, _, errno := syscall.Syscall(
+		syscall.SYS_IOCTL,
+		dst.Fd(),
+		ficlone,
+		src.Fd(),
+	); errno != 0 {
+		err = SparseCopy(src, dst)
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(dest)
+	}
+	return err
+}
+
+// SparseCopy copies the blocks of src which are not zeros to dst and sets
+// the size of dst to the size of src
+func SparseCopy(src, dst *os.File) error {
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, copyBlockSize)
+	zeros := make([]byte, copyBlockSize)
+	for offset := int64(0); offset < info.Size(); offset += copyBlockSize {
+		n, err := src.ReadAt(buf, offset)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if bytes.Equal(buf[:n], zeros[:n]) {
+			continue
+		}
+		if _, err := dst.WriteAt(buf[:n], offset); err != nil {
+			return err
+		}
+	}
+	return dst.Truncate(info.Size())
+}
+
+// Dir creates dest with a copy of the tree of source. The files are copied
+// with File and keep their mode, owner and modification time. The files
+// linked more than once within source are linked the same way within dest.
+// dest must not exist.
+func Dir(source, dest string) error {
+	c := &dirCopy{links: make(map[inode]string)}
+	if err := filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		return c.copy(path, filepath.Join(dest, rel), info)
+	}); err != nil {
+		// dest is removed only if it has been created by the copy
+		if len(c.dirs) != 0 {
+			os.RemoveAll(dest)
+		}
+		return err
+	}
+	// The times of the directories are set once their entries are created
+	for i := len(c.dirs) - 1; i >= 0; i-- {
+		if err := os.Chtimes(c.dirs[i].path, c.dirs[i].mtime, c.dirs[i].mtime); err != nil {
+			os.RemoveAll(dest)
+			return err
+		}
+	}
+	return nil
+}
+
+// Exchange atomically exchanges the paths, which must both exist
+func Exchange(path1, path2 string) error {
+	p1, err := unix.BytePtrFromString(path1)
+	if err != nil {
+		return err
+	}
+	p2, err := unix.BytePtrFromString(path2)
+	if err != nil {
+		return err
+	}
+	// The paths are relative to the current directory
+	cwd := unix.AT_FDCWD
+	if _, _, errno := unix.Syscall6(
+		unix.SYS_RENAMEAT2,
+		uintptr(cwd),
+		uintptr(unsafe.Pointer(p1)),
+		uintptr(cwd),
+		uintptr(unsafe.Pointer(p2)),
+		renameExchange,
+		0,
+	); errno != 0 {
+		return &os.LinkError{Op: "exchange", Old: path1, New: path2, Err: errno}
+	}
+	return nil
+}
+
+// inode identifies a file within the filesystems
+type inode struct {
+	dev uint64
+	ino uint64
+}
+
+// dirCopy is a copy of a directory tree in progress
+type dirCopy struct {
+	// links are the copies of the files linked more than once
+	links map[inode]string
+	// dirs are the directories copied
+	dirs []dirTime
+}
+
+// dirTime is the modification time of a directory copied
+type dirTime struct {
+	path  string
+	mtime time.Time
+}
+
+// copy copies the entry of the tree at path to dest
+func (c *dirCopy) copy(path, dest string, info os.FileInfo) error {
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return fmt.Errorf("Unable to stat %s", path)
+	}
+	mode := info.Mode()
+	switch {
+	case mode.IsDir():
+		if err := os.Mkdir(dest, mode.Perm()); err != nil {
+			return err
+		}
+		c.dirs = append(c.dirs, dirTime{path: dest, mtime: info.ModTime()})
+	case mode&os.ModeSymlink != 0:
+		target, err := os.Readlink(path)
+		if err != nil {
+			return err
+		}
+		if err := os.Symlink(target, dest); err != nil {
+			return err
+		}
+		return os.Lchown(dest, int(st.Uid), int(st.Gid))
+	case mode.IsRegular():
+		if st.Nlink > 1 {
+			key := inode{dev: uint64(st.Dev), ino: st.Ino}
+			if link, ok := c.links[key]; ok {
+				return os.Link(link, dest)
+			}
+			c.links[key] = dest
+		}
+		if err := File(path, dest); err != nil {
+			return err
+		}
+	default:
+		// Devices, fifos and sockets
+		if err := syscall.Mknod(dest, st.Mode, int(st.Rdev)); err != nil {
+			return err
+		}
+	}
+	if err := os.Lchown(dest, int(st.Uid), int(st.Gid)); err != nil {
+		return err
+	}
+	// The permissions are set again as they are masked on creation
+	if err := os.Chmod(dest, mode&(os.ModePerm|os.ModeSetuid|os.ModeSetgid|os.ModeSticky)); err != nil {
+		return err
+	}
+	if mode.IsDir() {
+		return nil
+	}
+	return os.Chtimes(dest, info.ModTime(), info.ModTime())
+}
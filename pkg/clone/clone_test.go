@@ -0,0 +1,151 @@
+package clone
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clone")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	source := filepath.Join(dir, "source")
+	f, err := os.Create(source)
+	require.NoError(t, err)
+	data := bytes.Repeat([]byte("clone"), 1024)
+	_, err = f.WriteAt(data, 10*copyBlockSize+100)
+	require.NoError(t, err)
+	require.NoError(t, f.Truncate(64*copyBlockSize))
+	require.NoError(t, f.Close())
+
+	dest := filepath.Join(dir, "dest")
+	require.NoError(t, File(source, dest))
+	require.Error(t, File(source, dest), "The clone must not overwrite a file")
+
+	want, err := ioutil.ReadFile(source)
+	require.NoError(t, err)
+	got, err := ioutil.ReadFile(dest)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	var st syscall.Stat_t
+	require.NoError(t, syscall.Stat(dest, &st))
+	require.True(t, st.Blocks*512 < 4*copyBlockSize,
+		"Only the blocks written must be allocated, got %d blocks", st.Blocks)
+}
+
+func TestSparseCopy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clone")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	src, err := os.Create(filepath.Join(dir, "source"))
+	require.NoError(t, err)
+	defer src.Close()
+	_, err = src.WriteAt([]byte("head"), 0)
+	require.NoError(t, err)
+	// The last block is shorter than copyBlockSize
+	_, err = src.WriteAt([]byte("tail"), 3*copyBlockSize+10)
+	require.NoError(t, err)
+
+	dst, err := os.Create(filepath.Join(dir, "dest"))
+	require.NoError(t, err)
+	defer dst.Close()
+	require.NoError(t, SparseCopy(src, dst))
+
+	info, err := dst.Stat()
+	require.NoError(t, err)
+	require.Equal(t, int64(3*copyBlockSize+14), info.Size())
+	buf := make([]byte, 4)
+	_, err = dst.ReadAt(buf, 3*copyBlockSize+10)
+	require.NoError(t, err)
+	require.Equal(t, "tail", string(buf))
+	_, err = dst.ReadAt(buf, 0)
+	require.NoError(t, err)
+	require.Equal(t, "head", string(buf))
+}
+
+func TestDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clone")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	source := filepath.Join(dir, "source")
+	require.NoError(t, os.MkdirAll(filepath.Join(source, "sub", "empty"), 0750))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(source, "sub", "file"), []byte("data"), 0640))
+	require.NoError(t, os.Link(
+		filepath.Join(source, "sub", "file"),
+		filepath.Join(source, "link")))
+	require.NoError(t, os.Symlink("sub/file", filepath.Join(source, "symlink")))
+	require.NoError(t, syscall.Mkfifo(filepath.Join(source, "fifo"), 0600))
+	mtime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(filepath.Join(source, "sub"), mtime, mtime))
+
+	dest := filepath.Join(dir, "dest")
+	require.NoError(t, Dir(source, dest))
+	require.Error(t, Dir(source, dest), "The copy must not overwrite a directory")
+	_, err = os.Stat(dest)
+	require.NoError(t, err, "A failed copy must not remove an existing directory")
+
+	data, err := ioutil.ReadFile(filepath.Join(dest, "sub", "file"))
+	require.NoError(t, err)
+	require.Equal(t, "data", string(data))
+
+	info, err := os.Stat(filepath.Join(dest, "sub", "file"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0640), info.Mode())
+	link, err := os.Stat(filepath.Join(dest, "link"))
+	require.NoError(t, err)
+	require.True(t, os.SameFile(info, link), "Hard links must be preserved")
+
+	target, err := os.Readlink(filepath.Join(dest, "symlink"))
+	require.NoError(t, err)
+	require.Equal(t, "sub/file", target)
+
+	info, err = os.Lstat(filepath.Join(dest, "fifo"))
+	require.NoError(t, err)
+	require.True(t, info.Mode()&os.ModeNamedPipe != 0)
+
+	info, err = os.Stat(filepath.Join(dest, "sub"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0750), info.Mode().Perm())
+	require.True(t, mtime.Equal(info.ModTime()), "Expected %v, got %v", mtime, info.ModTime())
+	info, err = os.Stat(filepath.Join(dest, "sub", "empty"))
+	require.NoError(t, err)
+	require.True(t, info.IsDir())
+
+	// The copy is independent from the source
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dest, "sub", "file"), []byte("new"), 0640))
+	data, err = ioutil.ReadFile(filepath.Join(source, "sub", "file"))
+	require.NoError(t, err)
+	require.Equal(t, "data", string(data))
+}
+
+func TestExchange(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clone")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	a, b := filepath.Join(dir, "a"), filepath.Join(dir, "b")
+	require.NoError(t, os.Mkdir(a, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(a, "file"), []byte("a"), 0644))
+	require.NoError(t, ioutil.WriteFile(b, []byte("b"), 0644))
+
+	require.NoError(t, Exchange(a, b))
+	data, err := ioutil.ReadFile(filepath.Join(b, "file"))
+	require.NoError(t, err)
+	require.Equal(t, "a", string(data))
+	data, err = ioutil.ReadFile(a)
+	require.NoError(t, err)
+	require.Equal(t, "b", string(data))
+
+	require.Error(t, Exchange(a, filepath.Join(dir, "missing")))
+}
@@ -17,12 +17,14 @@ limitations under the License.
 package grpcserver
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 	"sync"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -32,12 +34,18 @@ type GrpcServerConfig struct {
 	Name    string
 	Net     string
 	Address string
+	// Opts are additional options used to create the gRPC server,
+	// like interceptors
+	Opts []grpc.ServerOption
+	// TLSConfig, if provided, is used to serve TLS
+	TLSConfig *tls.Config
 }
 
 // GrpcServer is a server manager for gRPC implementations
 type GrpcServer struct {
 	name     string
 	listener net.Listener
+	opts     []grpc.ServerOption
 	server   *grpc.Server
 	wg       sync.WaitGroup
 	running  bool
@@ -64,9 +72,15 @@ func New(config *GrpcServerConfig) (*GrpcServer, error) {
 		return nil, fmt.Errorf("Unable to setup server: %s", err.Error())
 	}
 
+	opts := append([]grpc.ServerOption{}, config.Opts...)
+	if config.TLSConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(config.TLSConfig)))
+	}
+
 	return &GrpcServer{
 		name:     config.Name,
 		listener: l,
+		opts:     opts,
 	}, nil
 }
 
@@ -80,7 +94,7 @@ func (s *GrpcServer) Start(register func(grpcServer *grpc.Server)) error {
 		return fmt.Errorf("Server already running")
 	}
 
-	s.server = grpc.NewServer()
+	s.server = grpc.NewServer(s.opts...)
 	register(s.server)
 	reflection.Register(s.server)
 
@@ -1,3 +1,4 @@
+//go:generate mockgen -package=mock -destination=mock/mount.mock.go github.com/libopenstorage/openstorage/pkg/mount Manager
 // +build linux
 
 package mount
@@ -0,0 +1,265 @@
+// Package quota limits the capacity of directories with the project quotas
+// of their filesystem. The filesystem, XFS or ext4, must be mounted with
+// project quotas enabled (prjquota). Each directory is assigned its own
+// project id, which the files created within it inherit.
+package quota
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+
+	"github.com/docker/docker/pkg/mount"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+
+	"github.com/libopenstorage/openstorage/pkg/chattr"
+)
+
+var (
+	// ErrNotSupported is returned if the filesystem of a directory does
+	// not have project quotas enabled
+	ErrNotSupported = errors.New("Project quotas are not enabled on the filesystem")
+)
+
+const (
+	qGetQuota = 0x800007
+	qSetQuota = 0x800008
+	prjQuota  = 2
+	// qifBLimits is set in dqblk.valid to set the block limits
+	qifBLimits = 1
+	// qifBlockSize is the size of the units of the block limits
+	qifBlockSize = 1024
+)
+
+// dqblk is the struct if_dqblk of quotactl
+type dqblk struct {
+	bHardLimit uint64
+	bSoftLimit uint64
+	curSpace   uint64
+	iHardLimit uint64
+	iSoftLimit uint64
+	curInodes  uint64
+	bTime      uint64
+	iTime      uint64
+	valid      uint32
+	_          uint32
+}
+
+// Set limits the size of the blocks allocated within the directory to
+// limit, assigning a project id to the directory if it has none. A limit of
+// 0 removes the limit.
+func Set(dir string, limit uint64) error {
+	dev, err := device(dir)
+	if err != nil {
+		return err
+	}
+	projectID, err := projectID(dir)
+	if err != nil {
+		return err
+	}
+	if projectID == 0 {
+		if projectID, err = newProjectID(dir); err != nil {
+			return err
+		}
+		if err := chattr.SetProjectID(dir, projectID); err != nil {
+			return err
+		}
+	}
+	// Limits are rounded up to the units of quotactl
+	q := dqblk{
+		bHardLimit: (limit + qifBlockSize - 1) / qifBlockSize,
+		bSoftLimit: (limit + qifBlockSize - 1) / qifBlockSize,
+		valid:      qifBLimits,
+	}
+	if err := quotactl(qSetQuota, dev, projectID, &q); err != nil {
+		return fmt.Errorf("Unable to set the quota of project %d of %s: %v", projectID, dir, err)
+	}
+	return nil
+}
+
+// Limit limits the capacity of the directory to size with Set. If the
+// filesystem of the directory does not have project quotas enabled, it
+// returns ErrNotSupported when enforce is set and size is not 0, or else
+// leaves the capacity unlimited with a warning.
+func Limit(dir string, size uint64, enforce bool) error {
+	err := Set(dir, size)
+	if err != ErrNotSupported {
+		return err
+	} else if size == 0 {
+		return nil
+	} else if enforce {
+		return err
+	}
+	logrus.Warnf("Capacity of %s is not enforced: %v", dir, err)
+	return nil
+}
+
+// Clear removes the limit of the directory, if it has one
+func Clear(dir string) error {
+	if _, err := device(dir); err != nil {
+		return err
+	}
+	projectID, err := projectID(dir)
+	if err != nil || projectID == 0 {
+		return err
+	}
+	return Set(dir, 0)
+}
+
+// Usage returns the size of the blocks allocated within the directory. The
+// usage is accounted by the project quota of the directory if it has one,
+// or else by walking the directory.
+func Usage(dir string) (uint64, error) {
+	dev, err := device(dir)
+	if err == ErrNotSupported {
+		return walkUsage(dir)
+	} else if err != nil {
+		return 0, err
+	}
+	projectID, err := projectID(dir)
+	if err != nil {
+		return 0, err
+	}
+	if projectID == 0 {
+		return walkUsage(dir)
+	}
+	var q dqblk
+	if err := quotactl(qGetQuota, dev, projectID, &q); err != nil {
+		return 0, fmt.Errorf("Unable to get the quota of project %d of %s: %v", projectID, dir, err)
+	}
+	return q.curSpace, nil
+}
+
+// device returns the device of the filesystem of the directory, which must
+// have project quotas enabled
+func device(dir string) (string, error) {
+	var st syscall.Stat_t
+	if err := syscall.Stat(dir, &st); err != nil {
+		return "", err
+	}
+	mounts, err := mount.GetMounts()
+	if err != nil {
+		return "", err
+	}
+	major, minor := int(unix.Major(uint64(st.Dev))), int(unix.Minor(uint64(st.Dev)))
+	for _, m := range mounts {
+		if m.Major != major || m.Minor != minor || !filepath.IsAbs(m.Source) {
+			continue
+		}
+		// Project 0 is accounted if project quotas are enabled
+		var q dqblk
+		if err := quotactl(qGetQuota, m.Source, 0, &q); err != nil {
+			return "", ErrNotSupported
+		}
+		return m.Source, nil
+	}
+	return "", ErrNotSupported
+}
+
+// projectID returns the project id of the directory, which is 0 if the
+// directory only inherits the project id of its parent
+func projectID(dir string) (uint32, error) {
+	projectID, err := chattr.GetProjectID(dir)
+	if err != nil || projectID == 0 {
+		return 0, err
+	}
+	parentID, err := chattr.GetProjectID(filepath.Dir(filepath.Clean(dir)))
+	if err != nil {
+		return 0, err
+	}
+	if projectID == parentID {
+		return 0, nil
+	}
+	return projectID, nil
+}
+
+// newProjectID returns a project id for the directory which is not the id
+// of the project of any other directory within its parent. The id is
+// derived from the name of the directory so that it is stable.
+func newProjectID(dir string) (uint32, error) {
+	dir = filepath.Clean(dir)
+	entries, err := ioutil.ReadDir(filepath.Dir(dir))
+	if err != nil {
+		return 0, err
+	}
+	parentID, err := chattr.GetProjectID(filepath.Dir(dir))
+	if err != nil {
+		return 0, err
+	}
+	used := map[uint32]bool{0: true, parentID: true}
+	for _, e := range entries {
+		if !e.IsDir() || e.Name() == filepath.Base(dir) {
+			continue
+		}
+		if id, err := chattr.GetProjectID(filepath.Join(filepath.Dir(dir), e.Name())); err == nil {
+			used[id] = true
+		}
+	}
+	return pickProjectID(filepath.Base(dir), used), nil
+}
+
+// pickProjectID returns the first project id, starting from the hash of the
+// name, which is not used
+func pickProjectID(name string, used map[uint32]bool) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	projectID := h.Sum32()
+	for used[projectID] {
+		projectID++
+	}
+	return projectID
+}
+
+// walkUsage returns the size of the blocks allocated to the files of the
+// directory, counting the files linked more than once only once
+func walkUsage(dir string) (uint64, error) {
+	var usage uint64
+	seen := make(map[uint64]bool)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if os.IsNotExist(err) {
+			// The file has been removed during the walk
+			return nil
+		} else if err != nil {
+			return err
+		}
+		st, ok := info.Sys().(*syscall.Stat_t)
+		if !ok {
+			return nil
+		}
+		if st.Nlink > 1 && !info.IsDir() {
+			if seen[st.Ino] {
+				return nil
+			}
+			seen[st.Ino] = true
+		}
+		// st_blocks is in units of 512 bytes whatever the block size
+		usage += uint64(st.Blocks) * 512
+		return nil
+	})
+	return usage, err
+}
+
+func quotactl(cmd int, dev string, projectID uint32, q *dqblk) error {
+	special, err := unix.BytePtrFromString(dev)
+	if err != nil {
+		return err
+	}
+	if _, _, errno := unix.Syscall6(
+		unix.SYS_QUOTACTL,
+		uintptr(cmd<<8|prjQuota),
+		uintptr(unsafe.Pointer(special)),
+		uintptr(projectID),
+		uintptr(unsafe.Pointer(q)),
+		0,
+		0,
+	); errno != 0 {
+		return errno
+	}
+	return nil
+}
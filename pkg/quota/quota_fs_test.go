@@ -0,0 +1,67 @@
+// +build linux,have_quota
+
+package quota
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/libopenstorage/openstorage/pkg/chattr"
+)
+
+// TestQuota requires a kernel with project quotas (CONFIG_QUOTA) to mount
+// an ext4 filesystem with project quotas enabled
+func TestQuota(t *testing.T) {
+	dir, err := ioutil.TempDir("", "quota")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	image, mountPath := filepath.Join(dir, "image"), filepath.Join(dir, "mnt")
+	require.NoError(t, os.Mkdir(mountPath, 0755))
+	out, err := exec.Command("truncate", "-s", "256M", image).CombinedOutput()
+	require.NoError(t, err, string(out))
+	out, err = exec.Command("mkfs.ext4", "-q", "-F", "-O", "quota,project", image).CombinedOutput()
+	require.NoError(t, err, string(out))
+	out, err = exec.Command("mount", "-o", "loop,prjquota", image, mountPath).CombinedOutput()
+	require.NoError(t, err, string(out))
+	defer syscall.Unmount(mountPath, 0)
+
+	vol := filepath.Join(mountPath, "vol")
+	require.NoError(t, os.Mkdir(vol, 0755))
+	require.NoError(t, Set(vol, 4*1024*1024))
+	projectID, err := chattr.GetProjectID(vol)
+	require.NoError(t, err)
+	require.NotEqual(t, uint32(0), projectID)
+
+	// The files of the directory are limited to its quota
+	require.NoError(t, ioutil.WriteFile(filepath.Join(vol, "small"), make([]byte, 1024*1024), 0644))
+	err = ioutil.WriteFile(filepath.Join(vol, "large"), make([]byte, 8*1024*1024), 0644)
+	require.Error(t, err)
+	usage, err := Usage(vol)
+	require.NoError(t, err)
+	require.True(t, usage >= 1024*1024 && usage <= 4*1024*1024, "Unexpected usage %d", usage)
+
+	// Another directory gets another project
+	other := filepath.Join(mountPath, "other")
+	require.NoError(t, os.Mkdir(other, 0755))
+	require.NoError(t, Set(other, 1024*1024))
+	otherID, err := chattr.GetProjectID(other)
+	require.NoError(t, err)
+	require.NotEqual(t, projectID, otherID)
+
+	// Growing the limit keeps the project of the directory
+	require.NoError(t, os.Remove(filepath.Join(vol, "large")))
+	require.NoError(t, Set(vol, 16*1024*1024))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(vol, "large"), make([]byte, 8*1024*1024), 0644))
+	grownID, err := chattr.GetProjectID(vol)
+	require.NoError(t, err)
+	require.Equal(t, projectID, grownID)
+
+	require.NoError(t, Clear(vol))
+}
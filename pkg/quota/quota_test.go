@@ -0,0 +1,69 @@
+package quota
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickProjectID(t *testing.T) {
+	used := map[uint32]bool{0: true}
+	projectID := pickProjectID("volume", used)
+	require.NotEqual(t, uint32(0), projectID)
+	require.Equal(t, projectID, pickProjectID("volume", used), "Project ids must be stable")
+
+	used[projectID] = true
+	used[projectID+1] = true
+	require.Equal(t, projectID+2, pickProjectID("volume", used))
+}
+
+func TestWalkUsage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "quota")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	empty, err := walkUsage(dir)
+	require.NoError(t, err)
+
+	data := make([]byte, 1024*1024)
+	for i := range data {
+		data[i] = 1
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "sub", "file"), data, 0644))
+	require.NoError(t, os.Link(filepath.Join(dir, "sub", "file"), filepath.Join(dir, "link")))
+	// Sparse files only account for the blocks written
+	f, err := os.Create(filepath.Join(dir, "sparse"))
+	require.NoError(t, err)
+	require.NoError(t, f.Truncate(1024*1024*1024))
+	require.NoError(t, f.Close())
+
+	usage, err := walkUsage(dir)
+	require.NoError(t, err)
+	require.True(t, usage >= empty+uint64(len(data)), "Usage %d must account for the file", usage)
+	require.True(t, usage < empty+2*uint64(len(data)),
+		"Usage %d must account for the linked file and the sparse file once", usage)
+}
+
+func TestUnsupported(t *testing.T) {
+	dir, err := ioutil.TempDir("", "quota")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	if _, err := device(dir); err != ErrNotSupported {
+		t.Skipf("Project quotas are enabled on the filesystem of %s", dir)
+	}
+	require.Equal(t, ErrNotSupported, Set(dir, 1024*1024))
+	require.Equal(t, ErrNotSupported, Clear(dir))
+	require.Equal(t, ErrNotSupported, Limit(dir, 1024*1024, true))
+	require.NoError(t, Limit(dir, 0, true), "No limit is required")
+	require.NoError(t, Limit(dir, 1024*1024, false), "The limit is not enforced")
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "file"), make([]byte, 8192), 0644))
+	usage, err := Usage(dir)
+	require.NoError(t, err)
+	require.True(t, usage >= 8192, "Usage %d must be walked", usage)
+}
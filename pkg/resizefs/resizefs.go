@@ -0,0 +1,54 @@
+// Package resizefs grows the filesystem of a volume once its device
+// has been expanded.
+package resizefs
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const (
+	resize2fsCmd = "resize2fs"
+	xfsGrowfsCmd = "xfs_growfs"
+)
+
+// Resize grows the filesystem on the device, which is mounted on mountPath,
+// to the size of the device. ext4 is grown through the device and xfs,
+// which can only be grown while it is mounted, through the mount path.
+func Resize(format api.FSType, devicePath, mountPath string) error {
+	var cmd *exec.Cmd
+	switch format {
+	case api.FSType_FS_TYPE_EXT4:
+		cmd = exec.Command(which(resize2fsCmd), devicePath)
+	case api.FSType_FS_TYPE_XFS:
+		cmd = exec.Command(which(xfsGrowfsCmd), mountPath)
+	default:
+		return fmt.Errorf("Resizing a %s filesystem is not supported", format.SimpleString())
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s failed: %s. Err: %v",
+			strings.Join(cmd.Args, " "),
+			stderr.String(),
+			err)
+	}
+	return nil
+}
+
+func which(bin string) string {
+	pathList := []string{"/usr/bin", "/sbin", "/usr/sbin", "/usr/local/bin"}
+	for _, p := range pathList {
+		if _, err := os.Stat(path.Join(p, bin)); err == nil {
+			return path.Join(p, bin)
+		}
+	}
+	return bin
+}
@@ -0,0 +1,21 @@
+package resizefs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+func TestResizeNotSupported(t *testing.T) {
+	err := Resize(api.FSType_FS_TYPE_NFS, "/dev/fake", "/mnt/fake")
+	require.Error(t, err, "Expected an error on resizing nfs")
+	require.Contains(t, err.Error(), "not supported")
+}
+
+func TestResizeFailure(t *testing.T) {
+	// The device does not exist
+	err := Resize(api.FSType_FS_TYPE_EXT4, "/dev/osd-test-nonexistent", "/mnt/fake")
+	require.Error(t, err, "Expected an error on resizing a missing device")
+}
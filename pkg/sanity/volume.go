@@ -54,7 +54,6 @@ var _ = Describe("Volume [Volume Tests]", func() {
 		var (
 			volumeID         string
 			numVolumesBefore int
-			numVolumesAfter  int
 		)
 
 		BeforeEach(func() {
@@ -70,9 +69,8 @@ var _ = Describe("Volume [Volume Tests]", func() {
 				err = volumedriver.Delete(volumeID)
 				Expect(err).ToNot(HaveOccurred())
 
-				volumes, err := volumedriver.Enumerate(&api.VolumeLocator{}, nil)
+				_, err = volumedriver.Enumerate(&api.VolumeLocator{}, nil)
 				Expect(err).ToNot(HaveOccurred())
-				numVolumesAfter = len(volumes)
 			}
 		})
 
@@ -178,7 +176,6 @@ var _ = Describe("Volume [Volume Tests]", func() {
 			volumeID         string
 			volumeIDs        []string
 			numVolumesBefore int
-			numVolumesAfter  int
 			volumesToCreate  int
 		)
 		AfterEach(func() {
@@ -190,9 +187,8 @@ var _ = Describe("Volume [Volume Tests]", func() {
 					Expect(err).ToNot(HaveOccurred())
 				}
 
-				volumes, err := volumedriver.Enumerate(&api.VolumeLocator{}, nil)
+				_, err = volumedriver.Enumerate(&api.VolumeLocator{}, nil)
 				Expect(err).ToNot(HaveOccurred())
-				numVolumesAfter = len(volumes)
 			}
 		})
 
@@ -254,15 +250,12 @@ var _ = Describe("Volume [Volume Tests]", func() {
 	Describe("Volume Delete ", func() {
 
 		var (
-			volumeID         string
-			numVolumesBefore int
+			volumeID string
 		)
 
 		BeforeEach(func() {
 
-			var err error
-			volumes, err := volumedriver.Enumerate(&api.VolumeLocator{}, make(map[string]string))
-			numVolumesBefore = len(volumes)
+			_, err := volumedriver.Enumerate(&api.VolumeLocator{}, make(map[string]string))
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -381,7 +374,6 @@ var _ = Describe("Volume [Volume Tests]", func() {
 	Describe("Volume Attach Detach", func() {
 		var (
 			numVolumesBefore int
-			numVolumesAfter  int
 			volumeID         string
 		)
 
@@ -398,9 +390,8 @@ var _ = Describe("Volume [Volume Tests]", func() {
 			err = volumedriver.Delete(volumeID)
 			Expect(err).ToNot(HaveOccurred())
 
-			volumes, err := volumedriver.Enumerate(&api.VolumeLocator{}, nil)
+			_, err = volumedriver.Enumerate(&api.VolumeLocator{}, nil)
 			Expect(err).ToNot(HaveOccurred())
-			numVolumesAfter = len(volumes)
 		})
 
 		It("Should attach and detach successfully", func() {
@@ -458,10 +449,8 @@ var _ = Describe("Volume [Volume Tests]", func() {
 
 		var (
 			numVolumesBefore int
-			numVolumesAfter  int
 			volumeID         string
 			mountPath        string
-			volumesToCreate  int
 		)
 
 		BeforeEach(func() {
@@ -481,9 +470,8 @@ var _ = Describe("Volume [Volume Tests]", func() {
 			err = volumedriver.Delete(volumeID)
 			Expect(err).ToNot(HaveOccurred())
 
-			volumes, err := volumedriver.Enumerate(&api.VolumeLocator{}, nil)
+			_, err = volumedriver.Enumerate(&api.VolumeLocator{}, nil)
 			Expect(err).ToNot(HaveOccurred())
-			numVolumesAfter = len(volumes)
 		})
 
 		It("Should mount and unmount successfully", func() {
@@ -492,7 +480,6 @@ var _ = Describe("Volume [Volume Tests]", func() {
 
 			var err error
 
-			volumesToCreate = 1
 			var size = 5
 			vr := &api.VolumeCreateRequest{
 				Locator: &api.VolumeLocator{
@@ -538,7 +525,6 @@ var _ = Describe("Volume [Volume Tests]", func() {
 
 		var (
 			numVolumesBefore int
-			numVolumesAfter  int
 			volumeID         string
 		)
 
@@ -557,9 +543,8 @@ var _ = Describe("Volume [Volume Tests]", func() {
 			err = volumedriver.Delete(volumeID)
 			Expect(err).ToNot(HaveOccurred())
 
-			volumes, err := volumedriver.Enumerate(&api.VolumeLocator{}, nil)
+			_, err = volumedriver.Enumerate(&api.VolumeLocator{}, nil)
 			Expect(err).ToNot(HaveOccurred())
-			numVolumesAfter = len(volumes)
 		})
 
 		It("Should update successfully with the new volume size.", func() {
@@ -691,7 +676,6 @@ var _ = Describe("Volume [Volume Tests]", func() {
 
 		var (
 			numVolumesBefore int
-			numVolumesAfter  int
 			volumeID         string
 		)
 
@@ -708,9 +692,8 @@ var _ = Describe("Volume [Volume Tests]", func() {
 			err = volumedriver.Delete(volumeID)
 			Expect(err).ToNot(HaveOccurred())
 
-			volumes, err := volumedriver.Enumerate(&api.VolumeLocator{}, nil)
+			_, err = volumedriver.Enumerate(&api.VolumeLocator{}, nil)
 			Expect(err).ToNot(HaveOccurred())
-			numVolumesAfter = len(volumes)
 		})
 
 		It("Should retrieve volume stats successfully", func() {
@@ -758,7 +741,6 @@ var _ = Describe("Volume [Volume Tests]", func() {
 
 		var (
 			numVolumesBefore int
-			numVolumesAfter  int
 			volumeID         string
 		)
 
@@ -775,9 +757,8 @@ var _ = Describe("Volume [Volume Tests]", func() {
 			err = volumedriver.Delete(volumeID)
 			Expect(err).ToNot(HaveOccurred())
 
-			volumes, err := volumedriver.Enumerate(&api.VolumeLocator{}, nil)
+			_, err = volumedriver.Enumerate(&api.VolumeLocator{}, nil)
 			Expect(err).ToNot(HaveOccurred())
-			numVolumesAfter = len(volumes)
 		})
 
 		It("Should get ActiveRequests successfully", func() {
@@ -823,7 +804,6 @@ var _ = Describe("Volume [Volume Tests]", func() {
 
 		var (
 			numVolumesBefore int
-			numVolumesAfter  int
 			volumeID         string
 		)
 
@@ -840,9 +820,8 @@ var _ = Describe("Volume [Volume Tests]", func() {
 			err = volumedriver.Delete(volumeID)
 			Expect(err).ToNot(HaveOccurred())
 
-			volumes, err := volumedriver.Enumerate(&api.VolumeLocator{}, nil)
+			_, err = volumedriver.Enumerate(&api.VolumeLocator{}, nil)
 			Expect(err).ToNot(HaveOccurred())
-			numVolumesAfter = len(volumes)
 		})
 
 		It("Should get volume used size successfully", func() {
@@ -888,7 +867,6 @@ var _ = Describe("Volume [Volume Tests]", func() {
 
 		var (
 			numVolumesBefore int
-			numVolumesAfter  int
 			volumeID         string
 		)
 
@@ -911,9 +889,8 @@ var _ = Describe("Volume [Volume Tests]", func() {
 			err = volumedriver.Delete(volumeID)
 			Expect(err).ToNot(HaveOccurred())
 
-			volumes, err := volumedriver.Enumerate(&api.VolumeLocator{}, nil)
+			_, err = volumedriver.Enumerate(&api.VolumeLocator{}, nil)
 			Expect(err).ToNot(HaveOccurred())
-			numVolumesAfter = len(volumes)
 		})
 
 		It("Should quiesce unquiesce volume successfully", func() {
@@ -0,0 +1,256 @@
+/*
+Package tlsutil provides TLS configurations which reload certificates
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package tlsutil
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Config provides the files used to serve TLS
+type Config struct {
+	// CertFile is the PEM encoded certificate of the server
+	CertFile string
+	// KeyFile is the PEM encoded private key of the certificate
+	KeyFile string
+	// CAFile is the PEM encoded CA bundle used to verify client certificates.
+	// If provided, client certificates are verified when presented.
+	CAFile string
+	// ClientAuth requires clients to present a certificate signed by CAFile
+	ClientAuth bool
+}
+
+// CertReloader provides TLS configurations using the files of a Config.
+// The files are reloaded when they change on disk, allowing certificates
+// to be rotated without restarting the server.
+type CertReloader struct {
+	config    Config
+	lock      sync.Mutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+}
+
+// NewCertReloader loads the files of the configuration and returns
+// a CertReloader for them.
+func NewCertReloader(config *Config) (*CertReloader, error) {
+	if config == nil {
+		return nil, fmt.Errorf("Configuration must be provided")
+	}
+	if len(config.CertFile) == 0 || len(config.KeyFile) == 0 {
+		return nil, fmt.Errorf("Certificate and key files must be provided")
+	}
+	if config.ClientAuth && len(config.CAFile) == 0 {
+		return nil, fmt.Errorf("CA file must be provided to require client certificates")
+	}
+
+	r := &CertReloader{
+		config: *config,
+	}
+	modTimes, _ := r.modified()
+	if err := r.load(modTimes); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ServerConfig returns a TLS configuration for servers
+func (r *CertReloader) ServerConfig() *tls.Config {
+	c := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return r.Certificate(), nil
+		},
+	}
+	if len(r.config.CAFile) != 0 {
+		// Client certificates are verified by verifyClient() so
+		// that the CA file can be reloaded
+		c.ClientAuth = tls.RequestClientCert
+		if r.config.ClientAuth {
+			c.ClientAuth = tls.RequireAnyClientCert
+		}
+		c.VerifyPeerCertificate = r.verifyClient
+	}
+	return c
+}
+
+// LoopbackClientConfig returns a TLS configuration for clients running in
+// the same process as the server, like a REST gateway. The client presents
+// the certificate of the server and only trusts the server certificate.
+func (r *CertReloader) LoopbackClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// The server certificate is verified by VerifyPeerCertificate
+		InsecureSkipVerify: true,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.Certificate(), nil
+		},
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 || !r.isServerCertificate(rawCerts[0]) {
+				return fmt.Errorf("Server did not present the expected certificate")
+			}
+			return nil
+		},
+	}
+}
+
+// ClientConfig returns a TLS configuration for clients of the servers of
+// the other nodes. The servers are verified with the CA file, or with the
+// CAs of the system if none is configured, and the certificate is presented
+// to the servers which request one.
+func (r *CertReloader) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    r.ClientCAs(),
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.Certificate(), nil
+		},
+	}
+}
+
+// Certificate returns the current certificate
+func (r *CertReloader) Certificate() *tls.Certificate {
+	r.reload()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.cert
+}
+
+// ClientCAs returns the current pool of CAs used to verify clients
+func (r *CertReloader) ClientCAs() *x509.CertPool {
+	r.reload()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.clientCAs
+}
+
+func (r *CertReloader) verifyClient(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		// tls.RequireAnyClientCert rejects the client if required
+		return nil
+	}
+
+	// Allow in process clients using LoopbackClientConfig()
+	if r.isServerCertificate(rawCerts[0]) {
+		return nil
+	}
+
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("Failed to parse client certificate: %v", err)
+		}
+		certs[i] = cert
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         r.ClientCAs(),
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	if _, err := certs[0].Verify(opts); err != nil {
+		return fmt.Errorf("Failed to verify client certificate: %v", err)
+	}
+	return nil
+}
+
+func (r *CertReloader) isServerCertificate(raw []byte) bool {
+	cert := r.Certificate()
+	return len(cert.Certificate) != 0 && bytes.Equal(raw, cert.Certificate[0])
+}
+
+// reload loads the files again if any of them has changed
+func (r *CertReloader) reload() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	modTimes, changed := r.modified()
+	if !changed {
+		return
+	}
+	if err := r.load(modTimes); err != nil {
+		// Keep serving the previous certificates. The files will be loaded
+		// again once they are modified, for example when a rotation
+		// finishes writing all of them.
+		r.modTimes = modTimes
+		logrus.Warnf("Failed to reload TLS certificates: %v", err)
+		return
+	}
+	logrus.Infof("Reloaded TLS certificate %s", r.config.CertFile)
+}
+
+// modified returns the modification times of the files and
+// true if any of them changed since they were loaded
+func (r *CertReloader) modified() (map[string]time.Time, bool) {
+	changed := false
+	modTimes := make(map[string]time.Time)
+	for _, file := range []string{r.config.CertFile, r.config.KeyFile, r.config.CAFile} {
+		if len(file) == 0 {
+			continue
+		}
+		fi, err := os.Stat(file)
+		if err != nil {
+			// Files may be missing while they are rotated
+			modTimes[file] = r.modTimes[file]
+			continue
+		}
+		modTimes[file] = fi.ModTime()
+		if !fi.ModTime().Equal(r.modTimes[file]) {
+			changed = true
+		}
+	}
+	return modTimes, changed
+}
+
+func (r *CertReloader) load(modTimes map[string]time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.config.CertFile, r.config.KeyFile)
+	if err != nil {
+		return fmt.Errorf("Unable to load certificate %s and key %s: %v",
+			r.config.CertFile, r.config.KeyFile, err)
+	}
+
+	var clientCAs *x509.CertPool
+	if len(r.config.CAFile) != 0 {
+		pem, err := ioutil.ReadFile(r.config.CAFile)
+		if err != nil {
+			return fmt.Errorf("Unable to read CA file %s: %v", r.config.CAFile, err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("No certificates found in CA file %s", r.config.CAFile)
+		}
+	}
+
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.modTimes = modTimes
+	return nil
+}
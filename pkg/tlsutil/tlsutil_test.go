@@ -0,0 +1,283 @@
+/*
+Package tlsutil provides TLS configurations which reload certificates
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package tlsutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// newTestCert creates a certificate signed by the parent,
+// or a self signed CA if parent is nil
+func newTestCert(t *testing.T, name string, serial int64, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{
+			x509.ExtKeyUsageServerAuth,
+			x509.ExtKeyUsageClientAuth,
+		},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	signer := &testCert{cert: template, key: key}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer = parent
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer.cert, &key.PublicKey, signer.key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	return &testCert{cert: cert, key: key}
+}
+
+func (c *testCert) certPem() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw})
+}
+
+func (c *testCert) keyPem(t *testing.T) []byte {
+	der, err := x509.MarshalECPrivateKey(c.key)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func (c *testCert) tlsCertificate(t *testing.T) tls.Certificate {
+	cert, err := tls.X509KeyPair(c.certPem(), c.keyPem(t))
+	assert.NoError(t, err)
+	return cert
+}
+
+// writeFiles saves the certificate and the key in dir with
+// the modification time provided
+func (c *testCert) writeFiles(t *testing.T, dir string, modTime time.Time) (string, string) {
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	assert.NoError(t, ioutil.WriteFile(certFile, c.certPem(), 0600))
+	assert.NoError(t, ioutil.WriteFile(keyFile, c.keyPem(t), 0600))
+	assert.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	assert.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+	return certFile, keyFile
+}
+
+// startTestTLSServer accepts connections and writes a byte to the clients
+// which complete the handshake
+func startTestTLSServer(t *testing.T, config *tls.Config) net.Listener {
+	l, err := tls.Listen("tcp", "127.0.0.1:0", config)
+	assert.NoError(t, err)
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			if err := conn.(*tls.Conn).Handshake(); err == nil {
+				conn.Write([]byte("x"))
+			}
+			conn.Close()
+		}
+	}()
+	return l
+}
+
+// dialTestTLSServer returns the certificate of the server if the
+// connection was accepted
+func dialTestTLSServer(address string, config *tls.Config) (*x509.Certificate, error) {
+	conn, err := tls.Dial("tcp", address, config)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if _, err := conn.Read(make([]byte, 1)); err != nil {
+		return nil, err
+	}
+	return conn.ConnectionState().PeerCertificates[0], nil
+}
+
+func TestNewCertReloaderBadConfig(t *testing.T) {
+	_, err := NewCertReloader(nil)
+	assert.Error(t, err)
+
+	_, err = NewCertReloader(&Config{CertFile: "cert.pem"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "key")
+
+	_, err = NewCertReloader(&Config{
+		CertFile:   "cert.pem",
+		KeyFile:    "key.pem",
+		ClientAuth: true,
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "CA")
+
+	_, err = NewCertReloader(&Config{
+		CertFile: "/nonexistent/cert.pem",
+		KeyFile:  "/nonexistent/key.pem",
+	})
+	assert.Error(t, err)
+}
+
+func TestCertReloaderClientAuth(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tlsutil")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := newTestCert(t, "ca", 1, nil)
+	server := newTestCert(t, "server", 2, ca)
+	client := newTestCert(t, "client", 3, ca)
+	otherCa := newTestCert(t, "otherca", 4, nil)
+	untrusted := newTestCert(t, "untrusted", 5, otherCa)
+
+	certFile, keyFile := server.writeFiles(t, dir, time.Now())
+	caFile := filepath.Join(dir, "ca.pem")
+	assert.NoError(t, ioutil.WriteFile(caFile, ca.certPem(), 0600))
+
+	r, err := NewCertReloader(&Config{
+		CertFile:   certFile,
+		KeyFile:    keyFile,
+		CAFile:     caFile,
+		ClientAuth: true,
+	})
+	assert.NoError(t, err)
+	l := startTestTLSServer(t, r.ServerConfig())
+	defer l.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	// Trusted client
+	cert, err := dialTestTLSServer(l.Addr().String(), &tls.Config{
+		RootCAs:      roots,
+		Certificates: []tls.Certificate{client.tlsCertificate(t)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "server", cert.Subject.CommonName)
+
+	// No client certificate
+	_, err = dialTestTLSServer(l.Addr().String(), &tls.Config{
+		RootCAs: roots,
+	})
+	assert.Error(t, err)
+
+	// Client certificate from another CA
+	_, err = dialTestTLSServer(l.Addr().String(), &tls.Config{
+		RootCAs:      roots,
+		Certificates: []tls.Certificate{untrusted.tlsCertificate(t)},
+	})
+	assert.Error(t, err)
+
+	// In process client
+	cert, err = dialTestTLSServer(l.Addr().String(), r.LoopbackClientConfig())
+	assert.NoError(t, err)
+	assert.Equal(t, "server", cert.Subject.CommonName)
+
+	// Other node with a certificate from the same CA
+	nodeDir := filepath.Join(dir, "node")
+	assert.NoError(t, os.Mkdir(nodeDir, 0700))
+	nodeCertFile, nodeKeyFile := client.writeFiles(t, nodeDir, time.Now())
+	node, err := NewCertReloader(&Config{
+		CertFile: nodeCertFile,
+		KeyFile:  nodeKeyFile,
+		CAFile:   caFile,
+	})
+	assert.NoError(t, err)
+	cert, err = dialTestTLSServer(l.Addr().String(), node.ClientConfig())
+	assert.NoError(t, err)
+	assert.Equal(t, "server", cert.Subject.CommonName)
+
+	// The server must be signed by the CA
+	otherDir := filepath.Join(dir, "other")
+	assert.NoError(t, os.Mkdir(otherDir, 0700))
+	otherCertFile, otherKeyFile := untrusted.writeFiles(t, otherDir, time.Now())
+	otherCaFile := filepath.Join(otherDir, "ca.pem")
+	assert.NoError(t, ioutil.WriteFile(otherCaFile, otherCa.certPem(), 0600))
+	other, err := NewCertReloader(&Config{
+		CertFile: otherCertFile,
+		KeyFile:  otherKeyFile,
+		CAFile:   otherCaFile,
+	})
+	assert.NoError(t, err)
+	_, err = dialTestTLSServer(l.Addr().String(), other.ClientConfig())
+	assert.Error(t, err)
+}
+
+func TestCertReloaderReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tlsutil")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := newTestCert(t, "ca", 1, nil)
+	server := newTestCert(t, "server", 2, ca)
+	certFile, keyFile := server.writeFiles(t, dir, time.Now())
+
+	r, err := NewCertReloader(&Config{
+		CertFile: certFile,
+		KeyFile:  keyFile,
+	})
+	assert.NoError(t, err)
+	l := startTestTLSServer(t, r.ServerConfig())
+	defer l.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	cert, err := dialTestTLSServer(l.Addr().String(), &tls.Config{RootCAs: roots})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), cert.SerialNumber.Int64())
+
+	// Rotate the certificate
+	rotated := newTestCert(t, "server", 3, ca)
+	rotated.writeFiles(t, dir, time.Now().Add(time.Minute))
+	cert, err = dialTestTLSServer(l.Addr().String(), &tls.Config{RootCAs: roots})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), cert.SerialNumber.Int64())
+
+	// A bad rotation keeps the previous certificate
+	assert.NoError(t, ioutil.WriteFile(certFile, []byte("bad"), 0600))
+	later := time.Now().Add(2 * time.Minute)
+	assert.NoError(t, os.Chtimes(certFile, later, later))
+	cert, err = dialTestTLSServer(l.Addr().String(), &tls.Config{RootCAs: roots})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), cert.SerialNumber.Int64())
+}
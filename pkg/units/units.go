@@ -87,7 +87,7 @@ func String(b uint64) string {
 		return fmt.Sprintf("%.2f TiB", float64(b)/float64(TiB))
 	}
 	if b > GiB {
-		return fmt.Sprintf("%.1f TiB", float64(b)/float64(GiB))
+		return fmt.Sprintf("%.1f GiB", float64(b)/float64(GiB))
 	}
 	if b > MiB {
 		return fmt.Sprintf("%v MiB", b/MiB)
@@ -57,3 +57,11 @@ func TestParse(t *testing.T) {
 	testParse(t, "t", 1000*1000*1000*1000, 1024*1024*1024*1024)
 	testParse(t, "p", 1000*1000*1000*1000*1000, 1024*1024*1024*1024*1024)
 }
+
+func TestString(t *testing.T) {
+	require.Equal(t, "512 bytes", String(512))
+	require.Equal(t, "2 KiB", String(2*KiB))
+	require.Equal(t, "3 MiB", String(3*MiB))
+	require.Equal(t, "1.5 GiB", String(3*GiB/2))
+	require.Equal(t, "2.00 TiB", String(2*TiB))
+}
@@ -1,14 +1,19 @@
 package common
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	// TODO(pedge): what is this for?
 	_ "sync"
 
 	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
 )
 
 const (
@@ -18,12 +23,14 @@ const (
 type defaultStoreEnumerator struct {
 	driver string
 	kvdb   kvdb.Kvdb
+	index  *volumeIndex
 }
 
 func newDefaultStoreEnumerator(driver string, kvdb kvdb.Kvdb) *defaultStoreEnumerator {
 	return &defaultStoreEnumerator{
 		kvdb:   kvdb,
 		driver: driver,
+		index:  newVolumeIndex(driver, kvdb),
 	}
 }
 
@@ -43,8 +50,21 @@ func (e *defaultStoreEnumerator) Unlock(token interface{}) error {
 
 // CreateVol returns error if volume with the same ID already existe.
 func (e *defaultStoreEnumerator) CreateVol(vol *api.Volume) error {
-	_, err := e.kvdb.Create(e.volKey(vol.Id), vol, 0)
-	return err
+	kvp, err := e.index.lockWrites()
+	if err != nil {
+		return err
+	}
+	defer e.index.unlockWrites(kvp)
+
+	// The entries of an existing volume are kept if the creation fails
+	old, err := e.GetVol(vol.Id)
+	if err != nil {
+		old = nil
+	}
+	return e.index.update(old, vol, func() error {
+		_, err := e.kvdb.Create(e.volKey(vol.Id), vol, 0)
+		return err
+	})
 }
 
 // GetVol from volumeID.
@@ -56,14 +76,38 @@ func (e *defaultStoreEnumerator) GetVol(volumeID string) (*api.Volume, error) {
 
 // UpdateVol with vol
 func (e *defaultStoreEnumerator) UpdateVol(vol *api.Volume) error {
-	_, err := e.kvdb.Put(e.volKey(vol.Id), vol, 0)
-	return err
+	kvp, err := e.index.lockWrites()
+	if err != nil {
+		return err
+	}
+	defer e.index.unlockWrites(kvp)
+
+	old, err := e.GetVol(vol.Id)
+	if err != nil {
+		old = nil
+	}
+	return e.index.update(old, vol, func() error {
+		_, err := e.kvdb.Put(e.volKey(vol.Id), vol, 0)
+		return err
+	})
 }
 
 // DeleteVol. Returns error if volume does not exist.
 func (e *defaultStoreEnumerator) DeleteVol(volumeID string) error {
-	_, err := e.kvdb.Delete(e.volKey(volumeID))
-	return err
+	kvp, err := e.index.lockWrites()
+	if err != nil {
+		return err
+	}
+	defer e.index.unlockWrites(kvp)
+
+	old, err := e.GetVol(volumeID)
+	if err != nil {
+		old = nil
+	}
+	return e.index.update(old, nil, func() error {
+		_, err := e.kvdb.Delete(e.volKey(volumeID))
+		return err
+	})
 }
 
 // Inspect specified volumes.
@@ -105,6 +149,119 @@ func (e *defaultStoreEnumerator) Enumerate(
 	return volumes, nil
 }
 
+// EnumerateWithFilter returns a page of the volumes selected by the filter.
+// The volumes are looked up in the index when the filter has an indexed
+// field, otherwise all the volumes are read and matched against the filter.
+func (e *defaultStoreEnumerator) EnumerateWithFilter(
+	filter *api.VolumeFilter,
+	pageToken string,
+	maxResults int,
+) ([]*api.Volume, string, error) {
+	after, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var ids []string
+	if filter.IsEmpty() {
+		ids, err = e.volumeIDs()
+	} else {
+		var indexed bool
+		ids, indexed, err = e.index.candidates(filter, e.volumes)
+		if err == nil && !indexed {
+			return e.enumerateAll(filter, after, maxResults)
+		}
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	sort.Strings(ids)
+	start := sort.Search(len(ids), func(i int) bool { return ids[i] > after })
+	volumes := make([]*api.Volume, 0)
+	for _, id := range ids[start:] {
+		v, err := e.GetVol(id)
+		if err == kvdb.ErrNotFound {
+			continue
+		} else if err != nil {
+			return nil, "", err
+		}
+		if !filter.Match(v) {
+			continue
+		}
+		// A token is only returned when a volume is left for the next page
+		if maxResults > 0 && len(volumes) == maxResults {
+			return volumes, PageToken(volumes[len(volumes)-1].GetId()), nil
+		}
+		volumes = append(volumes, v)
+	}
+	return volumes, "", nil
+}
+
+// enumerateAll returns a page of the volumes selected by the filter after
+// matching all the volumes
+func (e *defaultStoreEnumerator) enumerateAll(
+	filter *api.VolumeFilter,
+	after string,
+	maxResults int,
+) ([]*api.Volume, string, error) {
+	vols, err := e.volumes()
+	if err != nil {
+		return nil, "", err
+	}
+	sort.Slice(vols, func(i, j int) bool { return vols[i].GetId() < vols[j].GetId() })
+
+	volumes := make([]*api.Volume, 0)
+	for _, v := range vols {
+		if v.GetId() <= after || !filter.Match(v) {
+			continue
+		}
+		if maxResults > 0 && len(volumes) == maxResults {
+			return volumes, PageToken(volumes[len(volumes)-1].GetId()), nil
+		}
+		volumes = append(volumes, v)
+	}
+	return volumes, "", nil
+}
+
+// volumes returns all the volumes of the driver
+func (e *defaultStoreEnumerator) volumes() ([]*api.Volume, error) {
+	kvp, err := e.kvdb.Enumerate(e.volKeyPrefix())
+	if err != nil {
+		return nil, err
+	}
+	volumes := make([]*api.Volume, 0, len(kvp))
+	for _, v := range kvp {
+		if isLockKey(v.Key) {
+			continue
+		}
+		elem := &api.Volume{}
+		if err := json.Unmarshal(v.Value, elem); err != nil {
+			logrus.Warnf("Failed to read volume %s: %v", v.Key, err)
+			continue
+		}
+		volumes = append(volumes, elem)
+	}
+	return volumes, nil
+}
+
+// volumeIDs returns the ids of all the volumes of the driver
+func (e *defaultStoreEnumerator) volumeIDs() ([]string, error) {
+	keys, err := e.kvdb.Keys(e.volKeyPrefix(), "/")
+	if err == kvdb.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if !isLockKey(key) {
+			ids = append(ids, key)
+		}
+	}
+	return ids, nil
+}
+
 // SnapEnumerate for specified volume
 func (e *defaultStoreEnumerator) SnapEnumerate(
 	volumeIDs []string,
@@ -149,6 +306,25 @@ func (e *defaultStoreEnumerator) volKeyPrefix() string {
 	return fmt.Sprintf("%s/%s/volumes/", keyBase, e.driver)
 }
 
+func isLockKey(key string) bool {
+	return strings.HasSuffix(key, ".lock")
+}
+
+// PageToken returns the token of the page of the volumes
+// whose ids follow the volume id
+func PageToken(volumeID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(volumeID))
+}
+
+// decodePageToken returns the volume id preceding the page
+func decodePageToken(token string) (string, error) {
+	id, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", volume.ErrEinval
+	}
+	return string(id), nil
+}
+
 func hasSubset(set map[string]string, subset map[string]string) bool {
 	if subset == nil || len(subset) == 0 {
 		return true
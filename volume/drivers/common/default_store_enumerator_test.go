@@ -1,11 +1,15 @@
 package common
 
 import (
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/proto/time"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/portworx/kvdb"
 	"github.com/portworx/kvdb/mem"
@@ -134,3 +138,149 @@ func newSnapVolume(snapID string, volumeID string) *api.Volume {
 		Source:  &api.Source{Parent: volumeID},
 	}
 }
+
+func TestEnumerateWithFilter(t *testing.T) {
+	kv := kvdb.Instance()
+	e := newDefaultStoreEnumerator("filter_test", kv)
+	for i, labels := range []map[string]string{
+		{"env": "prod", "tier": "db"},
+		{"env": "prod", "tier": "cache"},
+		{"env": "dev"},
+		{"tier": "db"},
+	} {
+		v := &api.Volume{
+			Id:      fmt.Sprintf("vol%d", i),
+			Locator: &api.VolumeLocator{Name: fmt.Sprintf("name%d", i), VolumeLabels: labels},
+			Ctime:   prototime.TimeToTimestamp(time.Unix(int64(i*100), 0)),
+			State:   api.VolumeState_VOLUME_STATE_DETACHED,
+			Status:  api.VolumeStatus_VOLUME_STATUS_UP,
+			Spec: &api.VolumeSpec{
+				Ownership: &api.Ownership{Owner: fmt.Sprintf("user%d", i%2)},
+			},
+		}
+		assert.NoError(t, e.CreateVol(v))
+	}
+	ids := func(vols []*api.Volume) []string {
+		ids := make([]string, len(vols))
+		for i, v := range vols {
+			ids[i] = v.GetId()
+		}
+		return ids
+	}
+
+	// Pages of all the volumes
+	vols, token, err := e.EnumerateWithFilter(nil, "", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vol0", "vol1", "vol2"}, ids(vols))
+	assert.NotEmpty(t, token)
+	vols, token, err = e.EnumerateWithFilter(nil, token, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vol3"}, ids(vols))
+	assert.Empty(t, token)
+
+	// No token is returned when the last page is full
+	vols, token, err = e.EnumerateWithFilter(nil, "", 4)
+	assert.NoError(t, err)
+	assert.Len(t, vols, 4)
+	assert.Empty(t, token)
+	vols, token, err = e.EnumerateWithFilter(&api.VolumeFilter{Owner: "user0"}, "", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vol0", "vol2"}, ids(vols))
+	assert.Empty(t, token)
+
+	_, _, err = e.EnumerateWithFilter(nil, "not a token!", 3)
+	assert.Equal(t, volume.ErrEinval, err)
+
+	// Indexed filters
+	selector, err := api.ParseLabelSelector("env in (prod,dev),tier")
+	assert.NoError(t, err)
+	vols, _, err = e.EnumerateWithFilter(&api.VolumeFilter{LabelSelector: selector}, "", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vol0", "vol1"}, ids(vols))
+
+	vols, token, err = e.EnumerateWithFilter(&api.VolumeFilter{Owner: "user1"}, "", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vol1"}, ids(vols))
+	vols, token, err = e.EnumerateWithFilter(&api.VolumeFilter{Owner: "user1"}, token, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vol3"}, ids(vols))
+	assert.Empty(t, token)
+
+	// Filters which are not indexed
+	selector, err = api.ParseLabelSelector("!env")
+	assert.NoError(t, err)
+	vols, _, err = e.EnumerateWithFilter(&api.VolumeFilter{LabelSelector: selector}, "", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vol3"}, ids(vols))
+	vols, _, err = e.EnumerateWithFilter(&api.VolumeFilter{
+		CreatedAfter:  prototime.TimeToTimestamp(time.Unix(50, 0)),
+		CreatedBefore: prototime.TimeToTimestamp(time.Unix(250, 0)),
+	}, "", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vol1", "vol2"}, ids(vols))
+
+	// The index follows the updates
+	v, err := e.GetVol("vol2")
+	assert.NoError(t, err)
+	v.State = api.VolumeState_VOLUME_STATE_ATTACHED
+	assert.NoError(t, e.UpdateVol(v))
+	filter := &api.VolumeFilter{State: api.VolumeState_VOLUME_STATE_ATTACHED}
+	vols, _, err = e.EnumerateWithFilter(filter, "", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vol2"}, ids(vols))
+	assert.NoError(t, e.DeleteVol("vol2"))
+	vols, _, err = e.EnumerateWithFilter(filter, "", 0)
+	assert.NoError(t, err)
+	assert.Empty(t, vols)
+	keys, err := kv.Keys(e.index.statePrefix(api.VolumeState_VOLUME_STATE_ATTACHED), "/")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// The index is rebuilt when it is missing
+	assert.NoError(t, kv.DeleteTree(e.index.prefix))
+	e = newDefaultStoreEnumerator("filter_test", kv)
+	vols, _, err = e.EnumerateWithFilter(&api.VolumeFilter{
+		Locator: &api.VolumeLocator{Name: "name3"},
+	}, "", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vol3"}, ids(vols))
+
+	for _, id := range []string{"vol0", "vol1", "vol3"} {
+		assert.NoError(t, e.DeleteVol(id))
+	}
+}
+
+func TestVolumeIndexConcurrentUpdates(t *testing.T) {
+	kv := kvdb.Instance()
+	e := newDefaultStoreEnumerator("concurrent_test", kv)
+	id := "vol"
+	assert.NoError(t, e.CreateVol(&api.Volume{Id: id}))
+
+	// Each update changes the name of the volume, only the
+	// entry of the last name must be left in the index
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, e.UpdateVol(&api.Volume{
+				Id:      id,
+				Locator: &api.VolumeLocator{Name: fmt.Sprintf("name%d", i)},
+			}))
+		}(i)
+	}
+	wg.Wait()
+
+	v, err := e.GetVol(id)
+	assert.NoError(t, err)
+	kvps, err := kv.Enumerate(e.index.prefix + "name/")
+	assert.NoError(t, err)
+	assert.Len(t, kvps, 1)
+	vols, _, err := e.EnumerateWithFilter(&api.VolumeFilter{
+		Locator: &api.VolumeLocator{Name: v.GetLocator().GetName()},
+	}, "", 0)
+	assert.NoError(t, err)
+	assert.Len(t, vols, 1)
+
+	assert.NoError(t, e.DeleteVol(id))
+}
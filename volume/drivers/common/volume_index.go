@@ -0,0 +1,274 @@
+package common
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/portworx/kvdb"
+	"github.com/sirupsen/logrus"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+const (
+	// indexVersion is changed when the layout of the index changes
+	// so that the index is rebuilt.
+	indexVersion = "1"
+)
+
+// volumeIndex maintains in the kvdb the ids of the volumes by name, label,
+// state, status and owner so that filtered enumerations do not have to
+// read all the volumes. Each entry of the index is a key made of the
+// indexed field, its value and the volume id, with the id as value.
+// The changes of the volumes and of the index are serialized across the
+// cluster by a kvdb lock so that concurrent updates cannot lose entries.
+type volumeIndex struct {
+	kvdb    kvdb.Kvdb
+	prefix  string
+	lockKey string
+
+	lock  sync.Mutex
+	ready bool
+}
+
+func newVolumeIndex(driver string, kvdb kvdb.Kvdb) *volumeIndex {
+	return &volumeIndex{
+		kvdb:    kvdb,
+		prefix:  fmt.Sprintf("%s/%s/index/", keyBase, driver),
+		lockKey: fmt.Sprintf("%s/%s/locks/index", keyBase, driver),
+	}
+}
+
+// lockWrites takes the lock of the index. It must be held while a volume
+// is read, written and its entries updated.
+func (x *volumeIndex) lockWrites() (*kvdb.KVPair, error) {
+	return x.kvdb.Lock(x.lockKey)
+}
+
+// unlockWrites releases the lock taken by lockWrites
+func (x *volumeIndex) unlockWrites(kvp *kvdb.KVPair) {
+	if err := x.kvdb.Unlock(kvp); err != nil {
+		logrus.Warnf("Failed to unlock the volume index %s: %v", x.prefix, err)
+	}
+}
+
+// update writes the volume which changes from old to new. Either volume
+// may be nil. The entries of the new volume are added before it is written
+// so that it is never missing from the index, and the entries of the old
+// volume which are no longer valid are removed once it has been written.
+// If the write fails, the entries added are removed. The lock of the index
+// must be held.
+func (x *volumeIndex) update(old, new *api.Volume, write func() error) error {
+	oldKeys := x.keys(old)
+	newKeys := x.keys(new)
+	for key := range newKeys {
+		if oldKeys[key] {
+			continue
+		}
+		if _, err := x.kvdb.Put(key, new.GetId(), 0); err != nil {
+			x.remove(newKeys, oldKeys)
+			return err
+		}
+	}
+	if err := write(); err != nil {
+		x.remove(newKeys, oldKeys)
+		return err
+	}
+	x.remove(oldKeys, newKeys)
+	return nil
+}
+
+// remove deletes the old entries which are not in keep. Failures are only
+// logged since the volumes found in the index are always matched against
+// the filter.
+func (x *volumeIndex) remove(old, keep map[string]bool) {
+	for key := range old {
+		if keep[key] {
+			continue
+		}
+		if _, err := x.kvdb.Delete(key); err != nil && err != kvdb.ErrNotFound {
+			logrus.Warnf("Failed to remove volume index entry %s: %v", key, err)
+		}
+	}
+}
+
+// keys returns the index entries of the volume
+func (x *volumeIndex) keys(v *api.Volume) map[string]bool {
+	keys := make(map[string]bool)
+	if v == nil {
+		return keys
+	}
+	id := url.PathEscape(v.GetId())
+	if name := v.GetLocator().GetName(); len(name) != 0 {
+		keys[x.namePrefix(name)+id] = true
+	}
+	for key, value := range v.GetLocator().GetVolumeLabels() {
+		keys[x.labelValuePrefix(key, value)+id] = true
+	}
+	keys[x.statePrefix(v.GetState())+id] = true
+	keys[x.statusPrefix(v.GetStatus())+id] = true
+	if owner := v.GetSpec().GetOwnership().GetOwner(); len(owner) != 0 {
+		keys[x.ownerPrefix(owner)+id] = true
+	}
+	return keys
+}
+
+// candidates returns the ids of the volumes which may be selected by the
+// filter. It returns false if none of the fields of the filter is indexed,
+// in which case all the volumes must be matched against the filter.
+func (x *volumeIndex) candidates(
+	filter *api.VolumeFilter,
+	volumes func() ([]*api.Volume, error),
+) ([]string, bool, error) {
+	var prefixes [][]string
+	if name := filter.GetLocator().GetName(); len(name) != 0 {
+		prefixes = append(prefixes, []string{x.namePrefix(name)})
+	}
+	for key, value := range filter.GetLocator().GetVolumeLabels() {
+		prefixes = append(prefixes, []string{x.labelValuePrefix(key, value)})
+	}
+	for _, r := range filter.GetLabelSelector() {
+		switch r.GetOperator() {
+		case api.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN:
+			values := make([]string, 0, len(r.GetValues()))
+			for _, value := range r.GetValues() {
+				values = append(values, x.labelValuePrefix(r.GetKey(), value))
+			}
+			prefixes = append(prefixes, values)
+		case api.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_EXISTS:
+			prefixes = append(prefixes, []string{x.labelPrefix(r.GetKey())})
+		}
+	}
+	if filter.GetState() != api.VolumeState_VOLUME_STATE_NONE {
+		prefixes = append(prefixes, []string{x.statePrefix(filter.GetState())})
+	}
+	if filter.GetStatus() != api.VolumeStatus_VOLUME_STATUS_NONE {
+		prefixes = append(prefixes, []string{x.statusPrefix(filter.GetStatus())})
+	}
+	if owner := filter.GetOwner(); len(owner) != 0 {
+		prefixes = append(prefixes, []string{x.ownerPrefix(owner)})
+	}
+	if len(prefixes) == 0 {
+		return nil, false, nil
+	}
+
+	if err := x.build(volumes); err != nil {
+		return nil, false, err
+	}
+
+	// The candidates are in the union of the entries of each
+	// field and in the intersection of the fields
+	var ids map[string]bool
+	for _, union := range prefixes {
+		found := make(map[string]bool)
+		for _, prefix := range union {
+			kvps, err := x.kvdb.Enumerate(prefix)
+			if err != nil && err != kvdb.ErrNotFound {
+				return nil, false, err
+			}
+			for _, kvp := range kvps {
+				id := string(kvp.Value)
+				if ids == nil || ids[id] {
+					found[id] = true
+				}
+			}
+		}
+		ids = found
+		if len(ids) == 0 {
+			break
+		}
+	}
+
+	candidates := make([]string, 0, len(ids))
+	for id := range ids {
+		candidates = append(candidates, id)
+	}
+	return candidates, true, nil
+}
+
+// build indexes all the volumes if the index has not been built yet,
+// for example for the volumes created before the index existed. The
+// volumes cannot change while the index is built as its lock is held.
+func (x *volumeIndex) build(volumes func() ([]*api.Volume, error)) error {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+	if x.ready {
+		return nil
+	}
+	if built, err := x.built(); err != nil || built {
+		x.ready = built
+		return err
+	}
+
+	kvp, err := x.lockWrites()
+	if err != nil {
+		return err
+	}
+	defer x.unlockWrites(kvp)
+	// Another node may have built the index while waiting for the lock
+	if built, err := x.built(); err != nil || built {
+		x.ready = built
+		return err
+	}
+
+	logrus.Infof("Building the volume index %s", x.prefix)
+	if err := x.kvdb.DeleteTree(x.prefix); err != nil && err != kvdb.ErrNotFound {
+		return err
+	}
+	vols, err := volumes()
+	if err != nil {
+		return err
+	}
+	for _, v := range vols {
+		for key := range x.keys(v) {
+			if _, err := x.kvdb.Put(key, v.GetId(), 0); err != nil {
+				return err
+			}
+		}
+	}
+	if _, err := x.kvdb.Put(x.versionKey(), indexVersion, 0); err != nil {
+		return err
+	}
+	x.ready = true
+	return nil
+}
+
+// built returns true if the current version of the index has been built
+func (x *volumeIndex) built() (bool, error) {
+	kvp, err := x.kvdb.Get(x.versionKey())
+	if err == kvdb.ErrNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return string(kvp.Value) == indexVersion, nil
+}
+
+func (x *volumeIndex) versionKey() string {
+	return x.prefix + "version"
+}
+
+func (x *volumeIndex) namePrefix(name string) string {
+	return x.prefix + "name/" + url.PathEscape(name) + "/"
+}
+
+func (x *volumeIndex) labelPrefix(key string) string {
+	return x.prefix + "labels/" + url.PathEscape(key) + "/"
+}
+
+func (x *volumeIndex) labelValuePrefix(key, value string) string {
+	return x.labelPrefix(key) + url.PathEscape(value) + "/"
+}
+
+func (x *volumeIndex) statePrefix(state api.VolumeState) string {
+	return x.prefix + "state/" + state.String() + "/"
+}
+
+func (x *volumeIndex) statusPrefix(status api.VolumeStatus) string {
+	return x.prefix + "status/" + status.String() + "/"
+}
+
+func (x *volumeIndex) ownerPrefix(owner string) string {
+	return x.prefix + "owner/" + url.PathEscape(owner) + "/"
+}
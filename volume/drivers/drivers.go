@@ -10,6 +10,7 @@ import (
 	"github.com/libopenstorage/openstorage/volume/drivers/buse"
 	"github.com/libopenstorage/openstorage/volume/drivers/coprhd"
 	"github.com/libopenstorage/openstorage/volume/drivers/fake"
+	"github.com/libopenstorage/openstorage/volume/drivers/loopback"
 	"github.com/libopenstorage/openstorage/volume/drivers/nfs"
 	"github.com/libopenstorage/openstorage/volume/drivers/pwx"
 	"github.com/libopenstorage/openstorage/volume/drivers/vfs"
@@ -33,6 +34,8 @@ var (
 		{DriverType: buse.Type, Name: buse.Name},
 		// COPRHD driver
 		{DriverType: coprhd.Type, Name: coprhd.Name},
+		// Loopback driver provisions storage from loop devices backed by local sparse files.
+		{DriverType: loopback.Type, Name: loopback.Name},
 		// NFS driver provisions storage from an NFS server.
 		{DriverType: nfs.Type, Name: nfs.Name},
 		// PWX driver provisions storage from PWX cluster.
@@ -45,14 +48,15 @@ var (
 
 	volumeDriverRegistry = volume.NewVolumeDriverRegistry(
 		map[string]func(map[string]string) (volume.VolumeDriver, error){
-			aws.Name:    aws.Init,
-			btrfs.Name:  btrfs.Init,
-			buse.Name:   buse.Init,
-			coprhd.Name: coprhd.Init,
-			nfs.Name:    nfs.Init,
-			pwx.Name:    pwx.Init,
-			vfs.Name:    vfs.Init,
-			fake.Name:   fake.Init,
+			aws.Name:      aws.Init,
+			btrfs.Name:    btrfs.Init,
+			buse.Name:     buse.Init,
+			coprhd.Name:   coprhd.Init,
+			loopback.Name: loopback.Init,
+			nfs.Name:      nfs.Init,
+			pwx.Name:      pwx.Init,
+			vfs.Name:      vfs.Init,
+			fake.Name:     fake.Init,
 		},
 	)
 )
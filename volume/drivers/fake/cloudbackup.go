@@ -0,0 +1,565 @@
+/*
+Package fake provides an in-memory fake driver implementation
+Copyright 2019 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/pborman/uuid"
+	"github.com/portworx/kvdb"
+)
+
+const (
+	// objectStoreKeyPrefix is the prefix of the keys of the in-process
+	// object store of the cloud backups. The objects of a backup are
+	// stored in the bucket of its credential under
+	// <objectStoreKeyPrefix>/<credential>/<backup>/.
+	objectStoreKeyPrefix = "/fake/objectstore"
+	// backupObject is the object of a backup describing it. It is uploaded
+	// once all the blocks of the backup have been uploaded.
+	backupObject = "backup"
+	// blocksObjectPrefix is the prefix of the objects of the blocks of a
+	// backup
+	blocksObjectPrefix = "blocks"
+	// schedKeyPrefix is the prefix of the keys of the backup schedules
+	schedKeyPrefix = "/fake/cloudbackup/schedules"
+
+	// metadataBackupType is the metadata of a backup set to full or
+	// incremental
+	metadataBackupType = "type"
+	// metadataBaseBackup is the metadata of an incremental backup set to
+	// the id of the backup it is based on
+	metadataBaseBackup = "base"
+)
+
+// cloudBackup is the backup object of a backup
+type cloudBackup struct {
+	Info      api.CloudBackupInfo
+	ClusterID string
+	// Volume is the volume at the time of the backup
+	Volume *api.Volume
+	// Blocks are the indexes of the blocks of the backup
+	Blocks []int64
+}
+
+// cloudOp is the last backup or restore of a volume
+type cloudOp struct {
+	status api.CloudBackupStatus
+	// srcVolumeID is the volume backed up, or the volume of the backup
+	// restored
+	srcVolumeID string
+	// stateChanged is signaled when the op is resumed or stopped
+	stateChanged *sync.Cond
+}
+
+func backupKey(credUUID, backupID string, object ...string) string {
+	return path.Join(append([]string{objectStoreKeyPrefix, credUUID, backupID}, object...)...)
+}
+
+func blockObject(index int64) string {
+	return path.Join(blocksObjectPrefix, strconv.FormatInt(index, 10))
+}
+
+// getBackup returns a backup of the bucket of a credential
+func (d *driver) getBackup(credUUID, backupID string) (*cloudBackup, error) {
+	var backup cloudBackup
+	if _, err := d.kv.GetVal(backupKey(credUUID, backupID, backupObject), &backup); err != nil {
+		if err == kvdb.ErrNotFound {
+			return nil, fmt.Errorf("Backup %s not found", backupID)
+		}
+		return nil, err
+	}
+	return &backup, nil
+}
+
+// enumerateBackups returns the backups of the bucket of a credential
+// selected by the request, the most recent first
+func (d *driver) enumerateBackups(input *api.CloudBackupGenericRequest) ([]*cloudBackup, error) {
+	if err := d.CredsValidate(input.CredentialUUID); err != nil {
+		return nil, err
+	}
+	kvp, err := d.kv.Enumerate(path.Join(objectStoreKeyPrefix, input.CredentialUUID) + "/")
+	if err != nil {
+		return nil, err
+	}
+	clusterID := input.ClusterID
+	if len(clusterID) == 0 {
+		clusterID = d.cl.localClusterID()
+	}
+	backups := make([]*cloudBackup, 0)
+	for _, v := range kvp {
+		if path.Base(v.Key) != backupObject {
+			continue
+		}
+		backup := &cloudBackup{}
+		if err := json.Unmarshal(v.Value, backup); err != nil {
+			return nil, err
+		}
+		if len(input.SrcVolumeID) != 0 && backup.Info.SrcVolumeID != input.SrcVolumeID {
+			continue
+		}
+		if !input.All && backup.ClusterID != clusterID {
+			continue
+		}
+		backups = append(backups, backup)
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Info.Timestamp.After(backups[j].Info.Timestamp)
+	})
+	return backups, nil
+}
+
+// deleteBackup deletes the objects of a backup
+func (d *driver) deleteBackup(credUUID, backupID string) error {
+	return d.kv.DeleteTree(backupKey(credUUID, backupID) + "/")
+}
+
+// startCloudOp registers a backup or a restore of a volume. It fails if
+// another op of the volume is in progress.
+func (d *driver) startCloudOp(
+	volumeID string,
+	srcVolumeID string,
+	backupID string,
+	opType api.CloudBackupOpType,
+) (*cloudOp, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if op, ok := d.cloudOps[volumeID]; ok && op.inProgress() {
+		return nil, fmt.Errorf("A %s of volume %s is already in progress",
+			strings.ToLower(string(op.status.OpType)),
+			volumeID)
+	}
+	op := &cloudOp{
+		status: api.CloudBackupStatus{
+			ID:        backupID,
+			OpType:    opType,
+			Status:    api.CloudBackupStatusActive,
+			StartTime: time.Now(),
+			NodeID:    d.cl.localNodeID(),
+		},
+		srcVolumeID:  srcVolumeID,
+		stateChanged: sync.NewCond(&d.lock),
+	}
+	d.cloudOps[volumeID] = op
+	return op, nil
+}
+
+func (op *cloudOp) inProgress() bool {
+	return op.status.Status == api.CloudBackupStatusActive ||
+		op.status.Status == api.CloudBackupStatusPaused
+}
+
+// transfer waits until the op is not paused and returns false if it has
+// been stopped. It must be called with the lock held.
+func (op *cloudOp) transfer() bool {
+	for op.status.Status == api.CloudBackupStatusPaused {
+		op.stateChanged.Wait()
+	}
+	return op.status.Status == api.CloudBackupStatusActive
+}
+
+// completeCloudOp completes an op and records it in the history. It must
+// be called with the lock held.
+func (d *driver) completeCloudOp(op *cloudOp, status api.CloudBackupStatusType, err error) {
+	if op.status.Status == api.CloudBackupStatusActive {
+		op.status.Status = status
+	}
+	op.status.CompletedTime = time.Now()
+	message := fmt.Sprintf("Cloudsnap %s completed successfully", op.status.OpType)
+	switch op.status.Status {
+	case api.CloudBackupStatusStopped:
+		message = fmt.Sprintf("Cloudsnap %s stopped", op.status.OpType)
+	case api.CloudBackupStatusFailed:
+		message = fmt.Sprintf("Cloudsnap %s failed: %v", op.status.OpType, err)
+		logrus.Warnf("%s %s of volume %s failed: %v",
+			op.status.OpType,
+			op.status.ID,
+			op.srcVolumeID,
+			err)
+	}
+	d.history = append(d.history, api.CloudBackupHistoryItem{
+		SrcVolumeID: op.srcVolumeID,
+		Timestamp:   op.status.CompletedTime,
+		Status:      message,
+	})
+}
+
+// CloudBackupCreate takes a point in time copy of the data of a volume and
+// uploads it block by block to the bucket of the credential in the
+// background. The backup is incremental if it is not full and the volume
+// has already been backed up.
+func (d *driver) CloudBackupCreate(input *api.CloudBackupCreateRequest) error {
+	v, err := d.getVolume(input.VolumeID)
+	if err != nil {
+		return err
+	}
+	backups, err := d.enumerateBackups(&api.CloudBackupGenericRequest{
+		SrcVolumeID:    v.Id,
+		CredentialUUID: input.CredentialUUID,
+	})
+	if err != nil {
+		return err
+	}
+
+	backup := &cloudBackup{
+		Info: api.CloudBackupInfo{
+			ID:            uuid.New(),
+			SrcVolumeID:   v.Id,
+			SrcVolumeName: v.GetLocator().GetName(),
+			Timestamp:     time.Now(),
+			Metadata:      map[string]string{metadataBackupType: "full"},
+			Status:        string(api.CloudBackupStatusDone),
+		},
+		ClusterID: d.cl.localClusterID(),
+		Volume:    v,
+	}
+	if !input.Full && len(backups) != 0 {
+		backup.Info.Metadata[metadataBackupType] = "incremental"
+		backup.Info.Metadata[metadataBaseBackup] = backups[0].Info.ID
+	}
+	op, err := d.startCloudOp(v.Id, v.Id, backup.Info.ID, api.CloudBackupOp)
+	if err != nil {
+		return err
+	}
+
+	d.lock.Lock()
+	data := d.data[v.Id].clone()
+	d.lock.Unlock()
+	for index := range data {
+		backup.Blocks = append(backup.Blocks, index)
+	}
+	sort.Slice(backup.Blocks, func(i, j int) bool {
+		return backup.Blocks[i] < backup.Blocks[j]
+	})
+
+	go d.upload(input.CredentialUUID, backup, data, op)
+	return nil
+}
+
+// upload uploads the blocks then the backup object of a backup
+func (d *driver) upload(credUUID string, backup *cloudBackup, data volumeData, op *cloudOp) {
+	for _, index := range backup.Blocks {
+		d.lock.Lock()
+		active := op.transfer()
+		d.lock.Unlock()
+		if !active {
+			break
+		}
+
+		_, err := d.kv.Put(backupKey(credUUID, backup.Info.ID, blockObject(index)), data[index], 0)
+		d.lock.Lock()
+		if err != nil {
+			d.completeCloudOp(op, api.CloudBackupStatusFailed, err)
+			d.lock.Unlock()
+			d.deleteBackup(credUUID, backup.Info.ID)
+			return
+		}
+		op.status.BytesDone += blockSize
+		d.lock.Unlock()
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if !op.transfer() {
+		d.completeCloudOp(op, api.CloudBackupStatusStopped, nil)
+		d.deleteBackup(credUUID, backup.Info.ID)
+		return
+	}
+	if _, err := d.kv.Put(backupKey(credUUID, backup.Info.ID, backupObject), backup, 0); err != nil {
+		d.completeCloudOp(op, api.CloudBackupStatusFailed, err)
+		d.deleteBackup(credUUID, backup.Info.ID)
+		return
+	}
+	d.completeCloudOp(op, api.CloudBackupStatusDone, nil)
+}
+
+// CloudBackupRestore creates a volume from a backup and downloads the
+// blocks of the backup to it in the background
+func (d *driver) CloudBackupRestore(
+	input *api.CloudBackupRestoreRequest,
+) (*api.CloudBackupRestoreResponse, error) {
+	if len(input.NodeID) != 0 && input.NodeID != d.cl.localNodeID() {
+		return nil, fmt.Errorf("Backups can only be restored on node %s", d.cl.localNodeID())
+	}
+	if err := d.CredsValidate(input.CredentialUUID); err != nil {
+		return nil, err
+	}
+	backup, err := d.getBackup(input.CredentialUUID, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := input.RestoreVolumeName
+	if len(name) == 0 {
+		name = "restore-" + backup.Info.ID
+	}
+	labels := make(map[string]string)
+	for k, v := range backup.Volume.GetLocator().GetVolumeLabels() {
+		labels[k] = v
+	}
+	spec := *backup.Volume.GetSpec()
+	volumeID, err := d.create(
+		&api.VolumeLocator{Name: name, VolumeLabels: labels},
+		&api.Source{},
+		&spec,
+		false)
+	if err != nil {
+		return nil, err
+	}
+	op, err := d.startCloudOp(volumeID, backup.Info.SrcVolumeID, backup.Info.ID, api.CloudRestoreOp)
+	if err != nil {
+		return nil, err
+	}
+
+	go d.download(input.CredentialUUID, backup, volumeID, op)
+	return &api.CloudBackupRestoreResponse{RestoreVolumeID: volumeID}, nil
+}
+
+// download downloads the blocks of a backup to a volume
+func (d *driver) download(credUUID string, backup *cloudBackup, volumeID string, op *cloudOp) {
+	for _, index := range backup.Blocks {
+		d.lock.Lock()
+		active := op.transfer()
+		d.lock.Unlock()
+		if !active {
+			break
+		}
+
+		kvp, err := d.kv.Get(backupKey(credUUID, backup.Info.ID, blockObject(index)))
+		d.lock.Lock()
+		if err != nil {
+			d.completeCloudOp(op, api.CloudBackupStatusFailed, err)
+			d.lock.Unlock()
+			return
+		}
+		if data, ok := d.data[volumeID]; ok {
+			data[index] = kvp.Value
+		}
+		op.status.BytesDone += blockSize
+		d.lock.Unlock()
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if !op.transfer() {
+		d.completeCloudOp(op, api.CloudBackupStatusStopped, nil)
+		return
+	}
+	d.completeCloudOp(op, api.CloudBackupStatusDone, nil)
+}
+
+// CloudBackupEnumerate returns the backups of the bucket of the credential
+// of the cluster, or of all the clusters
+func (d *driver) CloudBackupEnumerate(
+	input *api.CloudBackupEnumerateRequest,
+) (*api.CloudBackupEnumerateResponse, error) {
+	backups, err := d.enumerateBackups(&input.CloudBackupGenericRequest)
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.CloudBackupEnumerateResponse{
+		Backups: make([]api.CloudBackupInfo, 0, len(backups)),
+	}
+	for _, backup := range backups {
+		resp.Backups = append(resp.Backups, backup.Info)
+	}
+	return resp, nil
+}
+
+// CloudBackupDelete deletes a backup unless incremental backups are based
+// on it and the deletion is not forced
+func (d *driver) CloudBackupDelete(input *api.CloudBackupDeleteRequest) error {
+	backup, err := d.getBackup(input.CredentialUUID, input.ID)
+	if err != nil {
+		return err
+	}
+	if !input.Force {
+		backups, err := d.enumerateBackups(&api.CloudBackupGenericRequest{
+			SrcVolumeID:    backup.Info.SrcVolumeID,
+			CredentialUUID: input.CredentialUUID,
+			All:            true,
+		})
+		if err != nil {
+			return err
+		}
+		for _, b := range backups {
+			if b.Info.Metadata[metadataBaseBackup] == input.ID {
+				return fmt.Errorf("Backup %s has incremental backups based on it", input.ID)
+			}
+		}
+	}
+	return d.deleteBackup(input.CredentialUUID, input.ID)
+}
+
+// CloudBackupDeleteAll deletes the backups selected by the request
+func (d *driver) CloudBackupDeleteAll(input *api.CloudBackupDeleteAllRequest) error {
+	backups, err := d.enumerateBackups(&input.CloudBackupGenericRequest)
+	if err != nil {
+		return err
+	}
+	for _, backup := range backups {
+		if err := d.deleteBackup(input.CredentialUUID, backup.Info.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CloudBackupStatus returns the status of the last backup or restore of the
+// volumes, keyed by the id of the volume backed up or restored
+func (d *driver) CloudBackupStatus(
+	input *api.CloudBackupStatusRequest,
+) (*api.CloudBackupStatusResponse, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	resp := &api.CloudBackupStatusResponse{
+		Statuses: make(map[string]api.CloudBackupStatus),
+	}
+	for volumeID, op := range d.cloudOps {
+		if len(input.SrcVolumeID) == 0 || input.SrcVolumeID == volumeID {
+			resp.Statuses[volumeID] = op.status
+		}
+	}
+	return resp, nil
+}
+
+// CloudBackupCatalog returns the objects of a backup
+func (d *driver) CloudBackupCatalog(
+	input *api.CloudBackupCatalogRequest,
+) (*api.CloudBackupCatalogResponse, error) {
+	backup, err := d.getBackup(input.CredentialUUID, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.CloudBackupCatalogResponse{
+		Contents: []string{backupObject},
+	}
+	for _, index := range backup.Blocks {
+		resp.Contents = append(resp.Contents, blockObject(index))
+	}
+	return resp, nil
+}
+
+// CloudBackupHistory returns the backups and restores of a volume, or of
+// all the volumes
+func (d *driver) CloudBackupHistory(
+	input *api.CloudBackupHistoryRequest,
+) (*api.CloudBackupHistoryResponse, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	resp := &api.CloudBackupHistoryResponse{
+		HistoryList: make([]api.CloudBackupHistoryItem, 0),
+	}
+	for _, item := range d.history {
+		if len(input.SrcVolumeID) == 0 || input.SrcVolumeID == item.SrcVolumeID {
+			resp.HistoryList = append(resp.HistoryList, item)
+		}
+	}
+	return resp, nil
+}
+
+// CloudBackupStateChange pauses, resumes or stops the backup or the restore
+// in progress of a volume
+func (d *driver) CloudBackupStateChange(input *api.CloudBackupStateChangeRequest) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	op, ok := d.cloudOps[input.SrcVolumeID]
+	if !ok || !op.inProgress() {
+		return fmt.Errorf("No backup or restore of volume %s in progress", input.SrcVolumeID)
+	}
+
+	switch input.RequestedState {
+	case api.CloudBackupRequestedStatePause:
+		if op.status.Status != api.CloudBackupStatusActive {
+			return fmt.Errorf("%s of volume %s is not active", op.status.OpType, input.SrcVolumeID)
+		}
+		op.status.Status = api.CloudBackupStatusPaused
+	case api.CloudBackupRequestedStateResume:
+		if op.status.Status != api.CloudBackupStatusPaused {
+			return fmt.Errorf("%s of volume %s is not paused", op.status.OpType, input.SrcVolumeID)
+		}
+		op.status.Status = api.CloudBackupStatusActive
+	case api.CloudBackupRequestedStateStop:
+		op.status.Status = api.CloudBackupStatusStopped
+	default:
+		return fmt.Errorf("Invalid requested state %q", input.RequestedState)
+	}
+	op.stateChanged.Broadcast()
+	return nil
+}
+
+// CloudBackupSchedCreate records a backup schedule of a volume. The
+// schedules are not run by the driver.
+func (d *driver) CloudBackupSchedCreate(
+	input *api.CloudBackupSchedCreateRequest,
+) (*api.CloudBackupSchedCreateResponse, error) {
+	if _, err := d.getVolume(input.SrcVolumeID); err != nil {
+		return nil, err
+	}
+	if err := d.CredsValidate(input.CredentialUUID); err != nil {
+		return nil, err
+	}
+	if len(input.Schedule) == 0 {
+		return nil, fmt.Errorf("Backup schedule of volume %s is empty", input.SrcVolumeID)
+	}
+
+	id := uuid.New()
+	if _, err := d.kv.Put(schedKeyPrefix+"/"+id, &input.CloudBackupScheduleInfo, 0); err != nil {
+		return nil, err
+	}
+	return &api.CloudBackupSchedCreateResponse{UUID: id}, nil
+}
+
+// CloudBackupSchedDelete deletes a backup schedule
+func (d *driver) CloudBackupSchedDelete(input *api.CloudBackupSchedDeleteRequest) error {
+	if _, err := d.kv.Delete(schedKeyPrefix + "/" + input.UUID); err != nil {
+		if err == kvdb.ErrNotFound {
+			return fmt.Errorf("Backup schedule %s not found", input.UUID)
+		}
+		return err
+	}
+	return nil
+}
+
+// CloudBackupSchedEnumerate returns the backup schedules keyed by their id
+func (d *driver) CloudBackupSchedEnumerate() (*api.CloudBackupSchedEnumerateResponse, error) {
+	kvp, err := d.kv.Enumerate(schedKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.CloudBackupSchedEnumerateResponse{
+		Schedules: make(map[string]api.CloudBackupScheduleInfo, len(kvp)),
+	}
+	for _, v := range kvp {
+		var sched api.CloudBackupScheduleInfo
+		if err := json.Unmarshal(v.Value, &sched); err != nil {
+			return nil, err
+		}
+		resp.Schedules[path.Base(v.Key)] = sched
+	}
+	return resp, nil
+}
@@ -0,0 +1,172 @@
+/*
+Package fake provides an in-memory fake driver implementation
+Copyright 2019 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fake
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/libopenstorage/openstorage/alert"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/cluster"
+	"github.com/portworx/kvdb"
+)
+
+// Types of the alerts raised by the driver
+const (
+	alertNodeJoined int64 = iota + 1
+	alertVolumeCreated
+	alertVolumeDeleted
+)
+
+// clusterListener raises the alerts of the driver once the node has joined
+// its cluster. Without a cluster, the driver raises no alert.
+type clusterListener struct {
+	cluster.NullClusterListener
+	kv kvdb.Kvdb
+
+	lock      sync.Mutex
+	nodeID    string
+	clusterID string
+	alerts    alert.Alert
+}
+
+func (cl *clusterListener) Init(
+	self *api.Node,
+	clusterInfo *cluster.ClusterInfo,
+) (cluster.FinalizeInitCb, error) {
+	return nil, nil
+}
+
+func (cl *clusterListener) Join(
+	self *api.Node,
+	initState *cluster.ClusterInitState,
+	handleNotifications cluster.ClusterNotify,
+) error {
+	alerts, err := alert.New(alert.Name, initState.ClusterInfo.Id, cl.kv)
+	if err != nil {
+		return err
+	}
+	cl.lock.Lock()
+	cl.nodeID = self.Id
+	cl.clusterID = initState.ClusterInfo.Id
+	cl.alerts = alerts
+	cl.lock.Unlock()
+
+	cl.raise(
+		api.ResourceType_RESOURCE_TYPE_NODE,
+		self.Id,
+		alertNodeJoined,
+		fmt.Sprintf("Node %s joined cluster %s", self.Id, initState.ClusterInfo.Id))
+	return nil
+}
+
+func (cl *clusterListener) String() string {
+	return Name
+}
+
+// QuorumMember returns true as every node of the driver provides storage
+func (cl *clusterListener) QuorumMember(node *api.Node) bool {
+	return true
+}
+
+func (cl *clusterListener) EnumerateAlerts(
+	timeStart, timeEnd time.Time,
+	resource api.ResourceType,
+) (*api.Alerts, error) {
+	alerts, err := cl.alertClient()
+	if err != nil {
+		return nil, err
+	}
+	list, err := alerts.EnumerateWithinTimeRange(timeStart, timeEnd, resource)
+	if err != nil {
+		return nil, err
+	}
+	return &api.Alerts{Alert: list}, nil
+}
+
+func (cl *clusterListener) ClearAlert(resource api.ResourceType, alertID int64) error {
+	alerts, err := cl.alertClient()
+	if err != nil {
+		return err
+	}
+	return alerts.Clear(resource, alertID, 0)
+}
+
+func (cl *clusterListener) EraseAlert(resource api.ResourceType, alertID int64) error {
+	alerts, err := cl.alertClient()
+	if err != nil {
+		return err
+	}
+	return alerts.Erase(resource, alertID)
+}
+
+// alertClient returns the alert client of the cluster of the node
+func (cl *clusterListener) alertClient() (alert.Alert, error) {
+	cl.lock.Lock()
+	defer cl.lock.Unlock()
+	if cl.alerts == nil {
+		return nil, fmt.Errorf("Node has not joined a cluster")
+	}
+	return cl.alerts, nil
+}
+
+// raise raises an alert of the driver if the node has joined its cluster
+func (cl *clusterListener) raise(
+	resource api.ResourceType,
+	resourceID string,
+	alertType int64,
+	message string,
+) {
+	alerts, err := cl.alertClient()
+	if err != nil {
+		return
+	}
+	if err := alerts.Raise(&api.Alert{
+		AlertType:  alertType,
+		Severity:   api.SeverityType_SEVERITY_TYPE_NOTIFY,
+		Message:    message,
+		ResourceId: resourceID,
+		Resource:   resource,
+	}); err != nil {
+		logrus.Warnf("Unable to raise alert %q: %v", message, err)
+	}
+}
+
+// localNodeID returns the id of the node in its cluster, or its host name
+// if it has not joined a cluster
+func (cl *clusterListener) localNodeID() string {
+	cl.lock.Lock()
+	defer cl.lock.Unlock()
+	if len(cl.nodeID) != 0 {
+		return cl.nodeID
+	}
+	hostname, _ := os.Hostname()
+	return hostname
+}
+
+// localClusterID returns the id of the cluster of the node, which is empty
+// if it has not joined a cluster
+func (cl *clusterListener) localClusterID() string {
+	cl.lock.Lock()
+	defer cl.lock.Unlock()
+	return cl.clusterID
+}
@@ -0,0 +1,88 @@
+/*
+Package fake provides an in-memory fake driver implementation
+Copyright 2019 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fake
+
+import (
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+)
+
+// blockSize is the size of the blocks of the data of the volumes
+const blockSize = 4096
+
+// volumeData is the data of a volume kept in memory by blocks indexed by
+// their offset divided by blockSize. The blocks which have never been
+// written are not allocated and read as zeros.
+type volumeData map[int64][]byte
+
+// readAt reads len(buf) bytes from offset
+func (data volumeData) readAt(buf []byte, offset int64) {
+	for n := 0; n < len(buf); {
+		index, start := (offset+int64(n))/blockSize, (offset+int64(n))%blockSize
+		block, ok := data[index]
+		if !ok {
+			block = make([]byte, blockSize)
+		}
+		n += copy(buf[n:], block[start:])
+	}
+}
+
+// writeAt writes buf at offset, allocating the blocks written for the
+// first time
+func (data volumeData) writeAt(buf []byte, offset int64) {
+	for n := 0; n < len(buf); {
+		index, start := (offset+int64(n))/blockSize, (offset+int64(n))%blockSize
+		block, ok := data[index]
+		if !ok {
+			block = make([]byte, blockSize)
+			data[index] = block
+		}
+		n += copy(block[start:], buf[n:])
+	}
+}
+
+// clone returns a copy of the data
+func (data volumeData) clone() volumeData {
+	c := make(volumeData, len(data))
+	for index, block := range data {
+		c[index] = append([]byte(nil), block...)
+	}
+	return c
+}
+
+// usedBytes returns the size of the blocks allocated
+func (data volumeData) usedBytes() uint64 {
+	return uint64(len(data)) * blockSize
+}
+
+// volumeStats are the I/O stats of a volume
+type volumeStats struct {
+	// total are the stats since the volume has been created
+	total api.Stats
+	// last are the total stats when the stats were last reported
+	// without being cumulative
+	last api.Stats
+	// lastTime is the time when the stats were last reported without
+	// being cumulative
+	lastTime time.Time
+}
+
+// elapsedMs returns the number of milliseconds since start
+func elapsedMs(start time.Time) uint64 {
+	return uint64(time.Since(start) / time.Millisecond)
+}
@@ -19,12 +19,15 @@ package fake
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
-	"strings"
-
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/cluster"
+	"github.com/libopenstorage/openstorage/pkg/options"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/libopenstorage/openstorage/volume/drivers/common"
 	"github.com/pborman/uuid"
@@ -37,15 +40,20 @@ const (
 	Type           = api.DriverType_DRIVER_TYPE_BLOCK
 )
 
-// Implements the open storage volume interface.
+// Implements the open storage volume interface. The data, the stats and
+// the operations in progress of the volumes are kept in memory and are
+// lost when the driver restarts.
 type driver struct {
-	volume.IODriver
 	volume.StoreEnumerator
-	volume.StatsDriver
-	volume.QuiesceDriver
-	volume.CredsDriver
-	volume.CloudBackupDriver
 	kv kvdb.Kvdb
+	cl *clusterListener
+
+	lock     sync.Mutex
+	data     map[string]volumeData
+	stats    map[string]*volumeStats
+	quiesced map[string]*quiesceOp
+	cloudOps map[string]*cloudOp
+	history  []api.CloudBackupHistoryItem
 }
 
 type fakeCred struct {
@@ -53,14 +61,21 @@ type fakeCred struct {
 	Params map[string]string
 }
 
+// quiesceOp is the quiesce of a volume
+type quiesceOp struct {
+	id    string
+	timer *time.Timer
+}
+
 func Init(params map[string]string) (volume.VolumeDriver, error) {
 	inst := &driver{
-		IODriver:          volume.IONotSupported,
-		StoreEnumerator:   common.NewDefaultStoreEnumerator(Name, kvdb.Instance()),
-		StatsDriver:       volume.StatsNotSupported,
-		QuiesceDriver:     volume.QuiesceNotSupported,
-		CloudBackupDriver: volume.CloudBackupNotSupported,
-		kv:                kvdb.Instance(),
+		StoreEnumerator: common.NewDefaultStoreEnumerator(Name, kvdb.Instance()),
+		kv:              kvdb.Instance(),
+		cl:              &clusterListener{kv: kvdb.Instance()},
+		data:            make(map[string]volumeData),
+		stats:           make(map[string]*volumeStats),
+		quiesced:        make(map[string]*quiesceOp),
+		cloudOps:        make(map[string]*cloudOp),
 	}
 
 	volumeInfo, err := inst.StoreEnumerator.Enumerate(&api.VolumeLocator{}, nil)
@@ -73,6 +88,14 @@ func Init(params map[string]string) (volume.VolumeDriver, error) {
 		}
 	}
 
+	c, err := cluster.Inst()
+	if err != nil {
+		logrus.Println("Fake driver initializing in single node mode")
+	} else {
+		logrus.Println("Fake driver initializing in clustered mode")
+		c.AddEventListener(inst.cl)
+	}
+
 	logrus.Println("Fake driver initialized")
 	return inst, nil
 }
@@ -90,6 +113,31 @@ func (d *driver) Status() [][2]string {
 	return [][2]string{}
 }
 
+// getVolume returns the volume of an id or of a name
+func (d *driver) getVolume(volumeID string) (*api.Volume, error) {
+	if v, err := d.GetVol(volumeID); err == nil {
+		return v, nil
+	}
+	if len(volumeID) != 0 {
+		vols, err := d.StoreEnumerator.Enumerate(&api.VolumeLocator{Name: volumeID}, nil)
+		if err == nil && len(vols) == 1 {
+			return vols[0], nil
+		}
+	}
+	return nil, volume.ErrEnoEnt
+}
+
+// Inspect returns the volumes found among the ids or names
+func (d *driver) Inspect(volumeIDs []string) ([]*api.Volume, error) {
+	vols := make([]*api.Volume, 0, len(volumeIDs))
+	for _, id := range volumeIDs {
+		if v, err := d.getVolume(id); err == nil {
+			vols = append(vols, v)
+		}
+	}
+	return vols, nil
+}
+
 //
 // These functions below implement the volume driver interface.
 //
@@ -99,9 +147,28 @@ func (d *driver) Create(
 	source *api.Source,
 	spec *api.VolumeSpec) (string, error) {
 
+	return d.create(locator, source, spec, false)
+}
+
+// create creates a volume, copying the data of its parent if it has one
+func (d *driver) create(
+	locator *api.VolumeLocator,
+	source *api.Source,
+	spec *api.VolumeSpec,
+	readonly bool,
+) (string, error) {
+
+	if len(locator.GetName()) == 0 {
+		return "", fmt.Errorf("Cannot create a volume with an empty volume name")
+	}
 	if spec.Size == 0 {
 		return "", fmt.Errorf("Volume size cannot be zero")
 	}
+	if vols, err := d.StoreEnumerator.Enumerate(&api.VolumeLocator{Name: locator.Name}, nil); err != nil {
+		return "", err
+	} else if len(vols) != 0 {
+		return "", fmt.Errorf("Volume with name %s already exists", locator.Name)
+	}
 
 	volumeID := strings.TrimSuffix(uuid.New(), "\n")
 
@@ -121,26 +188,58 @@ func (d *driver) Create(
 		source,
 		spec,
 	)
+	v.Readonly = readonly
 
 	if err := d.CreateVol(v); err != nil {
 		return "", err
 	}
+
+	d.lock.Lock()
+	if parent := source.GetParent(); len(parent) != 0 {
+		d.data[v.Id] = d.data[parent].clone()
+	} else {
+		d.data[v.Id] = make(volumeData)
+	}
+	d.stats[v.Id] = &volumeStats{lastTime: time.Now()}
+	d.lock.Unlock()
+
+	d.cl.raise(
+		api.ResourceType_RESOURCE_TYPE_VOLUME,
+		v.Id,
+		alertVolumeCreated,
+		fmt.Sprintf("Volume %s created", locator.Name))
 	return v.Id, nil
 }
 
 func (d *driver) Delete(volumeID string) error {
-	_, err := d.GetVol(volumeID)
+	v, err := d.getVolume(volumeID)
 	if err != nil {
 		logrus.Println(err)
 		return err
 	}
 
-	err = d.DeleteVol(volumeID)
+	err = d.DeleteVol(v.Id)
 	if err != nil {
 		logrus.Println(err)
 		return err
 	}
 
+	d.lock.Lock()
+	delete(d.data, v.Id)
+	delete(d.stats, v.Id)
+	if op, ok := d.quiesced[v.Id]; ok {
+		if op.timer != nil {
+			op.timer.Stop()
+		}
+		delete(d.quiesced, v.Id)
+	}
+	d.lock.Unlock()
+
+	d.cl.raise(
+		api.ResourceType_RESOURCE_TYPE_VOLUME,
+		v.Id,
+		alertVolumeDeleted,
+		fmt.Sprintf("Volume %s deleted", v.GetLocator().GetName()))
 	return nil
 }
 
@@ -148,51 +247,90 @@ func (d *driver) MountedAt(mountpath string) string {
 	return ""
 }
 
+// Mount records a mount path of an attached volume. Mount, Unmount, Attach,
+// Detach and Set hold the driver lock while they update a volume so that
+// concurrent updates are not lost.
 func (d *driver) Mount(volumeID string, mountpath string, options map[string]string) error {
-	v, err := d.GetVol(volumeID)
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	v, err := d.getVolume(volumeID)
 	if err != nil {
 		logrus.Println(err)
 		return err
 	}
+	if v.GetState() != api.VolumeState_VOLUME_STATE_ATTACHED {
+		return volume.ErrVolDetached
+	}
+	for _, path := range v.AttachPath {
+		if path == mountpath {
+			return nil
+		}
+	}
 
 	v.AttachPath = append(v.AttachPath, mountpath)
 	return d.UpdateVol(v)
 }
 
+// Unmount removes a mount path of a volume. A quiesced volume cannot be
+// unmounted.
 func (d *driver) Unmount(volumeID string, mountpath string, options map[string]string) error {
-	v, err := d.GetVol(volumeID)
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	v, err := d.getVolume(volumeID)
 	if err != nil {
 		return err
 	}
-	if len(v.AttachPath) == 0 {
-		return fmt.Errorf("Device %v not mounted", volumeID)
+	paths := make([]string, 0, len(v.AttachPath))
+	for _, path := range v.AttachPath {
+		if path != mountpath {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == len(v.AttachPath) {
+		return fmt.Errorf("Device %v not mounted at %v", volumeID, mountpath)
+	}
+	if _, quiesced := d.quiesced[v.Id]; quiesced {
+		return fmt.Errorf("Volume %v is quiesced", volumeID)
 	}
 
-	v.AttachPath = nil
+	v.AttachPath = paths
 	return d.UpdateVol(v)
 }
 
+// Snapshot creates a snapshot or a clone of a volume with a copy of its data
 func (d *driver) Snapshot(volumeID string, readonly bool, locator *api.VolumeLocator) (string, error) {
-	volIDs := []string{volumeID}
-	vols, err := d.Inspect(volIDs)
+	v, err := d.getVolume(volumeID)
 	if err != nil {
-		return "", nil
+		return "", err
 	}
-	source := &api.Source{Parent: volumeID}
+	spec := *v.GetSpec()
 	logrus.Infof("Creating snap vol name: %s", locator.Name)
-	newVolumeID, err := d.Create(locator, source, vols[0].Spec)
-	if err != nil {
-		return "", nil
-	}
-
-	return newVolumeID, nil
+	return d.create(locator, &api.Source{Parent: v.Id}, &spec, readonly)
 }
 
+// Restore replaces the data of a detached volume with the data of one of
+// its snapshots
 func (d *driver) Restore(volumeID string, snapID string) error {
-	if _, err := d.Inspect([]string{volumeID, snapID}); err != nil {
+	v, err := d.getVolume(volumeID)
+	if err != nil {
+		return err
+	}
+	snap, err := d.getVolume(snapID)
+	if err != nil {
 		return err
 	}
+	if snap.GetSource().GetParent() != v.Id {
+		return fmt.Errorf("Volume %s is not a snapshot of volume %s", snapID, volumeID)
+	}
+	if v.GetState() == api.VolumeState_VOLUME_STATE_ATTACHED {
+		return volume.ErrVolAttached
+	}
 
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.data[v.Id] = d.data[snap.Id].clone()
 	return nil
 }
 
@@ -202,28 +340,267 @@ func (d *driver) SnapshotGroup(groupID string, labels map[string]string) (*api.G
 	return nil, volume.ErrNotSupported
 }
 
+// Attach attaches the volume to the node and returns its device. Encrypted
+// volumes must be attached with a secret.
 func (d *driver) Attach(volumeID string, attachOptions map[string]string) (string, error) {
-	return "/dev/fake/" + volumeID, nil
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	v, err := d.getVolume(volumeID)
+	if err != nil {
+		return "", err
+	}
+	if v.GetSpec().GetEncrypted() &&
+		len(attachOptions[options.OptionsSecret]) == 0 &&
+		len(attachOptions[options.OptionsSecretKey]) == 0 {
+		return "", fmt.Errorf("Volume %s is encrypted and requires a secret", volumeID)
+	}
+	if v.GetState() == api.VolumeState_VOLUME_STATE_ATTACHED {
+		return v.DevicePath, nil
+	}
+
+	v.DevicePath = "/dev/fake/" + v.Id
+	v.AttachedOn = d.cl.localNodeID()
+	v.AttachedState = api.AttachState_ATTACH_STATE_EXTERNAL
+	v.State = api.VolumeState_VOLUME_STATE_ATTACHED
+	if err := d.UpdateVol(v); err != nil {
+		return "", err
+	}
+	return v.DevicePath, nil
 }
 
+// Detach detaches the volume from the node once it has been unmounted.
+// Detaching a detached volume does nothing.
 func (d *driver) Detach(volumeID string, options map[string]string) error {
-	return nil
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	v, err := d.getVolume(volumeID)
+	if err != nil {
+		return err
+	}
+	if v.GetState() != api.VolumeState_VOLUME_STATE_ATTACHED {
+		return nil
+	}
+	if len(v.AttachPath) != 0 {
+		return fmt.Errorf("Volume %s is mounted at %v", volumeID, v.AttachPath)
+	}
+
+	v.DevicePath = ""
+	v.AttachedOn = ""
+	v.State = api.VolumeState_VOLUME_STATE_DETACHED
+	return d.UpdateVol(v)
 }
 
+// Set updates the locator and replaces the spec of the volume. Volumes can
+// only be grown.
 func (d *driver) Set(volumeID string, locator *api.VolumeLocator, spec *api.VolumeSpec) error {
-	if spec != nil {
-		return volume.ErrNotSupported
-	}
-	v, err := d.GetVol(volumeID)
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	v, err := d.getVolume(volumeID)
 	if err != nil {
 		return err
 	}
 	if locator != nil {
 		v.Locator = locator
 	}
+	if spec != nil {
+		if spec.GetSize() < v.GetSpec().GetSize() {
+			return fmt.Errorf("Volume %s cannot be shrunk", volumeID)
+		}
+		v.Spec = spec
+	}
 	return d.UpdateVol(v)
 }
 
+// ioVolume returns the volume of an I/O, which must be attached, and the
+// length of the I/O within the volume
+func (d *driver) ioVolume(volumeID string, buf []byte, sz uint64, offset int64) (*api.Volume, int64, error) {
+	v, err := d.getVolume(volumeID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if v.GetState() != api.VolumeState_VOLUME_STATE_ATTACHED {
+		return nil, 0, volume.ErrVolDetached
+	}
+	size := int64(v.GetSpec().GetSize())
+	if offset < 0 || offset > size {
+		return nil, 0, volume.ErrEinval
+	}
+	n := int64(len(buf))
+	if int64(sz) < n {
+		n = int64(sz)
+	}
+	if size-offset < n {
+		n = size - offset
+	}
+	return v, n, nil
+}
+
+// Read reads the data of a volume. The data never written reads as zeros.
+func (d *driver) Read(volumeID string, buf []byte, sz uint64, offset int64) (int64, error) {
+	start := time.Now()
+	v, n, err := d.ioVolume(volumeID, buf, sz, offset)
+	if err != nil {
+		return 0, err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.data[v.Id].readAt(buf[:n], offset)
+	s := d.volumeStats(v.Id)
+	s.total.Reads++
+	s.total.ReadBytes += uint64(n)
+	s.total.ReadMs += elapsedMs(start)
+	return n, nil
+}
+
+// Write writes the data of a volume. Snapshots and quiesced volumes cannot
+// be written.
+func (d *driver) Write(volumeID string, buf []byte, sz uint64, offset int64) (int64, error) {
+	start := time.Now()
+	v, n, err := d.ioVolume(volumeID, buf, sz, offset)
+	if err != nil {
+		return 0, err
+	}
+	if v.GetReadonly() {
+		return 0, fmt.Errorf("Volume %s is read only", volumeID)
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if _, ok := d.quiesced[v.Id]; ok {
+		return 0, volume.ErrVolBusy
+	}
+	data, ok := d.data[v.Id]
+	if !ok {
+		data = make(volumeData)
+		d.data[v.Id] = data
+	}
+	data.writeAt(buf[:n], offset)
+	s := d.volumeStats(v.Id)
+	s.total.Writes++
+	s.total.WriteBytes += uint64(n)
+	s.total.WriteMs += elapsedMs(start)
+	return n, nil
+}
+
+// Flush does nothing as the data of the volumes is written synchronously
+func (d *driver) Flush(volumeID string) error {
+	_, err := d.getVolume(volumeID)
+	return err
+}
+
+// volumeStats returns the stats of a volume. It must be called with the
+// lock held.
+func (d *driver) volumeStats(volumeID string) *volumeStats {
+	s, ok := d.stats[volumeID]
+	if !ok {
+		s = &volumeStats{lastTime: time.Now()}
+		d.stats[volumeID] = s
+	}
+	return s
+}
+
+// Stats returns the I/O stats of a volume since it has been created if
+// cumulative, or else since the stats were last returned.
+func (d *driver) Stats(volumeID string, cumulative bool) (*api.Stats, error) {
+	v, err := d.getVolume(volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	s := d.volumeStats(v.Id)
+	stats := s.total
+	if cumulative {
+		stats.IntervalMs = elapsedMs(time.Unix(v.GetCtime().GetSeconds(), 0))
+	} else {
+		stats.Reads -= s.last.Reads
+		stats.ReadMs -= s.last.ReadMs
+		stats.ReadBytes -= s.last.ReadBytes
+		stats.Writes -= s.last.Writes
+		stats.WriteMs -= s.last.WriteMs
+		stats.WriteBytes -= s.last.WriteBytes
+		stats.IntervalMs = elapsedMs(s.lastTime)
+		s.last = s.total
+		s.lastTime = time.Now()
+	}
+	stats.IoMs = stats.ReadMs + stats.WriteMs
+	stats.BytesUsed = d.data[v.Id].usedBytes()
+	return &stats, nil
+}
+
+// UsedSize returns the size of the data written to a volume
+func (d *driver) UsedSize(volumeID string) (uint64, error) {
+	v, err := d.getVolume(volumeID)
+	if err != nil {
+		return 0, err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.data[v.Id].usedBytes(), nil
+}
+
+// GetActiveRequests returns no request as the I/Os complete synchronously
+func (d *driver) GetActiveRequests() (*api.ActiveRequests, error) {
+	return &api.ActiveRequests{}, nil
+}
+
+// Quiesce blocks the writes to a mounted volume until it is unquiesced or
+// until the timeout expires
+func (d *driver) Quiesce(volumeID string, timeoutSeconds uint64, quiesceID string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	v, err := d.getVolume(volumeID)
+	if err != nil {
+		return err
+	}
+	if len(v.GetAttachPath()) == 0 {
+		return fmt.Errorf("Volume %s is not mounted", volumeID)
+	}
+	if _, ok := d.quiesced[v.Id]; ok {
+		return fmt.Errorf("Volume %s is already quiesced", volumeID)
+	}
+	op := &quiesceOp{id: quiesceID}
+	if timeoutSeconds > 0 {
+		op.timer = time.AfterFunc(time.Duration(timeoutSeconds)*time.Second, func() {
+			d.lock.Lock()
+			defer d.lock.Unlock()
+			if d.quiesced[v.Id] == op {
+				logrus.Infof("Quiesce %s of volume %s timed out", quiesceID, v.Id)
+				delete(d.quiesced, v.Id)
+			}
+		})
+	}
+	d.quiesced[v.Id] = op
+	return nil
+}
+
+// Unquiesce resumes the writes to a quiesced volume
+func (d *driver) Unquiesce(volumeID string) error {
+	v, err := d.getVolume(volumeID)
+	if err != nil {
+		return err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	op, ok := d.quiesced[v.Id]
+	if !ok {
+		return fmt.Errorf("Volume %s is not quiesced", volumeID)
+	}
+	if op.timer != nil {
+		op.timer.Stop()
+	}
+	delete(d.quiesced, v.Id)
+	return nil
+}
+
 func (d *driver) Shutdown() {}
 
 func (d *driver) CredsCreate(
@@ -267,8 +644,15 @@ func (d *driver) CredsEnumerate() (map[string]interface{}, error) {
 	return creds, nil
 }
 
+// CredsValidate checks that the credential exists
 func (d *driver) CredsValidate(
 	uuid string,
 ) error {
+	if _, err := d.kv.Get(credsKeyPrefix + "/" + uuid); err != nil {
+		if err == kvdb.ErrNotFound {
+			return fmt.Errorf("Credential %s not found", uuid)
+		}
+		return err
+	}
 	return nil
 }
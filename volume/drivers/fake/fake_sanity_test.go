@@ -0,0 +1,77 @@
+// +build daemon
+
+/*
+Package fake provides an in-memory fake driver implementation
+Copyright 2018 Portworx
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fake_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/api/server"
+	"github.com/libopenstorage/openstorage/cluster"
+	"github.com/libopenstorage/openstorage/config"
+	"github.com/libopenstorage/openstorage/pkg/sanity"
+	"github.com/libopenstorage/openstorage/volume"
+	volumedrivers "github.com/libopenstorage/openstorage/volume/drivers"
+	"github.com/libopenstorage/openstorage/volume/drivers/fake"
+	"github.com/stretchr/testify/require"
+)
+
+const cloudBackupConfig = `cloudproviders:
+  fake:
+    CredType: "fake"
+    CredAccountName: "account"
+    CredAccountKey: "key"
+`
+
+// TestFakeSanity runs the OSD sanity tests against the REST servers of the
+// fake driver and of a single node cluster
+func TestFakeSanity(t *testing.T) {
+	// The driver is registered once the cluster is initialized to listen
+	// to its events
+	require.NoError(t, cluster.Init(config.ClusterConfig{
+		ClusterId:     "fakecluster",
+		NodeId:        "fakenode",
+		DefaultDriver: fake.Name,
+	}))
+	cm, err := cluster.Inst()
+	require.NoError(t, err)
+	require.NoError(t, volumedrivers.Register(fake.Name, nil))
+	go cm.Start(0, false, "9003")
+	for i := 0; i < 30; i++ {
+		if status, err := cm.NodeStatus(); err == nil && status == api.Status_STATUS_OK {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+
+	require.NoError(t, server.StartVolumeMgmtAPI(fake.Name, volume.DriverAPIBase, 0, nil, nil))
+	require.NoError(t, server.StartClusterAPI(cluster.APIBase, 0))
+
+	f, err := ioutil.TempFile("", "fake-cloud-backup")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(cloudBackupConfig)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	sanity.Test(t, "", fake.Name, f.Name())
+}
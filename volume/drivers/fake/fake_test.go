@@ -17,8 +17,14 @@ limitations under the License.
 package fake
 
 import (
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/options"
+	"github.com/libopenstorage/openstorage/volume"
 	"github.com/portworx/kvdb"
 	"github.com/portworx/kvdb/mem"
 	"github.com/sirupsen/logrus"
@@ -69,3 +75,464 @@ func TestFakeCredentials(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Empty(t, creds)
 }
+
+func TestFakeSet(t *testing.T) {
+	d, err := Init(map[string]string{})
+	assert.NoError(t, err)
+
+	id, err := d.Create(&api.VolumeLocator{Name: "myvol"}, &api.Source{}, &api.VolumeSpec{
+		Size:      1024,
+		HaLevel:   2,
+		Sticky:    true,
+		IoProfile: api.IoProfile_IO_PROFILE_DB,
+		Ownership: &api.Ownership{Owner: "owner"},
+	})
+	assert.NoError(t, err)
+	defer d.Delete(id)
+
+	// Expand the volume, replacing its spec
+	err = d.Set(id, nil, &api.VolumeSpec{
+		Size:      2048,
+		HaLevel:   2,
+		IoProfile: api.IoProfile_IO_PROFILE_SEQUENTIAL,
+		Ownership: &api.Ownership{Owner: "owner"},
+	})
+	assert.NoError(t, err)
+	vols, err := d.Inspect([]string{id})
+	assert.NoError(t, err)
+	assert.Len(t, vols, 1)
+	assert.Equal(t, uint64(2048), vols[0].GetSpec().GetSize())
+	assert.Equal(t, int64(2), vols[0].GetSpec().GetHaLevel())
+	assert.False(t, vols[0].GetSpec().GetSticky())
+	assert.Equal(t, api.IoProfile_IO_PROFILE_SEQUENTIAL, vols[0].GetSpec().GetIoProfile())
+	assert.Equal(t, "owner", vols[0].GetSpec().GetOwnership().GetOwner())
+	assert.Equal(t, "myvol", vols[0].GetLocator().GetName())
+
+	// Volumes cannot be shrunk
+	err = d.Set(id, nil, &api.VolumeSpec{Size: 1024})
+	assert.Error(t, err)
+}
+
+func TestFakeAttachEncrypted(t *testing.T) {
+	d, err := Init(map[string]string{})
+	assert.NoError(t, err)
+
+	id, err := d.Create(&api.VolumeLocator{Name: "myencryptedvol"}, &api.Source{}, &api.VolumeSpec{
+		Size:      1024,
+		Encrypted: true,
+	})
+	assert.NoError(t, err)
+
+	_, err = d.Attach(id, nil)
+	assert.Error(t, err)
+
+	devicePath, err := d.Attach(id, map[string]string{
+		options.OptionsSecretKey: "mypassphrase",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "/dev/fake/"+id, devicePath)
+
+	_, err = d.Attach("doesnotexist", nil)
+	assert.Error(t, err)
+}
+
+func TestFakeCreateDelete(t *testing.T) {
+	d, err := Init(map[string]string{})
+	assert.NoError(t, err)
+
+	_, err = d.Create(&api.VolumeLocator{}, &api.Source{}, &api.VolumeSpec{Size: 1024})
+	assert.Error(t, err)
+
+	id, err := d.Create(&api.VolumeLocator{Name: "mynamedvol"}, &api.Source{}, &api.VolumeSpec{
+		Size: 1024,
+	})
+	assert.NoError(t, err)
+	_, err = d.Create(&api.VolumeLocator{Name: "mynamedvol"}, &api.Source{}, &api.VolumeSpec{
+		Size: 1024,
+	})
+	assert.Error(t, err)
+
+	// Volumes are found by id or by name
+	vols, err := d.Inspect([]string{"mynamedvol", "doesnotexist"})
+	assert.NoError(t, err)
+	assert.Len(t, vols, 1)
+	assert.Equal(t, id, vols[0].GetId())
+
+	assert.NoError(t, d.Delete("mynamedvol"))
+	assert.Error(t, d.Delete(id))
+}
+
+func TestFakeAttachMount(t *testing.T) {
+	d, err := Init(map[string]string{})
+	assert.NoError(t, err)
+
+	id, err := d.Create(&api.VolumeLocator{Name: "myattachedvol"}, &api.Source{}, &api.VolumeSpec{
+		Size: 1024,
+	})
+	assert.NoError(t, err)
+	defer d.Delete(id)
+
+	// Detached volumes cannot be mounted and can be detached again
+	assert.Error(t, d.Mount(id, "/mnt/fake", nil))
+	assert.NoError(t, d.Detach(id, nil))
+
+	devicePath, err := d.Attach(id, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "/dev/fake/"+id, devicePath)
+	vols, err := d.Inspect([]string{id})
+	assert.NoError(t, err)
+	assert.Len(t, vols, 1)
+	assert.Equal(t, api.VolumeState_VOLUME_STATE_ATTACHED, vols[0].GetState())
+	assert.Equal(t, devicePath, vols[0].GetDevicePath())
+	assert.NotEmpty(t, vols[0].GetAttachedOn())
+
+	assert.NoError(t, d.Mount(id, "/mnt/fake1", nil))
+	assert.NoError(t, d.Mount(id, "/mnt/fake2", nil))
+	assert.Error(t, d.Detach(id, nil))
+	assert.NoError(t, d.Unmount(id, "/mnt/fake1", nil))
+	assert.Error(t, d.Unmount(id, "/mnt/fake1", nil))
+	vols, err = d.Inspect([]string{id})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/mnt/fake2"}, vols[0].GetAttachPath())
+	assert.NoError(t, d.Unmount(id, "/mnt/fake2", nil))
+
+	assert.NoError(t, d.Detach(id, nil))
+	vols, err = d.Inspect([]string{id})
+	assert.NoError(t, err)
+	assert.Equal(t, api.VolumeState_VOLUME_STATE_DETACHED, vols[0].GetState())
+	assert.Empty(t, vols[0].GetDevicePath())
+}
+
+func TestFakeConcurrentMounts(t *testing.T) {
+	d, err := Init(map[string]string{})
+	assert.NoError(t, err)
+
+	id, err := d.Create(&api.VolumeLocator{Name: "myconcurrentvol"}, &api.Source{}, &api.VolumeSpec{
+		Size: 1024,
+	})
+	assert.NoError(t, err)
+	defer d.Delete(id)
+	_, err = d.Attach(id, nil)
+	assert.NoError(t, err)
+
+	// No mount path is lost when the volume is mounted concurrently
+	var wg sync.WaitGroup
+	paths := 20
+	for i := 0; i < paths; i++ {
+		wg.Add(1)
+		go func(path string) {
+			defer wg.Done()
+			assert.NoError(t, d.Mount(id, path, nil))
+		}(fmt.Sprintf("/mnt/fake%d", i))
+	}
+	wg.Wait()
+	vols, err := d.Inspect([]string{id})
+	assert.NoError(t, err)
+	assert.Len(t, vols[0].GetAttachPath(), paths)
+}
+
+func TestFakeIOStats(t *testing.T) {
+	d, err := Init(map[string]string{})
+	assert.NoError(t, err)
+
+	id, err := d.Create(&api.VolumeLocator{Name: "myiovol"}, &api.Source{}, &api.VolumeSpec{
+		Size: 3 * blockSize,
+	})
+	assert.NoError(t, err)
+	defer d.Delete(id)
+
+	buf := []byte("hello")
+	_, err = d.Write(id, buf, uint64(len(buf)), 0)
+	assert.Equal(t, volume.ErrVolDetached, err)
+	_, err = d.Attach(id, nil)
+	assert.NoError(t, err)
+	defer d.Detach(id, nil)
+
+	// Write across two blocks then read back
+	n, err := d.Write(id, buf, uint64(len(buf)), blockSize-2)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(buf)), n)
+	read := make([]byte, 7)
+	n, err = d.Read(id, read, uint64(len(read)), blockSize-3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), n)
+	assert.Equal(t, []byte("\x00hello\x00"), read)
+
+	// I/Os are truncated to the size of the volume
+	n, err = d.Write(id, buf, uint64(len(buf)), 3*blockSize-1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	stats, err := d.Stats(id, true)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), stats.GetWrites())
+	assert.Equal(t, uint64(6), stats.GetWriteBytes())
+	assert.Equal(t, uint64(1), stats.GetReads())
+	assert.Equal(t, uint64(7), stats.GetReadBytes())
+	assert.Equal(t, uint64(3*blockSize), stats.GetBytesUsed())
+	used, err := d.UsedSize(id)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3*blockSize), used)
+
+	// Non cumulative stats are reset once returned
+	stats, err = d.Stats(id, false)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), stats.GetWrites())
+	stats, err = d.Stats(id, false)
+	assert.NoError(t, err)
+	assert.Zero(t, stats.GetWrites())
+	assert.Zero(t, stats.GetReads())
+}
+
+func TestFakeQuiesce(t *testing.T) {
+	d, err := Init(map[string]string{})
+	assert.NoError(t, err)
+
+	id, err := d.Create(&api.VolumeLocator{Name: "myquiescedvol"}, &api.Source{}, &api.VolumeSpec{
+		Size: blockSize,
+	})
+	assert.NoError(t, err)
+	defer d.Delete(id)
+
+	assert.Error(t, d.Quiesce(id, 0, "q1"))
+	_, err = d.Attach(id, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, d.Mount(id, "/mnt/quiesced", nil))
+
+	assert.NoError(t, d.Quiesce(id, 0, "q1"))
+	assert.Error(t, d.Quiesce(id, 0, "q2"))
+	buf := []byte("data")
+	_, err = d.Write(id, buf, uint64(len(buf)), 0)
+	assert.Equal(t, volume.ErrVolBusy, err)
+	assert.Error(t, d.Unmount(id, "/mnt/quiesced", nil))
+	assert.NoError(t, d.Unquiesce(id))
+	assert.Error(t, d.Unquiesce(id))
+	_, err = d.Write(id, buf, uint64(len(buf)), 0)
+	assert.NoError(t, err)
+
+	// The volume is unquiesced once the timeout expires
+	assert.NoError(t, d.Quiesce(id, 1, "q3"))
+	time.Sleep(1500 * time.Millisecond)
+	assert.Error(t, d.Unquiesce(id))
+
+	assert.NoError(t, d.Unmount(id, "/mnt/quiesced", nil))
+	assert.NoError(t, d.Detach(id, nil))
+}
+
+func TestFakeSnapshotRestore(t *testing.T) {
+	d, err := Init(map[string]string{})
+	assert.NoError(t, err)
+
+	id, err := d.Create(&api.VolumeLocator{Name: "mysnappedvol"}, &api.Source{}, &api.VolumeSpec{
+		Size: blockSize,
+	})
+	assert.NoError(t, err)
+	defer d.Delete(id)
+	_, err = d.Attach(id, nil)
+	assert.NoError(t, err)
+	_, err = d.Write(id, []byte("before"), 6, 0)
+	assert.NoError(t, err)
+
+	snapID, err := d.Snapshot(id, true, &api.VolumeLocator{Name: "mysnap"})
+	assert.NoError(t, err)
+	defer d.Delete(snapID)
+	_, err = d.Snapshot("doesnotexist", true, &api.VolumeLocator{Name: "mysnap2"})
+	assert.Error(t, err)
+	vols, err := d.Inspect([]string{"mysnap"})
+	assert.NoError(t, err)
+	assert.Len(t, vols, 1)
+	assert.True(t, vols[0].IsSnapshot())
+
+	_, err = d.Write(id, []byte("after!"), 6, 0)
+	assert.NoError(t, err)
+
+	// Attached volumes cannot be restored
+	assert.Equal(t, volume.ErrVolAttached, d.Restore(id, snapID))
+	assert.NoError(t, d.Detach(id, nil))
+	assert.NoError(t, d.Restore(id, snapID))
+	_, err = d.Attach(id, nil)
+	assert.NoError(t, err)
+	buf := make([]byte, 6)
+	_, err = d.Read(id, buf, 6, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "before", string(buf))
+	assert.NoError(t, d.Detach(id, nil))
+}
+
+// waitCloudOp waits for the backup or restore of a volume to complete
+func waitCloudOp(t *testing.T, d volume.VolumeDriver, volumeID string) api.CloudBackupStatus {
+	for i := 0; i < 100; i++ {
+		resp, err := d.CloudBackupStatus(&api.CloudBackupStatusRequest{SrcVolumeID: volumeID})
+		assert.NoError(t, err)
+		status := resp.Statuses[volumeID]
+		if status.Status != api.CloudBackupStatusActive {
+			return status
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Backup or restore of volume %s did not complete", volumeID)
+	return api.CloudBackupStatus{}
+}
+
+func TestFakeCloudBackup(t *testing.T) {
+	d, err := Init(map[string]string{})
+	assert.NoError(t, err)
+
+	credID, err := d.CredsCreate(map[string]string{"bucket": "backups"})
+	assert.NoError(t, err)
+	defer d.CredsDelete(credID)
+	assert.NoError(t, d.CredsValidate(credID))
+	assert.Error(t, d.CredsValidate("doesnotexist"))
+
+	id, err := d.Create(&api.VolumeLocator{Name: "mybackedupvol"}, &api.Source{}, &api.VolumeSpec{
+		Size: 2 * blockSize,
+	})
+	assert.NoError(t, err)
+	defer d.Delete(id)
+	_, err = d.Attach(id, nil)
+	assert.NoError(t, err)
+	_, err = d.Write(id, []byte("backup"), 6, blockSize)
+	assert.NoError(t, err)
+
+	// Full then incremental backups
+	assert.Error(t, d.CloudBackupCreate(&api.CloudBackupCreateRequest{
+		VolumeID:       id,
+		CredentialUUID: "doesnotexist",
+	}))
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, d.CloudBackupCreate(&api.CloudBackupCreateRequest{
+			VolumeID:       id,
+			CredentialUUID: credID,
+		}))
+		status := waitCloudOp(t, d, id)
+		assert.Equal(t, api.CloudBackupStatusDone, status.Status)
+		assert.Equal(t, api.CloudBackupOp, status.OpType)
+		assert.Equal(t, uint64(blockSize), status.BytesDone)
+	}
+	resp, err := d.CloudBackupEnumerate(&api.CloudBackupEnumerateRequest{
+		CloudBackupGenericRequest: api.CloudBackupGenericRequest{
+			SrcVolumeID:    id,
+			CredentialUUID: credID,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Backups, 2)
+	incremental, full := resp.Backups[0], resp.Backups[1]
+	assert.Equal(t, "full", full.Metadata[metadataBackupType])
+	assert.Equal(t, "incremental", incremental.Metadata[metadataBackupType])
+	assert.Equal(t, full.ID, incremental.Metadata[metadataBaseBackup])
+	assert.Equal(t, "mybackedupvol", full.SrcVolumeName)
+
+	catalog, err := d.CloudBackupCatalog(&api.CloudBackupCatalogRequest{
+		ID:             full.ID,
+		CredentialUUID: credID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{backupObject, blockObject(1)}, catalog.Contents)
+
+	history, err := d.CloudBackupHistory(&api.CloudBackupHistoryRequest{SrcVolumeID: id})
+	assert.NoError(t, err)
+	assert.Len(t, history.HistoryList, 2)
+	assert.Equal(t, "Cloudsnap Backup completed successfully", history.HistoryList[0].Status)
+
+	// Restore the full backup to a new volume
+	restored, err := d.CloudBackupRestore(&api.CloudBackupRestoreRequest{
+		ID:                full.ID,
+		RestoreVolumeName: "myrestoredvol",
+		CredentialUUID:    credID,
+	})
+	assert.NoError(t, err)
+	defer d.Delete(restored.RestoreVolumeID)
+	status := waitCloudOp(t, d, restored.RestoreVolumeID)
+	assert.Equal(t, api.CloudBackupStatusDone, status.Status)
+	assert.Equal(t, api.CloudRestoreOp, status.OpType)
+	_, err = d.Attach(restored.RestoreVolumeID, nil)
+	assert.NoError(t, err)
+	buf := make([]byte, 6)
+	_, err = d.Read(restored.RestoreVolumeID, buf, 6, blockSize)
+	assert.NoError(t, err)
+	assert.Equal(t, "backup", string(buf))
+	assert.NoError(t, d.Detach(restored.RestoreVolumeID, nil))
+	_, err = d.CloudBackupRestore(&api.CloudBackupRestoreRequest{
+		ID:                full.ID,
+		RestoreVolumeName: "myrestoredvol",
+		CredentialUUID:    credID,
+	})
+	assert.Error(t, err)
+
+	// Completed backups cannot change state
+	assert.Error(t, d.CloudBackupStateChange(&api.CloudBackupStateChangeRequest{
+		SrcVolumeID:    id,
+		RequestedState: api.CloudBackupRequestedStatePause,
+	}))
+
+	// The full backup cannot be deleted before the incremental one
+	assert.Error(t, d.CloudBackupDelete(&api.CloudBackupDeleteRequest{
+		ID:             full.ID,
+		CredentialUUID: credID,
+	}))
+	assert.NoError(t, d.CloudBackupDelete(&api.CloudBackupDeleteRequest{
+		ID:             full.ID,
+		CredentialUUID: credID,
+		Force:          true,
+	}))
+	assert.NoError(t, d.CloudBackupDeleteAll(&api.CloudBackupDeleteAllRequest{
+		CloudBackupGenericRequest: api.CloudBackupGenericRequest{
+			SrcVolumeID:    id,
+			CredentialUUID: credID,
+		},
+	}))
+	resp, err = d.CloudBackupEnumerate(&api.CloudBackupEnumerateRequest{
+		CloudBackupGenericRequest: api.CloudBackupGenericRequest{
+			SrcVolumeID:    id,
+			CredentialUUID: credID,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Backups)
+	assert.NoError(t, d.Detach(id, nil))
+}
+
+func TestFakeCloudBackupSchedules(t *testing.T) {
+	d, err := Init(map[string]string{})
+	assert.NoError(t, err)
+
+	credID, err := d.CredsCreate(map[string]string{})
+	assert.NoError(t, err)
+	defer d.CredsDelete(credID)
+	id, err := d.Create(&api.VolumeLocator{Name: "myscheduledvol"}, &api.Source{}, &api.VolumeSpec{
+		Size: blockSize,
+	})
+	assert.NoError(t, err)
+	defer d.Delete(id)
+
+	info := api.CloudBackupScheduleInfo{
+		SrcVolumeID:    id,
+		CredentialUUID: credID,
+		Schedule:       "- freq: daily\n  hour: 23\n  minute: 00",
+		MaxBackups:     1,
+	}
+	created, err := d.CloudBackupSchedCreate(&api.CloudBackupSchedCreateRequest{
+		CloudBackupScheduleInfo: info,
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, created.UUID)
+	_, err = d.CloudBackupSchedCreate(&api.CloudBackupSchedCreateRequest{
+		CloudBackupScheduleInfo: api.CloudBackupScheduleInfo{
+			SrcVolumeID:    "doesnotexist",
+			CredentialUUID: credID,
+			Schedule:       info.Schedule,
+		},
+	})
+	assert.Error(t, err)
+
+	scheds, err := d.CloudBackupSchedEnumerate()
+	assert.NoError(t, err)
+	assert.Equal(t, info, scheds.Schedules[created.UUID])
+
+	assert.NoError(t, d.CloudBackupSchedDelete(&api.CloudBackupSchedDeleteRequest{UUID: created.UUID}))
+	assert.Error(t, d.CloudBackupSchedDelete(&api.CloudBackupSchedDeleteRequest{UUID: created.UUID}))
+	scheds, err = d.CloudBackupSchedEnumerate()
+	assert.NoError(t, err)
+	assert.NotContains(t, scheds.Schedules, created.UUID)
+}
@@ -0,0 +1,102 @@
+package loopback
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+	"syscall"
+)
+
+const (
+	losetupBin  = "/sbin/losetup"
+	fsfreezeBin = "/usr/sbin/fsfreeze"
+)
+
+// losetup runs losetup with the arguments and returns its output
+func losetup(args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(losetupBin, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%s failed: %s. Err: %v",
+			strings.Join(cmd.Args, " "),
+			strings.TrimSpace(stderr.String()),
+			err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// loopAttach attaches the file to a free loop device and returns the device
+func loopAttach(file string, readonly bool) (string, error) {
+	args := []string{"--find", "--show"}
+	if readonly {
+		args = append(args, "--read-only")
+	}
+	return losetup(append(args, file)...)
+}
+
+// loopDevice returns the loop device the file is attached to, which is
+// empty if the file is not attached
+func loopDevice(file string) (string, error) {
+	out, err := losetup("--associated", file)
+	if err != nil {
+		return "", err
+	}
+	return parseAssociated(out), nil
+}
+
+// parseAssociated returns the first device of the output of
+// losetup --associated, whose lines are formatted as
+// /dev/loop0: [2049]:1234 (/path/to/file)
+func parseAssociated(out string) string {
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		if i := strings.Index(scanner.Text(), ":"); i > 0 {
+			return scanner.Text()[:i]
+		}
+	}
+	return ""
+}
+
+// loopDetach detaches the loop device
+func loopDetach(device string) error {
+	_, err := losetup("--detach", device)
+	return err
+}
+
+// loopSetCapacity updates the size of the loop device to the size of its
+// file
+func loopSetCapacity(device string) error {
+	_, err := losetup("--set-capacity", device)
+	return err
+}
+
+// fsFreeze freezes or unfreezes the filesystem mounted on the mount path
+func fsFreeze(mountPath string, freeze bool) error {
+	opt := "-f"
+	if !freeze {
+		opt = "-u"
+	}
+	if out, err := exec.Command(fsfreezeBin, opt, mountPath).CombinedOutput(); err != nil {
+		return fmt.Errorf("%s %s %s failed: %s. Err: %v",
+			fsfreezeBin,
+			opt,
+			mountPath,
+			strings.TrimSpace(string(out)),
+			err)
+	}
+	return nil
+}
+
+// allocatedBytes returns the size of the blocks allocated to the file
+func allocatedBytes(file string) (uint64, error) {
+	var st syscall.Stat_t
+	if err := syscall.Stat(file, &st); err != nil {
+		return 0, err
+	}
+	// st_blocks is in units of 512 bytes whatever the block size
+	return uint64(st.Blocks) * 512, nil
+}
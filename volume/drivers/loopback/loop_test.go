@@ -0,0 +1,15 @@
+package loopback
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAssociated(t *testing.T) {
+	require.Equal(t, "", parseAssociated(""))
+	require.Equal(t, "/dev/loop0",
+		parseAssociated("/dev/loop0: [65024]:9617494 (/var/lib/openstorage/loopback/vol)"))
+	require.Equal(t, "/dev/loop3",
+		parseAssociated("/dev/loop3: [2049]:12 (/vol)\n/dev/loop4: [2049]:12 (/vol)"))
+}
@@ -0,0 +1,503 @@
+package loopback
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/clone"
+	"github.com/libopenstorage/openstorage/pkg/resizefs"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/libopenstorage/openstorage/volume/drivers/common"
+	"github.com/pborman/uuid"
+	"github.com/portworx/kvdb"
+)
+
+const (
+	// Name of the driver
+	Name = "loopback"
+	// Type of the driver
+	Type = api.DriverType_DRIVER_TYPE_BLOCK
+	// RootParam is the parameter of the directory of the volume files
+	RootParam = "home"
+	// DefaultHome is the directory of the volume files if RootParam is
+	// not set
+	DefaultHome = "/var/lib/openstorage/loopback"
+)
+
+// driver provisions each volume as a sparse file in its home directory,
+// which is attached to the node as a loop device
+type driver struct {
+	volume.IODriver
+	volume.StoreEnumerator
+	volume.StatsDriver
+	volume.QuiesceDriver
+	volume.CredsDriver
+	volume.CloudBackupDriver
+	home string
+	// lock serializes the operations changing the volume files and their
+	// loop devices
+	lock sync.Mutex
+}
+
+// Init initializes the loopback driver
+func Init(params map[string]string) (volume.VolumeDriver, error) {
+	home, ok := params[RootParam]
+	if !ok {
+		home = DefaultHome
+	}
+	if err := os.MkdirAll(home, 0744); err != nil {
+		return nil, err
+	}
+	d := &driver{
+		IODriver:          volume.IONotSupported,
+		StoreEnumerator:   common.NewDefaultStoreEnumerator(Name, kvdb.Instance()),
+		StatsDriver:       volume.StatsNotSupported,
+		QuiesceDriver:     volume.QuiesceNotSupported,
+		CredsDriver:       volume.CredsNotSupported,
+		CloudBackupDriver: volume.CloudBackupNotSupported,
+		home:              home,
+	}
+
+	vols, err := d.StoreEnumerator.Enumerate(&api.VolumeLocator{}, nil)
+	if err != nil {
+		logrus.Println("Could not enumerate Volumes, ", err)
+	}
+	for _, v := range vols {
+		if v.Status == api.VolumeStatus_VOLUME_STATUS_NONE {
+			v.Status = api.VolumeStatus_VOLUME_STATUS_UP
+		}
+		// The loop devices do not survive a reboot of the node
+		if v.State == api.VolumeState_VOLUME_STATE_ATTACHED {
+			dev, err := loopDevice(d.volumeFile(v.Id))
+			if err != nil {
+				logrus.Warnf("Unable to find the loop device of volume %s: %v", v.Id, err)
+			}
+			if len(dev) == 0 {
+				detached(v)
+			} else {
+				v.DevicePath = dev
+			}
+		}
+		if err := d.UpdateVol(v); err != nil {
+			logrus.Warnf("Unable to update volume %s: %v", v.Id, err)
+		}
+	}
+
+	logrus.Infof("%s initialized with volume files in %s", Name, home)
+	return d, nil
+}
+
+func (d *driver) String() string {
+	return Name
+}
+
+func (d *driver) Name() string {
+	return Name
+}
+
+func (d *driver) Type() api.DriverType {
+	return Type
+}
+
+// Status diagnostic information
+func (d *driver) Status() [][2]string {
+	return [][2]string{{"Home", d.home}}
+}
+
+// Create creates the sparse file of the volume and formats it. The volume
+// is a raw block volume if the spec has no format. The file of a volume
+// created from a parent is a clone of the file of the parent.
+func (d *driver) Create(
+	locator *api.VolumeLocator,
+	source *api.Source,
+	spec *api.VolumeSpec,
+) (string, error) {
+	if spec.Size == 0 {
+		return "", fmt.Errorf("Volume size cannot be zero: %s", Name)
+	}
+	if source != nil && len(source.Parent) != 0 {
+		parent, err := d.GetVol(source.Parent)
+		if err != nil {
+			return "", err
+		}
+		return d.clone(parent, locator, source, spec, false)
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	volumeID := strings.TrimSuffix(uuid.New(), "\n")
+	file := d.volumeFile(volumeID)
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return "", err
+	}
+	err = f.Truncate(int64(spec.Size))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && spec.Format != api.FSType_FS_TYPE_NONE {
+		err = mkfs(spec.Format, file)
+	}
+	if err != nil {
+		os.Remove(file)
+		return "", err
+	}
+
+	v := common.NewVolume(volumeID, spec.Format, locator, source, spec)
+	if err := d.CreateVol(v); err != nil {
+		os.Remove(file)
+		return "", err
+	}
+	logrus.Infof("%s created volume %s of %d bytes in %s", Name, volumeID, spec.Size, file)
+	return volumeID, nil
+}
+
+// Delete deletes the file of the volume, which must be detached
+func (d *driver) Delete(volumeID string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return err
+	}
+	if v.State == api.VolumeState_VOLUME_STATE_ATTACHED {
+		return volume.ErrVolAttached
+	}
+	if err := os.Remove(d.volumeFile(volumeID)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return d.DeleteVol(volumeID)
+}
+
+func (d *driver) MountedAt(mountpath string) string {
+	return ""
+}
+
+// Attach sets up a loop device for the file of the volume and returns the
+// device. Attaching an attached volume returns its device.
+func (d *driver) Attach(volumeID string, attachOptions map[string]string) (string, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return "", err
+	}
+	file := d.volumeFile(volumeID)
+	if v.State == api.VolumeState_VOLUME_STATE_ATTACHED {
+		if dev, err := loopDevice(file); err == nil && dev == v.DevicePath {
+			return v.DevicePath, nil
+		}
+	}
+
+	dev, err := loopAttach(file, v.Readonly)
+	if err != nil {
+		return "", err
+	}
+	hostname, _ := os.Hostname()
+	v.DevicePath = dev
+	v.AttachedOn = hostname
+	v.AttachedState = api.AttachState_ATTACH_STATE_EXTERNAL
+	v.State = api.VolumeState_VOLUME_STATE_ATTACHED
+	if err := d.UpdateVol(v); err != nil {
+		loopDetach(dev)
+		return "", err
+	}
+	logrus.Infof("%s attached volume %s to %s", Name, volumeID, dev)
+	return dev, nil
+}
+
+// Detach tears down the loop device of the volume once it has been
+// unmounted. Detaching a detached volume does nothing.
+func (d *driver) Detach(volumeID string, options map[string]string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return err
+	}
+	if v.State != api.VolumeState_VOLUME_STATE_ATTACHED {
+		return nil
+	}
+	if len(v.AttachPath) != 0 {
+		return fmt.Errorf("Volume %s is mounted at %v", volumeID, v.AttachPath)
+	}
+	if err := loopDetach(v.DevicePath); err != nil {
+		return err
+	}
+	detached(v)
+	return d.UpdateVol(v)
+}
+
+// Mount mounts the filesystem of the attached volume at the mount path.
+// The filesystem is then grown if the volume has been resized while it was
+// not mounted.
+func (d *driver) Mount(volumeID string, mountpath string, options map[string]string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return err
+	}
+	if v.State != api.VolumeState_VOLUME_STATE_ATTACHED {
+		return volume.ErrVolDetached
+	}
+	if v.Spec.Format == api.FSType_FS_TYPE_NONE {
+		return fmt.Errorf("Volume %s is a raw block volume without filesystem", volumeID)
+	}
+	for _, p := range v.AttachPath {
+		if p == mountpath {
+			return nil
+		}
+	}
+	var flags uintptr
+	if v.Readonly {
+		flags |= syscall.MS_RDONLY
+	}
+	if err := syscall.Mount(
+		v.DevicePath,
+		mountpath,
+		v.Spec.Format.SimpleString(),
+		flags,
+		"",
+	); err != nil {
+		return fmt.Errorf("Failed to mount %v at %v: %v", v.DevicePath, mountpath, err)
+	}
+	if !v.Readonly {
+		if err := resizefs.Resize(v.Spec.Format, v.DevicePath, mountpath); err != nil {
+			logrus.Warnf("Unable to grow the filesystem of volume %s: %v", volumeID, err)
+		}
+	}
+	v.AttachPath = append(v.AttachPath, mountpath)
+	return d.UpdateVol(v)
+}
+
+// Unmount unmounts the volume from the mount path
+func (d *driver) Unmount(volumeID string, mountpath string, options map[string]string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return err
+	}
+	paths := make([]string, 0, len(v.AttachPath))
+	for _, p := range v.AttachPath {
+		if p != mountpath {
+			paths = append(paths, p)
+		}
+	}
+	if len(paths) == len(v.AttachPath) {
+		return fmt.Errorf("Volume %s is not mounted at %s", volumeID, mountpath)
+	}
+	if err := syscall.Unmount(mountpath, 0); err != nil {
+		return err
+	}
+	v.AttachPath = paths
+	return d.UpdateVol(v)
+}
+
+// Set updates the locator and the spec of the volume, resizing it to the
+// size of the spec. Volumes can only be grown. The loop device and the
+// filesystem of the volume are grown online if it is attached and mounted.
+func (d *driver) Set(volumeID string, locator *api.VolumeLocator, spec *api.VolumeSpec) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return err
+	}
+	if locator != nil {
+		v.Locator = locator
+	}
+	grown := false
+	if spec != nil {
+		spec = spec.Copy()
+		if spec.Size < v.Spec.Size {
+			return fmt.Errorf("Volume %s cannot be shrunk", volumeID)
+		}
+		// The volume keeps its filesystem
+		spec.Format = v.Spec.Format
+		if spec.Size > v.Spec.Size {
+			if err := d.grow(v, spec.Size); err != nil {
+				return err
+			}
+			grown = true
+		}
+		v.Spec = spec
+	}
+	if err := d.UpdateVol(v); err != nil {
+		return err
+	}
+	if grown && len(v.AttachPath) != 0 {
+		// The filesystem is otherwise grown when the volume is mounted
+		return resizefs.Resize(v.Spec.Format, v.DevicePath, v.AttachPath[0])
+	}
+	return nil
+}
+
+// grow grows the file of the volume and its loop device if it is attached
+func (d *driver) grow(v *api.Volume, size uint64) error {
+	if err := os.Truncate(d.volumeFile(v.Id), int64(size)); err != nil {
+		return err
+	}
+	v.Spec.Size = size
+	if v.State != api.VolumeState_VOLUME_STATE_ATTACHED {
+		return nil
+	}
+	return loopSetCapacity(v.DevicePath)
+}
+
+// Snapshot creates a volume whose file is a clone of the file of the
+// volume. The filesystem of the volume is frozen during the clone if it is
+// mounted.
+func (d *driver) Snapshot(volumeID string, readonly bool, locator *api.VolumeLocator) (string, error) {
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return "", err
+	}
+	return d.clone(v, locator, &api.Source{Parent: volumeID}, v.Spec, readonly)
+}
+
+// Restore replaces the file of the volume, which must be detached, with a
+// clone of the file of its snapshot
+func (d *driver) Restore(volumeID string, snapID string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return err
+	}
+	snap, err := d.GetVol(snapID)
+	if err != nil {
+		return err
+	}
+	if snap.GetSource().GetParent() != volumeID {
+		return fmt.Errorf("Volume %s is not a snapshot of volume %s", snapID, volumeID)
+	}
+	if v.State == api.VolumeState_VOLUME_STATE_ATTACHED {
+		return volume.ErrVolAttached
+	}
+
+	file := d.volumeFile(volumeID)
+	tmp := file + ".restore"
+	os.Remove(tmp)
+	if err := clone.File(d.volumeFile(snapID), tmp); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, file); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	v.Spec.Size = snap.Spec.Size
+	return d.UpdateVol(v)
+}
+
+func (d *driver) SnapshotGroup(groupID string, labels map[string]string) (*api.GroupSnapCreateResponse, error) {
+	return nil, volume.ErrNotSupported
+}
+
+// UsedSize returns the size of the blocks allocated to the file of the
+// volume
+func (d *driver) UsedSize(volumeID string) (uint64, error) {
+	if _, err := d.GetVol(volumeID); err != nil {
+		return 0, err
+	}
+	return allocatedBytes(d.volumeFile(volumeID))
+}
+
+func (d *driver) Shutdown() {
+	logrus.Printf("%s Shutting down", Name)
+}
+
+// clone creates a volume whose file is a clone of the file of the parent,
+// grown to the size of the spec
+func (d *driver) clone(
+	parent *api.Volume,
+	locator *api.VolumeLocator,
+	source *api.Source,
+	spec *api.VolumeSpec,
+	readonly bool,
+) (string, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if len(parent.AttachPath) != 0 {
+		mountPath := parent.AttachPath[0]
+		if err := fsFreeze(mountPath, true); err != nil {
+			logrus.Warnf("Unable to freeze volume %s at %s: %v", parent.Id, mountPath, err)
+		} else {
+			defer fsFreeze(mountPath, false)
+		}
+	}
+
+	volumeID := strings.TrimSuffix(uuid.New(), "\n")
+	file := d.volumeFile(volumeID)
+	if err := clone.File(d.volumeFile(parent.Id), file); err != nil {
+		return "", err
+	}
+	cloneSpec := *spec
+	if cloneSpec.Size < parent.Spec.Size {
+		cloneSpec.Size = parent.Spec.Size
+	}
+	cloneSpec.Format = parent.Spec.Format
+	if cloneSpec.Size > parent.Spec.Size {
+		if err := os.Truncate(file, int64(cloneSpec.Size)); err != nil {
+			os.Remove(file)
+			return "", err
+		}
+	}
+
+	v := common.NewVolume(volumeID, cloneSpec.Format, locator, source, &cloneSpec)
+	v.Readonly = readonly
+	if err := d.CreateVol(v); err != nil {
+		os.Remove(file)
+		return "", err
+	}
+	return volumeID, nil
+}
+
+// volumeFile returns the file of the volume
+func (d *driver) volumeFile(volumeID string) string {
+	return filepath.Join(d.home, volumeID)
+}
+
+// detached resets the attach state of the volume
+func detached(v *api.Volume) {
+	v.DevicePath = ""
+	v.AttachedOn = ""
+	v.AttachedState = api.AttachState_ATTACH_STATE_EXTERNAL
+	v.State = api.VolumeState_VOLUME_STATE_DETACHED
+}
+
+// mkfs formats the file with the filesystem
+func mkfs(format api.FSType, file string) error {
+	cmd := "/sbin/mkfs." + format.SimpleString()
+	args := []string{file}
+	if format == api.FSType_FS_TYPE_EXT4 {
+		// mke2fs asks for a confirmation before formatting a file
+		args = append([]string{"-F"}, args...)
+	}
+	if out, err := exec.Command(cmd, args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("%s %s failed: %s. Err: %v",
+			cmd,
+			strings.Join(args, " "),
+			strings.TrimSpace(string(out)),
+			err)
+	}
+	return nil
+}
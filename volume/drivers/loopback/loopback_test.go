@@ -0,0 +1,139 @@
+// +build linux,have_loop
+
+package loopback
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/libopenstorage/openstorage/volume/drivers/test"
+)
+
+const (
+	KiB = 1024
+	MiB = KiB * 1024
+	GiB = MiB * 1024
+)
+
+func newDriver(t *testing.T) (volume.VolumeDriver, string) {
+	home, err := ioutil.TempDir("", "loopback")
+	require.NoError(t, err)
+	d, err := Init(map[string]string{RootParam: home})
+	require.NoError(t, err)
+	return d, home
+}
+
+func TestAll(t *testing.T) {
+	d, home := newDriver(t)
+	defer os.RemoveAll(home)
+
+	ctx := test.NewContext(d)
+	ctx.Filesystem = api.FSType_FS_TYPE_EXT4
+	test.Run(t, ctx)
+}
+
+func TestResize(t *testing.T) {
+	d, home := newDriver(t)
+	defer os.RemoveAll(home)
+
+	id, err := d.Create(
+		&api.VolumeLocator{Name: "resize"},
+		nil,
+		&api.VolumeSpec{Size: 64 * MiB, Format: api.FSType_FS_TYPE_EXT4},
+	)
+	require.NoError(t, err)
+	defer d.Delete(id)
+
+	used, err := d.UsedSize(id)
+	require.NoError(t, err)
+	require.True(t, used < 64*MiB, "The volume file must be sparse")
+
+	dev, err := d.Attach(id, nil)
+	require.NoError(t, err)
+	defer d.Detach(id, nil)
+
+	require.Error(t, d.Set(id, nil, &api.VolumeSpec{Size: 32 * MiB}))
+	require.NoError(t, d.Set(id, nil, &api.VolumeSpec{
+		Size:      128 * MiB,
+		Ownership: &api.Ownership{Owner: "user"},
+	}))
+
+	vols, err := d.Inspect([]string{id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(128*MiB), vols[0].Spec.Size)
+	require.Equal(t, api.FSType_FS_TYPE_EXT4, vols[0].Spec.Format)
+	require.Equal(t, "user", vols[0].Spec.Ownership.Owner)
+	info, err := os.Stat(home + "/" + id)
+	require.NoError(t, err)
+	require.Equal(t, int64(128*MiB), info.Size())
+	size, err := blockDeviceSize(dev)
+	require.NoError(t, err)
+	require.Equal(t, uint64(128*MiB), size)
+
+	mountPath, err := ioutil.TempDir("", "loopback-mnt")
+	require.NoError(t, err)
+	defer os.RemoveAll(mountPath)
+	require.NoError(t, d.Mount(id, mountPath, nil))
+	defer d.Unmount(id, mountPath, nil)
+
+	require.Error(t, d.Delete(id), "Delete of an attached volume must fail")
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	d, home := newDriver(t)
+	defer os.RemoveAll(home)
+
+	id, err := d.Create(
+		&api.VolumeLocator{Name: "raw"},
+		nil,
+		&api.VolumeSpec{Size: 16 * MiB},
+	)
+	require.NoError(t, err)
+	defer d.Delete(id)
+
+	dev, err := d.Attach(id, nil)
+	require.NoError(t, err)
+	require.Error(t, d.Mount(id, home, nil), "Mount of a raw block volume must fail")
+	require.NoError(t, ioutil.WriteFile(dev, []byte("before"), 0600))
+
+	snapID, err := d.Snapshot(id, true, &api.VolumeLocator{Name: "raw-snap"})
+	require.NoError(t, err)
+	defer d.Delete(snapID)
+	snaps, err := d.Inspect([]string{snapID})
+	require.NoError(t, err)
+	require.Equal(t, id, snaps[0].Source.Parent)
+	require.True(t, snaps[0].Readonly)
+
+	require.NoError(t, ioutil.WriteFile(dev, []byte("after!"), 0600))
+	require.Equal(t, volume.ErrVolAttached, d.Restore(id, snapID))
+	require.NoError(t, d.Detach(id, nil))
+	require.NoError(t, d.Restore(id, snapID))
+
+	dev, err = d.Attach(id, nil)
+	require.NoError(t, err)
+	defer d.Detach(id, nil)
+	f, err := os.Open(dev)
+	require.NoError(t, err)
+	defer f.Close()
+	buf := make([]byte, 6)
+	_, err = f.ReadAt(buf, 0)
+	require.NoError(t, err)
+	require.Equal(t, "before", string(buf))
+}
+
+// blockDeviceSize returns the size of the block device
+func blockDeviceSize(dev string) (uint64, error) {
+	out, err := exec.Command("blockdev", "--getsize64", dev).Output()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(out)), 10, 64)
+}
@@ -297,6 +297,20 @@ func (mr *MockVolumeDriverMockRecorder) Enumerate(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enumerate", reflect.TypeOf((*MockVolumeDriver)(nil).Enumerate), arg0, arg1)
 }
 
+// EnumerateWithFilter mocks base method
+func (m *MockVolumeDriver) EnumerateWithFilter(arg0 *api.VolumeFilter, arg1 string, arg2 int) ([]*api.Volume, string, error) {
+	ret := m.ctrl.Call(m, "EnumerateWithFilter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*api.Volume)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnumerateWithFilter indicates an expected call of EnumerateWithFilter
+func (mr *MockVolumeDriverMockRecorder) EnumerateWithFilter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnumerateWithFilter", reflect.TypeOf((*MockVolumeDriver)(nil).EnumerateWithFilter), arg0, arg1, arg2)
+}
+
 // Flush mocks base method
 func (m *MockVolumeDriver) Flush(arg0 string) error {
 	ret := m.ctrl.Call(m, "Flush", arg0)
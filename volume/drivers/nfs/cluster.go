@@ -0,0 +1,150 @@
+package nfs
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/libopenstorage/openstorage/alert"
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/cluster"
+	"github.com/portworx/kvdb"
+)
+
+const (
+	// alertServerUnreachable is the type of the alerts raised when an nfs
+	// server becomes unreachable
+	alertServerUnreachable int64 = iota + 1
+)
+
+const (
+	// serverUnreachableTag is the unique tag of the alert of an nfs server
+	// which is unreachable, so that a single alert is raised per server
+	serverUnreachableTag = "nfs_server_unreachable"
+)
+
+// clusterListener raises the alerts of the driver once the node has joined
+// its cluster. Without a cluster, the driver raises no alert.
+type clusterListener struct {
+	cluster.NullClusterListener
+	kv kvdb.Kvdb
+
+	lock   sync.Mutex
+	alerts alert.Alert
+}
+
+func (cl *clusterListener) Init(
+	self *api.Node,
+	clusterInfo *cluster.ClusterInfo,
+) (cluster.FinalizeInitCb, error) {
+	return nil, nil
+}
+
+func (cl *clusterListener) Join(
+	self *api.Node,
+	initState *cluster.ClusterInitState,
+	handleNotifications cluster.ClusterNotify,
+) error {
+	alerts, err := alert.New(alert.Name, initState.ClusterInfo.Id, cl.kv)
+	if err != nil {
+		return err
+	}
+	cl.lock.Lock()
+	cl.alerts = alerts
+	cl.lock.Unlock()
+	return nil
+}
+
+func (cl *clusterListener) String() string {
+	return Name
+}
+
+// QuorumMember returns true as the nfs driver does not prevent the node from
+// being part of the quorum
+func (cl *clusterListener) QuorumMember(node *api.Node) bool {
+	return true
+}
+
+// EnumerateAlerts returns the alerts of the nfs servers
+func (cl *clusterListener) EnumerateAlerts(
+	timeStart, timeEnd time.Time,
+	resource api.ResourceType,
+) (*api.Alerts, error) {
+	alerts, err := cl.alertClient()
+	if err != nil {
+		return nil, err
+	}
+	list, err := alerts.EnumerateWithinTimeRange(timeStart, timeEnd, resource)
+	if err != nil {
+		return nil, err
+	}
+	serverAlerts := make([]*api.Alert, 0, len(list))
+	for _, a := range list {
+		if a.UniqueTag == serverUnreachableTag {
+			serverAlerts = append(serverAlerts, a)
+		}
+	}
+	return &api.Alerts{Alert: serverAlerts}, nil
+}
+
+// alertClient returns the alert client of the cluster of the node
+func (cl *clusterListener) alertClient() (alert.Alert, error) {
+	cl.lock.Lock()
+	defer cl.lock.Unlock()
+	if cl.alerts == nil {
+		return nil, fmt.Errorf("Node has not joined a cluster")
+	}
+	return cl.alerts, nil
+}
+
+// serverUnreachable raises the alert of the nfs server unless it has
+// already been raised. The alert of a previous outage of the server, which
+// has been cleared, is replaced.
+func (cl *clusterListener) serverUnreachable(server string, reason error) {
+	alerts, err := cl.alertClient()
+	if err != nil {
+		return
+	}
+	a := &api.Alert{
+		AlertType:  alertServerUnreachable,
+		Severity:   api.SeverityType_SEVERITY_TYPE_ALARM,
+		Message:    fmt.Sprintf("NFS server %s is unreachable: %v", server, reason),
+		ResourceId: server,
+		Resource:   api.ResourceType_RESOURCE_TYPE_CLUSTER,
+		UniqueTag:  serverUnreachableTag,
+	}
+	if err := alerts.RaiseIfNotExist(a); err != nil {
+		logrus.Warnf("Unable to raise the alert of NFS server %s: %v", server, err)
+		return
+	}
+	raised, err := alerts.Retrieve(a.Resource, a.Id)
+	if err != nil || !raised.Cleared {
+		return
+	}
+	if err := alerts.Erase(a.Resource, a.Id); err != nil {
+		logrus.Warnf("Unable to erase the alert of NFS server %s: %v", server, err)
+		return
+	}
+	a.Id = 0
+	if err := alerts.RaiseIfNotExist(a); err != nil {
+		logrus.Warnf("Unable to raise the alert of NFS server %s: %v", server, err)
+	}
+}
+
+// serverReachable clears the alert of the nfs server, if it has been raised
+func (cl *clusterListener) serverReachable(server string) {
+	alerts, err := cl.alertClient()
+	if err != nil {
+		return
+	}
+	if err := alerts.ClearByUniqueTag(
+		api.ResourceType_RESOURCE_TYPE_CLUSTER,
+		server,
+		serverUnreachableTag,
+		0,
+	); err != nil {
+		logrus.Debugf("Unable to clear the alert of NFS server %s: %v", server, err)
+	}
+}
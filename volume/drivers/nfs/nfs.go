@@ -12,12 +12,14 @@ import (
 
 	"github.com/sirupsen/logrus"
 
-	"math/rand"
 	"strings"
+	"sync"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/cluster"
 	"github.com/libopenstorage/openstorage/config"
 	"github.com/libopenstorage/openstorage/pkg/mount"
+	"github.com/libopenstorage/openstorage/pkg/quota"
 	"github.com/libopenstorage/openstorage/pkg/seed"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/libopenstorage/openstorage/volume/drivers/common"
@@ -31,6 +33,11 @@ const (
 	NfsDBKey     = "OpenStorageNFSKey"
 	nfsMountPath = "/var/lib/openstorage/nfs/"
 	nfsBlockFile = ".blockdevice"
+	// EnforceSizeParam can be set to false so that volumes with a size can
+	// be created on shares whose filesystem does not have project quotas
+	// enabled, which is the case of the shares of remote nfs servers. The
+	// capacity of those volumes is then not enforced.
+	EnforceSizeParam = "enforce_size"
 )
 
 // Implements the open storage volume interface.
@@ -44,6 +51,14 @@ type driver struct {
 	nfsServers []string
 	nfsPath    string
 	mounter    mount.Manager
+	cl         *clusterListener
+	// enforceSize is set if the capacity of the volumes must be enforced
+	enforceSize bool
+
+	// servers are the states of the nfs servers, as of their last probe
+	servers     map[string]*nfsServer
+	serversLock sync.Mutex
+	stopProbes  chan struct{}
 }
 
 func Init(params map[string]string) (volume.VolumeDriver, error) {
@@ -62,6 +77,28 @@ func Init(params map[string]string) (volume.VolumeDriver, error) {
 	//TB-FIXME: modify driver params flow to support map[string]struct/array
 	servers := strings.Split(server, ",")
 
+	probeInterval := DefaultProbeInterval
+	if interval, ok := params[ProbeIntervalParam]; ok {
+		var err error
+		if probeInterval, err = time.ParseDuration(interval); err != nil {
+			return nil, fmt.Errorf("Invalid %s %q: %v", ProbeIntervalParam, interval, err)
+		}
+		if probeInterval <= 0 {
+			return nil, fmt.Errorf("Invalid %s %q: must be positive", ProbeIntervalParam, interval)
+		}
+	}
+
+	enforceSize := true
+	if enforce, ok := params[EnforceSizeParam]; ok {
+		var err error
+		if enforceSize, err = strconv.ParseBool(enforce); err != nil {
+			return nil, fmt.Errorf("Invalid %s %q: %v", EnforceSizeParam, enforce, err)
+		}
+		if !enforceSize {
+			logrus.Warnf("Capacity of the NFS volumes is not enforced without project quotas")
+		}
+	}
+
 	// Create a mount manager for this NFS server. Blank sever is OK.
 	mounter, err := mount.New(mount.NFSMount, nil, servers, nil, []string{}, "")
 	if err != nil {
@@ -78,6 +115,10 @@ func Init(params map[string]string) (volume.VolumeDriver, error) {
 		nfsPath:           path,
 		mounter:           mounter,
 		CloudBackupDriver: volume.CloudBackupNotSupported,
+		cl:                &clusterListener{kv: kvdb.Instance()},
+		servers:           make(map[string]*nfsServer),
+		stopProbes:        make(chan struct{}),
+		enforceSize:       enforceSize,
 	}
 
 	//make directory for each nfs server
@@ -86,36 +127,24 @@ func Init(params map[string]string) (volume.VolumeDriver, error) {
 		if err := os.MkdirAll(nfsMountPath+v, 0744); err != nil {
 			return nil, err
 		}
-	}
-	src := inst.nfsPath
-	if server != "" {
-		src = ":" + inst.nfsPath
+		inst.servers[v] = &nfsServer{address: v}
 	}
 
-	//mount each nfs server
+	//mount each nfs server. The servers which cannot be mounted are down
+	//until a probe mounts them, but at least one server must be mounted.
+	var mountErr error
+	mounted := 0
 	for _, v := range inst.nfsServers {
-		// If src is already mounted at dest, leave it be.
-		mountExists, err := mounter.Exists(src, nfsMountPath+v)
-		if !mountExists {
-			// Mount the nfs server locally on a unique path.
-			syscall.Unmount(nfsMountPath+v, 0)
-			if server != "" {
-				err = syscall.Mount(
-					src,
-					nfsMountPath+v,
-					"nfs",
-					0,
-					"nolock,addr="+v,
-				)
-			} else {
-				err = syscall.Mount(src, nfsMountPath+v, "", syscall.MS_BIND, "")
-			}
-			if err != nil {
-				logrus.Printf("Unable to mount %s:%s at %s (%+v)",
-					v, inst.nfsPath, nfsMountPath+v, err)
-				return nil, err
-			}
+		if err := inst.mountServer(v); err != nil {
+			logrus.Warnf("%v", err)
+			mountErr = err
+			continue
 		}
+		inst.servers[v].mounted = true
+		mounted++
+	}
+	if mounted == 0 {
+		return nil, mountErr
 	}
 
 	volumeInfo, err := inst.StoreEnumerator.Enumerate(&api.VolumeLocator{}, nil)
@@ -128,6 +157,14 @@ func Init(params map[string]string) (volume.VolumeDriver, error) {
 		}
 	}
 
+	if c, err := cluster.Inst(); err == nil {
+		c.AddEventListener(inst.cl)
+	}
+
+	// The servers which are down cannot have volumes placed on them
+	inst.probeServers()
+	go inst.probeServersEvery(probeInterval)
+
 	logrus.Println("NFS initialized and driver mounted at: ", nfsMountPath)
 	return inst, nil
 }
@@ -140,23 +177,79 @@ func (d *driver) Type() api.DriverType {
 	return Type
 }
 
-// Status diagnostic information
+// Status returns the state of each nfs server
 func (d *driver) Status() [][2]string {
-	return [][2]string{}
+	return d.serversStatus()
 }
 
-//
-//Utility functions
-//
-func (d *driver) getNewVolumeServer() (string, error) {
-	//randomly select one
-	if d.nfsServers != nil && len(d.nfsServers) > 0 {
-		return d.nfsServers[rand.Intn(len(d.nfsServers))], nil
+// Inspect returns the volumes, which are down if their server is down as
+// seen from this node
+func (d *driver) Inspect(volumeIDs []string) ([]*api.Volume, error) {
+	vols, err := d.StoreEnumerator.Inspect(volumeIDs)
+	if err != nil {
+		return nil, err
 	}
+	d.setVolumesStatus(vols)
+	return vols, nil
+}
 
-	return "", errors.New("No NFS servers found")
+// Enumerate returns the volumes, which are down if their server is down as
+// seen from this node
+func (d *driver) Enumerate(
+	locator *api.VolumeLocator,
+	labels map[string]string,
+) ([]*api.Volume, error) {
+	vols, err := d.StoreEnumerator.Enumerate(locator, labels)
+	if err != nil {
+		return nil, err
+	}
+	d.setVolumesStatus(vols)
+	return vols, nil
 }
 
+// EnumerateWithFilter returns a page of the volumes selected by the filter,
+// which are down if their server is down as seen from this node. As the
+// stored status of the volumes is not the one seen from this node, the
+// volumes are matched against the status of the filter after it is set.
+func (d *driver) EnumerateWithFilter(
+	filter *api.VolumeFilter,
+	pageToken string,
+	maxResults int,
+) ([]*api.Volume, string, error) {
+	if filter.GetStatus() == api.VolumeStatus_VOLUME_STATUS_NONE {
+		vols, token, err := d.StoreEnumerator.EnumerateWithFilter(filter, pageToken, maxResults)
+		if err != nil {
+			return nil, "", err
+		}
+		d.setVolumesStatus(vols)
+		return vols, token, nil
+	}
+
+	unfiltered := *filter
+	unfiltered.Status = api.VolumeStatus_VOLUME_STATUS_NONE
+	vols, _, err := d.StoreEnumerator.EnumerateWithFilter(&unfiltered, pageToken, 0)
+	if err != nil {
+		return nil, "", err
+	}
+	d.setVolumesStatus(vols)
+	volumes := make([]*api.Volume, 0)
+	for _, v := range vols {
+		if v.Status != filter.Status {
+			continue
+		}
+		// A token is only returned when a volume is left for the next page
+		if maxResults > 0 && len(volumes) == maxResults {
+			return volumes, common.PageToken(volumes[len(volumes)-1].GetId()), nil
+		}
+		volumes = append(volumes, v)
+	}
+	return volumes, "", nil
+}
+
+//
+//Utility functions
+//
+
 //get nfsPath for specified volume
 func (d *driver) getNFSPath(v *api.Volume) (string, error) {
 	locator := v.GetLocator()
@@ -232,27 +325,39 @@ func (d *driver) Create(
 		locator.VolumeLabels = make(map[string]string)
 	}
 
-	//check if user passed server as option
+	//place the volume on the server passed as option or else on the
+	//server with the most space available
 	labels := locator.GetVolumeLabels()
-	_, ok := labels["server"]
-	if !ok {
-		server, err := d.getNewVolumeServer()
-		if err != nil {
-			logrus.Infof("no nfs servers found...")
-			return "", err
-		} else {
-			logrus.Infof("Assigning random nfs server: %s to volume: %s", server, volumeID)
-		}
-
-		labels["server"] = server
+	server, release, err := d.placeVolume(d.placementCandidates(locator, spec), spec.Size)
+	if err != nil {
+		logrus.Warnf("Unable to place volume %s: %v", volumeID, err)
+		return "", err
 	}
+	if _, ok := labels[serverLabel]; !ok {
+		logrus.Infof("Assigning nfs server: %s to volume: %s", server, volumeID)
+	}
+	labels[serverLabel] = server
 
-	// Create a directory on the NFS server with this UUID.
-	volPathParent := path.Join(nfsMountPath, labels["server"])
+	// Create a directory on the NFS server with this UUID. The capacity of
+	// the directory is limited to the size of the volume.
+	volPathParent := path.Join(nfsMountPath, labels[serverLabel])
 	volPath := path.Join(volPathParent, volumeID)
-	err := os.MkdirAll(volPath, 0744)
+	blockPath := path.Join(volPathParent, volumeID+nfsBlockFile)
+	err = os.MkdirAll(volPath, 0744)
 	if err != nil {
 		logrus.Println(err)
+		release()
+		return "", err
+	}
+	cleanup := func() {
+		release()
+		quota.Clear(volPath)
+		os.RemoveAll(volPath)
+		os.Remove(blockPath)
+	}
+	if err := quota.Limit(volPath, spec.Size, d.enforceSize); err != nil {
+		logrus.Warnf("Unable to limit the capacity of volume %s: %v", volumeID, err)
+		cleanup()
 		return "", err
 	}
 	if source != nil {
@@ -261,26 +366,30 @@ func (d *driver) Create(
 			if err != nil {
 				logrus.Warnf("Failed to initailize seed from %q : %v",
 					source.Seed, err)
+				cleanup()
 				return "", err
 			}
 			err = seed.Load(path.Join(volPath, config.DataDir))
 			if err != nil {
 				logrus.Warnf("Failed to  seed from %q to %q: %v",
 					source.Seed, volPathParent, err)
+				cleanup()
 				return "", err
 			}
 		}
 	}
 
-	f, err := os.Create(path.Join(volPathParent, volumeID+nfsBlockFile))
+	f, err := os.Create(blockPath)
 	if err != nil {
 		logrus.Println(err)
+		cleanup()
 		return "", err
 	}
 	defer f.Close()
 
 	if err := f.Truncate(int64(spec.Size)); err != nil {
 		logrus.Println(err)
+		cleanup()
 		return "", err
 	}
 
@@ -291,9 +400,10 @@ func (d *driver) Create(
 		source,
 		spec,
 	)
-	v.DevicePath = path.Join(volPathParent, volumeID+nfsBlockFile)
+	v.DevicePath = blockPath
 
 	if err := d.CreateVol(v); err != nil {
+		cleanup()
 		return "", err
 	}
 	return v.Id, err
@@ -315,6 +425,9 @@ func (d *driver) Delete(volumeID string) error {
 	}
 
 	// Delete the directory on the nfs server.
+	if err := quota.Clear(nfsVolPath); err != nil && err != quota.ErrNotSupported {
+		logrus.Warnf("Unable to clear the quota of volume %s: %v", volumeID, err)
+	}
 	os.RemoveAll(nfsVolPath)
 
 	err = d.DeleteVol(volumeID)
@@ -342,6 +455,9 @@ func (d *driver) Mount(volumeID string, mountpath string, options map[string]str
 		logrus.Printf("Could not find server for volume: %s", volumeID)
 		return err
 	}
+	if err := d.serverUp(v.GetLocator().GetVolumeLabels()[serverLabel]); err != nil {
+		return err
+	}
 
 	srcPath := path.Join(":", nfsPath, volumeID)
 	mountExists, err := d.mounter.Exists(srcPath, mountpath)
@@ -351,7 +467,7 @@ func (d *driver) Mount(volumeID string, mountpath string, options map[string]str
 		if err := d.mounter.Mount(
 			0, path.Join(nfsPath, volumeID),
 			mountpath,
-			string(v.Spec.Format),
+			"",
 			syscall.MS_BIND,
 			"",
 			0,
@@ -401,6 +517,12 @@ func (d *driver) Snapshot(volumeID string, readonly bool, locator *api.VolumeLoc
 	source := &api.Source{Parent: volumeID}
 	locator.Name = d.getNewSnapVolName(source.Parent)
 
+	// The snapshot is placed on the server of its parent
+	if locator.VolumeLabels == nil {
+		locator.VolumeLabels = make(map[string]string)
+	}
+	locator.VolumeLabels[serverLabel] = vols[0].GetLocator().GetVolumeLabels()[serverLabel]
+
 	logrus.Infof("Creating snap vol name: %s", locator.Name)
 	newVolumeID, err := d.Create(locator, source, vols[0].Spec)
 	if err != nil {
@@ -466,10 +588,10 @@ func (d *driver) Detach(volumeID string, options map[string]string) error {
 	return nil
 }
 
+// Set updates the locator and the spec of the volume. The
+// capacity of the volume is limited to the size of the spec, which can only
+// be grown.
 func (d *driver) Set(volumeID string, locator *api.VolumeLocator, spec *api.VolumeSpec) error {
-	if spec != nil {
-		return volume.ErrNotSupported
-	}
 	v, err := d.GetVol(volumeID)
 	if err != nil {
 		return err
@@ -477,11 +599,45 @@ func (d *driver) Set(volumeID string, locator *api.VolumeLocator, spec *api.Volu
 	if locator != nil {
 		v.Locator = locator
 	}
+	if spec != nil {
+		if spec.Size < v.Spec.Size {
+			return fmt.Errorf("Volume %s cannot be shrunk", volumeID)
+		}
+		if spec.Size != v.Spec.Size {
+			nfsVolPath, err := d.getNFSVolumePath(v)
+			if err != nil {
+				return err
+			}
+			// The simulated block volume has the size of the volume. It is
+			// shrunk back if the capacity of the volume cannot be grown.
+			if err := os.Truncate(v.DevicePath, int64(spec.Size)); err != nil {
+				return err
+			}
+			if err := quota.Limit(nfsVolPath, spec.Size, d.enforceSize); err != nil {
+				if terr := os.Truncate(v.DevicePath, int64(v.Spec.Size)); terr != nil {
+					logrus.Warnf("Unable to restore the size of %s: %v", v.DevicePath, terr)
+				}
+				return err
+			}
+		}
+		v.Spec = spec
+	}
 	return d.UpdateVol(v)
 }
 
+// UsedSize returns the size of the blocks allocated within the directory of
+// the volume on the nfs server
+func (d *driver) UsedSize(volumeID string) (uint64, error) {
+	nfsVolPath, err := d.getNFSVolumePathById(volumeID)
+	if err != nil {
+		return 0, err
+	}
+	return quota.Usage(nfsVolPath)
+}
+
 func (d *driver) Shutdown() {
 	logrus.Printf("%s Shutting down", Name)
+	close(d.stopProbes)
 
 	for _, v := range d.nfsServers {
 		logrus.Infof("Umounting: %s", nfsMountPath+v)
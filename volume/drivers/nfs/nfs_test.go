@@ -1,11 +1,20 @@
 package nfs
 
 import (
+	"errors"
+	"io/ioutil"
 	"os"
+	"path"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/cluster"
+	"github.com/libopenstorage/openstorage/pkg/quota"
 	"github.com/libopenstorage/openstorage/volume/drivers/test"
+	"github.com/portworx/kvdb"
 )
 
 var (
@@ -18,7 +27,8 @@ func TestAll(t *testing.T) {
 		t.Fatalf("Failed to create test path: %v", err)
 	}
 
-	d, err := Init(map[string]string{"path": testPath})
+	// The filesystem of the test path may not have project quotas enabled
+	d, err := Init(map[string]string{"path": testPath, EnforceSizeParam: "false"})
 	if err != nil {
 		t.Fatalf("Failed to initialize Volume Driver: %v", err)
 	}
@@ -27,3 +37,235 @@ func TestAll(t *testing.T) {
 
 	test.RunShort(t, ctx)
 }
+
+func TestSetUsedSize(t *testing.T) {
+	err := os.MkdirAll(testPath, 0744)
+	if err != nil {
+		t.Fatalf("Failed to create test path: %v", err)
+	}
+
+	d, err := Init(map[string]string{"path": testPath, EnforceSizeParam: "false"})
+	if err != nil {
+		t.Fatalf("Failed to initialize Volume Driver: %v", err)
+	}
+	defer d.Shutdown()
+
+	id, err := d.Create(
+		&api.VolumeLocator{Name: "nfs-sized"},
+		nil,
+		&api.VolumeSpec{Size: 16 * 1024 * 1024})
+	require.NoError(t, err)
+	defer d.Delete(id)
+
+	volPath, err := d.(*driver).getNFSVolumePathById(id)
+	require.NoError(t, err)
+	empty, err := d.UsedSize(id)
+	require.NoError(t, err)
+	err = ioutil.WriteFile(path.Join(volPath, "file"), make([]byte, 1024*1024), 0644)
+	require.NoError(t, err)
+	used, err := d.UsedSize(id)
+	require.NoError(t, err)
+	require.True(t, used >= empty+1024*1024, "Expected the file to be used, got %d bytes", used)
+
+	require.Error(t, d.Set(id, nil, &api.VolumeSpec{Size: 1024 * 1024}), "Shrink must fail")
+	require.NoError(t, d.Set(id, nil, &api.VolumeSpec{Size: 32 * 1024 * 1024}))
+	vols, err := d.Inspect([]string{id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(32*1024*1024), vols[0].Spec.Size)
+	info, err := os.Stat(vols[0].DevicePath)
+	require.NoError(t, err)
+	require.Equal(t, int64(32*1024*1024), info.Size())
+}
+
+func TestEnforceSize(t *testing.T) {
+	err := os.MkdirAll(testPath, 0744)
+	if err != nil {
+		t.Fatalf("Failed to create test path: %v", err)
+	}
+	if err := quota.Set(testPath, 0); err != quota.ErrNotSupported {
+		t.Skipf("Project quotas are enabled on the filesystem of %s", testPath)
+	}
+
+	_, err = Init(map[string]string{"path": testPath, EnforceSizeParam: "maybe"})
+	require.Error(t, err)
+	d, err := Init(map[string]string{"path": testPath})
+	if err != nil {
+		t.Fatalf("Failed to initialize Volume Driver: %v", err)
+	}
+	defer d.Shutdown()
+
+	// Volumes with a size cannot be created without project quotas
+	before, err := ioutil.ReadDir(mountPath(""))
+	require.NoError(t, err)
+	_, err = d.Create(&api.VolumeLocator{Name: "nfs-enforced"}, nil, &api.VolumeSpec{Size: 1024})
+	require.Error(t, err)
+	after, err := ioutil.ReadDir(mountPath(""))
+	require.NoError(t, err)
+	require.Len(t, after, len(before), "The directory of the volume must be removed")
+
+	// Volumes without a size are not limited
+	id, err := d.Create(&api.VolumeLocator{Name: "nfs-unlimited"}, nil, &api.VolumeSpec{})
+	require.NoError(t, err)
+	defer d.Delete(id)
+	require.Error(t, d.Set(id, nil, &api.VolumeSpec{Size: 1024}))
+	vols, err := d.Inspect([]string{id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), vols[0].Spec.Size)
+	info, err := os.Stat(vols[0].DevicePath)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), info.Size(), "The block file must be shrunk back")
+}
+
+func TestPlacement(t *testing.T) {
+	d := &driver{
+		nfsServers: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"},
+		servers: map[string]*nfsServer{
+			"10.0.0.1": {address: "10.0.0.1", up: true, available: 100},
+			"10.0.0.2": {address: "10.0.0.2", up: true, available: 300},
+			"10.0.0.3": {address: "10.0.0.3", up: false, available: 500},
+		},
+	}
+
+	// The server up with the most space available is picked
+	server, _, err := d.placeVolume(d.nfsServers, 150)
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.2", server)
+	// The space of the volume is deducted until the next probe
+	server, _, err = d.placeVolume(d.nfsServers, 50)
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.2", server)
+	server, release, err := d.placeVolume(d.nfsServers, 50)
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.1", server)
+	_, _, err = d.placeVolume(d.nfsServers, 200)
+	require.Error(t, err, "No server has the space available")
+	_, _, err = d.placeVolume([]string{"10.0.0.3"}, 1)
+	require.Error(t, err, "Server is down")
+	_, _, err = d.placeVolume([]string{"10.0.0.4"}, 1)
+	require.Error(t, err, "Server is unknown")
+
+	// The space of a volume which is not created is given back, unless the
+	// server has been probed since
+	release()
+	require.Equal(t, uint64(100), d.servers["10.0.0.1"].available)
+	_, release, err = d.placeVolume(d.nfsServers, 50)
+	require.NoError(t, err)
+	d.servers["10.0.0.2"].available = 400
+	d.servers["10.0.0.2"].probes++
+	release()
+	require.Equal(t, uint64(400), d.servers["10.0.0.2"].available)
+
+	// The locator sets the server, else the spec lists the servers
+	require.Equal(t, d.nfsServers, d.placementCandidates(&api.VolumeLocator{}, &api.VolumeSpec{}))
+	require.Equal(t,
+		[]string{"10.0.0.1", "10.0.0.3"},
+		d.placementCandidates(
+			&api.VolumeLocator{},
+			&api.VolumeSpec{VolumeLabels: map[string]string{"server": "10.0.0.1, 10.0.0.3"}}))
+	require.Equal(t,
+		[]string{"10.0.0.2"},
+		d.placementCandidates(
+			&api.VolumeLocator{VolumeLabels: map[string]string{"server": "10.0.0.2"}},
+			&api.VolumeSpec{VolumeLabels: map[string]string{"server": "10.0.0.1"}}))
+}
+
+func TestServerDown(t *testing.T) {
+	err := os.MkdirAll(testPath, 0744)
+	if err != nil {
+		t.Fatalf("Failed to create test path: %v", err)
+	}
+
+	d, err := Init(map[string]string{
+		"path":             testPath,
+		ProbeIntervalParam: "1h",
+		EnforceSizeParam:   "false",
+	})
+	if err != nil {
+		t.Fatalf("Failed to initialize Volume Driver: %v", err)
+	}
+	defer d.Shutdown()
+
+	status := d.Status()
+	require.Len(t, status, 1)
+	require.Equal(t, "Server "+testPath, status[0][0])
+	require.Contains(t, status[0][1], "Up since")
+
+	id, err := d.Create(&api.VolumeLocator{Name: "nfs-down"}, nil, &api.VolumeSpec{Size: 1024})
+	require.NoError(t, err)
+	defer d.Delete(id)
+	mountPath, err := ioutil.TempDir("", "nfs-down")
+	require.NoError(t, err)
+	defer os.RemoveAll(mountPath)
+
+	// The volumes of the server are down until it comes back up, as seen
+	// from this node only
+	d.(*driver).updateServer("", nil, errors.New("unreachable"))
+	vols, err := d.Inspect([]string{id})
+	require.NoError(t, err)
+	require.Equal(t, api.VolumeStatus_VOLUME_STATUS_DOWN, vols[0].Status)
+	vols, err = d.Enumerate(&api.VolumeLocator{Name: "nfs-down"}, nil)
+	require.NoError(t, err)
+	require.Len(t, vols, 1)
+	require.Equal(t, api.VolumeStatus_VOLUME_STATUS_DOWN, vols[0].Status)
+	vols, token, err := d.EnumerateWithFilter(
+		&api.VolumeFilter{Status: api.VolumeStatus_VOLUME_STATUS_DOWN}, "", 1)
+	require.NoError(t, err)
+	require.Len(t, vols, 1)
+	require.Equal(t, id, vols[0].Id)
+	require.Empty(t, token)
+	vols, _, err = d.EnumerateWithFilter(
+		&api.VolumeFilter{Status: api.VolumeStatus_VOLUME_STATUS_UP}, "", 0)
+	require.NoError(t, err)
+	require.Empty(t, vols)
+	stored, err := d.(*driver).GetVol(id)
+	require.NoError(t, err)
+	require.Equal(t, api.VolumeStatus_VOLUME_STATUS_UP, stored.Status,
+		"The status seen from this node is not stored")
+	require.Contains(t, d.Status()[0][1], "Down since")
+	require.Error(t, d.Mount(id, mountPath, nil), "Server is down")
+	_, err = d.Create(&api.VolumeLocator{Name: "nfs-down-create"}, nil, &api.VolumeSpec{Size: 1024})
+	require.Error(t, err, "Server is down")
+
+	d.(*driver).probeServer("")
+	vols, err = d.Inspect([]string{id})
+	require.NoError(t, err)
+	require.Equal(t, api.VolumeStatus_VOLUME_STATUS_UP, vols[0].Status)
+	require.NoError(t, d.Mount(id, mountPath, nil))
+	require.NoError(t, d.Unmount(id, mountPath, nil))
+}
+
+func TestServerAlerts(t *testing.T) {
+	cl := &clusterListener{kv: kvdb.Instance()}
+	err := cl.Join(nil, &cluster.ClusterInitState{ClusterInfo: &cluster.ClusterInfo{Id: "nfs-alerts"}}, nil)
+	require.NoError(t, err)
+	enumerate := func() []*api.Alert {
+		alerts, err := cl.EnumerateAlerts(
+			time.Now().Add(-time.Hour),
+			time.Now().Add(time.Hour),
+			api.ResourceType_RESOURCE_TYPE_CLUSTER)
+		require.NoError(t, err)
+		return alerts.Alert
+	}
+
+	// A single alert is raised while the server is unreachable
+	cl.serverUnreachable("10.0.0.1", errors.New("unreachable"))
+	cl.serverUnreachable("10.0.0.1", errors.New("unreachable"))
+	alerts := enumerate()
+	require.Len(t, alerts, 1)
+	require.Equal(t, "10.0.0.1", alerts[0].ResourceId)
+	require.Equal(t, api.SeverityType_SEVERITY_TYPE_ALARM, alerts[0].Severity)
+	require.False(t, alerts[0].Cleared)
+	outage := alerts[0].Id
+
+	cl.serverReachable("10.0.0.1")
+	alerts = enumerate()
+	require.Len(t, alerts, 1)
+	require.True(t, alerts[0].Cleared)
+
+	// The next outage raises a new alert
+	cl.serverUnreachable("10.0.0.1", errors.New("unreachable"))
+	alerts = enumerate()
+	require.Len(t, alerts, 1)
+	require.False(t, alerts[0].Cleared)
+	require.NotEqual(t, outage, alerts[0].Id)
+}
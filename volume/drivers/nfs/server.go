@@ -0,0 +1,325 @@
+package nfs
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/units"
+)
+
+const (
+	// ProbeIntervalParam is the interval between the probes of the nfs
+	// servers, as a duration such as 30s
+	ProbeIntervalParam = "probe_interval"
+	// DefaultProbeInterval is the interval between the probes of the nfs
+	// servers if none is configured
+	DefaultProbeInterval = 30 * time.Second
+	// probeTimeout is the time after which a server which does not answer
+	// its probe is down
+	probeTimeout = 10 * time.Second
+	// serverLabel is the label of the server of a volume. In a spec, the
+	// label lists the servers a volume can be placed on, separated by
+	// commas.
+	serverLabel = "server"
+)
+
+// nfsServer is the state of an nfs server, as of its last probe
+type nfsServer struct {
+	address string
+	// mounted is set once the export of the server is mounted
+	mounted bool
+	// up is set if the server answered its last probe
+	up bool
+	// since is the time of the last change of up, which is zero until the
+	// server is probed
+	since time.Time
+	// err is the reason the server is down
+	err error
+	// capacity and available are the sizes of the filesystem of the export
+	capacity  uint64
+	available uint64
+	// probing is set while a probe of the server is in progress
+	probing bool
+	// probes is the number of probes which got the capacity of the server
+	probes uint64
+}
+
+// probeResult is the result of the probe of a server
+type probeResult struct {
+	statfs syscall.Statfs_t
+	err    error
+}
+
+// mountPath returns the path the export of the server is mounted on
+func mountPath(address string) string {
+	return nfsMountPath + address
+}
+
+// serverName returns the name of the server in the status and the alerts.
+// A server without address is a bind mount of the path of the driver.
+func (d *driver) serverName(address string) string {
+	if address == "" {
+		return d.nfsPath
+	}
+	return address
+}
+
+// mountServer mounts the export of the server, unless it is already mounted
+func (d *driver) mountServer(address string) error {
+	src := d.nfsPath
+	if address != "" {
+		src = ":" + d.nfsPath
+	}
+	// If src is already mounted at dest, leave it be.
+	if mountExists, _ := d.mounter.Exists(src, mountPath(address)); mountExists {
+		return nil
+	}
+	// Mount the nfs server locally on a unique path.
+	syscall.Unmount(mountPath(address), 0)
+	var err error
+	if address != "" {
+		err = syscall.Mount(src, mountPath(address), "nfs", 0, "nolock,addr="+address)
+	} else {
+		err = syscall.Mount(src, mountPath(address), "", syscall.MS_BIND, "")
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to mount %s:%s at %s: %v",
+			address, d.nfsPath, mountPath(address), err)
+	}
+	return nil
+}
+
+// probeServers probes all the servers and waits for their probes
+func (d *driver) probeServers() {
+	var wg sync.WaitGroup
+	for _, address := range d.nfsServers {
+		wg.Add(1)
+		go func(address string) {
+			defer wg.Done()
+			d.probeServer(address)
+		}(address)
+	}
+	wg.Wait()
+}
+
+// probeServersEvery probes the servers every interval until the driver is shut
+// down
+func (d *driver) probeServersEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			d.probeServers()
+		case <-d.stopProbes:
+			return
+		}
+	}
+}
+
+// probeServer mounts the export of the server if it is not mounted and gets
+// the capacity of its filesystem. The server is down if this fails or does
+// not complete within probeTimeout. A probe which does not complete keeps
+// running, and the server remains down until it completes.
+func (d *driver) probeServer(address string) {
+	d.serversLock.Lock()
+	s := d.servers[address]
+	if s.probing {
+		d.serversLock.Unlock()
+		d.updateServer(address, nil, fmt.Errorf("Last probe has not completed"))
+		return
+	}
+	s.probing = true
+	mounted := s.mounted
+	d.serversLock.Unlock()
+
+	done := make(chan *probeResult, 1)
+	go func() {
+		r := &probeResult{}
+		if !mounted {
+			r.err = d.mountServer(address)
+		}
+		if r.err == nil {
+			r.err = syscall.Statfs(mountPath(address), &r.statfs)
+		}
+		d.serversLock.Lock()
+		s.probing = false
+		if !mounted && r.err == nil {
+			s.mounted = true
+		}
+		d.serversLock.Unlock()
+		done <- r
+	}()
+
+	select {
+	case r := <-done:
+		if r.err != nil {
+			d.updateServer(address, nil, r.err)
+		} else {
+			d.updateServer(address, &r.statfs, nil)
+		}
+	case <-time.After(probeTimeout):
+		d.updateServer(address, nil, fmt.Errorf("Probe timed out after %v", probeTimeout))
+	}
+}
+
+// updateServer updates the state of the server with the result of its
+// probe. When the server goes down or comes back up, the alert of the server
+// is raised or cleared. The state is not stored with the volumes as each
+// node probes the servers on its own, and a server may only be unreachable
+// from some of the nodes.
+func (d *driver) updateServer(address string, statfs *syscall.Statfs_t, err error) {
+	d.serversLock.Lock()
+	s := d.servers[address]
+	up := err == nil
+	changed := s.since.IsZero() || s.up != up
+	s.up = up
+	s.err = err
+	if statfs != nil {
+		s.capacity = statfs.Blocks * uint64(statfs.Bsize)
+		s.available = statfs.Bavail * uint64(statfs.Bsize)
+		s.probes++
+	}
+	if changed {
+		s.since = time.Now()
+	}
+	d.serversLock.Unlock()
+	if !changed {
+		return
+	}
+
+	if up {
+		logrus.Infof("NFS server %s is up", d.serverName(address))
+		d.cl.serverReachable(d.serverName(address))
+	} else {
+		logrus.Warnf("NFS server %s is down: %v", d.serverName(address), err)
+		d.cl.serverUnreachable(d.serverName(address), err)
+	}
+}
+
+// setVolumesStatus sets the status of the volumes placed on a server which
+// is down to down, as seen from this node
+func (d *driver) setVolumesStatus(vols []*api.Volume) {
+	d.serversLock.Lock()
+	defer d.serversLock.Unlock()
+	for _, v := range vols {
+		server, ok := v.GetLocator().GetVolumeLabels()[serverLabel]
+		if !ok {
+			continue
+		}
+		if s, ok := d.servers[server]; ok && !s.up {
+			v.Status = api.VolumeStatus_VOLUME_STATUS_DOWN
+		}
+	}
+}
+
+// serverUp returns an error if the server is known to be down from this node
+func (d *driver) serverUp(address string) error {
+	d.serversLock.Lock()
+	defer d.serversLock.Unlock()
+	if s, ok := d.servers[address]; ok && !s.up {
+		return fmt.Errorf("NFS server %s is down: %v", d.serverName(address), s.err)
+	}
+	return nil
+}
+
+// placementCandidates returns the servers a volume can be placed on. The
+// server can be set by the label of the locator, or else chosen among the
+// servers listed by the label of the spec, or else among all the servers.
+func (d *driver) placementCandidates(
+	locator *api.VolumeLocator,
+	spec *api.VolumeSpec,
+) []string {
+	if server, ok := locator.GetVolumeLabels()[serverLabel]; ok {
+		return []string{server}
+	}
+	if servers, ok := spec.GetVolumeLabels()[serverLabel]; ok {
+		candidates := make([]string, 0)
+		for _, server := range strings.Split(servers, ",") {
+			candidates = append(candidates, strings.TrimSpace(server))
+		}
+		return candidates
+	}
+	return d.nfsServers
+}
+
+// placeVolume returns the server, among the candidates, which is up and has
+// the most space available, which must be at least the size of the volume.
+// The size is deducted from the space available of the server until its next
+// probe so that the volumes created meanwhile are spread across the
+// servers. The returned function gives the space back if the volume is not
+// created.
+func (d *driver) placeVolume(candidates []string, size uint64) (string, func(), error) {
+	if len(candidates) == 0 {
+		return "", nil, fmt.Errorf("No NFS servers found")
+	}
+	d.serversLock.Lock()
+	defer d.serversLock.Unlock()
+
+	var placed *nfsServer
+	for _, address := range candidates {
+		s, ok := d.servers[address]
+		if !ok {
+			return "", nil, fmt.Errorf("Unknown NFS server %s", address)
+		}
+		if !s.up || s.available < size {
+			continue
+		}
+		if placed == nil || s.available > placed.available {
+			placed = s
+		}
+	}
+	if placed == nil {
+		names := make([]string, 0, len(candidates))
+		for _, address := range candidates {
+			names = append(names, d.serverName(address))
+		}
+		return "", nil, fmt.Errorf("No NFS server is up with %s available among %s",
+			units.String(size), strings.Join(names, ", "))
+	}
+	placed.available -= size
+	probes := placed.probes
+	release := func() {
+		d.serversLock.Lock()
+		defer d.serversLock.Unlock()
+		// The space available of a server probed since is up to date
+		if placed.probes == probes {
+			placed.available += size
+		}
+	}
+	return placed.address, release, nil
+}
+
+// serversStatus returns the status of each server
+func (d *driver) serversStatus() [][2]string {
+	d.serversLock.Lock()
+	defer d.serversLock.Unlock()
+
+	status := make([][2]string, 0, len(d.nfsServers))
+	for _, address := range d.nfsServers {
+		s := d.servers[address]
+		var state string
+		switch {
+		case s.since.IsZero():
+			state = "Not probed"
+		case s.up:
+			state = fmt.Sprintf("Up since %s, %s available of %s",
+				s.since.Format(time.RFC3339),
+				units.String(s.available),
+				units.String(s.capacity))
+		default:
+			state = fmt.Sprintf("Down since %s: %v", s.since.Format(time.RFC3339), s.err)
+		}
+		status = append(status, [2]string{
+			"Server " + d.serverName(address),
+			state,
+		})
+	}
+	return status
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/pkg/clone"
+	"github.com/libopenstorage/openstorage/pkg/quota"
 	"github.com/libopenstorage/openstorage/volume"
 	"github.com/libopenstorage/openstorage/volume/drivers/common"
 	"github.com/pborman/uuid"
@@ -25,28 +27,35 @@ const (
 	Type = api.DriverType_DRIVER_TYPE_FILE
 	// freezebin free binary
 	freezebin = "/usr/sbin/fsfreeze"
+	// RootParam is the parameter of the directory of the volumes, which
+	// is volume.VolumeBase if it is not set
+	RootParam = "home"
 )
 
 type driver struct {
 	volume.IODriver
 	volume.BlockDriver
-	volume.SnapshotDriver
 	volume.StoreEnumerator
 	volume.StatsDriver
 	volume.CredsDriver
 	volume.CloudBackupDriver
+	home string
 }
 
 // Init Driver intialization.
 func Init(params map[string]string) (volume.VolumeDriver, error) {
+	home, ok := params[RootParam]
+	if !ok {
+		home = volume.VolumeBase
+	}
 	return &driver{
 		volume.IONotSupported,
 		volume.BlockNotSupported,
-		volume.SnapshotNotSupported,
 		common.NewDefaultStoreEnumerator(Name, kvdb.Instance()),
 		volume.StatsNotSupported,
 		volume.CredsNotSupported,
 		volume.CloudBackupNotSupported,
+		home,
 	}, nil
 }
 
@@ -58,10 +67,23 @@ func (d *driver) Type() api.DriverType {
 	return Type
 }
 
+// Create creates the directory of the volume, whose capacity is limited to
+// the size of the volume. The directory of a volume created from a parent
+// is a copy of the directory of the parent.
 func (d *driver) Create(locator *api.VolumeLocator, source *api.Source, spec *api.VolumeSpec) (string, error) {
+	if source != nil && len(source.Parent) != 0 {
+		if _, err := d.GetVol(source.Parent); err != nil {
+			return "", err
+		}
+		return d.clone(source.Parent, locator, source, spec, false)
+	}
 	volumeID := strings.TrimSuffix(uuid.New(), "\n")
 	// Create a directory on the Local machine with this UUID.
-	if err := os.MkdirAll(filepath.Join(volume.VolumeBase, string(volumeID)), 0744); err != nil {
+	if err := os.MkdirAll(d.volumePath(volumeID), 0744); err != nil {
+		return "", err
+	}
+	if err := quota.Limit(d.volumePath(volumeID), spec.Size, false); err != nil {
+		os.RemoveAll(d.volumePath(volumeID))
 		return "", err
 	}
 	v := common.NewVolume(
@@ -71,7 +93,7 @@ func (d *driver) Create(locator *api.VolumeLocator, source *api.Source, spec *ap
 		source,
 		spec,
 	)
-	v.DevicePath = filepath.Join(volume.VolumeBase, volumeID)
+	v.DevicePath = d.volumePath(volumeID)
 	if err := d.CreateVol(v); err != nil {
 		return "", err
 	}
@@ -82,7 +104,10 @@ func (d *driver) Delete(volumeID string) error {
 	if _, err := d.GetVol(volumeID); err != nil {
 		return err
 	}
-	os.RemoveAll(filepath.Join(volume.VolumeBase, string(volumeID)))
+	if err := quota.Clear(d.volumePath(volumeID)); err != nil && err != quota.ErrNotSupported {
+		logrus.Warnf("Unable to clear the quota of volume %s: %v", volumeID, err)
+	}
+	os.RemoveAll(d.volumePath(volumeID))
 	if err := d.DeleteVol(volumeID); err != nil {
 		return err
 	}
@@ -102,23 +127,35 @@ func (d *driver) Mount(volumeID string, mountpath string, options map[string]str
 		logrus.Println(err)
 		return err
 	}
-	if len(v.AttachPath) > 0 && len(v.AttachPath) > 0 {
+	if len(v.AttachPath) > 0 {
 		return fmt.Errorf("Volume %q already mounted at %q", volumeID, v.AttachPath[0])
 	}
 	syscall.Unmount(mountpath, 0)
 	if err := syscall.Mount(
-		filepath.Join(volume.VolumeBase, string(volumeID)),
+		d.volumePath(volumeID),
 		mountpath,
-		string(v.Spec.Format),
+		"",
 		syscall.MS_BIND, "",
 	); err != nil {
 		logrus.Printf("Cannot mount %s at %s because %+v",
-			filepath.Join(volume.VolumeBase, string(volumeID)),
+			d.volumePath(volumeID),
 			mountpath,
 			err,
 		)
 		return err
 	}
+	// Bind mounts can only be made read only once mounted
+	if v.Readonly {
+		if err := syscall.Mount(
+			"",
+			mountpath,
+			"",
+			syscall.MS_BIND|syscall.MS_REMOUNT|syscall.MS_RDONLY, "",
+		); err != nil {
+			syscall.Unmount(mountpath, 0)
+			return err
+		}
+	}
 	if v.AttachPath == nil {
 		v.AttachPath = make([]string, 1)
 	}
@@ -143,10 +180,11 @@ func (d *driver) Unmount(volumeID string, mountpath string, options map[string]s
 	return d.UpdateVol(v)
 }
 
+// Set updates the locator and the spec of the volume. The
+// capacity of the volume is limited to the size of the spec, which can only
+// be grown. The capacity is not enforced if the filesystem does not have
+// project quotas enabled.
 func (d *driver) Set(volumeID string, locator *api.VolumeLocator, spec *api.VolumeSpec) error {
-	if spec != nil {
-		return volume.ErrNotSupported
-	}
 	v, err := d.GetVol(volumeID)
 	if err != nil {
 		return err
@@ -154,9 +192,125 @@ func (d *driver) Set(volumeID string, locator *api.VolumeLocator, spec *api.Volu
 	if locator != nil {
 		v.Locator = locator
 	}
+	if spec != nil {
+		if spec.Size < v.Spec.Size {
+			return fmt.Errorf("Volume %s cannot be shrunk", volumeID)
+		}
+		if spec.Size != v.Spec.Size {
+			if err := quota.Limit(d.volumePath(volumeID), spec.Size, false); err != nil {
+				return err
+			}
+		}
+		v.Spec = spec
+	}
 	return d.UpdateVol(v)
 }
 
+// UsedSize returns the size of the blocks allocated within the directory of
+// the volume
+func (d *driver) UsedSize(volumeID string) (uint64, error) {
+	if _, err := d.GetVol(volumeID); err != nil {
+		return 0, err
+	}
+	return quota.Usage(d.volumePath(volumeID))
+}
+
+// Snapshot creates a volume whose directory is a copy of the directory of
+// the volume
+func (d *driver) Snapshot(volumeID string, readonly bool, locator *api.VolumeLocator) (string, error) {
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return "", err
+	}
+	return d.clone(volumeID, locator, &api.Source{Parent: volumeID}, v.Spec, readonly)
+}
+
+// Restore atomically replaces the directory of the volume, which must not be
+// mounted, with a copy of the directory of its snapshot
+func (d *driver) Restore(volumeID string, snapID string) error {
+	v, err := d.GetVol(volumeID)
+	if err != nil {
+		return err
+	}
+	snap, err := d.GetVol(snapID)
+	if err != nil {
+		return err
+	}
+	if snap.GetSource().GetParent() != volumeID {
+		return fmt.Errorf("Volume %s is not a snapshot of volume %s", snapID, volumeID)
+	}
+	// The mounts of the volume would keep the content being replaced
+	if len(v.AttachPath) != 0 {
+		return fmt.Errorf("Volume %s is mounted at %v", volumeID, v.AttachPath)
+	}
+
+	restorePath := d.volumePath(volumeID) + ".restore"
+	os.RemoveAll(restorePath)
+	if err := clone.Dir(d.volumePath(snapID), restorePath); err != nil {
+		return err
+	}
+	if err := quota.Limit(restorePath, v.Spec.Size, false); err != nil {
+		os.RemoveAll(restorePath)
+		return err
+	}
+	if err := clone.Exchange(restorePath, d.volumePath(volumeID)); err != nil {
+		quota.Clear(restorePath)
+		os.RemoveAll(restorePath)
+		return err
+	}
+	// restorePath is now the previous content of the volume
+	if err := quota.Clear(restorePath); err != nil && err != quota.ErrNotSupported {
+		logrus.Warnf("Unable to clear the quota of %s: %v", restorePath, err)
+	}
+	if err := os.RemoveAll(restorePath); err != nil {
+		logrus.Warnf("Unable to remove %s: %v", restorePath, err)
+	}
+	return nil
+}
+
+func (d *driver) SnapshotGroup(groupID string, labels map[string]string) (*api.GroupSnapCreateResponse, error) {
+	return nil, volume.ErrNotSupported
+}
+
+// clone creates a volume whose directory is a copy of the directory of the
+// parent, recording the parent in the source of the volume
+func (d *driver) clone(
+	parentID string,
+	locator *api.VolumeLocator,
+	source *api.Source,
+	spec *api.VolumeSpec,
+	readonly bool,
+) (string, error) {
+	volumeID := strings.TrimSuffix(uuid.New(), "\n")
+	if err := clone.Dir(d.volumePath(parentID), d.volumePath(volumeID)); err != nil {
+		return "", err
+	}
+	if err := quota.Limit(d.volumePath(volumeID), spec.Size, false); err != nil {
+		os.RemoveAll(d.volumePath(volumeID))
+		return "", err
+	}
+	v := common.NewVolume(
+		volumeID,
+		api.FSType_FS_TYPE_VFS,
+		locator,
+		source,
+		spec,
+	)
+	v.Readonly = readonly
+	v.DevicePath = d.volumePath(volumeID)
+	if err := d.CreateVol(v); err != nil {
+		quota.Clear(d.volumePath(volumeID))
+		os.RemoveAll(d.volumePath(volumeID))
+		return "", err
+	}
+	return v.Id, nil
+}
+
+// volumePath returns the directory of the volume
+func (d *driver) volumePath(volumeID string) string {
+	return filepath.Join(d.home, volumeID)
+}
+
 func (d *driver) Status() [][2]string {
 	return [][2]string{}
 }
@@ -0,0 +1,149 @@
+package vfs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/libopenstorage/openstorage/api"
+	"github.com/libopenstorage/openstorage/volume"
+	"github.com/portworx/kvdb"
+	"github.com/portworx/kvdb/mem"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	kv, err := kvdb.New(mem.Name, "vfs_test", []string{}, nil, logrus.Panicf)
+	if err != nil {
+		logrus.Panicf("Failed to initialize KVDB")
+	}
+	if err := kvdb.SetInstance(kv); err != nil {
+		logrus.Panicf("Failed to set KVDB instance")
+	}
+}
+
+func newDriver(t *testing.T) (volume.VolumeDriver, string) {
+	home, err := ioutil.TempDir("", "vfs")
+	require.NoError(t, err)
+	d, err := Init(map[string]string{RootParam: home})
+	require.NoError(t, err)
+	return d, home
+}
+
+func readVolumeFile(t *testing.T, home, volumeID, name string) string {
+	data, err := ioutil.ReadFile(filepath.Join(home, volumeID, name))
+	require.NoError(t, err)
+	return string(data)
+}
+
+func writeVolumeFile(t *testing.T, home, volumeID, name, data string) {
+	require.NoError(t, ioutil.WriteFile(filepath.Join(home, volumeID, name), []byte(data), 0644))
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	d, home := newDriver(t)
+	defer os.RemoveAll(home)
+
+	id, err := d.Create(&api.VolumeLocator{Name: "vol"}, nil, &api.VolumeSpec{Size: 1})
+	require.NoError(t, err)
+	writeVolumeFile(t, home, id, "file", "before")
+
+	snapID, err := d.Snapshot(id, true, &api.VolumeLocator{
+		Name:         "snap",
+		VolumeLabels: map[string]string{"oh": "snap"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "before", readVolumeFile(t, home, snapID, "file"))
+
+	snaps, err := d.SnapEnumerate([]string{id}, nil)
+	require.NoError(t, err)
+	require.Len(t, snaps, 1)
+	require.Equal(t, snapID, snaps[0].Id)
+	require.Equal(t, id, snaps[0].Source.Parent)
+	require.True(t, snaps[0].Readonly)
+
+	writeVolumeFile(t, home, id, "file", "after")
+	writeVolumeFile(t, home, id, "new", "new")
+	require.Equal(t, "before", readVolumeFile(t, home, snapID, "file"))
+
+	require.Error(t, d.Restore(snapID, id), "Restore of a volume which is not a snapshot must fail")
+	require.NoError(t, d.Restore(id, snapID))
+	require.Equal(t, "before", readVolumeFile(t, home, id, "file"))
+	_, err = os.Stat(filepath.Join(home, id, "new"))
+	require.True(t, os.IsNotExist(err), "Restore must remove the files created after the snapshot")
+	_, err = os.Stat(filepath.Join(home, id+".restore"))
+	require.True(t, os.IsNotExist(err))
+
+	// The snapshot is kept by the restore
+	require.Equal(t, "before", readVolumeFile(t, home, snapID, "file"))
+
+	require.NoError(t, d.Delete(snapID))
+	require.NoError(t, d.Delete(id))
+}
+
+func TestClone(t *testing.T) {
+	d, home := newDriver(t)
+	defer os.RemoveAll(home)
+
+	id, err := d.Create(&api.VolumeLocator{Name: "parent"}, nil, &api.VolumeSpec{Size: 1})
+	require.NoError(t, err)
+	defer d.Delete(id)
+	writeVolumeFile(t, home, id, "file", "parent")
+
+	cloneID, err := d.Create(
+		&api.VolumeLocator{Name: "clone"},
+		&api.Source{Parent: id},
+		&api.VolumeSpec{Size: 1},
+	)
+	require.NoError(t, err)
+	defer d.Delete(cloneID)
+	require.Equal(t, "parent", readVolumeFile(t, home, cloneID, "file"))
+
+	vols, err := d.Inspect([]string{cloneID})
+	require.NoError(t, err)
+	require.Len(t, vols, 1)
+	require.Equal(t, id, vols[0].Source.Parent)
+	require.False(t, vols[0].Readonly)
+	require.Equal(t, filepath.Join(home, cloneID), vols[0].DevicePath)
+
+	writeVolumeFile(t, home, cloneID, "file", "clone")
+	require.Equal(t, "parent", readVolumeFile(t, home, id, "file"))
+
+	_, err = d.Create(
+		&api.VolumeLocator{Name: "orphan"},
+		&api.Source{Parent: "missing"},
+		&api.VolumeSpec{Size: 1},
+	)
+	require.Error(t, err)
+}
+
+func TestSetUsedSize(t *testing.T) {
+	d, home := newDriver(t)
+	defer os.RemoveAll(home)
+
+	id, err := d.Create(&api.VolumeLocator{Name: "sized"}, nil, &api.VolumeSpec{Size: 16 * 1024 * 1024})
+	require.NoError(t, err)
+	defer d.Delete(id)
+
+	empty, err := d.UsedSize(id)
+	require.NoError(t, err)
+	writeVolumeFile(t, home, id, "file", string(make([]byte, 1024*1024)))
+	used, err := d.UsedSize(id)
+	require.NoError(t, err)
+	require.True(t, used >= empty+1024*1024, "Expected the file to be used, got %d bytes", used)
+
+	require.Error(t, d.Set(id, nil, &api.VolumeSpec{Size: 1024 * 1024}), "Shrink must fail")
+	require.NoError(t, d.Set(id, nil, &api.VolumeSpec{
+		Size:      32 * 1024 * 1024,
+		Ownership: &api.Ownership{Owner: "user"},
+	}))
+	vols, err := d.Inspect([]string{id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(32*1024*1024), vols[0].Spec.Size)
+	require.Equal(t, "user", vols[0].Spec.Ownership.Owner)
+
+	_, err = d.UsedSize("missing")
+	require.Error(t, err)
+}
@@ -206,6 +206,16 @@ type Enumerator interface {
 	Enumerate(locator *api.VolumeLocator, labels map[string]string) ([]*api.Volume, error)
 	// Enumerate snaps for specified volumes
 	SnapEnumerate(volID []string, snapLabels map[string]string) ([]*api.Volume, error)
+	// EnumerateWithFilter returns, in the order of their ids, the volumes
+	// selected by the filter starting at the page token. At most maxResults
+	// volumes are returned, or all of them if maxResults is 0, with the
+	// token of the next page, which is empty on the last page.
+	// Errors ErrEinval may be returned for invalid page tokens.
+	EnumerateWithFilter(
+		filter *api.VolumeFilter,
+		pageToken string,
+		maxResults int,
+	) ([]*api.Volume, string, error)
 }
 
 // StoreEnumerator combines Store and Enumerator capabilities